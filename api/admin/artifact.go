@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/artifact"
+	"github.com/go-vela/server/database"
+	sMiddleware "github.com/go-vela/server/router/middleware/settings"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation GET /api/v1/admin/artifacts/retention admin GetArtifactRetention
+//
+// Get a dry-run report of the artifacts the retention rules would remove
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully planned artifact retention
+//     schema:
+//       "$ref": "#/definitions/ArtifactRetentionReport"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetArtifactRetention represents the API handler to report the artifacts
+// the platform artifact retention rules would remove, without removing them.
+func GetArtifactRetention(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	s := sMiddleware.FromContext(c)
+	ctx := c.Request.Context()
+
+	l.Debug("platform admin: planning artifact retention")
+
+	// check captured value because we aren't retrieving settings from the database
+	// instead we are retrieving the auto-refreshed middleware value
+	if s == nil {
+		retErr := fmt.Errorf("platform settings not found")
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	report, err := artifact.PlanRetention(ctx, database.FromContext(c), s.GetArtifactRetention(), time.Now())
+	if err != nil {
+		retErr := fmt.Errorf("unable to plan artifact retention: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
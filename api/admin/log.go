@@ -11,8 +11,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	apiLog "github.com/go-vela/server/api/log"
 	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/server/util"
 )
 
@@ -152,8 +154,14 @@ func CleanLogs(c *gin.Context) {
 	// get database driver for vacuum operations
 	driver := database.FromContext(c).Driver()
 
+	// remove the objects logs were moved to along with the logs
+	var st storage.Storage
+	if c.GetBool("storage-enable") {
+		st = storage.FromGinContext(c)
+	}
+
 	// perform the cleanup operation
-	result, err := database.FromContext(c).CleanLogs(ctx, before, batchSize, withVacuum, driver)
+	result, err := database.FromContext(c).CleanLogs(ctx, before, batchSize, withVacuum, driver, apiLog.RemoveObject(st))
 	if err != nil {
 		retErr := fmt.Errorf("unable to clean logs: %w", err)
 		util.HandleError(c, http.StatusInternalServerError, retErr)
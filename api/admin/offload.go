@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/log"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation POST /api/v1/admin/log/offload admin AdminOffloadLogs
+//
+// Move historical log records to the object storage in batches
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: before
+//   description: Unix timestamp - move logs created before this time (default max build timeout ago)
+//   required: false
+//   type: integer
+// - in: query
+//   name: batch_size
+//   description: Number of records to move per batch (default 100, max 1000)
+//   required: false
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully moved log records
+//     schema:
+//       "$ref": "#/definitions/LogOffloadResponse"
+//   '400':
+//     description: Invalid request parameters
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Storage is not enabled
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// OffloadLogs represents the API handler to move historical
+// log records from the database to the object storage in batches.
+func OffloadLogs(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	db := database.FromContext(c)
+	st := storage.FromGinContext(c)
+	ctx := c.Request.Context()
+
+	l.Info("platform admin: offloading log records")
+
+	if !c.GetBool("storage-enable") || st == nil {
+		util.HandleError(c, http.StatusForbidden, errors.New("storage is not enabled"))
+
+		return
+	}
+
+	startTime := time.Now()
+
+	// capture before query parameter, default to max build timeout
+	before, err := strconv.ParseInt(c.DefaultQuery("before", fmt.Sprint((time.Now().Add(-(time.Minute * (constants.BuildTimeoutMax + 5)))).Unix())), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert before query parameter %s to int64: %w", c.Query("before"), err)
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture and validate batch_size query parameter (optional, default 100, max 1000)
+	batchSize := 100
+	if batchSizeStr := c.Query("batch_size"); batchSizeStr != "" {
+		batchSize, err = strconv.Atoi(batchSizeStr)
+		if err != nil {
+			retErr := fmt.Errorf("unable to convert batch_size query parameter %s to int: %w", batchSizeStr, err)
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		if batchSize < 1 || batchSize > 1000 {
+			retErr := fmt.Errorf("batch_size must be between 1 and 1000 (provided: %d)", batchSize)
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+	}
+
+	l.WithFields(logrus.Fields{
+		"before":     before,
+		"batch_size": batchSize,
+	}).Info("starting log offload operation")
+
+	response := types.LogOffloadResponse{}
+
+	for {
+		logs, err := db.ListLogsForOffload(ctx, before, batchSize)
+		if err != nil {
+			retErr := fmt.Errorf("unable to list logs to offload: %w", err)
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		for _, lg := range logs {
+			err = log.Offload(ctx, db, st, lg)
+			if err != nil {
+				retErr := fmt.Errorf("unable to offload logs after moving %d log records: %w", response.OffloadedCount, err)
+				util.HandleError(c, http.StatusInternalServerError, retErr)
+
+				return
+			}
+
+			response.OffloadedCount++
+			response.OffloadedBytes += int64(len(lg.GetData()))
+		}
+
+		// offloaded logs are no longer listed so a partial batch is the last one
+		if len(logs) < batchSize {
+			break
+		}
+	}
+
+	duration := time.Since(startTime)
+
+	l.WithFields(logrus.Fields{
+		"offloaded_count":  response.OffloadedCount,
+		"offloaded_bytes":  response.OffloadedBytes,
+		"duration_seconds": duration.Seconds(),
+	}).Info("log offload operation completed")
+
+	response.DurationSeconds = duration.Seconds()
+	response.Message = fmt.Sprintf("Moved %d log records (%d bytes) to the storage", response.OffloadedCount, response.OffloadedBytes)
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/storage/filesystem"
+)
+
+func TestAdmin_OffloadLogs(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	// setup mock database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	// setup mock storage
+	st, err := filesystem.NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Errorf("unable to create test storage client: %v", err)
+	}
+
+	for i := int64(1); i <= 3; i++ {
+		lg := new(types.Log)
+		lg.SetRepoID(1)
+		lg.SetBuildID(1)
+		lg.SetStepID(i)
+		lg.SetData([]byte("foo"))
+		lg.SetCreatedAt(1)
+
+		err = db.CreateLog(t.Context(), lg)
+		if err != nil {
+			t.Errorf("unable to create test log: %v", err)
+		}
+	}
+
+	cutoffTime := time.Now().Unix()
+
+	// setup tests
+	tests := []struct {
+		name        string
+		queryParams string
+		storage     bool
+		wantStatus  int
+		wantCount   int64
+	}{
+		{
+			name:        "storage not enabled",
+			queryParams: fmt.Sprintf("before=%d", cutoffTime),
+			storage:     false,
+			wantStatus:  http.StatusForbidden,
+		},
+		{
+			name:        "invalid before parameter",
+			queryParams: "before=invalid",
+			storage:     true,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "invalid batch_size parameter",
+			queryParams: fmt.Sprintf("before=%d&batch_size=invalid", cutoffTime),
+			storage:     true,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "batch_size too large",
+			queryParams: fmt.Sprintf("before=%d&batch_size=2000", cutoffTime),
+			storage:     true,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "successful offload",
+			queryParams: fmt.Sprintf("before=%d&batch_size=2", cutoffTime),
+			storage:     true,
+			wantStatus:  http.StatusOK,
+			wantCount:   3,
+		},
+		{
+			name:        "logs already offloaded",
+			queryParams: fmt.Sprintf("before=%d", cutoffTime),
+			storage:     true,
+			wantStatus:  http.StatusOK,
+			wantCount:   0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup context
+			resp := httptest.NewRecorder()
+			context, engine := gin.CreateTestContext(resp)
+
+			// setup request
+			context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodPost, fmt.Sprintf("/api/v1/admin/log/offload?%s", test.queryParams), nil)
+
+			// setup vela mock server
+			engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logrus.New())) })
+			engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+			engine.Use(func(c *gin.Context) { c.Set("storage-enable", test.storage) })
+			engine.Use(func(c *gin.Context) { storage.WithGinContext(c, st) })
+			engine.POST("/api/v1/admin/log/offload", OffloadLogs)
+
+			// run test
+			engine.ServeHTTP(context.Writer, context.Request)
+
+			if resp.Code != test.wantStatus {
+				t.Errorf("OffloadLogs returned %v, want %v", resp.Code, test.wantStatus)
+			}
+
+			if resp.Code != http.StatusOK {
+				return
+			}
+
+			var response types.LogOffloadResponse
+
+			err := json.Unmarshal(resp.Body.Bytes(), &response)
+			if err != nil {
+				t.Errorf("unable to unmarshal response: %v", err)
+			}
+
+			if response.OffloadedCount != test.wantCount {
+				t.Errorf("OffloadedCount is %d, want %d", response.OffloadedCount, test.wantCount)
+			}
+
+			if response.OffloadedBytes != 3*test.wantCount {
+				t.Errorf("OffloadedBytes is %d, want %d", response.OffloadedBytes, 3*test.wantCount)
+			}
+		})
+	}
+}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/go-vela/server/api/types/settings"
 	"github.com/go-vela/server/compiler/native"
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/internal/image"
 	"github.com/go-vela/server/queue"
@@ -179,6 +181,12 @@ func UpdateSettings(c *gin.Context) {
 			l.Infof("platform admin: updating starlark exec limit to %d", *input.StarlarkExecLimit)
 		}
 
+		if input.JsonnetExecTimeout != nil {
+			_s.SetJsonnetExecTimeout(*input.JsonnetExecTimeout)
+
+			l.Infof("platform admin: updating jsonnet exec timeout to %d", *input.JsonnetExecTimeout)
+		}
+
 		if input.BlockedImages != nil {
 			for _, restriction := range input.GetBlockedImages() {
 				if restriction.GetImage() == "" {
@@ -210,6 +218,57 @@ func UpdateSettings(c *gin.Context) {
 
 			l.Infof("platform admin: updating warn images to: %v", input.GetWarnImages())
 		}
+
+		if input.HTTPSTemplateAllowlist != nil {
+			_s.SetHTTPSTemplateAllowlist(input.GetHTTPSTemplateAllowlist())
+
+			l.Infof("platform admin: updating https template allowlist to: %v", input.GetHTTPSTemplateAllowlist())
+		}
+
+		if input.OCITemplateAllowlist != nil {
+			_s.SetOCITemplateAllowlist(input.GetOCITemplateAllowlist())
+
+			l.Infof("platform admin: updating oci template allowlist to: %v", input.GetOCITemplateAllowlist())
+		}
+
+		if input.WorkerFlavors != nil {
+			_s.SetWorkerFlavors(input.GetWorkerFlavors())
+
+			l.Infof("platform admin: updating worker flavors to: %v", input.GetWorkerFlavors())
+		}
+
+		if input.PolicyRules != nil {
+			for _, rule := range input.GetPolicyRules() {
+				if rule.GetName() == "" {
+					retErr := fmt.Errorf("policy rule entry missing name")
+
+					util.HandleError(c, http.StatusBadRequest, retErr)
+
+					return
+				}
+
+				if rule.GetAction() != constants.PolicyActionBlock && rule.GetAction() != constants.PolicyActionWarn {
+					retErr := fmt.Errorf("policy rule %s has invalid action %s: must be %s or %s", rule.GetName(), rule.GetAction(), constants.PolicyActionBlock, constants.PolicyActionWarn)
+
+					util.HandleError(c, http.StatusBadRequest, retErr)
+
+					return
+				}
+
+				err := pipeline.ValidatePolicy(rule.GetRule())
+				if err != nil {
+					retErr := fmt.Errorf("policy rule %s is invalid: %w", rule.GetName(), err)
+
+					util.HandleError(c, http.StatusBadRequest, retErr)
+
+					return
+				}
+			}
+
+			_s.SetPolicyRules(input.GetPolicyRules())
+
+			l.Infof("platform admin: updating policy rules to: %v", input.GetPolicyRules())
+		}
 	}
 
 	if input.Queue != nil {
@@ -218,6 +277,21 @@ func UpdateSettings(c *gin.Context) {
 		}
 
 		l.Infof("platform admin: updating queue routes to: %s", input.GetRoutes())
+
+		if input.Queue.Pools != nil {
+			err = validatePools(input.GetPools())
+			if err != nil {
+				retErr := fmt.Errorf("invalid worker pools for platform settings: %w", err)
+
+				util.HandleError(c, http.StatusBadRequest, retErr)
+
+				return
+			}
+
+			_s.SetPools(input.GetPools())
+
+			l.Infof("platform admin: updating worker pools to: %v", input.GetPools())
+		}
 	}
 
 	if input.SCM != nil {
@@ -303,6 +377,40 @@ func UpdateSettings(c *gin.Context) {
 		l.Infof("platform admin: updating enable shared secrets to: %t", input.GetEnableSharedSecrets())
 	}
 
+	if input.ArtifactRetention != nil {
+		for _, rule := range input.GetArtifactRetention() {
+			err = rule.Validate()
+			if err != nil {
+				retErr := fmt.Errorf("invalid artifact retention for platform settings: %w", err)
+
+				util.HandleError(c, http.StatusBadRequest, retErr)
+
+				return
+			}
+		}
+
+		_s.SetArtifactRetention(input.GetArtifactRetention())
+
+		l.Infof("platform admin: updating artifact retention to: %v", input.GetArtifactRetention())
+	}
+
+	if input.LogRetention != nil {
+		for _, rule := range input.GetLogRetention() {
+			err = rule.Validate()
+			if err != nil {
+				retErr := fmt.Errorf("invalid log retention for platform settings: %w", err)
+
+				util.HandleError(c, http.StatusBadRequest, retErr)
+
+				return
+			}
+		}
+
+		_s.SetLogRetention(input.GetLogRetention())
+
+		l.Infof("platform admin: updating log retention to: %v", input.GetLogRetention())
+	}
+
 	_s.SetUpdatedBy(u.GetName())
 
 	// send API call to update the settings
@@ -412,3 +520,30 @@ func RestoreSettings(c *gin.Context) {
 
 	c.JSON(http.StatusOK, s)
 }
+
+// validatePools is a helper function to verify the worker pools
+// provided for the platform settings are populated correctly.
+func validatePools(pools []settings.WorkerPool) error {
+	names := make(map[string]bool)
+
+	for _, pool := range pools {
+		err := pool.Validate()
+		if err != nil {
+			return err
+		}
+
+		if names[pool.GetName()] {
+			return fmt.Errorf("more than one worker pool is named %s", pool.GetName())
+		}
+
+		names[pool.GetName()] = true
+
+		// the labels of a pool are a selector like the worker labels of a pipeline
+		_, err = (&pipeline.Worker{Labels: pool.GetLabels()}).Selector()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
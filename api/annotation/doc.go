@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package annotation provides the annotation handlers for the Vela API.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/api/annotation"
+package annotation
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/problemmatcher"
+)
+
+// Record parses the log for a finished step into annotations and
+// stores them, replacing any annotations previously parsed for the step.
+func Record(ctx context.Context, db database.Interface, b *types.Build, s *types.Step, lg *types.Log) ([]*types.Annotation, error) {
+	now := time.Now().UTC().Unix()
+
+	annotations := problemmatcher.Match(lg.GetData())
+
+	for _, a := range annotations {
+		a.SetRepoID(b.GetRepo().GetID())
+		a.SetBuildID(b.GetID())
+		a.SetStepID(s.GetID())
+		a.SetCreatedAt(now)
+	}
+
+	err := db.DeleteAnnotationsForStep(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove previous annotations: %w", err)
+	}
+
+	err = db.CreateAnnotations(ctx, annotations)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create annotations: %w", err)
+	}
+
+	return annotations, nil
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+)
+
+func TestAnnotation_Record(t *testing.T) {
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	owner := new(types.User)
+	owner.SetID(1)
+
+	r := new(types.Repo)
+	r.SetOwner(owner)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+	r.SetFullName("octocat/hello-world")
+	r.SetVisibility("public")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+	r.SetHash("baz")
+
+	r, err = db.CreateRepo(t.Context(), r)
+	if err != nil {
+		t.Fatalf("unable to create repo: %v", err)
+	}
+
+	b := new(types.Build)
+	b.SetRepo(r)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+
+	b, err = db.CreateBuild(t.Context(), b)
+	if err != nil {
+		t.Fatalf("unable to create build: %v", err)
+	}
+
+	s := new(types.Step)
+	s.SetBuildID(b.GetID())
+	s.SetRepoID(r.GetID())
+	s.SetNumber(1)
+	s.SetName("test")
+	s.SetImage("golang:latest")
+
+	s, err = db.CreateStep(t.Context(), s)
+	if err != nil {
+		t.Fatalf("unable to create step: %v", err)
+	}
+
+	lg := new(types.Log)
+	lg.SetStepID(s.GetID())
+	lg.SetData([]byte("# github.com/octocat/hello-world\n./main.go:12:3: undefined: foo\n::warning file=app.js,line=1::Unexpected console statement\n"))
+
+	// record twice to ensure the annotations are replaced
+	for range 2 {
+		_, err = Record(t.Context(), db, b, s, lg)
+		if err != nil {
+			t.Fatalf("Record returned err: %v", err)
+		}
+	}
+
+	got, err := db.ListAnnotationsForStep(t.Context(), s)
+	if err != nil {
+		t.Fatalf("unable to list annotations: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("Record stored %d annotations, want %d", len(got), 2)
+	}
+
+	for _, a := range got {
+		if a.GetRepoID() != r.GetID() || a.GetBuildID() != b.GetID() || a.GetStepID() != s.GetID() {
+			t.Errorf("Record stored %v, want repo, build and step populated", a)
+		}
+	}
+
+	if got[0].GetFile() != "main.go" || got[0].GetLine() != 12 || got[0].GetSeverity() != constants.AnnotationError {
+		t.Errorf("Record stored %v, want main.go:12 error", got[0])
+	}
+
+	if got[1].GetFile() != "app.js" || got[1].GetSeverity() != constants.AnnotationWarning {
+		t.Errorf("Record stored %v, want app.js warning", got[1])
+	}
+}
@@ -0,0 +1,272 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/api/testresult"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/util"
+)
+
+// checksumPattern matches a SHA-256 digest of an artifact.
+var checksumPattern = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/artifacts artifacts CreateArtifact
+//
+// Record an artifact uploaded for a build
+//
+// The artifact must already be uploaded to the storage bucket through
+// a presigned PUT URL. The size and content type are captured from the
+// uploaded object when not provided, and the artifact expires based on
+// the retention configured for the server. Recording an artifact with
+// the name of an existing artifact for the build replaces it.
+//
+// The checksum is asserted by the client uploading the artifact and
+// is not verified against the uploaded object. When provided, it must
+// be a SHA-256 digest in the sha256:<hex> format.
+//
+// When a report format is provided, the artifact is parsed as a test
+// report and the results are stored for the build.
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: query
+//   name: report
+//   description: Format of the test report to parse from the artifact
+//   type: string
+//   enum:
+//   - junit
+//   - go
+// - in: body
+//   name: body
+//   description: Artifact uploaded for the build
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Artifact"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully replaced the artifact
+//     schema:
+//       "$ref": "#/definitions/Artifact"
+//   '201':
+//     description: Successfully recorded the artifact
+//     schema:
+//       "$ref": "#/definitions/Artifact"
+//   '400':
+//     description: Invalid request payload, path or test report
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Storage is not enabled
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: The artifact has not been uploaded
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateArtifact represents the API handler to record
+// an artifact uploaded to the storage for a build.
+func CreateArtifact(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	b := build.Retrieve(c)
+	r := repo.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	if !c.MustGet("storage-enable").(bool) {
+		util.HandleError(c, http.StatusForbidden, errors.New("storage is not enabled"))
+
+		return
+	}
+
+	l.Debugf("recording artifact for build %s", entry)
+
+	// capture body from API request
+	input := new(types.Artifact)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new artifact for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	name := input.GetName()
+
+	// artifacts are uploaded to a single object name within the build
+	if len(name) == 0 || strings.Contains(name, "/") {
+		retErr := fmt.Errorf("invalid artifact name %q provided for build %s", name, entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// the checksum is client-asserted, so only its format is verified
+	if len(input.GetChecksum()) > 0 {
+		checksum := strings.ToLower(input.GetChecksum())
+
+		if !checksumPattern.MatchString(checksum) {
+			retErr := fmt.Errorf("invalid checksum %q provided for artifact %s for build %s: must be sha256:<hex>", input.GetChecksum(), name, entry)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		input.SetChecksum(checksum)
+	}
+
+	// capture report query parameter if present
+	format := c.Query("report")
+
+	switch format {
+	case "", constants.TestReportJUnit, constants.TestReportGo:
+	default:
+		retErr := fmt.Errorf("invalid test report format %q provided for build %s", format, entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	s := storage.FromGinContext(c)
+
+	// ensure the artifact was uploaded to the path of the presigned URL
+	object, err := s.StatObject(ctx, &types.Object{
+		ObjectName: fmt.Sprintf("%s/%s/%d/%s", r.GetOrg(), r.GetName(), b.GetNumber(), name),
+		Bucket:     types.Bucket{BucketName: s.GetBucket()},
+	})
+	if err != nil {
+		retErr := fmt.Errorf("unable to find upload for artifact %s for build %s: %w", name, entry, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	var results []*types.TestResult
+
+	// parse the test report before recording the artifact
+	if len(format) > 0 {
+		results, err = testresult.Parse(ctx, s, object, format)
+		if err != nil {
+			retErr := fmt.Errorf("unable to parse test report %s for build %s: %w", name, entry, err)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+
+	// update fields in artifact object
+	input.SetID(0)
+	input.SetBuildID(b.GetID())
+	input.SetRepoID(r.GetID())
+	input.SetObjectPath(object.ObjectName)
+	input.SetSize(object.Size)
+	input.SetCreatedAt(now.Unix())
+	input.SetExpiresAt(0)
+
+	if len(input.GetContentType()) == 0 {
+		input.SetContentType(object.ContentType)
+	}
+
+	// artifacts are kept indefinitely without a retention
+	if retention := c.MustGet("artifact-retention").(time.Duration); retention > 0 {
+		input.SetExpiresAt(now.Add(retention).Unix())
+	}
+
+	status := http.StatusCreated
+
+	// replace the artifact when it was already recorded for the build
+	a, err := database.FromContext(c).GetArtifactForBuild(ctx, b, name)
+	switch {
+	case err == nil:
+		status = http.StatusOK
+
+		input.SetID(a.GetID())
+
+		a, err = database.FromContext(c).UpdateArtifact(ctx, input)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		a, err = database.FromContext(c).CreateArtifact(ctx, input)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to record artifact %s for build %s: %w", name, entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	l.WithFields(logrus.Fields{
+		"artifact":    a.GetName(),
+		"artifact_id": a.GetID(),
+	}).Infof("artifact %s recorded for build %s", a.GetName(), entry)
+
+	if len(format) > 0 {
+		err = testresult.Record(ctx, database.FromContext(c), r, b, a, results)
+		if err != nil {
+			retErr := fmt.Errorf("unable to record test results from %s for build %s: %w", name, entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		l.Infof("%d test results recorded from %s for build %s", len(results), a.GetName(), entry)
+	}
+
+	c.JSON(status, a)
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"testing"
+)
+
+func TestArtifact_checksumPattern(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		checksum string
+		want     bool
+	}{
+		{name: "sha256", checksum: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", want: true},
+		{name: "no algorithm", checksum: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", want: false},
+		{name: "other algorithm", checksum: "md5:d41d8cd98f00b204e9800998ecf8427e", want: false},
+		{name: "short digest", checksum: "sha256:e3b0c44298fc1c149afbf4c8996fb924", want: false},
+		{name: "not hex", checksum: "sha256:z3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := checksumPattern.MatchString(test.checksum)
+
+			if got != test.want {
+				t.Errorf("checksumPattern match for %s is %v, want %v", test.checksum, got, test.want)
+			}
+		})
+	}
+}
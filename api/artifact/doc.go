@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package artifact provides the artifact handlers for the Vela API.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/api/artifact"
+package artifact
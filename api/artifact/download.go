@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/router/middleware/artifact"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/artifacts/{artifact}/download-url artifacts GetArtifactDownloadURL
+//
+// Get a temporary presigned URL to download an artifact for a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: artifact
+//   description: Name of the artifact
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully generated the presigned URL for the artifact
+//     schema:
+//       "$ref": "#/definitions/PresignURL"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Storage is not enabled
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '410':
+//     description: The artifact has expired
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to generate the presigned URL
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetArtifactDownloadURL represents the API handler to generate
+// a temporary presigned URL to download an artifact for a build.
+func GetArtifactDownloadURL(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	a := artifact.Retrieve(c)
+	b := build.Retrieve(c)
+	r := repo.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d/%s", r.GetFullName(), b.GetNumber(), a.GetName())
+
+	if !c.MustGet("storage-enable").(bool) {
+		util.HandleError(c, http.StatusForbidden, errors.New("storage is not enabled"))
+
+		return
+	}
+
+	// expired artifacts remain until they are removed by the sweeper
+	if a.GetExpiresAt() > 0 && a.GetExpiresAt() < time.Now().UTC().Unix() {
+		retErr := fmt.Errorf("artifact %s has expired", entry)
+
+		util.HandleError(c, http.StatusGone, retErr)
+
+		return
+	}
+
+	l.Debugf("generating download URL for artifact %s", entry)
+
+	s := storage.FromGinContext(c)
+
+	url, err := s.PresignedGetObject(ctx, &types.Object{
+		ObjectName: a.GetObjectPath(),
+		Bucket:     types.Bucket{BucketName: s.GetBucket()},
+	})
+	if err != nil {
+		retErr := fmt.Errorf("unable to generate download URL for artifact %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &types.PresignURL{URL: url})
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/router/middleware/artifact"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/artifacts/{artifact} artifacts GetArtifact
+//
+// Get an artifact for a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: artifact
+//   description: Name of the artifact
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the artifact
+//     schema:
+//       "$ref": "#/definitions/Artifact"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetArtifact represents the API handler to get an artifact for a build.
+func GetArtifact(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	b := build.Retrieve(c)
+	r := repo.Retrieve(c)
+	a := artifact.Retrieve(c)
+
+	l.Debugf("reading artifact %s/%d/%s", r.GetFullName(), b.GetNumber(), a.GetName())
+
+	c.JSON(http.StatusOK, a)
+}
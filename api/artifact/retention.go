@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+)
+
+// PlanRetention determines the artifacts the provided retention
+// rules would remove across every repo with artifacts.
+func PlanRetention(ctx context.Context, db database.Interface, rules []settings.RetentionRule, now time.Time) (*types.ArtifactRetentionReport, error) {
+	report := &types.ArtifactRetentionReport{Artifacts: []*types.Artifact{}}
+
+	// nothing is removed without retention rules
+	if len(rules) == 0 {
+		return report, nil
+	}
+
+	ids, err := db.ListArtifactRepoIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list repos with artifacts: %w", err)
+	}
+
+	for _, id := range ids {
+		r, err := db.GetRepo(ctx, id)
+		if err != nil {
+			// artifacts of removed repos are not subject to repo rules
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+
+			return nil, fmt.Errorf("unable to get repo %d: %w", id, err)
+		}
+
+		rule := settings.MatchRetentionRule(rules, r.GetOrg(), r.GetName())
+		if rule == nil {
+			continue
+		}
+
+		artifacts, err := planRepoRetention(ctx, db, rule, r, now)
+		if err != nil {
+			return nil, fmt.Errorf("unable to plan artifact retention for %s: %w", r.GetFullName(), err)
+		}
+
+		for _, a := range artifacts {
+			report.Artifacts = append(report.Artifacts, a)
+			report.Count++
+			report.Bytes += a.GetSize()
+		}
+	}
+
+	return report, nil
+}
+
+// planRepoRetention is a helper function to determine the artifacts
+// of a single repo that are not protected by the retention rule.
+func planRepoRetention(ctx context.Context, db database.Interface, rule *settings.RetentionRule, r *types.Repo, now time.Time) ([]*types.Artifact, error) {
+	// rules without a build or age limit keep every artifact
+	if rule.GetKeepBuilds() == 0 && rule.GetKeepDays() == 0 {
+		return nil, nil
+	}
+
+	// artifacts are ordered from the most recent build to the oldest
+	artifacts, err := db.ListArtifactsForRepo(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := now.Add(-time.Duration(rule.GetKeepDays()) * 24 * time.Hour).Unix()
+
+	remove := []*types.Artifact{}
+	kept := make(map[int64]bool)
+
+	for _, a := range artifacts {
+		keep, ok := kept[a.GetBuildID()]
+		if !ok {
+			keep, err = keepBuild(ctx, db, rule, a.GetBuildID(), int64(len(kept)))
+			if err != nil {
+				return nil, err
+			}
+
+			kept[a.GetBuildID()] = keep
+		}
+
+		if keep {
+			continue
+		}
+
+		if rule.GetKeepDays() > 0 && a.GetCreatedAt() >= cutoff {
+			continue
+		}
+
+		remove = append(remove, a)
+	}
+
+	return remove, nil
+}
+
+// keepBuild is a helper function to determine if every artifact of a
+// build is protected by the retention rule, where position is the number
+// of more recent builds with artifacts for the repo.
+func keepBuild(ctx context.Context, db database.Interface, rule *settings.RetentionRule, id, position int64) (bool, error) {
+	if position < rule.GetKeepBuilds() {
+		return true, nil
+	}
+
+	if !rule.GetKeepTags() && !rule.GetKeepDeployments() {
+		return false, nil
+	}
+
+	b, err := db.GetBuild(ctx, id)
+	if err != nil {
+		// artifacts of removed builds are never protected by their event
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to get build %d: %w", id, err)
+	}
+
+	switch b.GetEvent() {
+	case constants.EventTag:
+		return rule.GetKeepTags(), nil
+	case constants.EventDeploy:
+		return rule.GetKeepDeployments(), nil
+	default:
+		return false, nil
+	}
+}
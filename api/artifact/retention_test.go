@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+)
+
+func TestArtifact_PlanRetention(t *testing.T) {
+	// setup types
+	now := time.Now()
+	old := now.Add(-60 * 24 * time.Hour).Unix()
+
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	owner := new(types.User)
+	owner.SetID(1)
+
+	r := new(types.Repo)
+	r.SetOwner(owner)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+	r.SetFullName("octocat/hello-world")
+	r.SetVisibility("public")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+	r.SetHash("baz")
+
+	r, err = db.CreateRepo(t.Context(), r)
+	if err != nil {
+		t.Fatalf("unable to create repo: %v", err)
+	}
+
+	// builds 1-4 are old, build 2 is a tag, build 3 is a deployment and build 5 is recent
+	events := []string{constants.EventPush, constants.EventTag, constants.EventDeploy, constants.EventPush, constants.EventPush}
+
+	for i, event := range events {
+		b := new(types.Build)
+		b.SetRepo(r)
+		b.SetNumber(int64(i + 1))
+		b.SetEvent(event)
+
+		b, err = db.CreateBuild(t.Context(), b)
+		if err != nil {
+			t.Fatalf("unable to create build: %v", err)
+		}
+
+		created := old
+		if i == len(events)-1 {
+			created = now.Unix()
+		}
+
+		a := new(types.Artifact)
+		a.SetBuildID(b.GetID())
+		a.SetRepoID(r.GetID())
+		a.SetName("app.tar.gz")
+		a.SetObjectPath(fmt.Sprintf("octocat/hello-world/%d/app.tar.gz", b.GetNumber()))
+		a.SetSize(100)
+		a.SetCreatedAt(created)
+
+		_, err = db.CreateArtifact(t.Context(), a)
+		if err != nil {
+			t.Fatalf("unable to create artifact: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name  string
+		rules []settings.RetentionRule
+		want  []string
+	}{
+		{
+			name:  "no rules",
+			rules: nil,
+			want:  []string{},
+		},
+		{
+			name:  "keep latest builds",
+			rules: []settings.RetentionRule{{KeepBuilds: new(int64(2))}},
+			want:  []string{"octocat/hello-world/3/app.tar.gz", "octocat/hello-world/2/app.tar.gz", "octocat/hello-world/1/app.tar.gz"},
+		},
+		{
+			name:  "keep days",
+			rules: []settings.RetentionRule{{KeepDays: new(int64(30))}},
+			want:  []string{"octocat/hello-world/4/app.tar.gz", "octocat/hello-world/3/app.tar.gz", "octocat/hello-world/2/app.tar.gz", "octocat/hello-world/1/app.tar.gz"},
+		},
+		{
+			name:  "keep tags and deployments",
+			rules: []settings.RetentionRule{{KeepDays: new(int64(30)), KeepTags: new(true), KeepDeployments: new(true)}},
+			want:  []string{"octocat/hello-world/4/app.tar.gz", "octocat/hello-world/1/app.tar.gz"},
+		},
+		{
+			name: "repo rule overrides platform rule",
+			rules: []settings.RetentionRule{
+				{KeepDays: new(int64(30))},
+				{RuleScope: settings.RuleScope{Org: new("octocat"), Repo: new("hello-world")}, KeepBuilds: new(int64(4))},
+			},
+			want: []string{"octocat/hello-world/1/app.tar.gz"},
+		},
+		{
+			name:  "rule without limits",
+			rules: []settings.RetentionRule{{KeepTags: new(true)}},
+			want:  []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := PlanRetention(t.Context(), db, test.rules, now)
+			if err != nil {
+				t.Fatalf("PlanRetention returned err: %v", err)
+			}
+
+			paths := []string{}
+			for _, a := range got.Artifacts {
+				paths = append(paths, a.GetObjectPath())
+			}
+
+			if fmt.Sprint(paths) != fmt.Sprint(test.want) {
+				t.Errorf("PlanRetention is %v, want %v", paths, test.want)
+			}
+
+			if got.Count != int64(len(test.want)) || got.Bytes != int64(100*len(test.want)) {
+				t.Errorf("PlanRetention count is %d (%d bytes), want %d", got.Count, got.Bytes, len(test.want))
+			}
+		})
+	}
+}
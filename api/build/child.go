@@ -0,0 +1,358 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/cache"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/registry"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/children builds CreateChildBuild
+//
+// Create a child build from a pipeline generated by a running build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Generated pipeline configuration
+//   required: true
+//   schema:
+//     type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Request processed but child build was skipped
+//     schema:
+//       type: string
+//   '201':
+//     description: Successfully created the child build
+//     type: json
+//     schema:
+//       "$ref": "#/definitions/Build"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '413':
+//     description: Pipeline configuration exceeds the maximum size
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '429':
+//     description: Concurrent build limit reached for repository
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateChildBuild represents the API handler to create a child build
+// from a pipeline configuration generated by a step of a running build.
+func CreateChildBuild(c *gin.Context) {
+	// capture middleware values
+	m := c.MustGet("metadata").(*internal.Metadata)
+	l := c.MustGet("logger").(*logrus.Entry)
+	b := build.Retrieve(c)
+	r := repo.Retrieve(c)
+	scm := scm.FromContext(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	l.Debugf("creating child build for build %s", entry)
+
+	// only a running build can generate a child build
+	if b.GetStatus() != constants.StatusRunning {
+		retErr := fmt.Errorf("unable to create child build for %s: build is not running", entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture body from API request, limited to the size of a pipeline configuration
+	data, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, registry.MaxTemplateSize))
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			retErr := fmt.Errorf("unable to create child build for %s: pipeline configuration exceeds maximum size of %d bytes", entry, maxErr.Limit)
+
+			util.HandleError(c, http.StatusRequestEntityTooLarge, retErr)
+
+			return
+		}
+
+		retErr := fmt.Errorf("unable to read pipeline configuration for child build of %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		retErr := fmt.Errorf("unable to create child build for %s: no pipeline configuration provided", entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// child builds are not allowed to generate further child builds
+	generated, err := generatedPipeline(ctx, database.FromContext(c), b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get pipeline for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	if generated != nil {
+		retErr := fmt.Errorf("unable to create child build for %s: build is already a child of build %d", entry, b.GetParent())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// the child build inherits the context of the parent build
+	childBuild := new(types.Build)
+	*childBuild = *b
+
+	childBuild.SetParent(b.GetNumber())
+
+	l.Debugf("generating queue items for child build of %s", entry)
+
+	// child form
+	config := CompileAndPublishConfig{
+		Build:    childBuild,
+		Metadata: m,
+		BaseErr:  "unable to create child build",
+		Source:   "child",
+		Pipeline: data,
+		Retries:  1,
+	}
+
+	// generate queue items
+	_, item, code, err := CompileAndPublish(
+		c,
+		config,
+		database.FromContext(c),
+		cache.FromContext(c),
+		scm,
+		compiler.FromContext(c),
+		queue.FromContext(c),
+	)
+	if err != nil {
+		util.HandleError(c, code, err)
+
+		return
+	}
+
+	// determine whether or not to send compiled build to queue
+	shouldEnqueue, err := ShouldEnqueue(c, l, item.Build, r)
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	// the commit status for a child build is reported through the parent build
+	if shouldEnqueue {
+		// publish the build to the queue
+		go Enqueue(
+			context.WithoutCancel(ctx),
+			queue.FromGinContext(c),
+			database.FromContext(c),
+			item,
+			item.Build.GetRoute(),
+		)
+	} else {
+		err := GatekeepBuild(c, item.Build, item.Build.GetRepo(), scm.GenerateStatusToken(ctx, item.Build))
+		if err != nil {
+			util.HandleError(c, http.StatusInternalServerError, err)
+
+			return
+		}
+	}
+
+	l.WithFields(logrus.Fields{
+		"child_build":    item.Build.GetNumber(),
+		"child_build_id": item.Build.GetID(),
+	}).Info("child build created")
+
+	c.JSON(http.StatusCreated, item.Build)
+}
+
+// generatedDigest returns the digest a pipeline configuration generated
+// by a build is stored under, which keeps it apart from the configuration
+// at the commit and from other configurations generated for the commit.
+func generatedDigest(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// generatedPipeline returns the pipeline for a child build, or nil when the
+// build was compiled from the pipeline configuration at its commit.
+func generatedPipeline(ctx context.Context, db database.Interface, b *types.Build) (*types.Pipeline, error) {
+	if b.GetParent() == 0 || b.GetPipelineID() == 0 {
+		return nil, nil
+	}
+
+	p, err := db.GetPipeline(ctx, b.GetPipelineID())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	// only generated pipelines are stored with a digest
+	if len(p.GetGeneratedDigest()) == 0 {
+		return nil, nil
+	}
+
+	return p, nil
+}
+
+// childParent returns the build that generated the provided
+// build, or nil when the build is not a child build.
+func childParent(ctx context.Context, db database.Interface, b *types.Build) (*types.Build, error) {
+	p, err := generatedPipeline(ctx, db, b)
+	if err != nil || p == nil {
+		return nil, err
+	}
+
+	parent, err := db.GetBuildForRepo(ctx, b.GetRepo(), b.GetParent())
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return parent, nil
+}
+
+// listChildBuilds returns the latest build for each pipeline generated by the provided build.
+func listChildBuilds(ctx context.Context, db database.Interface, b *types.Build) ([]*types.Build, error) {
+	// builds are returned newest first so restarted child builds supersede the originals
+	builds, err := db.ListChildBuilds(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	children := []*types.Build{}
+	seen := make(map[int64]bool)
+
+	for _, candidate := range builds {
+		if seen[candidate.GetPipelineID()] {
+			continue
+		}
+
+		seen[candidate.GetPipelineID()] = true
+
+		children = append(children, candidate)
+	}
+
+	return children, nil
+}
+
+// aggregateStatus returns the status of a parent build
+// combined with the statuses of its child builds.
+func aggregateStatus(parent *types.Build, children []*types.Build) string {
+	status := parent.GetStatus()
+
+	if status != constants.StatusSuccess {
+		return status
+	}
+
+	running := false
+
+	for _, child := range children {
+		switch child.GetStatus() {
+		case constants.StatusFailure, constants.StatusError, constants.StatusKilled, constants.StatusCanceled:
+			return constants.StatusFailure
+		case constants.StatusPending, constants.StatusPendingApproval, constants.StatusRunning:
+			running = true
+		}
+	}
+
+	if running {
+		return constants.StatusRunning
+	}
+
+	return status
+}
+
+// withChildStatus returns the build with its status combined with
+// the statuses of its child builds. The build is copied rather than
+// changed, so the status of the build itself is kept as is.
+func withChildStatus(ctx context.Context, db database.Interface, b *types.Build) (*types.Build, error) {
+	children, err := listChildBuilds(ctx, db, b)
+	if err != nil {
+		return nil, err
+	}
+
+	status := aggregateStatus(b, children)
+	if status == b.GetStatus() {
+		return b, nil
+	}
+
+	reported := new(types.Build)
+	*reported = *b
+
+	reported.SetStatus(status)
+
+	return reported, nil
+}
@@ -0,0 +1,536 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v3"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/native"
+	"github.com/go-vela/server/compiler/registry"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/internal"
+	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/queue/redis"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/server/router/middleware/perm"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/github"
+)
+
+func TestBuild_CreateChildBuild(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	ctx := context.Background()
+
+	// setup types
+	owner := testutils.APIUser().Crop()
+	owner.SetID(1)
+	owner.SetName("octocat")
+	owner.SetToken("foo")
+
+	r := testutils.APIRepo()
+	r.SetID(1)
+	r.SetOwner(owner)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+	r.SetBuildLimit(10)
+	r.SetActive(true)
+
+	p := testutils.APIPipeline()
+	p.SetRepo(r)
+	p.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	p.SetType(constants.PipelineTypeYAML)
+	p.SetRef("refs/heads/main")
+	p.SetVersion("1")
+	p.SetData([]byte("version: \"1\""))
+
+	parent := testutils.APIBuild()
+	parent.SetRepo(r)
+	parent.SetNumber(1)
+	parent.SetPipelineID(1)
+	parent.SetEvent(constants.EventPush)
+	parent.SetStatus(constants.StatusRunning)
+	parent.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	parent.SetBranch("main")
+	parent.SetRef("refs/heads/main")
+	parent.SetSender("octocat")
+	parent.SetSenderSCMID("1")
+
+	// setup mock database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	_, err = db.CreateUser(ctx, owner)
+	if err != nil {
+		t.Fatalf("unable to create test user: %v", err)
+	}
+
+	_, err = db.CreateRepo(ctx, r)
+	if err != nil {
+		t.Fatalf("unable to create test repo: %v", err)
+	}
+
+	p, err = db.CreatePipeline(ctx, p)
+	if err != nil {
+		t.Fatalf("unable to create test pipeline: %v", err)
+	}
+
+	parent, err = db.CreateBuild(ctx, parent)
+	if err != nil {
+		t.Fatalf("unable to create test build: %v", err)
+	}
+
+	// setup mock scm
+	s := httptest.NewServer(githubHandler())
+	defer s.Close()
+
+	client, err := github.NewTest(s.URL)
+	if err != nil {
+		t.Fatalf("unable to create test scm: %v", err)
+	}
+
+	// setup mock queue
+	q, err := redis.NewTest(
+		"tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		"DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		constants.DefaultRoute,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test queue: %v", err)
+	}
+
+	config := []byte(`version: "1"
+steps:
+  - name: test
+    image: alpine
+    commands:
+      - echo hello
+`)
+
+	// setup tests
+	tests := []struct {
+		name       string
+		claims     *token.Claims
+		body       []byte
+		wantStatus int
+	}{
+		{
+			name:       "build token for another build",
+			claims:     &token.Claims{TokenType: constants.WorkerBuildTokenType, BuildID: parent.GetID() + 1},
+			body:       config,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "user token",
+			claims:     &token.Claims{TokenType: constants.UserAccessTokenType},
+			body:       config,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "payload too large",
+			claims:     &token.Claims{TokenType: constants.WorkerBuildTokenType, BuildID: parent.GetID()},
+			body:       bytes.Repeat([]byte("#"), registry.MaxTemplateSize+1),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "empty payload",
+			claims:     &token.Claims{TokenType: constants.WorkerBuildTokenType, BuildID: parent.GetID()},
+			body:       []byte(" "),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "child build",
+			claims:     &token.Claims{TokenType: constants.WorkerBuildTokenType, BuildID: parent.GetID()},
+			body:       config,
+			wantStatus: http.StatusCreated,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := serveChildBuild(t, db, client, q, r, parent, test.claims, test.body)
+
+			if resp.Code != test.wantStatus {
+				t.Fatalf("CreateChildBuild returned %v, want %v: %s", resp.Code, test.wantStatus, resp.Body.String())
+			}
+		})
+	}
+
+	// the child build is linked to the parent build through a generated pipeline
+	children, err := listChildBuilds(ctx, db, parent)
+	if err != nil {
+		t.Fatalf("unable to list child builds: %v", err)
+	}
+
+	if len(children) != 1 {
+		t.Fatalf("listChildBuilds returned %d builds, want 1", len(children))
+	}
+
+	child := children[0]
+
+	if child.GetParent() != parent.GetNumber() {
+		t.Errorf("child build parent is %d, want %d", child.GetParent(), parent.GetNumber())
+	}
+
+	generated, err := db.GetPipeline(ctx, child.GetPipelineID())
+	if err != nil {
+		t.Fatalf("unable to get child pipeline: %v", err)
+	}
+
+	if generated.GetGeneratedDigest() != generatedDigest(config) {
+		t.Errorf("child pipeline digest is %s, want %s", generated.GetGeneratedDigest(), generatedDigest(config))
+	}
+
+	if generated.GetCommit() != parent.GetCommit() {
+		t.Errorf("child pipeline commit is %s, want %s", generated.GetCommit(), parent.GetCommit())
+	}
+
+	// the pipeline at the commit is kept apart from the generated pipeline
+	atCommit, err := db.GetPipelineForRepo(ctx, parent.GetCommit(), r)
+	if err != nil {
+		t.Fatalf("unable to get pipeline for commit: %v", err)
+	}
+
+	if atCommit.GetID() != p.GetID() {
+		t.Errorf("pipeline for commit is %d, want %d", atCommit.GetID(), p.GetID())
+	}
+
+	// the parent build keeps its own status
+	got, err := db.GetBuild(ctx, parent.GetID())
+	if err != nil {
+		t.Fatalf("unable to get parent build: %v", err)
+	}
+
+	if got.GetStatus() != constants.StatusRunning {
+		t.Errorf("parent build status is %s, want %s", got.GetStatus(), constants.StatusRunning)
+	}
+
+	// child builds are not allowed to generate further child builds
+	resp := serveChildBuild(t, db, client, q, r, child, &token.Claims{IsAdmin: true}, config)
+
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("CreateChildBuild for child build returned %v, want %v", resp.Code, http.StatusBadRequest)
+	}
+
+	// wait for the child build to be published to the queue
+	for range 50 {
+		length, err := q.RouteLength(ctx, constants.DefaultRoute)
+		if err == nil && length == 1 {
+			return
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	t.Errorf("child build was not published to queue route %s", constants.DefaultRoute)
+}
+
+func TestBuild_CreateChildBuild_BuildLimit(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	ctx := context.Background()
+
+	// setup types
+	owner := testutils.APIUser().Crop()
+	owner.SetID(1)
+	owner.SetName("octocat")
+	owner.SetToken("foo")
+
+	r := testutils.APIRepo()
+	r.SetID(1)
+	r.SetOwner(owner)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+	r.SetBuildLimit(1)
+	r.SetActive(true)
+
+	parent := testutils.APIBuild()
+	parent.SetRepo(r)
+	parent.SetNumber(1)
+	parent.SetEvent(constants.EventPush)
+	parent.SetStatus(constants.StatusRunning)
+	parent.SetCreated(time.Now().Add(-time.Minute).Unix())
+	parent.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	parent.SetBranch("main")
+	parent.SetRef("refs/heads/main")
+	parent.SetSender("octocat")
+	parent.SetSenderSCMID("1")
+
+	// setup mock database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	_, err = db.CreateUser(ctx, owner)
+	if err != nil {
+		t.Fatalf("unable to create test user: %v", err)
+	}
+
+	_, err = db.CreateRepo(ctx, r)
+	if err != nil {
+		t.Fatalf("unable to create test repo: %v", err)
+	}
+
+	parent, err = db.CreateBuild(ctx, parent)
+	if err != nil {
+		t.Fatalf("unable to create test build: %v", err)
+	}
+
+	// setup mock scm
+	s := httptest.NewServer(githubHandler())
+	defer s.Close()
+
+	client, err := github.NewTest(s.URL)
+	if err != nil {
+		t.Fatalf("unable to create test scm: %v", err)
+	}
+
+	// setup mock queue
+	q, err := redis.NewTest(
+		"tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		"DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		constants.DefaultRoute,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test queue: %v", err)
+	}
+
+	config := []byte(`version: "1"
+steps:
+  - name: test
+    image: alpine
+    commands:
+      - echo hello
+`)
+
+	cl := &token.Claims{TokenType: constants.WorkerBuildTokenType, BuildID: parent.GetID()}
+
+	// the running parent doesn't count against the limit
+	resp := serveChildBuild(t, db, client, q, r, parent, cl, config)
+
+	if resp.Code != http.StatusCreated {
+		t.Fatalf("CreateChildBuild returned %v, want %v: %s", resp.Code, http.StatusCreated, resp.Body.String())
+	}
+
+	// other pending builds of the repo do
+	pending := testutils.APIBuild()
+	pending.SetRepo(r)
+	pending.SetNumber(3)
+	pending.SetEvent(constants.EventPush)
+	pending.SetStatus(constants.StatusPending)
+	pending.SetCreated(time.Now().Add(-time.Minute).Unix())
+
+	_, err = db.CreateBuild(ctx, pending)
+	if err != nil {
+		t.Fatalf("unable to create test build: %v", err)
+	}
+
+	resp = serveChildBuild(t, db, client, q, r, parent, cl, config)
+
+	if resp.Code != http.StatusTooManyRequests {
+		t.Errorf("CreateChildBuild over the limit returned %v, want %v", resp.Code, http.StatusTooManyRequests)
+	}
+}
+
+// serveChildBuild is a test helper function to send a request
+// creating a child build of the provided build.
+func serveChildBuild(
+	t *testing.T,
+	db database.Interface,
+	client scm.Service,
+	q *redis.Client,
+	r *types.Repo,
+	b *types.Build,
+	cl *token.Claims,
+	body []byte,
+) *httptest.ResponseRecorder {
+	t.Helper()
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	path := fmt.Sprintf("/api/v1/repos/%s/builds/%d/children", r.GetFullName(), b.GetNumber())
+
+	req, _ := http.NewRequestWithContext(t.Context(), http.MethodPost, path, bytes.NewReader(body))
+
+	// setup vela mock server
+	engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logger)) })
+	engine.Use(func(c *gin.Context) {
+		c.Set("metadata", &internal.Metadata{
+			Database: &internal.Database{Driver: "sqlite3"},
+			Queue:    &internal.Queue{Driver: "redis"},
+			Source:   &internal.Source{Driver: "github", Host: "github.com"},
+			Vela:     &internal.Vela{Address: "http://localhost:8080", WebAddress: "http://localhost:8888"},
+		})
+	})
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(func(c *gin.Context) { scm.WithGinContext(c, client) })
+	engine.Use(func(c *gin.Context) { compiler.WithGinContext(c, newTestCompiler(t)) })
+	engine.Use(func(c *gin.Context) { queue.WithGinContext(c, q) })
+	engine.Use(func(c *gin.Context) { claims.ToContext(c, cl) })
+	engine.Use(func(c *gin.Context) { repo.ToContext(c, r) })
+	engine.Use(func(c *gin.Context) { build.ToContext(c, b) })
+	engine.POST("/api/v1/repos/:org/:repo/builds/:build/children", perm.MustBuildAccess(), CreateChildBuild)
+
+	// run request
+	engine.ServeHTTP(resp, req)
+
+	if resp.Code == http.StatusCreated {
+		created := new(types.Build)
+
+		err := json.Unmarshal(resp.Body.Bytes(), created)
+		if err != nil {
+			t.Errorf("unable to unmarshal response: %v", err)
+		}
+
+		if created.GetParent() != b.GetNumber() {
+			t.Errorf("created build parent is %d, want %d", created.GetParent(), b.GetNumber())
+		}
+	}
+
+	return resp
+}
+
+// githubHandler is a test helper function returning a mock of the
+// GitHub API endpoints requested when compiling a child build.
+func githubHandler() http.Handler {
+	engine := gin.New()
+
+	engine.GET("/api/v3/repos/:org/:repo/collaborators/:user/permission", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"permission": "admin", "role_name": "admin"})
+	})
+
+	engine.GET("/api/v3/repos/:org/:repo/commits/:sha", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"sha": c.Param("sha"), "files": []gin.H{{"filename": "README.md"}}})
+	})
+
+	return engine
+}
+
+// newTestCompiler is a test helper function to create a compiler.
+func newTestCompiler(t *testing.T) compiler.Engine {
+	t.Helper()
+
+	cmd := &cli.Command{
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "clone-image",
+				Value: "target/vela-git:latest",
+			},
+			&cli.IntFlag{
+				Name:  "max-template-depth",
+				Value: 5,
+			},
+			&cli.BoolFlag{
+				Name:  "github-driver",
+				Value: false,
+			},
+			&cli.StringFlag{
+				Name:  "github-url",
+				Value: "",
+			},
+			&cli.StringFlag{
+				Name:  "github-token",
+				Value: "",
+			},
+			&cli.Int64Flag{
+				Name:  "compiler-starlark-exec-limit",
+				Value: 0,
+			},
+			&cli.Int64Flag{
+				Name:  "compiler-jsonnet-exec-timeout",
+				Value: 0,
+			},
+		},
+	}
+
+	engine, err := native.FromCLICommand(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("unable to create compiler: %v", err)
+	}
+
+	return engine
+}
+
+func Test_aggregateStatus(t *testing.T) {
+	build := func(status string, finished int64) *types.Build {
+		b := new(types.Build)
+		b.SetStatus(status)
+		b.SetFinished(finished)
+
+		return b
+	}
+
+	tests := []struct {
+		name     string
+		parent   *types.Build
+		children []*types.Build
+		want     string
+	}{
+		{"no children", build(constants.StatusSuccess, 1), nil, constants.StatusSuccess},
+		{"parent still running", build(constants.StatusRunning, 0), []*types.Build{build(constants.StatusFailure, 1)}, constants.StatusRunning},
+		{"parent failed", build(constants.StatusFailure, 1), []*types.Build{build(constants.StatusSuccess, 1)}, constants.StatusFailure},
+		{"children succeeded", build(constants.StatusSuccess, 1), []*types.Build{build(constants.StatusSuccess, 1), build(constants.StatusSkipped, 1)}, constants.StatusSuccess},
+		{"child running", build(constants.StatusSuccess, 1), []*types.Build{build(constants.StatusSuccess, 1), build(constants.StatusRunning, 0)}, constants.StatusRunning},
+		{"child failed", build(constants.StatusSuccess, 1), []*types.Build{build(constants.StatusRunning, 0), build(constants.StatusError, 1)}, constants.StatusFailure},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := aggregateStatus(tt.parent, tt.children); got != tt.want {
+				t.Errorf("aggregateStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_generatedDigest(t *testing.T) {
+	got := generatedDigest([]byte("version: \"1\""))
+	if len(got) != 64 || got != generatedDigest([]byte("version: \"1\"")) {
+		t.Errorf("generatedDigest() = %v, want stable sha256 digest", got)
+	}
+
+	if got == generatedDigest([]byte("version: \"2\"")) {
+		t.Errorf("generatedDigest() should differ for different configurations")
+	}
+}
@@ -35,6 +35,7 @@ type CompileAndPublishConfig struct {
 	Comment    string
 	Labels     []string
 	Files      []string
+	Pipeline   []byte
 	Retries    int
 }
 
@@ -156,6 +157,11 @@ func CompileAndPublish(
 		return nil, nil, http.StatusInternalServerError, retErr
 	}
 
+	// the running parent of a child build waits on the child, so it doesn't count against the limit
+	if strings.EqualFold(cfg.Source, "child") && builds > 0 {
+		builds--
+	}
+
 	logger.Debugf("currently %d builds running on repo %s", builds, r.GetFullName())
 
 	// check if the number of pending and running builds exceeds the limit for the repo
@@ -232,8 +238,15 @@ func CompileAndPublish(
 		pipeline *types.Pipeline
 		// variable to store the pipeline type for the repository
 		pipelineType = r.GetPipelineType()
+		// variable to store the digest of a generated pipeline configuration
+		digest string
 	)
 
+	// generated configurations are stored separately from the one at the commit
+	if len(cfg.Pipeline) > 0 {
+		digest = generatedDigest(cfg.Pipeline)
+	}
+
 	// implement a loop to process asynchronous operations with a retry limit
 	//
 	// Some operations taken during the webhook workflow can lead to race conditions
@@ -248,8 +261,15 @@ func CompileAndPublish(
 		}
 
 		// send database call to attempt to capture the pipeline if we already processed it before
-		pipeline, err = database.GetPipelineForRepo(ctx, b.GetCommit(), r)
-		if err != nil { // assume the pipeline doesn't exist in the database yet
+		if len(digest) > 0 {
+			pipeline, err = database.GetGeneratedPipelineForRepo(ctx, b.GetCommit(), digest, r)
+		} else {
+			pipeline, err = database.GetPipelineForRepo(ctx, b.GetCommit(), r)
+		}
+
+		if err != nil && len(digest) > 0 { // use the provided pipeline configuration
+			pipelineFile = cfg.Pipeline
+		} else if err != nil { // assume the pipeline doesn't exist in the database yet
 			// send API call to capture the pipeline configuration file
 			pipelineFile, err = scm.ConfigBackoff(ctx, u, r, b.GetCommit(), compileToken)
 			if err != nil {
@@ -342,6 +362,7 @@ func CompileAndPublish(
 			pipeline = compiled
 			pipeline.SetRepo(r)
 			pipeline.SetCommit(b.GetCommit())
+			pipeline.SetGeneratedDigest(digest)
 			pipeline.SetRef(b.GetRef())
 
 			// send API call to create the pipeline
@@ -479,6 +500,21 @@ func CompileAndPublish(
 		return nil, nil, http.StatusBadRequest, retErr
 	}
 
+	// restrict the route to workers satisfying the requested worker labels
+	if len(p.Worker.Labels) > 0 {
+		qs := queue.GetSettings()
+
+		route, err = labelRoute(ctx, database, route, qs.GetRoutes(), &p.Worker)
+		if err != nil {
+			retErr := fmt.Errorf("unable to set route for build %d for %s: %w", b.GetNumber(), r.GetFullName(), err)
+
+			// error out the build
+			CleanBuild(ctx, database, b, nil, nil, retErr)
+
+			return nil, nil, http.StatusBadRequest, retErr
+		}
+	}
+
 	b.SetRoute(route)
 
 	// publish the pipeline.Build to the build_executables table to be requested by a worker
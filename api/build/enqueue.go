@@ -20,6 +20,12 @@ import (
 
 // Enqueue is a helper function that pushes a queue item (build, repo, user) to the queue.
 func Enqueue(ctx context.Context, queue queue.Service, db database.Interface, item *models.Item, route string) {
+	enqueue(ctx, queue, db, item, route, true)
+}
+
+// enqueue is a helper function that pushes a queue item to the queue, checking
+// the worker pool quota of the org unless the item is released from being held.
+func enqueue(ctx context.Context, queue queue.Service, db database.Interface, item *models.Item, route string, checkQuota bool) {
 	l := logrus.WithFields(logrus.Fields{
 		"build":    item.Build.GetNumber(),
 		"build_id": item.Build.GetID(),
@@ -40,6 +46,23 @@ func Enqueue(ctx context.Context, queue queue.Service, db database.Interface, it
 		return
 	}
 
+	var hold string
+
+	// hold the build off the route when its org is over
+	// the quota of the worker pool serving the route
+	if checkQuota {
+		hold, err = holdRoute(ctx, queue, db, item.Build, route)
+		if err != nil {
+			l.Errorf("unable to check worker pool quota for build: %v", err)
+		}
+	}
+
+	if len(hold) > 0 {
+		l.Infof("org is over worker pool quota, holding build in queue route %s", hold)
+
+		route = hold
+	}
+
 	l.Debugf("pushing item for build to queue route %s", route)
 
 	// push item on to the queue
@@ -58,6 +81,11 @@ func Enqueue(ctx context.Context, queue queue.Service, db database.Interface, it
 		}
 	}
 
+	// held builds are enqueued once released
+	if len(hold) > 0 {
+		return
+	}
+
 	// update fields in build object
 	item.Build.SetEnqueued(time.Now().UTC().Unix())
 
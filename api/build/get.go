@@ -3,13 +3,16 @@
 package build
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/util"
 )
 
 // swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build} builds GetBuild
@@ -55,6 +58,10 @@ import (
 //     description: Not found
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
 
 // GetBuild represents the API handler to get
 // a build for a repository.
@@ -63,8 +70,21 @@ func GetBuild(c *gin.Context) {
 	l := c.MustGet("logger").(*logrus.Entry)
 	b := build.Retrieve(c)
 	r := repo.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	l.Debugf("reading build %s", entry)
+
+	// report the status of the build combined with its child builds
+	b, err := withChildStatus(ctx, database.FromContext(c), b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list child builds for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
 
-	l.Debugf("reading build %s/%d", r.GetFullName(), b.GetNumber())
+		return
+	}
 
 	c.JSON(http.StatusOK, b)
 }
@@ -37,6 +37,7 @@ type Graph struct {
 	Repo        string        `json:"repo"`
 	Nodes       map[int]*node `json:"nodes"`
 	Edges       []*edge       `json:"edges"`
+	Children    []*child      `json:"children"`
 }
 
 // node represents a pipeline stage and its relevant steps.
@@ -65,6 +66,15 @@ type edge struct {
 	Status string `json:"status"`
 }
 
+// child represents a build generated by a step in the build.
+type child struct {
+	BuildID     int64  `json:"build_id"`
+	BuildNumber int64  `json:"build_number"`
+	Status      string `json:"status"`
+	StartedAt   int    `json:"started_at"`
+	FinishedAt  int    `json:"finished_at"`
+}
+
 // stg represents a stage's steps and some metadata for producing node/edge information.
 type stg struct {
 	steps []*types.Step
@@ -157,7 +167,12 @@ func GetBuildGraph(c *gin.Context) {
 
 	var config []byte
 
-	lp, err := database.FromContext(c).GetPipelineForRepo(ctx, b.GetCommit(), r)
+	// child builds are compiled from their generated pipeline
+	lp, err := generatedPipeline(ctx, database.FromContext(c), b)
+	if err == nil && lp == nil {
+		lp, err = database.FromContext(c).GetPipelineForRepo(ctx, b.GetCommit(), r)
+	}
+
 	if err != nil { // assume the pipeline doesn't exist in the database yet (before pipeline support was added)
 		// send API call to capture the pipeline configuration file
 		config, err = scm.FromContext(c).ConfigBackoff(ctx, u, r, b.GetCommit(), u.GetToken())
@@ -209,6 +224,7 @@ func GetBuildGraph(c *gin.Context) {
 		WithMetadata(m).
 		WithRepo(r).
 		WithUser(u).
+		WithSCM(scm.FromContext(c)).
 		Compile(ctx, config)
 	if err != nil {
 		// format the error message with extra information
@@ -588,6 +604,28 @@ func GetBuildGraph(c *gin.Context) {
 	}
 
 	// construct the response
+	// retrieve the builds generated by the build
+	builds, err := listChildBuilds(ctx, database.FromContext(c), b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list child builds for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	children := []*child{}
+
+	for _, cb := range builds {
+		children = append(children, &child{
+			BuildID:     cb.GetID(),
+			BuildNumber: cb.GetNumber(),
+			Status:      cb.GetStatus(),
+			StartedAt:   int(cb.GetStarted()),
+			FinishedAt:  int(cb.GetFinished()),
+		})
+	}
+
 	graph := Graph{
 		BuildID:     b.GetID(),
 		BuildNumber: b.GetNumber(),
@@ -595,6 +633,7 @@ func GetBuildGraph(c *gin.Context) {
 		Repo:        r.GetName(),
 		Nodes:       nodes,
 		Edges:       edges,
+		Children:    children,
 	}
 
 	c.JSON(http.StatusOK, graph)
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/log"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage"
+)
+
+// finishLogs is a helper function to index the logs of a finished build
+// for search and to move the logs from the database to the storage.
+//
+// The logs are indexed before they are moved, since the data
+// of the moved logs is no longer kept in the database.
+func finishLogs(ctx context.Context, db database.Interface, st storage.Storage, b *types.Build, search, offload bool) {
+	if search {
+		logrus.Debugf("indexing logs for build %d", b.GetID())
+
+		err := log.IndexBuild(ctx, db, b)
+		if err != nil {
+			logrus.Errorf("unable to index logs for build %d: %v", b.GetID(), err)
+		}
+	}
+
+	if offload && st != nil {
+		logrus.Debugf("offloading logs for build %d", b.GetID())
+
+		err := log.OffloadBuild(ctx, db, st, b)
+		if err != nil {
+			logrus.Errorf("unable to offload logs for build %d: %v", b.GetID(), err)
+		}
+	}
+}
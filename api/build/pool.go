@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+)
+
+// poolRoutes is a helper function to resolve the queue routes of a worker
+// pool from its own routes and the routes served by active workers
+// satisfying its labels.
+func poolRoutes(ctx context.Context, db database.Interface, pool *settings.WorkerPool) ([]string, error) {
+	routes := make([]string, 0, len(pool.GetRoutes()))
+
+	for _, r := range pool.GetRoutes() {
+		routes = append(routes, strings.ToLower(r))
+	}
+
+	if len(pool.GetLabels()) > 0 {
+		workers, err := db.ListWorkers(ctx, "true", time.Now().Unix(), 0)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list active workers: %w", err)
+		}
+
+		selector := &pipeline.Worker{Labels: pool.GetLabels()}
+
+		for _, worker := range workers {
+			if !selector.Matches(worker.GetLabels()) {
+				continue
+			}
+
+			for _, r := range worker.GetRoutes() {
+				routes = append(routes, strings.ToLower(r))
+			}
+		}
+	}
+
+	slices.Sort(routes)
+
+	return slices.Compact(routes), nil
+}
+
+// holdRoute is a helper function to reserve a slot of the quota of the org
+// in the worker pool of the route for a build published to the route. The
+// route holding the build is returned when no slot is reserved, otherwise empty.
+func holdRoute(ctx context.Context, queue queue.Service, db database.Interface, b *types.Build, route string) (string, error) {
+	qs := queue.GetSettings()
+	org := b.GetRepo().GetOrg()
+
+	// the slots reserved in other pools are freed when the build is held
+	reserved := []settings.WorkerPool{}
+
+	for _, pool := range qs.GetPools() {
+		if pool.QuotaForOrg(org) == nil {
+			continue
+		}
+
+		routes, err := poolRoutes(ctx, db, &pool)
+		if err != nil {
+			return "", err
+		}
+
+		if !slices.Contains(routes, strings.ToLower(route)) {
+			continue
+		}
+
+		// builds wait behind the builds of the org already held
+		ok, err := queue.Reserve(ctx, &pool, org, b.GetID())
+		if err != nil {
+			return "", fmt.Errorf("unable to reserve slot of worker pool %s: %w", pool.GetName(), err)
+		}
+
+		if ok {
+			reserved = append(reserved, pool)
+
+			continue
+		}
+
+		for _, r := range reserved {
+			err = queue.Release(ctx, &r, org, b.GetID())
+			if err != nil {
+				logrus.WithError(err).Warnf("unable to release slot of worker pool %s for build %d", r.GetName(), b.GetID())
+			}
+		}
+
+		return pool.HoldRoute(org), nil
+	}
+
+	return "", nil
+}
+
+// ReleaseHeld is a helper function that frees the slots of the quota of
+// every org in a worker pool held by finished builds and publishes the
+// builds held over the quota to the queue, in the order they were held,
+// until the org reaches its quota again.
+func ReleaseHeld(ctx context.Context, queue queue.Service, db database.Interface) error {
+	qs := queue.GetSettings()
+
+	for _, pool := range qs.GetPools() {
+		for _, quota := range pool.GetQuotas() {
+			err := releaseHeldForOrg(ctx, queue, db, &pool, quota.GetOrg())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// releaseHeldForOrg is a helper function to free the slots held by finished
+// builds of an org in a worker pool and to publish the builds of the org
+// held in the pool to the queue while a slot can be reserved for them.
+func releaseHeldForOrg(ctx context.Context, queue queue.Service, db database.Interface, pool *settings.WorkerPool, org string) error {
+	reserved, err := queue.Reserved(ctx, pool, org)
+	if err != nil {
+		return fmt.Errorf("unable to list builds reserving slots of worker pool %s: %w", pool.GetName(), err)
+	}
+
+	for _, id := range reserved {
+		b, err := db.GetBuild(ctx, id)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			logrus.WithError(err).Warnf("unable to get build %d reserving slot of worker pool %s", id, pool.GetName())
+
+			continue
+		}
+
+		// the build is still queued or running
+		if err == nil && (b.GetStatus() == constants.StatusPending || b.GetStatus() == constants.StatusRunning) {
+			continue
+		}
+
+		err = queue.Release(ctx, pool, org, id)
+		if err != nil {
+			return fmt.Errorf("unable to release slot of worker pool %s for build %d: %w", pool.GetName(), id, err)
+		}
+	}
+
+	for {
+		item, err := queue.PopHeld(ctx, pool, org)
+		if err != nil {
+			return fmt.Errorf("unable to pop item from queue %s: %w", pool.HoldRoute(org), err)
+		}
+
+		// no build is held or the org reached its quota
+		if item == nil {
+			return nil
+		}
+
+		// refresh the build since it may have been canceled while held
+		b, err := db.GetBuild(ctx, item.Build.GetID())
+		if err != nil {
+			logrus.WithError(err).Warnf("unable to get build %d held in worker pool %s", item.Build.GetID(), pool.GetName())
+
+			continue
+		}
+
+		if b.GetStatus() != constants.StatusPending {
+			logrus.Debugf("dropping build %d held in worker pool %s with status %s", b.GetID(), pool.GetName(), b.GetStatus())
+
+			err = queue.Release(ctx, pool, org, b.GetID())
+			if err != nil {
+				return fmt.Errorf("unable to release slot of worker pool %s for build %d: %w", pool.GetName(), b.GetID(), err)
+			}
+
+			continue
+		}
+
+		logrus.Infof("releasing build %s/%d held in worker pool %s", b.GetRepo().GetFullName(), b.GetNumber(), pool.GetName())
+
+		item.Build = b
+
+		enqueue(ctx, queue, db, item, b.GetRoute(), false)
+	}
+}
+
+// ListPools is a helper function that captures the usage of the
+// quotas of every org in the worker pools of the queue.
+func ListPools(ctx context.Context, queue queue.Service, db database.Interface) ([]types.QueuePool, error) {
+	qs := queue.GetSettings()
+
+	pools := make([]types.QueuePool, 0, len(qs.GetPools()))
+
+	for _, pool := range qs.GetPools() {
+		routes, err := poolRoutes(ctx, db, &pool)
+		if err != nil {
+			return nil, err
+		}
+
+		quotas := make([]types.QueueQuota, 0, len(pool.GetQuotas()))
+
+		for _, quota := range pool.GetQuotas() {
+			active, err := db.CountActiveBuildsForOrg(ctx, quota.GetOrg(), routes)
+			if err != nil {
+				return nil, fmt.Errorf("unable to count active builds for org %s: %w", quota.GetOrg(), err)
+			}
+
+			held, err := queue.RouteLength(ctx, pool.HoldRoute(quota.GetOrg()))
+			if err != nil {
+				return nil, fmt.Errorf("unable to get queue length for %s: %w", pool.HoldRoute(quota.GetOrg()), err)
+			}
+
+			q := types.QueueQuota{}
+			q.SetOrg(quota.GetOrg())
+			q.SetLimit(quota.GetLimit())
+			q.SetActive(active)
+			q.SetHeld(held)
+
+			quotas = append(quotas, q)
+		}
+
+		p := types.QueuePool{}
+		p.SetName(pool.GetName())
+		p.SetRoutes(routes)
+		p.SetQuotas(quotas)
+
+		pools = append(pools, p)
+	}
+
+	return pools, nil
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/queue/models"
+	"github.com/go-vela/server/queue/redis"
+)
+
+func Test_Pools(t *testing.T) {
+	// setup types
+	ctx := context.Background()
+
+	owner := testutils.APIUser().Crop()
+	owner.SetID(1)
+	owner.SetName("octocat")
+	owner.SetToken("foo")
+
+	repo := testutils.APIRepo()
+	repo.SetID(1)
+	repo.SetOwner(owner)
+	repo.SetHash("baz")
+	repo.SetOrg("payments")
+	repo.SetName("api")
+	repo.SetFullName("payments/api")
+	repo.SetVisibility("public")
+
+	running := testutils.APIBuild()
+	running.SetID(1)
+	running.SetRepo(repo)
+	running.SetNumber(1)
+	running.SetStatus(constants.StatusRunning)
+	running.SetEnqueued(time.Now().Unix())
+	running.SetRoute("secure")
+
+	pending := testutils.APIBuild()
+	pending.SetID(2)
+	pending.SetRepo(repo)
+	pending.SetNumber(2)
+	pending.SetStatus(constants.StatusPending)
+	pending.SetRoute("gpu-secure")
+
+	queued := testutils.APIBuild()
+	queued.SetID(3)
+	queued.SetRepo(repo)
+	queued.SetNumber(3)
+	queued.SetStatus(constants.StatusPending)
+	queued.SetRoute("secure")
+
+	worker := new(api.Worker)
+	worker.SetHostname("pool-gpu")
+	worker.SetAddress("http://pool-gpu:8080")
+	worker.SetRoutes([]string{"gpu-secure"})
+	worker.SetActive(true)
+	worker.SetLastCheckedIn(time.Now().Add(-time.Minute).Unix())
+	worker.SetLabels(map[string]string{"pool": "secure"})
+
+	quota := settings.PoolQuota{}
+	quota.SetOrg("payments")
+	quota.SetLimit(1)
+
+	pool := settings.WorkerPool{}
+	pool.SetName("secure")
+	pool.SetRoutes([]string{"secure"})
+	pool.SetLabels([]string{"pool=secure"})
+	pool.SetQuotas([]settings.PoolQuota{quota})
+
+	ps := new(settings.Platform)
+	ps.Queue = new(settings.Queue)
+	ps.SetRoutes([]string{"secure", "gpu-secure"})
+	ps.SetPools([]settings.WorkerPool{pool})
+
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	_, err = db.CreateUser(ctx, owner)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	_, err = db.CreateRepo(ctx, repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	for _, b := range []*api.Build{running, pending, queued} {
+		_, err = db.CreateBuild(ctx, b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	_, err = db.CreateWorker(ctx, worker)
+	if err != nil {
+		t.Errorf("unable to create test worker: %v", err)
+	}
+
+	q, err := redis.NewTest(
+		"tCIevHOBq6DdN5SSBtteXUusjjd0fOqzk2eyi0DMq04NewmShNKQeUbbp3vkvIckb4pCxc+vxUo+mYf/vzOaSg==",
+		"DXsJkoTSkHlG26d75LyHJG+KQsXPr8VKPpmH/78zmko=",
+		"secure", "gpu-secure",
+	)
+	if err != nil {
+		t.Fatalf("unable to create test queue: %v", err)
+	}
+
+	q.SetSettings(ps)
+
+	hold := pool.HoldRoute("payments")
+
+	// the running build holds the only slot of the org
+	reserved, err := q.Reserve(ctx, &pool, "payments", running.GetID())
+	if err != nil || !reserved {
+		t.Errorf("unable to reserve slot for running build: %v", err)
+	}
+
+	// the org is at its quota, so the builds are held
+	Enqueue(ctx, q, db, &models.Item{Build: pending}, pending.GetRoute())
+	Enqueue(ctx, q, db, &models.Item{Build: queued}, queued.GetRoute())
+
+	assertLength(t, q, "gpu-secure", 0)
+	assertLength(t, q, "secure", 0)
+	assertLength(t, q, hold, 2)
+
+	b, err := db.GetBuild(ctx, pending.GetID())
+	if err != nil {
+		t.Errorf("unable to get held build: %v", err)
+	}
+
+	if b.GetEnqueued() != 0 {
+		t.Errorf("held build enqueued is %d, want 0", b.GetEnqueued())
+	}
+
+	pools, err := ListPools(ctx, q, db)
+	if err != nil {
+		t.Errorf("ListPools returned err: %v", err)
+	}
+
+	want := api.QueueQuota{}
+	want.SetOrg("payments")
+	want.SetLimit(1)
+	want.SetActive(1)
+	want.SetHeld(2)
+
+	if len(pools) != 1 {
+		t.Fatalf("ListPools returned %d pools, want 1", len(pools))
+	}
+
+	if !reflect.DeepEqual(pools[0].GetRoutes(), []string{"gpu-secure", "secure"}) {
+		t.Errorf("ListPools routes are %v, want %v", pools[0].GetRoutes(), []string{"gpu-secure", "secure"})
+	}
+
+	if !reflect.DeepEqual(pools[0].GetQuotas(), []api.QueueQuota{want}) {
+		t.Errorf("ListPools quotas are %v, want %v", pools[0].GetQuotas(), []api.QueueQuota{want})
+	}
+
+	// the org is still at its quota, so nothing is released
+	err = ReleaseHeld(ctx, q, db)
+	if err != nil {
+		t.Errorf("ReleaseHeld returned err: %v", err)
+	}
+
+	assertLength(t, q, "gpu-secure", 0)
+	assertLength(t, q, hold, 2)
+
+	running.SetStatus(constants.StatusSuccess)
+
+	_, err = db.UpdateBuild(ctx, running)
+	if err != nil {
+		t.Errorf("unable to update running build: %v", err)
+	}
+
+	// the org is under its quota once the running build finished,
+	// so the first build held is released and the next one waits
+	err = ReleaseHeld(ctx, q, db)
+	if err != nil {
+		t.Errorf("ReleaseHeld returned err: %v", err)
+	}
+
+	assertLength(t, q, "gpu-secure", 1)
+	assertLength(t, q, "secure", 0)
+	assertLength(t, q, hold, 1)
+
+	b, err = db.GetBuild(ctx, pending.GetID())
+	if err != nil {
+		t.Errorf("unable to get released build: %v", err)
+	}
+
+	if b.GetEnqueued() == 0 {
+		t.Errorf("released build enqueued is 0, want it to be set")
+	}
+
+	// the finished build freed its slot and the released build holds it
+	got, err := q.Reserved(ctx, &pool, "payments")
+	if err != nil {
+		t.Errorf("unable to list reserved builds: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, []int64{pending.GetID()}) {
+		t.Errorf("reserved builds are %v, want %v", got, []int64{pending.GetID()})
+	}
+}
+
+// assertLength is a test helper function to verify
+// the number of items in a route of the queue.
+func assertLength(t *testing.T, q *redis.Client, route string, want int64) {
+	t.Helper()
+
+	got, err := q.RouteLength(context.Background(), route)
+	if err != nil {
+		t.Errorf("unable to get length of queue route %s: %v", route, err)
+	}
+
+	if got != want {
+		t.Errorf("length of queue route %s is %d, want %d", route, got, want)
+	}
+}
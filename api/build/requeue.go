@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/cache"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+)
+
+// Requeue is a helper function to restart a build on behalf of its sender without
+// a request, such as when the worker running the build was lost. The restarted build
+// is published and pushed to the queue, while the provided build is left unchanged.
+func Requeue(
+	ctx context.Context,
+	b *types.Build,
+	m *internal.Metadata,
+	database database.Interface,
+	cache cache.Service,
+	scm scm.Service,
+	compiler compiler.Engine,
+	queue queue.Service,
+) (*types.Build, error) {
+	// copy the build since it is prepopulated for the restart
+	restart := *b
+
+	// capture the generated pipeline when restarting a child build
+	generated, err := generatedPipeline(ctx, database, &restart)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pipeline for build %s/%d: %w", b.GetRepo().GetFullName(), b.GetNumber(), err)
+	}
+
+	// restart form
+	config := CompileAndPublishConfig{
+		Build:    &restart,
+		Metadata: m,
+		BaseErr:  "unable to requeue build",
+		Source:   "restart",
+		Retries:  1,
+	}
+
+	// child builds remain linked to the build that generated them
+	if generated != nil {
+		config.Pipeline = generated.GetData()
+	} else {
+		// parent to the previous build
+		restart.SetParent(b.GetNumber())
+	}
+
+	_, item, _, err := CompileAndPublish(
+		ctx,
+		config,
+		database,
+		cache,
+		scm,
+		compiler,
+		queue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// publish the build to the queue
+	go Enqueue(
+		context.WithoutCancel(ctx),
+		queue,
+		database,
+		item,
+		item.Build.GetRoute(),
+	)
+
+	return item.Build, nil
+}
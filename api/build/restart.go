@@ -151,10 +151,15 @@ func RestartBuild(c *gin.Context) {
 
 	b.SetSenderSCMID(senderID)
 
-	// parent to the previous build
-	b.SetParent(b.GetNumber())
+	// capture the generated pipeline when restarting a child build
+	generated, err := generatedPipeline(ctx, database.FromContext(c), b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get pipeline for build %s: %w", entry, err)
 
-	l.Debugf("generating queue items for build %s", entry)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
 
 	// restart form
 	config := CompileAndPublishConfig{
@@ -165,6 +170,16 @@ func RestartBuild(c *gin.Context) {
 		Retries:  1,
 	}
 
+	// child builds remain linked to the build that generated them
+	if generated != nil {
+		config.Pipeline = generated.GetData()
+	} else {
+		// parent to the previous build
+		b.SetParent(b.GetNumber())
+	}
+
+	l.Debugf("generating queue items for build %s", entry)
+
 	// generate queue items
 	_, item, code, err := CompileAndPublish(
 		c,
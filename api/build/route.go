@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/database"
+)
+
+// labelRoute is a helper function to decide the queue route of a build
+// requesting worker labels. Workers poll routes directly, so the build is
+// placed in a route only served by active workers satisfying the labels.
+// The route decided from the flavor and platform is required when the
+// pipeline requests either, otherwise the default route is preferred and
+// the first of the queue routes served by matching workers is used.
+func labelRoute(ctx context.Context, db database.Interface, route string, routes []string, w *pipeline.Worker) (string, error) {
+	workers, err := db.ListWorkers(ctx, "true", time.Now().Unix(), 0)
+	if err != nil {
+		return "", fmt.Errorf("unable to list active workers: %w", err)
+	}
+
+	// capture the routes served by matching and non-matching workers
+	matching := make(map[string]bool)
+	rejected := make(map[string]bool)
+
+	for _, worker := range workers {
+		match := w.Matches(worker.GetLabels())
+
+		for _, r := range worker.GetRoutes() {
+			r = strings.ToLower(r)
+
+			if match {
+				matching[r] = true
+			} else {
+				rejected[r] = true
+			}
+		}
+	}
+
+	qualifies := func(r string) bool {
+		r = strings.ToLower(r)
+
+		return matching[r] && !rejected[r]
+	}
+
+	if qualifies(route) {
+		return route, nil
+	}
+
+	// any other route would ignore the requested flavor and platform
+	if len(w.Flavor) > 0 || len(w.Platform) > 0 {
+		return "", fmt.Errorf("queue route %s is not served only by workers satisfying worker labels %s", route, strings.Join(w.Labels, ", "))
+	}
+
+	candidates := slices.Clone(routes)
+	slices.Sort(candidates)
+
+	for _, r := range candidates {
+		if qualifies(r) {
+			return r, nil
+		}
+	}
+
+	return "", fmt.Errorf("no queue route is served only by workers satisfying worker labels %s", strings.Join(w.Labels, ", "))
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/database"
+)
+
+func Test_labelRoute(t *testing.T) {
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	for _, w := range []struct {
+		hostname string
+		routes   []string
+		labels   map[string]string
+	}{
+		{hostname: "amd", routes: []string{"vela", "large"}, labels: map[string]string{"arch": "amd64"}},
+		{hostname: "arm", routes: []string{"vela", "arm"}, labels: map[string]string{"arch": "arm64"}},
+		{hostname: "gpu", routes: []string{"vela", "gpu"}, labels: map[string]string{"arch": "amd64", "gpu": "true"}},
+	} {
+		worker := new(api.Worker)
+		worker.SetHostname(w.hostname)
+		worker.SetAddress("http://" + w.hostname + ":8080")
+		worker.SetRoutes(w.routes)
+		worker.SetActive(true)
+		worker.SetLastCheckedIn(time.Now().Add(-time.Minute).Unix())
+		worker.SetLabels(w.labels)
+
+		_, err = db.CreateWorker(context.Background(), worker)
+		if err != nil {
+			t.Errorf("unable to create test worker: %v", err)
+		}
+	}
+
+	routes := []string{"vela", "large", "arm", "gpu"}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		route   string
+		flavor  string
+		labels  []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "route served only by matching workers",
+			route:  "gpu",
+			labels: []string{"gpu=true"},
+			want:   "gpu",
+		},
+		{
+			name:   "default route served by other workers",
+			route:  "vela",
+			labels: []string{"arch=arm64"},
+			want:   "arm",
+		},
+		{
+			name:   "first queue route served only by matching workers",
+			route:  "vela",
+			labels: []string{"arch=amd64"},
+			want:   "gpu",
+		},
+		{
+			name:    "flavor route served by other workers",
+			route:   "large",
+			flavor:  "large",
+			labels:  []string{"gpu=true"},
+			wantErr: true,
+		},
+		{
+			name:   "flavor route served only by matching workers",
+			route:  "gpu",
+			flavor: "gpu",
+			labels: []string{"arch=amd64"},
+			want:   "gpu",
+		},
+		{
+			name:    "no matching workers",
+			route:   "vela",
+			labels:  []string{"arch=s390x"},
+			wantErr: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := labelRoute(context.Background(), db, test.route, routes, &pipeline.Worker{Flavor: test.flavor, Labels: test.labels})
+			if (err != nil) != test.wantErr {
+				t.Errorf("labelRoute returned err %v, wantErr %v", err, test.wantErr)
+			}
+
+			if got != test.want {
+				t.Errorf("labelRoute is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
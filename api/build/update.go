@@ -3,6 +3,7 @@
 package build
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -14,9 +15,11 @@ import (
 	"github.com/go-vela/server/cache"
 	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/server/util"
 )
 
@@ -157,6 +160,16 @@ func UpdateBuild(c *gin.Context) {
 		b.SetDistribution(input.GetDistribution())
 	}
 
+	// capture the build that generated this build, if any
+	parent, err := childParent(ctx, database.FromContext(c), b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get parent build for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
 	// send API call to update the build
 	b, err = database.FromContext(c).UpdateBuild(ctx, b)
 	if err != nil {
@@ -169,6 +182,35 @@ func UpdateBuild(c *gin.Context) {
 
 	c.JSON(http.StatusOK, b)
 
+	// index and move the logs of a finished build in the background
+	if scmStatusReq && (c.GetBool("log-search") || c.GetBool("log-offload")) &&
+		b.GetStatus() != constants.StatusRunning && b.GetStatus() != constants.StatusPending && b.GetStatus() != constants.StatusPendingApproval {
+		go finishLogs(
+			context.WithoutCancel(ctx),
+			database.FromContext(c),
+			storage.FromGinContext(c),
+			b,
+			c.GetBool("log-search"),
+			c.GetBool("log-offload"),
+		)
+	}
+
+	// release the builds held over worker pool quotas now that a build finished
+	if q := queue.FromContext(c); q != nil && scmStatusReq &&
+		b.GetStatus() != constants.StatusRunning && b.GetStatus() != constants.StatusPending && b.GetStatus() != constants.StatusPendingApproval {
+		go func(db database.Interface) {
+			err := ReleaseHeld(context.WithoutCancel(ctx), q, db)
+			if err != nil {
+				l.Warnf("unable to release builds held in worker pools: %v", err)
+			}
+		}(database.FromContext(c))
+	}
+
+	// child builds report their status through the parent build
+	if parent != nil && scmStatusReq {
+		updateParentStatus(c, parent)
+	}
+
 	// check if the build is in a "final" state
 	// and if build is not a scheduled event
 	if scmStatusReq && b.GetEvent() != constants.EventSchedule {
@@ -186,10 +228,21 @@ func UpdateBuild(c *gin.Context) {
 			regenToken = true
 		}
 
-		// send API call to set the status on the commit
-		err = scm.FromContext(c).Status(ctx, b, scmToken)
-		if err != nil {
-			l.Errorf("unable to set commit status for build %s: %v", entry, err)
+		// child builds report their status through the parent build
+		if parent == nil {
+			// combine the status of any child builds with the status of the build
+			reported, err := withChildStatus(ctx, database.FromContext(c), b)
+			if err != nil {
+				l.Errorf("unable to list child builds for build %s: %v", entry, err)
+
+				reported = b
+			}
+
+			// send API call to set the status on the commit
+			err = scm.FromContext(c).Status(ctx, reported, scmToken)
+			if err != nil {
+				l.Errorf("unable to set commit status for build %s: %v", entry, err)
+			}
 		}
 
 		// if the build has reached a final state, evict the install token from cache
@@ -321,3 +374,49 @@ func UpdateComponentStatuses(c *gin.Context, b *types.Build, status, scmToken st
 
 	return nil
 }
+
+// updateParentStatus reports the status of a parent
+// build combined with the statuses of its child builds.
+func updateParentStatus(c *gin.Context, parent *types.Build) {
+	l := c.MustGet("logger").(*logrus.Entry)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d", parent.GetRepo().GetFullName(), parent.GetNumber())
+
+	err := reportStatus(ctx, database.FromContext(c), scm.FromContext(c), parent)
+	if err != nil {
+		l.Errorf("unable to set commit status for build %s: %v", entry, err)
+	}
+}
+
+// ReportStatus sets the commit status of a build finished outside of
+// the build API. Child builds report their status through the parent build.
+func ReportStatus(ctx context.Context, db database.Interface, scm scm.Service, b *types.Build) error {
+	// scheduled builds do not report a commit status
+	if b.GetEvent() == constants.EventSchedule {
+		return nil
+	}
+
+	parent, err := childParent(ctx, db, b)
+	if err != nil {
+		return fmt.Errorf("unable to get parent build: %w", err)
+	}
+
+	if parent != nil {
+		b = parent
+	}
+
+	return reportStatus(ctx, db, scm, b)
+}
+
+// reportStatus sets the commit status of a build
+// combined with the statuses of its child builds.
+func reportStatus(ctx context.Context, db database.Interface, scm scm.Service, b *types.Build) error {
+	reported, err := withChildStatus(ctx, db, b)
+	if err != nil {
+		return fmt.Errorf("unable to list child builds: %w", err)
+	}
+
+	// send API call to set the status on the commit
+	return scm.Status(ctx, reported, scm.GenerateStatusToken(ctx, b))
+}
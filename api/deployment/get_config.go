@@ -120,7 +120,7 @@ func getDeploymentConfig(c *gin.Context, l *logrus.Entry, u *types.User, r *type
 	}
 
 	// set up compiler
-	compiler := compiler.FromContext(c).Duplicate().WithCommit(ref).WithRepo(r).WithUser(u)
+	compiler := compiler.FromContext(c).Duplicate().WithCommit(ref).WithRepo(r).WithUser(u).WithSCM(scm.FromContext(c))
 
 	// compile the pipeline
 	pipeline, _, err := compiler.CompileLite(ctx, config, nil, true)
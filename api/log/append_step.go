@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/logs/chunks steps AppendStepLog
+//
+// Append a chunk to the step logs for a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: The log object with the data to append
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Log"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully appended to the logs for step
+//     schema:
+//       "$ref": "#/definitions/LogChunk"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AppendStepLog represents the API handler to append
+// a chunk to the logs for a step.
+func AppendStepLog(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	b := build.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	l.Debugf("appending to logs for step %s", entry)
+
+	// capture body from API request
+	input := new(types.Log)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if len(input.GetData()) == 0 {
+		retErr := fmt.Errorf("no log data provided for step %s", entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	lg := new(types.Log)
+	lg.SetRepoID(s.GetRepoID())
+	lg.SetBuildID(s.GetBuildID())
+	lg.SetStepID(s.GetID())
+
+	// send API call to append the chunk to the step logs
+	chunk, err := database.FromContext(c).CreateLogChunk(ctx, lg, input.GetData())
+	if err != nil {
+		var status int
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = http.StatusNotFound
+		} else {
+			status = http.StatusInternalServerError
+		}
+
+		retErr := fmt.Errorf("unable to append to logs for step %s: %w", entry, err)
+		util.HandleError(c, status, retErr)
+
+		return
+	}
+
+	publishChunk(ctx, l, queue.FromGinContext(c), stepChannel(s), chunk.Offset, chunk.Data)
+
+	c.JSON(http.StatusOK, chunk)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/server/util"
 )
 
@@ -92,6 +93,16 @@ func DeleteServiceLog(c *gin.Context) {
 		return
 	}
 
+	// remove the data of logs moved to the storage
+	err = remove(ctx, database.FromContext(c), storage.FromGinContext(c), sl)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete stored logs for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
 	// send API call to remove the log
 	err = database.FromContext(c).DeleteLog(ctx, sl)
 	if err != nil {
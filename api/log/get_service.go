@@ -6,15 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/server/util"
 )
 
@@ -46,6 +49,16 @@ import (
 //   description: Service number
 //   required: true
 //   type: integer
+// - in: query
+//   name: since
+//   description: Offset to read the logs from, only returning the data appended since
+//   type: integer
+//   default: 0
+// - in: query
+//   name: presign
+//   description: Redirect to download logs moved to the object storage instead of returning them
+//   type: boolean
+//   default: false
 // security:
 //   - ApiKeyAuth: []
 // responses:
@@ -53,6 +66,8 @@ import (
 //     description: Successfully retrieved the service logs
 //     schema:
 //       "$ref": "#/definitions/Log"
+//   '307':
+//     description: Redirected to download the logs moved to the object storage
 //   '400':
 //     description: Invalid request payload or path
 //     schema:
@@ -83,8 +98,61 @@ func GetServiceLog(c *gin.Context) {
 
 	l.Debugf("reading logs for service %s", entry)
 
+	// capture since query parameter if present, default to 0
+	since, err := strconv.ParseInt(c.DefaultQuery("since", "0"), 10, 64)
+	if err == nil && since < 0 {
+		err = fmt.Errorf("negative offset %d", since)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert since query parameter for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	st := storage.FromGinContext(c)
+
 	// send API call to capture the service logs
-	sl, err := database.FromContext(c).GetLogForService(ctx, s)
+	lookup := func() (*types.Log, error) {
+		return database.FromContext(c).GetLogForService(ctx, s)
+	}
+
+	// read the data of logs moved to the storage
+	get := func() (*types.Log, error) {
+		sl, err := lookup()
+		if err != nil {
+			return nil, err
+		}
+
+		return load(ctx, database.FromContext(c), st, sl)
+	}
+
+	var (
+		sl  *types.Log
+		url string
+	)
+
+	switch presigned, _ := strconv.ParseBool(c.Query("presign")); {
+	case since > 0:
+		// only read the logs appended since the offset
+		lg := new(types.Log)
+		lg.SetRepoID(s.GetRepoID())
+		lg.SetBuildID(s.GetBuildID())
+		lg.SetServiceID(s.GetID())
+
+		sl, err = logSince(ctx, database.FromContext(c), lg, since, get)
+	case presigned:
+		// logs that were not moved are returned with their data
+		sl, err = lookup()
+		if err == nil {
+			url, err = presign(ctx, database.FromContext(c), st, sl)
+		}
+	default:
+		sl, err = get()
+	}
+
 	if err != nil {
 		var status int
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -99,5 +167,12 @@ func GetServiceLog(c *gin.Context) {
 		return
 	}
 
+	// redirect to download the logs moved to the storage
+	if len(url) > 0 {
+		c.Redirect(http.StatusTemporaryRedirect, url)
+
+		return
+	}
+
 	c.JSON(http.StatusOK, sl)
 }
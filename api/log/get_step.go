@@ -6,15 +6,18 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 
+	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/server/util"
 )
 
@@ -46,6 +49,16 @@ import (
 //   description: Step number
 //   required: true
 //   type: integer
+// - in: query
+//   name: since
+//   description: Offset to read the logs from, only returning the data appended since
+//   type: integer
+//   default: 0
+// - in: query
+//   name: presign
+//   description: Redirect to download logs moved to the object storage instead of returning them
+//   type: boolean
+//   default: false
 // security:
 //   - ApiKeyAuth: []
 // responses:
@@ -54,6 +67,8 @@ import (
 //     type: json
 //     schema:
 //       "$ref": "#/definitions/Log"
+//   '307':
+//     description: Redirected to download the logs moved to the object storage
 //   '400':
 //     description: Invalid request payload or path
 //     schema:
@@ -84,8 +99,61 @@ func GetStepLog(c *gin.Context) {
 
 	l.Debugf("reading logs for step %s", entry)
 
+	// capture since query parameter if present, default to 0
+	since, err := strconv.ParseInt(c.DefaultQuery("since", "0"), 10, 64)
+	if err == nil && since < 0 {
+		err = fmt.Errorf("negative offset %d", since)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert since query parameter for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	st := storage.FromGinContext(c)
+
 	// send API call to capture the step logs
-	sl, err := database.FromContext(c).GetLogForStep(ctx, s)
+	lookup := func() (*types.Log, error) {
+		return database.FromContext(c).GetLogForStep(ctx, s)
+	}
+
+	// read the data of logs moved to the storage
+	get := func() (*types.Log, error) {
+		sl, err := lookup()
+		if err != nil {
+			return nil, err
+		}
+
+		return load(ctx, database.FromContext(c), st, sl)
+	}
+
+	var (
+		sl  *types.Log
+		url string
+	)
+
+	switch presigned, _ := strconv.ParseBool(c.Query("presign")); {
+	case since > 0:
+		// only read the logs appended since the offset
+		lg := new(types.Log)
+		lg.SetRepoID(s.GetRepoID())
+		lg.SetBuildID(s.GetBuildID())
+		lg.SetStepID(s.GetID())
+
+		sl, err = logSince(ctx, database.FromContext(c), lg, since, get)
+	case presigned:
+		// logs that were not moved are returned with their data
+		sl, err = lookup()
+		if err == nil {
+			url, err = presign(ctx, database.FromContext(c), st, sl)
+		}
+	default:
+		sl, err = get()
+	}
+
 	if err != nil {
 		var status int
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -100,5 +168,12 @@ func GetStepLog(c *gin.Context) {
 		return
 	}
 
+	// redirect to download the logs moved to the storage
+	if len(url) > 0 {
+		c.Redirect(http.StatusTemporaryRedirect, url)
+
+		return
+	}
+
 	c.JSON(http.StatusOK, sl)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/storage"
 	"github.com/go-vela/server/util"
 )
 
@@ -121,6 +122,18 @@ func ListLogsForBuild(c *gin.Context) {
 		return
 	}
 
+	// read the data of logs moved to the storage
+	for i, lg := range bl {
+		bl[i], err = load(ctx, database.FromContext(c), storage.FromGinContext(c), lg)
+		if err != nil {
+			retErr := fmt.Errorf("unable to read stored logs for build %s: %w", entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+	}
+
 	// create pagination object
 	pagination := api.Pagination{
 		Page:    page,
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	dbLog "github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/storage"
+)
+
+// objectName is a helper function to create the
+// name of the object a log is moved to in the storage.
+func objectName(lg *types.Log) string {
+	if lg.GetServiceID() > 0 {
+		return fmt.Sprintf("logs/%d/%d/service_%d.log", lg.GetRepoID(), lg.GetBuildID(), lg.GetServiceID())
+	}
+
+	return fmt.Sprintf("logs/%d/%d/step_%d.log", lg.GetRepoID(), lg.GetBuildID(), lg.GetStepID())
+}
+
+// Offload moves the data of a finished log to the storage,
+// leaving the log with a pointer to the object in the database.
+func Offload(ctx context.Context, db database.Interface, st storage.Storage, lg *types.Log) error {
+	object := &types.Object{
+		ObjectName:  objectName(lg),
+		Bucket:      types.Bucket{BucketName: st.GetBucket()},
+		Size:        int64(len(lg.GetData())),
+		ContentType: "text/plain",
+	}
+
+	// upload the object before updating the log so a failure leaves the data in the database
+	err := st.PutObject(ctx, object, bytes.NewReader(lg.GetData()))
+	if err != nil {
+		return fmt.Errorf("unable to upload log %d: %w", lg.GetID(), err)
+	}
+
+	err = db.OffloadLog(ctx, lg, object)
+	if err != nil {
+		return fmt.Errorf("unable to offload log %d: %w", lg.GetID(), err)
+	}
+
+	return nil
+}
+
+// OffloadBuild moves the data of every log for a finished
+// build that was not already moved to the storage.
+func OffloadBuild(ctx context.Context, db database.Interface, st storage.Storage, b *types.Build) error {
+	logs := []*types.Log{}
+	page := 1
+	perPage := 100
+
+	// capture every log before offloading any of them
+	// so the pages are not shifted by the updates
+	for page > 0 {
+		logsPart, err := db.ListLogsForBuild(ctx, b, page, perPage)
+		if err != nil {
+			return fmt.Errorf("unable to list logs for build %d: %w", b.GetID(), err)
+		}
+
+		logs = append(logs, logsPart...)
+
+		// assume no more pages exist if under 100 results are returned
+		if len(logsPart) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	for _, lg := range logs {
+		_, err := db.GetLogObject(ctx, lg)
+		if err == nil {
+			continue
+		}
+
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("unable to get object for log %d: %w", lg.GetID(), err)
+		}
+
+		err = Offload(ctx, db, st, lg)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// logObject is a helper function to get the object the
+// log was moved to, returning nil when the log was not moved.
+func logObject(ctx context.Context, db database.Interface, st storage.Storage, lg *types.Log) (*types.Object, error) {
+	// logs with data or without storage were not moved
+	if st == nil || len(lg.GetData()) > 0 {
+		return nil, nil
+	}
+
+	object, err := db.GetLogObject(ctx, lg)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	object.Bucket = types.Bucket{BucketName: st.GetBucket()}
+
+	return object, nil
+}
+
+// load is a helper function to read the data of
+// a log that was moved to the storage into the log.
+func load(ctx context.Context, db database.Interface, st storage.Storage, lg *types.Log) (*types.Log, error) {
+	object, err := logObject(ctx, db, st, lg)
+	if err != nil || object == nil {
+		return lg, err
+	}
+
+	reader, err := st.GetObject(ctx, object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download log %d: %w", lg.GetID(), err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read log %d: %w", lg.GetID(), err)
+	}
+
+	lg.SetData(data)
+
+	return lg, nil
+}
+
+// remove is a helper function to delete the
+// object a log was moved to from the storage.
+func remove(ctx context.Context, db database.Interface, st storage.Storage, lg *types.Log) error {
+	object, err := logObject(ctx, db, st, lg)
+	if err != nil || object == nil {
+		return err
+	}
+
+	return st.DeleteObject(ctx, object)
+}
+
+// RemoveObject returns the function removing the objects logs were
+// moved to from the storage when the logs are cleaned up, or nil
+// without storage.
+func RemoveObject(st storage.Storage) dbLog.RemoveObjectFunc {
+	if st == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, path string) error {
+		return st.DeleteObject(ctx, &types.Object{
+			ObjectName: path,
+			Bucket:     types.Bucket{BucketName: st.GetBucket()},
+		})
+	}
+}
+
+// presign is a helper function to create a URL to download a log
+// that was moved to the storage, returning an empty URL when the
+// log was not moved.
+func presign(ctx context.Context, db database.Interface, st storage.Storage, lg *types.Log) (string, error) {
+	object, err := logObject(ctx, db, st, lg)
+	if err != nil || object == nil {
+		return "", err
+	}
+
+	return st.PresignedGetObject(ctx, object)
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage/filesystem"
+)
+
+func TestLog_OffloadBuild(t *testing.T) {
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	st, err := filesystem.NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create test storage client: %v", err)
+	}
+
+	b := new(types.Build)
+	b.SetID(1)
+
+	service := new(types.Log)
+	service.SetRepoID(1)
+	service.SetBuildID(1)
+	service.SetServiceID(1)
+	service.SetData([]byte("foo"))
+	service.SetCreatedAt(1)
+
+	step := new(types.Log)
+	step.SetRepoID(1)
+	step.SetBuildID(1)
+	step.SetStepID(1)
+	step.SetData([]byte("bar"))
+	step.SetCreatedAt(1)
+
+	for _, lg := range []*types.Log{service, step} {
+		err = db.CreateLog(t.Context(), lg)
+		if err != nil {
+			t.Fatalf("unable to create log: %v", err)
+		}
+	}
+
+	// appended data is moved along with the log
+	_, err = db.CreateLogChunk(t.Context(), step, []byte("baz"))
+	if err != nil {
+		t.Fatalf("unable to append to log: %v", err)
+	}
+
+	// run test
+	err = OffloadBuild(t.Context(), db, st, b)
+	if err != nil {
+		t.Errorf("OffloadBuild returned err: %v", err)
+	}
+
+	// offloading the build again skips the moved logs
+	err = OffloadBuild(t.Context(), db, st, b)
+	if err != nil {
+		t.Errorf("OffloadBuild returned err for offloaded build: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		get    func() (*types.Log, error)
+		object string
+		want   string
+	}{
+		{
+			name:   "service",
+			get:    func() (*types.Log, error) { return db.GetLogForService(t.Context(), &types.Service{ID: new(int64(1))}) },
+			object: "logs/1/1/service_1.log",
+			want:   "foo",
+		},
+		{
+			name:   "step",
+			get:    func() (*types.Log, error) { return db.GetLogForStep(t.Context(), &types.Step{ID: new(int64(1))}) },
+			object: "logs/1/1/step_1.log",
+			want:   "barbaz",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lg, err := test.get()
+			if err != nil {
+				t.Fatalf("unable to get log: %v", err)
+			}
+
+			if len(lg.GetData()) > 0 {
+				t.Errorf("offloaded log data is %s, want none", lg.GetData())
+			}
+
+			url, err := presign(t.Context(), db, st, lg)
+			if err != nil {
+				t.Errorf("presign returned err: %v", err)
+			}
+
+			if len(url) == 0 {
+				t.Errorf("presign returned empty URL for offloaded log")
+			}
+
+			got, err := load(t.Context(), db, st, lg)
+			if err != nil {
+				t.Errorf("load returned err: %v", err)
+			}
+
+			if string(got.GetData()) != test.want {
+				t.Errorf("load is %s, want %s", got.GetData(), test.want)
+			}
+
+			// logs read without storage are returned without data
+			lg.SetData(nil)
+
+			got, err = load(t.Context(), db, nil, lg)
+			if err != nil {
+				t.Errorf("load returned err without storage: %v", err)
+			}
+
+			if len(got.GetData()) > 0 {
+				t.Errorf("load without storage is %s, want none", got.GetData())
+			}
+
+			err = remove(t.Context(), db, st, lg)
+			if err != nil {
+				t.Errorf("remove returned err: %v", err)
+			}
+
+			_, err = st.StatObject(t.Context(), &types.Object{ObjectName: test.object, Bucket: types.Bucket{BucketName: st.GetBucket()}})
+			if err == nil {
+				t.Errorf("StatObject should have returned err for removed object %s", test.object)
+			}
+		})
+	}
+}
+
+func TestLog_presign_NotOffloaded(t *testing.T) {
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	st, err := filesystem.NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create test storage client: %v", err)
+	}
+
+	lg := new(types.Log)
+	lg.SetRepoID(1)
+	lg.SetBuildID(1)
+	lg.SetStepID(1)
+	lg.SetData([]byte{})
+	lg.SetCreatedAt(1)
+
+	err = db.CreateLog(t.Context(), lg)
+	if err != nil {
+		t.Fatalf("unable to create log: %v", err)
+	}
+
+	// run test
+	url, err := presign(t.Context(), db, st, lg)
+	if err != nil {
+		t.Errorf("presign returned err: %v", err)
+	}
+
+	if len(url) > 0 {
+		t.Errorf("presign is %s, want empty URL for log that was not offloaded", url)
+	}
+}
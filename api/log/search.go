@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+)
+
+// IndexBuild creates the blocks of lines used to search
+// the logs of a finished build.
+//
+// Logs without data, such as logs moved to the storage,
+// are skipped to keep the blocks created before.
+func IndexBuild(ctx context.Context, db database.Interface, b *types.Build) error {
+	page := 1
+	perPage := 100
+
+	for page > 0 {
+		logsPart, err := db.ListLogsForBuild(ctx, b, page, perPage)
+		if err != nil {
+			return fmt.Errorf("unable to list logs for build %d: %w", b.GetID(), err)
+		}
+
+		for _, lg := range logsPart {
+			if len(lg.GetData()) == 0 {
+				continue
+			}
+
+			err = db.CreateLogSearch(ctx, lg)
+			if err != nil {
+				return fmt.Errorf("unable to create search for log %d: %w", lg.GetID(), err)
+			}
+		}
+
+		// assume no more pages exist if under 100 results are returned
+		if len(logsPart) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	return nil
+}
+
+// searchLogs is a helper function to search the logs for the repos
+// with the query parameters of the request and respond with the lines.
+func searchLogs(c *gin.Context, repos []*types.Repo, scope string) {
+	ctx := c.Request.Context()
+
+	if !c.GetBool("log-search") {
+		util.HandleError(c, http.StatusForbidden, errors.New("log search is not enabled"))
+
+		return
+	}
+
+	// capture q query parameter
+	query := strings.TrimSpace(c.Query("q"))
+	if len(query) == 0 {
+		retErr := fmt.Errorf("unable to search logs for %s: no q query parameter provided", scope)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture before query parameter if present, default to now
+	before, err := strconv.ParseInt(c.DefaultQuery("before", strconv.FormatInt(time.Now().UTC().Unix(), 10)), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert before query parameter for %s: %w", scope, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture after query parameter if present, default to 0
+	after, err := strconv.ParseInt(c.DefaultQuery("after", "0"), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert after query parameter for %s: %w", scope, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture lines query parameter if present, default to 2
+	lines, err := strconv.Atoi(c.DefaultQuery("lines", "2"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert lines query parameter for %s: %w", scope, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure lines isn't above or below allowed values
+	lines = max(0, min(10, lines))
+
+	// capture limit query parameter if present, default to 25
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "25"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert limit query parameter for %s: %w", scope, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure limit isn't above or below allowed values
+	limit = max(1, min(100, limit))
+
+	ids := []int64{}
+	names := make(map[int64]string)
+
+	for _, r := range repos {
+		ids = append(ids, r.GetID())
+		names[r.GetID()] = r.GetFullName()
+	}
+
+	// send API call to search the logs
+	results, err := database.FromContext(c).SearchLogs(ctx, ids, query, after, before, lines, limit)
+	if err != nil {
+		retErr := fmt.Errorf("unable to search logs for %s: %w", scope, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	err = describe(ctx, database.FromContext(c), names, results)
+	if err != nil {
+		retErr := fmt.Errorf("unable to search logs for %s: %w", scope, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// describe is a helper function to add the repo, build and
+// step or service the lines of a log search were found in.
+//
+// Lines from builds, steps or services that were removed are
+// returned without the details of the removed resources.
+func describe(ctx context.Context, db database.Interface, repos map[int64]string, results []*types.LogSearchResult) error {
+	builds := make(map[int64]*types.Build)
+	steps := make(map[int64]*types.Step)
+	services := make(map[int64]*types.Service)
+
+	for _, result := range results {
+		result.Repo = repos[result.RepoID]
+
+		b, err := lookup(builds, result.BuildID, func() (*types.Build, error) {
+			return db.GetBuild(ctx, result.BuildID)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to get build %d: %w", result.BuildID, err)
+		}
+
+		result.Build = b.GetNumber()
+
+		switch {
+		case result.StepID > 0:
+			s, err := lookup(steps, result.StepID, func() (*types.Step, error) {
+				return db.GetStep(ctx, result.StepID)
+			})
+			if err != nil {
+				return fmt.Errorf("unable to get step %d: %w", result.StepID, err)
+			}
+
+			result.Step = s.GetNumber()
+			result.Name = s.GetName()
+		case result.ServiceID > 0:
+			s, err := lookup(services, result.ServiceID, func() (*types.Service, error) {
+				return db.GetService(ctx, result.ServiceID)
+			})
+			if err != nil {
+				return fmt.Errorf("unable to get service %d: %w", result.ServiceID, err)
+			}
+
+			result.Service = s.GetNumber()
+			result.Name = s.GetName()
+		}
+	}
+
+	return nil
+}
+
+// lookup is a helper function to get a resource by ID once for
+// every line of a log search, returning nil for removed resources.
+func lookup[T any](cache map[int64]*T, id int64, get func() (*T, error)) (*T, error) {
+	v, ok := cache[id]
+	if ok {
+		return v, nil
+	}
+
+	v, err := get()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	cache[id] = v
+
+	return v, nil
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation GET /api/v1/search/logs/{org} builds SearchLogsForOrg
+//
+// Search the logs of the builds for the repos of an org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: query
+//   name: q
+//   description: Terms every matching line of the logs contains
+//   required: true
+//   type: string
+// - in: query
+//   name: before
+//   description: Search logs created before a certain time
+//   type: integer
+//   default: 1
+// - in: query
+//   name: after
+//   description: Search logs created after a certain time
+//   type: integer
+//   default: 0
+// - in: query
+//   name: lines
+//   description: Number of lines before and after each matching line (max 10)
+//   type: integer
+//   default: 2
+// - in: query
+//   name: limit
+//   description: Maximum number of matching lines (max 100)
+//   type: integer
+//   default: 25
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully searched the logs for the org
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LogSearchResult"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Log search is not enabled
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// SearchLogsForOrg represents the API handler to search the
+// logs of the builds for the repos of an org.
+//
+// Only the logs for public repos are searched unless
+// the user is an admin of the org.
+func SearchLogsForOrg(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	l.Debugf("searching logs for org %s", o)
+
+	filters := make(map[string]any)
+
+	// See if the user is an org admin to bypass individual permission checks
+	perm, err := scm.FromContext(c).OrgAccess(ctx, u, o)
+	if err != nil {
+		l.Errorf("unable to get user %s access level for org %s", u.GetName(), o)
+	}
+	// Only search public repos for non-admins
+	if perm != constants.PermissionAdmin {
+		filters["visibility"] = constants.VisibilityPublic
+	}
+
+	repos := []*types.Repo{}
+	page := 1
+	perPage := 100
+
+	for page > 0 {
+		// send API call to capture the list of repos for the org
+		reposPart, err := database.FromContext(c).ListReposForOrg(ctx, o, "name", filters, page, perPage)
+		if err != nil {
+			retErr := fmt.Errorf("unable to list repos for org %s: %w", o, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		repos = append(repos, reposPart...)
+
+		// assume no more pages exist if under 100 results are returned
+		if len(reposPart) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	searchLogs(c, repos, o)
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/router/middleware/repo"
+)
+
+// swagger:operation GET /api/v1/search/logs/{org}/{repo} builds SearchLogsForRepo
+//
+// Search the logs of the builds for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: query
+//   name: q
+//   description: Terms every matching line of the logs contains
+//   required: true
+//   type: string
+// - in: query
+//   name: before
+//   description: Search logs created before a certain time
+//   type: integer
+//   default: 1
+// - in: query
+//   name: after
+//   description: Search logs created after a certain time
+//   type: integer
+//   default: 0
+// - in: query
+//   name: lines
+//   description: Number of lines before and after each matching line (max 10)
+//   type: integer
+//   default: 2
+// - in: query
+//   name: limit
+//   description: Maximum number of matching lines (max 100)
+//   type: integer
+//   default: 25
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully searched the logs for the repo
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LogSearchResult"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Log search is not enabled
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// SearchLogsForRepo represents the API handler to search
+// the logs of the builds for a repo.
+func SearchLogsForRepo(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	r := repo.Retrieve(c)
+
+	l.Debugf("searching logs for repo %s", r.GetFullName())
+
+	searchLogs(c, []*types.Repo{r}, r.GetFullName())
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/repo"
+)
+
+func TestLog_SearchLogsForRepo(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	owner := new(types.User)
+	owner.SetID(1)
+
+	r := new(types.Repo)
+	r.SetOwner(owner)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+	r.SetFullName("octocat/hello-world")
+	r.SetVisibility(constants.VisibilityPublic)
+	r.SetPipelineType(constants.PipelineTypeYAML)
+	r.SetHash("baz")
+
+	r, err = db.CreateRepo(t.Context(), r)
+	if err != nil {
+		t.Fatalf("unable to create repo: %v", err)
+	}
+
+	b := new(types.Build)
+	b.SetRepo(r)
+	b.SetEvent(constants.EventPush)
+
+	b, err = db.CreateBuild(t.Context(), b)
+	if err != nil {
+		t.Fatalf("unable to create build: %v", err)
+	}
+
+	s := new(types.Step)
+	s.SetRepoID(r.GetID())
+	s.SetBuildID(b.GetID())
+	s.SetNumber(2)
+	s.SetName("test")
+	s.SetImage("golang:latest")
+
+	s, err = db.CreateStep(t.Context(), s)
+	if err != nil {
+		t.Fatalf("unable to create step: %v", err)
+	}
+
+	lg := new(types.Log)
+	lg.SetRepoID(r.GetID())
+	lg.SetBuildID(b.GetID())
+	lg.SetStepID(s.GetID())
+	lg.SetData([]byte("=== RUN TestFoo\npanic: runtime error\ngoroutine 1 [running]:\n"))
+	lg.SetCreatedAt(1)
+
+	err = db.CreateLog(t.Context(), lg)
+	if err != nil {
+		t.Fatalf("unable to create log: %v", err)
+	}
+
+	err = IndexBuild(t.Context(), db, b)
+	if err != nil {
+		t.Fatalf("IndexBuild returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		enabled bool
+		query   string
+		status  int
+		want    []*types.LogSearchResult
+	}{
+		{
+			name:    "matching line",
+			enabled: true,
+			query:   "q=Runtime+Error&lines=1",
+			status:  http.StatusOK,
+			want: []*types.LogSearchResult{
+				{
+					Repo:    "octocat/hello-world",
+					RepoID:  r.GetID(),
+					Build:   b.GetNumber(),
+					BuildID: b.GetID(),
+					Step:    2,
+					StepID:  s.GetID(),
+					Name:    "test",
+					Line:    2,
+					Text:    "panic: runtime error",
+					Before:  []string{"=== RUN TestFoo"},
+					After:   []string{"goroutine 1 [running]:"},
+				},
+			},
+		},
+		{
+			name:    "outside of time range",
+			enabled: true,
+			query:   "q=panic&after=10",
+			status:  http.StatusOK,
+			want:    []*types.LogSearchResult{},
+		},
+		{
+			name:    "search not enabled",
+			enabled: false,
+			query:   "q=panic",
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "missing query",
+			enabled: true,
+			query:   "q=+",
+			status:  http.StatusBadRequest,
+		},
+		{
+			name:    "invalid before parameter",
+			enabled: true,
+			query:   "q=panic&before=foo",
+			status:  http.StatusBadRequest,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+			context, engine := gin.CreateTestContext(resp)
+
+			context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodGet, "/search?"+test.query, nil)
+
+			engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logrus.New())) })
+			engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+			engine.Use(func(c *gin.Context) { repo.ToContext(c, r) })
+			engine.Use(func(c *gin.Context) { c.Set("log-search", test.enabled) })
+			engine.GET("/search", SearchLogsForRepo)
+
+			engine.ServeHTTP(context.Writer, context.Request)
+
+			if resp.Code != test.status {
+				t.Errorf("SearchLogsForRepo returned %v, want %v", resp.Code, test.status)
+			}
+
+			if resp.Code != http.StatusOK {
+				return
+			}
+
+			got := []*types.LogSearchResult{}
+
+			err := json.Unmarshal(resp.Body.Bytes(), &got)
+			if err != nil {
+				t.Errorf("unable to unmarshal response: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("SearchLogsForRepo is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
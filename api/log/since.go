@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+)
+
+// logSince is a helper function to read the data of a log from the offset.
+//
+// While the log has chunks at or before the offset, only the chunks appended
+// since the offset are read. Otherwise the data is read from the full log,
+// which includes the chunks compacted into the log.
+func logSince(ctx context.Context, db database.Interface, lg *types.Log, since int64, get func() (*types.Log, error)) (*types.Log, error) {
+	chunks, err := db.ListLogChunks(ctx, lg, since)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chunks) > 0 && chunks[0].Offset <= since {
+		data := []byte{}
+
+		for _, chunk := range chunks {
+			data = append(data, chunk.Data...)
+		}
+
+		lg.SetData(data[since-chunks[0].Offset:])
+
+		return lg, nil
+	}
+
+	full, err := get()
+	if err != nil {
+		return nil, err
+	}
+
+	data := full.GetData()
+
+	full.SetData(data[min(since, int64(len(data))):])
+
+	return full, nil
+}
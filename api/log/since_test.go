@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+)
+
+func TestLog_logSince(t *testing.T) {
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	lg := new(types.Log)
+	lg.SetRepoID(1)
+	lg.SetBuildID(1)
+	lg.SetStepID(1)
+	lg.SetData([]byte("foo"))
+	lg.SetCreatedAt(1)
+
+	err = db.CreateLog(t.Context(), lg)
+	if err != nil {
+		t.Fatalf("unable to create log: %v", err)
+	}
+
+	step := new(types.Step)
+	step.SetID(1)
+
+	get := func() (*types.Log, error) {
+		return db.GetLogForStep(t.Context(), step)
+	}
+
+	// newLog is a helper function to identify the log by step
+	newLog := func() *types.Log {
+		l := new(types.Log)
+		l.SetStepID(1)
+
+		return l
+	}
+
+	for _, data := range []string{"bar", "baz"} {
+		_, err = db.CreateLogChunk(t.Context(), newLog(), []byte(data))
+		if err != nil {
+			t.Fatalf("unable to append to log: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		compact bool
+		since   int64
+		want    string
+	}{
+		{
+			name:  "within compacted data",
+			since: 1,
+			want:  "oobarbaz",
+		},
+		{
+			name:  "within chunk",
+			since: 4,
+			want:  "arbaz",
+		},
+		{
+			name:  "caught up",
+			since: 9,
+			want:  "",
+		},
+		{
+			name:    "after compaction",
+			compact: true,
+			since:   4,
+			want:    "arbaz",
+		},
+		{
+			name:    "past the end",
+			compact: true,
+			since:   20,
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.compact {
+				err := db.CompactLog(t.Context(), newLog())
+				if err != nil {
+					t.Fatalf("unable to compact log: %v", err)
+				}
+			}
+
+			got, err := logSince(t.Context(), db, newLog(), test.since, get)
+			if err != nil {
+				t.Errorf("logSince returned err: %v", err)
+			}
+
+			if string(got.GetData()) != test.want {
+				t.Errorf("logSince is %q, want %q", got.GetData(), test.want)
+			}
+		})
+	}
+}
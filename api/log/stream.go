@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/queue"
+)
+
+// streamHeartbeat is how often a log stream checks if the
+// step or service finished and keeps the connection alive.
+var streamHeartbeat = 15 * time.Second
+
+// stepChannel returns the channel the chunks appended to the logs for a step are published to.
+func stepChannel(s *types.Step) string {
+	return fmt.Sprintf("vela:logs:step:%d", s.GetID())
+}
+
+// serviceChannel returns the channel the chunks appended to the logs for a service are published to.
+func serviceChannel(s *types.Service) string {
+	return fmt.Sprintf("vela:logs:service:%d", s.GetID())
+}
+
+// running returns whether a step or service with the status may still produce logs.
+func running(status string) bool {
+	return status == constants.StatusPending || status == constants.StatusRunning
+}
+
+// publishChunk is a helper function to broadcast data appended to a log at
+// the offset to the server replicas streaming the log. Failing to publish
+// does not fail the update since streams recover from the database.
+func publishChunk(ctx context.Context, l *logrus.Entry, q queue.Service, channel string, offset int64, data []byte) {
+	if q == nil || len(data) == 0 {
+		return
+	}
+
+	message, err := json.Marshal(&types.LogChunk{Offset: offset, Data: data})
+	if err == nil {
+		err = q.Publish(ctx, channel, message)
+	}
+
+	if err != nil {
+		l.Warnf("unable to publish log chunk to %s: %v", channel, err)
+	}
+}
+
+// streamLog is a helper function to tail a log as server-sent events.
+//
+// The current log is sent first, followed by the chunks published for
+// the log until the step or service finishes. Every event carries the
+// offset of the data in the log, and chunks missed by the subscription
+// are recovered from the database, so clients receive the log in order.
+func streamLog(c *gin.Context, channel string, get func() (*types.Log, error), status func() (string, error)) error {
+	ctx := c.Request.Context()
+
+	// subscribe before reading the log so no appended chunk is missed
+	messages, err := queue.FromGinContext(c).Subscribe(ctx, channel)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to %s: %w", channel, err)
+	}
+
+	lg, err := get()
+	if err != nil {
+		return err
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	var offset int64
+
+	// send is a helper function to send the data of the log from the offset
+	send := func(data []byte) {
+		if int64(len(data)) <= offset {
+			return
+		}
+
+		c.SSEvent("log", &types.LogChunk{Offset: offset, Data: data[offset:]})
+
+		offset = int64(len(data))
+	}
+
+	// reload is a helper function to send the data of the log missed by the subscription
+	reload := func() bool {
+		lg, err := get()
+		if err != nil {
+			return false
+		}
+
+		send(lg.GetData())
+
+		return true
+	}
+
+	send(lg.GetData())
+
+	// avoid waiting on chunks for a step or service that already finished
+	s, err := status()
+	if err == nil && !running(s) {
+		c.SSEvent("done", s)
+
+		return nil
+	}
+
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.Stream(func(_ io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case message, ok := <-messages:
+			if !ok {
+				return false
+			}
+
+			chunk := new(types.LogChunk)
+
+			err := json.Unmarshal(message, chunk)
+			if err != nil {
+				return true
+			}
+
+			end := chunk.Offset + int64(len(chunk.Data))
+
+			switch {
+			case end <= offset:
+				// the chunk was already sent
+			case chunk.Offset > offset:
+				// a chunk was missed so read the log instead
+				return reload()
+			default:
+				c.SSEvent("log", &types.LogChunk{Offset: offset, Data: chunk.Data[offset-chunk.Offset:]})
+
+				offset = end
+			}
+
+			return true
+		case <-heartbeat.C:
+			s, err := status()
+			if err != nil {
+				return false
+			}
+
+			if running(s) {
+				c.SSEvent("ping", "")
+
+				return true
+			}
+
+			// send the remainder of the log once the step or service finished
+			if reload() {
+				c.SSEvent("done", s)
+			}
+
+			return false
+		}
+	})
+
+	return nil
+}
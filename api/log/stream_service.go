@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/logs/stream services StreamServiceLog
+//
+// Stream the service logs for a build as server-sent events
+//
+// ---
+// produces:
+// - text/event-stream
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: service
+//   description: Service number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully streamed the logs for service
+//     schema:
+//       "$ref": "#/definitions/LogChunk"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// StreamServiceLog represents the API handler to stream
+// the logs for a service until the service finishes.
+func StreamServiceLog(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	b := build.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := service.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	l.Debugf("streaming logs for service %s", entry)
+
+	get := func() (*types.Log, error) {
+		lg, err := database.FromContext(c).GetLogForService(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+
+		// read the data of logs moved to the storage
+		return load(ctx, database.FromContext(c), storage.FromGinContext(c), lg)
+	}
+
+	status := func() (string, error) {
+		current, err := database.FromContext(c).GetService(ctx, s.GetID())
+
+		return current.GetStatus(), err
+	}
+
+	err := streamLog(c, serviceChannel(s), get, status)
+	if err != nil {
+		retErr := fmt.Errorf("unable to stream logs for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+}
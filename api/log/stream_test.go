@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/queue/redis"
+)
+
+func TestLog_streamLog(t *testing.T) {
+	// setup types
+	gin.SetMode(gin.TestMode)
+
+	streamHeartbeat = 50 * time.Millisecond
+
+	q, err := redis.NewTest("", "", "vela")
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	var (
+		mu     sync.Mutex
+		data   = []byte("foo")
+		status = constants.StatusRunning
+	)
+
+	get := func() (*types.Log, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		lg := new(types.Log)
+		lg.SetData(append([]byte{}, data...))
+
+		return lg, nil
+	}
+
+	getStatus := func() (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return status, nil
+	}
+
+	engine := gin.New()
+	engine.GET("/stream", func(c *gin.Context) {
+		queue.WithGinContext(c, q)
+
+		err := streamLog(c, "vela:logs:step:1", get, getStatus)
+		if err != nil {
+			t.Errorf("streamLog returned err: %v", err)
+		}
+	})
+
+	server := httptest.NewServer(engine)
+	defer server.Close()
+
+	// run test
+	resp, err := http.Get(server.URL + "/stream")
+	if err != nil {
+		t.Fatalf("unable to stream logs: %v", err)
+	}
+	defer resp.Body.Close()
+
+	events := make(chan [2]string)
+
+	go func() {
+		defer close(events)
+
+		var event string
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			switch {
+			case strings.HasPrefix(line, "event:"):
+				event = strings.TrimPrefix(line, "event:")
+			case strings.HasPrefix(line, "data:") && event != "ping":
+				events <- [2]string{event, strings.TrimPrefix(line, "data:")}
+			}
+		}
+	}()
+
+	var got []string
+
+	next := func() bool {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return false
+			}
+
+			if e[0] == "log" {
+				chunk := new(types.LogChunk)
+
+				err := json.Unmarshal([]byte(e[1]), chunk)
+				if err != nil {
+					t.Errorf("unable to decode chunk %s: %v", e[1], err)
+				}
+
+				got = append(got, e[0]+":"+string(chunk.Data))
+			} else {
+				got = append(got, e[0]+":"+e[1])
+			}
+
+			return true
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for log events")
+
+			return false
+		}
+	}
+
+	// wait for the current log before appending to it
+	next()
+
+	mu.Lock()
+	data = []byte("foobar")
+	mu.Unlock()
+
+	for _, chunk := range []*types.LogChunk{
+		{Offset: 3, Data: []byte("bar")},
+		// already sent
+		{Offset: 0, Data: []byte("foo")},
+		// overlaps the data already sent
+		{Offset: 3, Data: []byte("barbaz")},
+	} {
+		message, _ := json.Marshal(chunk)
+
+		err = q.Publish(context.Background(), "vela:logs:step:1", message)
+		if err != nil {
+			t.Errorf("Publish returned err: %v", err)
+		}
+	}
+
+	// wait for the appended data before finishing the step
+	for len(got) < 3 {
+		if !next() {
+			break
+		}
+	}
+
+	mu.Lock()
+	data = []byte("foobarbazqux")
+	status = constants.StatusSuccess
+	mu.Unlock()
+
+	for {
+		if !next() {
+			break
+		}
+	}
+
+	want := []string{"log:foo", "log:bar", "log:baz", "log:qux", "done:success"}
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("streamLog is %v, want %v", got, want)
+	}
+}
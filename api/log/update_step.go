@@ -3,6 +3,7 @@
 package log
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/step"
@@ -109,6 +111,9 @@ func UpdateStepLog(c *gin.Context) {
 		return
 	}
 
+	// capture the existing data to publish what the update appends
+	previous := sl.GetData()
+
 	// update log fields if provided
 	if len(input.GetData()) > 0 {
 		// update data if set
@@ -125,5 +130,10 @@ func UpdateStepLog(c *gin.Context) {
 		return
 	}
 
+	// publish the appended data for workers replacing the log wholesale
+	if len(input.GetData()) > len(previous) && bytes.HasPrefix(input.GetData(), previous) {
+		publishChunk(ctx, l, queue.FromGinContext(c), stepChannel(s), int64(len(previous)), input.GetData()[len(previous):])
+	}
+
 	c.JSON(http.StatusOK, nil)
 }
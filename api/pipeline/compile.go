@@ -15,6 +15,7 @@ import (
 	pMiddleware "github.com/go-vela/server/router/middleware/pipeline"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/util"
 )
 
@@ -93,7 +94,7 @@ func CompilePipeline(c *gin.Context) {
 	r.SetPipelineType(p.GetType())
 
 	// create the compiler object
-	compiler := compiler.FromContext(c).Duplicate().WithCommit(p.GetCommit()).WithMetadata(m).WithRepo(r).WithUser(u)
+	compiler := compiler.FromContext(c).Duplicate().WithCommit(p.GetCommit()).WithMetadata(m).WithRepo(r).WithUser(u).WithSCM(scm.FromContext(c))
 
 	ruleData := prepareRuleData(c)
 
@@ -116,12 +117,16 @@ func prepareRuleData(c *gin.Context) *pipeline.RuleData {
 	branch := c.Query("branch")
 	// capture the comment parameter
 	comment := c.Query("comment")
+	// capture the email parameter
+	email := c.Query("email")
 	// capture the event type parameter
 	event := c.Query("event")
 	// capture the instance parameter
 	instance := c.Query("instance")
 	// capture the label parameter
 	labelSet := c.QueryArray("label")
+	// capture the message parameter
+	message := c.Query("message")
 	// capture the repo parameter
 	ruleDataRepo := c.Query("repo")
 	// capture the sender parameter
@@ -138,9 +143,11 @@ func prepareRuleData(c *gin.Context) *pipeline.RuleData {
 	// if any ruledata query params were provided, create ruledata struct
 	if len(branch) > 0 ||
 		len(comment) > 0 ||
+		len(email) > 0 ||
 		len(event) > 0 ||
 		len(instance) > 0 ||
 		len(labelSet) > 0 ||
+		len(message) > 0 ||
 		len(pathSet) > 0 ||
 		len(ruleDataRepo) > 0 ||
 		len(sender) > 0 ||
@@ -150,9 +157,11 @@ func prepareRuleData(c *gin.Context) *pipeline.RuleData {
 		return &pipeline.RuleData{
 			Branch:   branch,
 			Comment:  comment,
+			Email:    email,
 			Event:    event,
 			Instance: instance,
 			Label:    labelSet,
+			Message:  message,
 			Path:     pathSet,
 			Repo:     ruleDataRepo,
 			Sender:   sender,
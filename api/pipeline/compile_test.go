@@ -28,9 +28,11 @@ func TestPrepareRuleData(t *testing.T) {
 			parameters: map[string]string{
 				"branch":   "main",
 				"comment":  "Test comment",
+				"email":    "octocat@github.com",
 				"event":    "push",
 				"instance": "vela-server",
 				"label":    "bug",
+				"message":  "[skip deploy] fix docs",
 				"repo":     "my-repo",
 				"sender":   "octocat",
 				"status":   "success",
@@ -41,9 +43,11 @@ func TestPrepareRuleData(t *testing.T) {
 			want: &pipeline.RuleData{
 				Branch:   "main",
 				Comment:  "Test comment",
+				Email:    "octocat@github.com",
 				Event:    "push",
 				Instance: "vela-server",
 				Label:    []string{"bug"},
+				Message:  "[skip deploy] fix docs",
 				Repo:     "my-repo",
 				Sender:   "octocat",
 				Status:   "success",
@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal"
+	pMiddleware "github.com/go-vela/server/router/middleware/pipeline"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation POST /api/v1/pipelines/{org}/{repo}/{pipeline}/diff pipelines DiffPipeline
+//
+// Compare the compiled pipeline for a commit against the compiled pipeline for a base commit
+//
+// ---
+// produces:
+// - application/yaml
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: pipeline
+//   description: Commit SHA for the head pipeline to compare
+//   required: true
+//   type: string
+// - in: query
+//   name: base
+//   description: Commit SHA for the base pipeline to compare against
+//   required: true
+//   type: string
+// - in: query
+//   name: event
+//   description: Build event used to compile both pipelines
+//   type: string
+//   default: push
+// - in: query
+//   name: branch
+//   description: Build branch used to compile both pipelines, defaults to the repo branch
+//   type: string
+// - in: query
+//   name: pull_request
+//   description: Pull request number to post the differences to as a comment, requires admin permissions for repos without the GitHub App installed
+//   type: integer
+// - in: query
+//   name: output
+//   description: Output string for specifying output format
+//   type: string
+//   default: yaml
+//   enum:
+//   - json
+//   - yaml
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully compared the pipelines
+//     schema:
+//       "$ref": "#/definitions/PipelineDiff"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DiffPipeline represents the API handler to compile a pipeline
+// configuration at two commits and capture the semantic differences
+// between them, optionally posting them to a pull request.
+func DiffPipeline(c *gin.Context) {
+	// capture middleware values
+	m := c.MustGet("metadata").(*internal.Metadata)
+	l := c.MustGet("logger").(*logrus.Entry)
+	p := pMiddleware.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	base := c.Query("base")
+	if len(base) == 0 {
+		retErr := fmt.Errorf("no base parameter provided")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	entry := fmt.Sprintf("%s/%s...%s", r.GetFullName(), base, p.GetCommit())
+
+	l.Debugf("comparing pipelines %s", entry)
+
+	number := 0
+
+	if pr := c.Query("pull_request"); len(pr) > 0 {
+		var err error
+
+		number, err = strconv.Atoi(pr)
+		if err != nil || number <= 0 {
+			retErr := fmt.Errorf("invalid pull_request parameter provided: %s", pr)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		// repos without the app installed are commented on with the
+		// owner's token, so only repo admins may comment as the owner
+		if r.GetInstallID() == 0 && !u.GetAdmin() {
+			perm, err := scm.FromContext(c).RepoAccess(ctx, u.GetName(), u.GetToken(), r.GetOrg(), r.GetName())
+			if err != nil || perm != constants.PermissionAdmin {
+				retErr := fmt.Errorf("user %s does not have 'admin' permissions to comment on pull requests for %s", u.GetName(), r.GetFullName())
+
+				util.HandleError(c, http.StatusUnauthorized, retErr)
+
+				return
+			}
+		}
+	}
+
+	basePipeline, err := diffBase(c, r, u, base)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get base pipeline for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// both pipelines are compiled for the same build so only the configuration differs
+	b := new(api.Build)
+	b.SetRepo(r)
+	b.SetEvent(util.QueryParameter(c, "event", constants.EventPush))
+	b.SetBranch(util.QueryParameter(c, "branch", r.GetBranch()))
+
+	compile := func(p *api.Pipeline) (*pipeline.Build, error) {
+		// ensure we use the expected pipeline type when compiling
+		r.SetPipelineType(p.GetType())
+
+		build, _, err := compiler.FromContext(c).
+			Duplicate().
+			WithBuild(b).
+			WithCommit(p.GetCommit()).
+			WithMetadata(m).
+			WithRepo(r).
+			WithUser(u).
+			WithSCM(scm.FromContext(c)).
+			Compile(ctx, p.GetData())
+
+		return build, err
+	}
+
+	from, err := compile(basePipeline)
+	if err != nil {
+		retErr := fmt.Errorf("unable to compile base pipeline for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	to, err := compile(p)
+	if err != nil {
+		retErr := fmt.Errorf("unable to compile pipeline for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	diff := pipeline.Compare(from, to)
+
+	if number > 0 {
+		err = scm.FromContext(c).CommentPullRequest(ctx, r, number, diffComment(diff, base, p.GetCommit()))
+		if err != nil {
+			retErr := fmt.Errorf("unable to comment on pull request %d for %s: %w", number, entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+	}
+
+	writeOutput(c, diff)
+}
+
+// diffBase is a helper function to capture the base pipeline from the
+// database or, when it has not been stored yet, from the scm.
+func diffBase(c *gin.Context, r *api.Repo, u *api.User, commit string) (*api.Pipeline, error) {
+	ctx := c.Request.Context()
+
+	p, err := database.FromContext(c).GetPipelineForRepo(ctx, commit, r)
+	if err == nil {
+		return p, nil
+	}
+
+	config, err := scm.FromContext(c).ConfigBackoff(ctx, u, r, commit, u.GetToken())
+	if err != nil {
+		return nil, err
+	}
+
+	p = new(api.Pipeline)
+	p.SetCommit(commit)
+	p.SetData(config)
+	p.SetType(r.GetPipelineType())
+
+	return p, nil
+}
+
+// diffComment is a helper function to render the
+// differences between two pipelines as markdown.
+func diffComment(diff *pipeline.Diff, base, head string) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "### Pipeline changes from `%s` to `%s`\n\n", short(base), short(head))
+
+	if diff.Empty() {
+		sb.WriteString("No changes to the compiled pipeline.\n")
+
+		return sb.String()
+	}
+
+	if len(diff.Escalations) > 0 {
+		sb.WriteString("> [!WARNING]\n> Privilege escalations:\n")
+
+		for _, e := range diff.Escalations {
+			fmt.Fprintf(&sb, "> - %s\n", e)
+		}
+
+		sb.WriteString("\n")
+	}
+
+	containers := func(title string, diffs []*pipeline.ContainerDiff) {
+		if len(diffs) == 0 {
+			return
+		}
+
+		fmt.Fprintf(&sb, "#### %s\n\n| Name | Change | Details |\n| --- | --- | --- |\n", title)
+
+		for _, d := range diffs {
+			name := d.Name
+			if len(d.Stage) > 0 {
+				name = fmt.Sprintf("%s:%s", d.Stage, d.Name)
+			}
+
+			details := []string{}
+
+			if d.Image != nil {
+				details = append(details, "image "+valueChange(d.Image))
+			}
+
+			if d.Privileged != nil {
+				details = append(details, "privileged "+valueChange(d.Privileged))
+			}
+
+			for _, k := range d.Environment {
+				details = append(details, fmt.Sprintf("environment `%s` %s", k.Key, k.Change))
+			}
+
+			for _, k := range d.Secrets {
+				details = append(details, fmt.Sprintf("secret `%s` %s", k.Key, k.Change))
+			}
+
+			if len(d.Fields) > 0 {
+				details = append(details, "changed "+strings.Join(d.Fields, ", "))
+			}
+
+			fmt.Fprintf(&sb, "| `%s` | %s | %s |\n", name, d.Change, strings.Join(details, "<br>"))
+		}
+
+		sb.WriteString("\n")
+	}
+
+	containers("Steps", diff.Steps)
+	containers("Services", diff.Services)
+
+	if len(diff.Secrets) > 0 {
+		sb.WriteString("#### Secrets\n\n| Name | Change | Details |\n| --- | --- | --- |\n")
+
+		for _, d := range diff.Secrets {
+			details := []string{}
+
+			if d.Key != nil {
+				details = append(details, "key "+valueChange(d.Key))
+			}
+
+			if len(d.Fields) > 0 {
+				details = append(details, "changed "+strings.Join(d.Fields, ", "))
+			}
+
+			fmt.Fprintf(&sb, "| `%s` | %s | %s |\n", d.Name, d.Change, strings.Join(details, "<br>"))
+		}
+
+		sb.WriteString("\n")
+	}
+
+	if len(diff.Environment) > 0 {
+		sb.WriteString("#### Environment\n\n| Key | Change |\n| --- | --- |\n")
+
+		for _, k := range diff.Environment {
+			fmt.Fprintf(&sb, "| `%s` | %s |\n", k.Key, k.Change)
+		}
+	}
+
+	return sb.String()
+}
+
+// valueChange is a helper function to render the change of a value as markdown.
+func valueChange(v *pipeline.ValueDiff) string {
+	switch {
+	case len(v.From) == 0:
+		return fmt.Sprintf("`%s`", v.To)
+	case len(v.To) == 0:
+		return fmt.Sprintf("`%s`", v.From)
+	default:
+		return fmt.Sprintf("`%s` → `%s`", v.From, v.To)
+	}
+}
+
+// short is a helper function to shorten a commit SHA.
+func short(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+
+	return commit
+}
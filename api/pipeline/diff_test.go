@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/internal"
+	pipelineMiddleware "github.com/go-vela/server/router/middleware/pipeline"
+	repoMiddleware "github.com/go-vela/server/router/middleware/repo"
+	userMiddleware "github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/github"
+)
+
+func TestPipeline_DiffPipeline_CommentRequiresAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// setup mock scm
+	engine := gin.New()
+	engine.GET("/api/v3/repos/:org/:repo/collaborators/:user/permission", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"permission": "write", "role_name": "write"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, err := github.NewTest(s.URL)
+	if err != nil {
+		t.Fatalf("unable to create test scm: %v", err)
+	}
+
+	// setup context
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.Request = httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/api/v1/pipelines/foo/bar/123/diff?base=456&pull_request=1", nil)
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	c.Set("logger", logrus.NewEntry(logger))
+	c.Set("metadata", &internal.Metadata{})
+
+	owner := new(api.User)
+	owner.SetName("octocat")
+	owner.SetToken("foo")
+
+	r := new(api.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetOwner(owner)
+	repoMiddleware.ToContext(c, r)
+
+	p := new(api.Pipeline)
+	p.SetCommit("123")
+	pipelineMiddleware.ToContext(c, p)
+
+	u := new(api.User)
+	u.SetName("hubot")
+	u.SetToken("bar")
+	userMiddleware.ToContext(c, u)
+
+	scm.WithGinContext(c, client)
+
+	// run test
+	DiffPipeline(c)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("DiffPipeline returned %v, want %v", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestPipeline_diffComment(t *testing.T) {
+	// setup types
+	diff := &pipeline.Diff{
+		Steps: []*pipeline.ContainerDiff{
+			{
+				Name:   "unit",
+				Stage:  "test",
+				Change: pipeline.DiffChanged,
+				Image:  &pipeline.ValueDiff{From: "golang:1.22", To: "golang:1.23"},
+				Secrets: []*pipeline.KeyDiff{
+					{Key: "GITHUB_TOKEN", Change: pipeline.DiffAdded, To: "token"},
+				},
+				Fields: []string{"commands"},
+			},
+			{
+				Name:   "docker",
+				Change: pipeline.DiffAdded,
+				Image:  &pipeline.ValueDiff{To: "docker:dind"},
+			},
+		},
+		Secrets: []*pipeline.SecretDiff{
+			{Name: "token", Change: pipeline.DiffAdded, Key: &pipeline.ValueDiff{To: "org/token"}},
+		},
+		Environment: []*pipeline.KeyDiff{
+			{Key: "FOO", Change: pipeline.DiffChanged, From: "bar", To: "baz"},
+		},
+		Escalations: []string{"step docker runs privileged"},
+	}
+
+	want := []string{
+		"### Pipeline changes from `1234567` to `abcdefg`",
+		"",
+		"> [!WARNING]",
+		"> Privilege escalations:",
+		"> - step docker runs privileged",
+		"",
+		"#### Steps",
+		"",
+		"| Name | Change | Details |",
+		"| --- | --- | --- |",
+		"| `test:unit` | changed | image `golang:1.22` → `golang:1.23`<br>secret `GITHUB_TOKEN` added<br>changed commands |",
+		"| `docker` | added | image `docker:dind` |",
+		"",
+		"#### Secrets",
+		"",
+		"| Name | Change | Details |",
+		"| --- | --- | --- |",
+		"| `token` | added | key `org/token` |",
+		"",
+		"#### Environment",
+		"",
+		"| Key | Change |",
+		"| --- | --- |",
+		"| `FOO` | changed |",
+	}
+
+	// run test
+	got := diffComment(diff, "1234567890", "abcdefghij")
+
+	if diff := cmp.Diff(strings.Join(want, "\n")+"\n", got); diff != "" {
+		t.Errorf("diffComment() mismatch (-want +got):\n%s", diff)
+	}
+
+	got = diffComment(new(pipeline.Diff), "1234567890", "abcdefghij")
+
+	if !strings.Contains(got, "No changes to the compiled pipeline.") {
+		t.Errorf("diffComment() for empty diff is %s", got)
+	}
+}
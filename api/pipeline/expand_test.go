@@ -146,6 +146,10 @@ func newTestCompiler(t *testing.T) compiler.Engine {
 				Name:  "compiler-starlark-exec-limit",
 				Value: 0,
 			},
+			&cli.Int64Flag{
+				Name:  "compiler-jsonnet-exec-timeout",
+				Value: 0,
+			},
 		},
 	}
 
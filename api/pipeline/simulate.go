@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/compiler/types/yaml"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/internal"
+	pMiddleware "github.com/go-vela/server/router/middleware/pipeline"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+)
+
+// maxScenarios is the maximum number of scenarios
+// a pipeline can be simulated against in one request.
+const maxScenarios = 25
+
+// outputTable is the output format rendering the simulation as a plain text table.
+const outputTable = "table"
+
+// Scenario represents a hypothetical event a pipeline is simulated against.
+//
+// swagger:model Scenario
+type Scenario struct {
+	Name     string   `json:"name"               yaml:"name"`
+	Branch   string   `json:"branch,omitempty"   yaml:"branch,omitempty"`
+	Comment  string   `json:"comment,omitempty"  yaml:"comment,omitempty"`
+	Email    string   `json:"email,omitempty"    yaml:"email,omitempty"`
+	Event    string   `json:"event,omitempty"    yaml:"event,omitempty"`
+	Instance string   `json:"instance,omitempty" yaml:"instance,omitempty"`
+	Label    []string `json:"label,omitempty"    yaml:"label,omitempty"`
+	Message  string   `json:"message,omitempty"  yaml:"message,omitempty"`
+	Path     []string `json:"path,omitempty"     yaml:"path,omitempty"`
+	Repo     string   `json:"repo,omitempty"     yaml:"repo,omitempty"`
+	Sender   string   `json:"sender,omitempty"   yaml:"sender,omitempty"`
+	Status   string   `json:"status,omitempty"   yaml:"status,omitempty"`
+	Tag      string   `json:"tag,omitempty"      yaml:"tag,omitempty"`
+	Target   string   `json:"target,omitempty"   yaml:"target,omitempty"`
+}
+
+// Simulation represents the resources of a pipeline that would run for a scenario.
+type Simulation struct {
+	Scenario string   `json:"scenario"           yaml:"scenario"`
+	Stages   []string `json:"stages,omitempty"   yaml:"stages,omitempty"`
+	Steps    []string `json:"steps,omitempty"    yaml:"steps,omitempty"`
+	Services []string `json:"services,omitempty" yaml:"services,omitempty"`
+	Secrets  []string `json:"secrets,omitempty"  yaml:"secrets,omitempty"`
+	Error    string   `json:"error,omitempty"    yaml:"error,omitempty"`
+}
+
+// SimulationRow represents whether a resource of a pipeline would run for each scenario.
+type SimulationRow struct {
+	Type string          `json:"type" yaml:"type"`
+	Name string          `json:"name" yaml:"name"`
+	Runs map[string]bool `json:"runs" yaml:"runs"`
+}
+
+// SimulationResult represents the result of simulating a pipeline against many scenarios.
+//
+// swagger:model SimulationResult
+type SimulationResult struct {
+	Scenarios []*Simulation    `json:"scenarios" yaml:"scenarios"`
+	Table     []*SimulationRow `json:"table"     yaml:"table"`
+}
+
+// swagger:operation POST /api/v1/pipelines/{org}/{repo}/{pipeline}/simulate pipelines SimulatePipeline
+//
+// Simulate which resources of a pipeline would run for many hypothetical events
+//
+// ---
+// produces:
+// - application/yaml
+// - application/json
+// - text/plain
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: pipeline
+//   description: Commit SHA for pipeline to retrieve
+//   required: true
+//   type: string
+// - in: query
+//   name: output
+//   description: Output string for specifying output format
+//   type: string
+//   default: yaml
+//   enum:
+//   - json
+//   - yaml
+//   - table
+// - in: body
+//   name: body
+//   description: Scenarios to simulate the pipeline against
+//   required: true
+//   schema:
+//     type: array
+//     items:
+//       "$ref": "#/definitions/Scenario"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully simulated the pipeline
+//     schema:
+//       "$ref": "#/definitions/SimulationResult"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// SimulatePipeline represents the API handler to compile a pipeline
+// configuration against many hypothetical events and report which
+// stages, steps, services and secrets would run for each.
+func SimulatePipeline(c *gin.Context) {
+	// capture middleware values
+	m := c.MustGet("metadata").(*internal.Metadata)
+	l := c.MustGet("logger").(*logrus.Entry)
+	p := pMiddleware.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%s", r.GetFullName(), p.GetCommit())
+
+	l.Debugf("simulating pipeline %s", entry)
+
+	// capture body from API request
+	scenarios := []*Scenario{}
+
+	err := c.Bind(&scenarios)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for scenarios of pipeline %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	err = validateScenarios(scenarios)
+	if err != nil {
+		retErr := fmt.Errorf("unable to simulate pipeline %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure we use the expected pipeline type when compiling
+	r.SetPipelineType(p.GetType())
+
+	// create the compiler object, which is reused to only capture templates once
+	compiler := compiler.FromContext(c).Duplicate().WithCommit(p.GetCommit()).WithMetadata(m).WithRepo(r).WithUser(u).WithSCM(scm.FromContext(c))
+
+	// compile the pipeline without rule data to capture every resource
+	all, _, err := compiler.CompileLite(ctx, p.GetData(), nil, false)
+	if err != nil {
+		retErr := fmt.Errorf("unable to compile pipeline %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	result := &SimulationResult{
+		Scenarios: []*Simulation{},
+	}
+
+	for _, scenario := range scenarios {
+		ruleData := scenario.ruleData()
+
+		// rule data for real builds always contains the repo and instance
+		if len(ruleData.Repo) == 0 {
+			ruleData.Repo = r.GetFullName()
+		}
+
+		if len(ruleData.Instance) == 0 && m.Vela != nil {
+			ruleData.Instance = m.Vela.Address
+		}
+
+		simulation := &Simulation{Scenario: scenario.Name}
+
+		build, _, err := compiler.CompileLite(ctx, p.GetData(), ruleData, false)
+		if err != nil {
+			simulation.Error = err.Error()
+		} else {
+			simulation.Stages, simulation.Steps, simulation.Services, simulation.Secrets = resources(build)
+		}
+
+		result.Scenarios = append(result.Scenarios, simulation)
+	}
+
+	result.Table = simulationTable(all, result.Scenarios)
+
+	if strings.EqualFold(util.QueryParameter(c, "output", outputYAML), outputTable) {
+		c.Data(http.StatusOK, "text/plain; charset=utf-8", renderTable(result))
+
+		return
+	}
+
+	writeOutput(c, result)
+}
+
+// validateScenarios is a helper function to verify the scenarios
+// are within the limit and are uniquely named. Scenarios without
+// a name are named after their position.
+func validateScenarios(scenarios []*Scenario) error {
+	if len(scenarios) == 0 {
+		return fmt.Errorf("no scenarios provided")
+	}
+
+	if len(scenarios) > maxScenarios {
+		return fmt.Errorf("%d scenarios provided, exceeds maximum of %d", len(scenarios), maxScenarios)
+	}
+
+	names := make(map[string]bool)
+
+	for i, scenario := range scenarios {
+		if scenario == nil {
+			return fmt.Errorf("scenario %d is empty", i+1)
+		}
+
+		if len(scenario.Name) == 0 {
+			scenario.Name = fmt.Sprintf("scenario %d", i+1)
+		}
+
+		if names[scenario.Name] {
+			return fmt.Errorf("scenario %s is provided more than once", scenario.Name)
+		}
+
+		names[scenario.Name] = true
+	}
+
+	return nil
+}
+
+// ruleData is a helper function to create the rule data for a scenario.
+// Scenarios without a status are simulated as a pending build, like
+// the rule data of a build when it is compiled.
+func (s *Scenario) ruleData() *pipeline.RuleData {
+	status := s.Status
+	if len(status) == 0 {
+		status = constants.StatusPending
+	}
+
+	return &pipeline.RuleData{
+		Branch:   s.Branch,
+		Comment:  s.Comment,
+		Email:    s.Email,
+		Event:    s.Event,
+		Instance: s.Instance,
+		Label:    s.Label,
+		Message:  s.Message,
+		Path:     s.Path,
+		Repo:     s.Repo,
+		Sender:   s.Sender,
+		Status:   status,
+		Tag:      s.Tag,
+		Target:   s.Target,
+	}
+}
+
+// resources is a helper function to capture the names of the stages, steps,
+// services and secrets of a compiled pipeline. Steps within a stage are
+// named <stage>:<step>. Secrets are only captured when a step left in the
+// pipeline references them, since rules don't remove the secrets themselves.
+func resources(b *yaml.Build) ([]string, []string, []string, []string) {
+	var stages, steps, services, secrets []string
+
+	referenced := make(map[string]bool)
+
+	reference := func(step *yaml.Step) {
+		for _, secret := range step.Secrets {
+			referenced[secret.Source] = true
+		}
+	}
+
+	for _, stage := range b.Stages {
+		stages = append(stages, stage.Name)
+
+		for _, step := range stage.Steps {
+			steps = append(steps, fmt.Sprintf("%s:%s", stage.Name, step.Name))
+
+			reference(step)
+		}
+	}
+
+	for _, step := range b.Steps {
+		steps = append(steps, step.Name)
+
+		reference(step)
+	}
+
+	for _, service := range b.Services {
+		services = append(services, service.Name)
+	}
+
+	for _, secret := range b.Secrets {
+		if referenced[secret.Name] {
+			secrets = append(secrets, secret.Name)
+		}
+	}
+
+	return stages, steps, services, secrets
+}
+
+// simulationTable is a helper function to create a row for every resource
+// of the pipeline recording whether it would run for each scenario.
+func simulationTable(all *yaml.Build, simulations []*Simulation) []*SimulationRow {
+	rows := []*SimulationRow{}
+
+	stages, steps, services, secrets := resources(all)
+
+	add := func(typ string, names []string, ran func(*Simulation) []string) {
+		for _, name := range names {
+			row := &SimulationRow{
+				Type: typ,
+				Name: name,
+				Runs: make(map[string]bool),
+			}
+
+			for _, simulation := range simulations {
+				row.Runs[simulation.Scenario] = slices.Contains(ran(simulation), name)
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	add("stage", stages, func(s *Simulation) []string { return s.Stages })
+	add("step", steps, func(s *Simulation) []string { return s.Steps })
+	add("service", services, func(s *Simulation) []string { return s.Services })
+	add("secret", secrets, func(s *Simulation) []string { return s.Secrets })
+
+	return rows
+}
+
+// renderTable is a helper function to render the simulation as a plain text
+// table with a column for each scenario, where an x marks resources that run.
+func renderTable(result *SimulationResult) []byte {
+	buf := new(bytes.Buffer)
+
+	w := tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+
+	header := []string{"TYPE", "NAME"}
+
+	for _, simulation := range result.Scenarios {
+		header = append(header, strings.ToUpper(simulation.Scenario))
+	}
+
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, row := range result.Table {
+		cols := []string{row.Type, row.Name}
+
+		for _, simulation := range result.Scenarios {
+			switch {
+			case len(simulation.Error) > 0:
+				cols = append(cols, "error")
+			case row.Runs[simulation.Scenario]:
+				cols = append(cols, "x")
+			default:
+				cols = append(cols, "-")
+			}
+		}
+
+		fmt.Fprintln(w, strings.Join(cols, "\t"))
+	}
+
+	_ = w.Flush()
+
+	// include the errors for scenarios that failed to compile
+	for _, simulation := range result.Scenarios {
+		if len(simulation.Error) > 0 {
+			fmt.Fprintf(buf, "\n%s: %s\n", simulation.Scenario, simulation.Error)
+		}
+	}
+
+	return buf.Bytes()
+}
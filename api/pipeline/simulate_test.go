@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-vela/server/compiler/types/yaml"
+	"github.com/go-vela/server/constants"
+)
+
+func TestPipeline_validateScenarios(t *testing.T) {
+	tooMany := []*Scenario{}
+
+	for range maxScenarios + 1 {
+		tooMany = append(tooMany, new(Scenario))
+	}
+
+	tests := []struct {
+		name      string
+		scenarios []*Scenario
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "named and unnamed scenarios",
+			scenarios: []*Scenario{{Name: "push to main", Event: "push"}, {Event: "tag"}},
+			wantNames: []string{"push to main", "scenario 2"},
+		},
+		{
+			name:      "no scenarios",
+			scenarios: []*Scenario{},
+			wantErr:   true,
+		},
+		{
+			name:      "too many scenarios",
+			scenarios: tooMany,
+			wantErr:   true,
+		},
+		{
+			name:      "duplicate names",
+			scenarios: []*Scenario{{Name: "push"}, {Name: "push"}},
+			wantErr:   true,
+		},
+		{
+			name:      "empty scenario",
+			scenarios: []*Scenario{nil},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateScenarios(tt.scenarios)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateScenarios() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			got := []string{}
+
+			for _, scenario := range tt.scenarios {
+				got = append(got, scenario.Name)
+			}
+
+			if diff := cmp.Diff(tt.wantNames, got); diff != "" {
+				t.Errorf("validateScenarios() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipeline_Scenario_ruleData(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		scenario *Scenario
+		want     string
+	}{
+		{name: "no status", scenario: &Scenario{Event: "push"}, want: constants.StatusPending},
+		{name: "status", scenario: &Scenario{Event: "push", Status: constants.StatusFailure}, want: constants.StatusFailure},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.scenario.ruleData()
+
+			if got.Status != test.want {
+				t.Errorf("ruleData status is %s, want %s", got.Status, test.want)
+			}
+		})
+	}
+}
+
+func TestPipeline_simulationTable(t *testing.T) {
+	// setup types
+	all := &yaml.Build{
+		Stages: yaml.StageSlice{
+			{
+				Name: "test",
+				Steps: yaml.StepSlice{
+					{Name: "unit"},
+				},
+			},
+			{
+				Name: "publish",
+				Steps: yaml.StepSlice{
+					{Name: "docker", Secrets: yaml.StepSecretSlice{{Source: "docker_password", Target: "DOCKER_PASSWORD"}}},
+				},
+			},
+		},
+		Services: yaml.ServiceSlice{
+			{Name: "postgres"},
+		},
+		Secrets: yaml.SecretSlice{
+			{Name: "docker_password"},
+		},
+	}
+
+	push := &yaml.Build{
+		Stages:   all.Stages,
+		Services: all.Services,
+		Secrets:  all.Secrets,
+	}
+
+	// rules remove the steps referencing the secret, not the secret itself
+	pr := &yaml.Build{
+		Stages:   all.Stages[:1],
+		Services: all.Services,
+		Secrets:  all.Secrets,
+	}
+
+	simulations := []*Simulation{
+		{Scenario: "push"},
+		{Scenario: "pull request"},
+		{Scenario: "broken", Error: "unable to compile"},
+	}
+
+	simulations[0].Stages, simulations[0].Steps, simulations[0].Services, simulations[0].Secrets = resources(push)
+	simulations[1].Stages, simulations[1].Steps, simulations[1].Services, simulations[1].Secrets = resources(pr)
+
+	want := []*SimulationRow{
+		{Type: "stage", Name: "test", Runs: map[string]bool{"push": true, "pull request": true, "broken": false}},
+		{Type: "stage", Name: "publish", Runs: map[string]bool{"push": true, "pull request": false, "broken": false}},
+		{Type: "step", Name: "test:unit", Runs: map[string]bool{"push": true, "pull request": true, "broken": false}},
+		{Type: "step", Name: "publish:docker", Runs: map[string]bool{"push": true, "pull request": false, "broken": false}},
+		{Type: "service", Name: "postgres", Runs: map[string]bool{"push": true, "pull request": true, "broken": false}},
+		{Type: "secret", Name: "docker_password", Runs: map[string]bool{"push": true, "pull request": false, "broken": false}},
+	}
+
+	// run test
+	got := simulationTable(all, simulations)
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("simulationTable() mismatch (-want +got):\n%s", diff)
+	}
+
+	table := string(renderTable(&SimulationResult{Scenarios: simulations, Table: got}))
+
+	wantTable := []string{
+		"TYPE     NAME             PUSH  PULL REQUEST  BROKEN",
+		"stage    test             x     x             error",
+		"stage    publish          x     -             error",
+		"step     test:unit        x     x             error",
+		"step     publish:docker   x     -             error",
+		"service  postgres         x     x             error",
+		"secret   docker_password  x     -             error",
+		"",
+		"broken: unable to compile",
+	}
+
+	if diff := cmp.Diff(strings.Join(wantTable, "\n")+"\n", table); diff != "" {
+		t.Errorf("renderTable() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -98,7 +98,7 @@ func GetTemplates(c *gin.Context) {
 	l.Debugf("reading templates from pipeline %s", entry)
 
 	// create the compiler object
-	compiler := compiler.FromContext(c).Duplicate().WithCommit(p.GetCommit()).WithMetadata(m).WithRepo(r).WithUser(u)
+	compiler := compiler.FromContext(c).Duplicate().WithCommit(p.GetCommit()).WithMetadata(m).WithRepo(r).WithUser(u).WithSCM(scm.FromContext(c))
 
 	// parse the pipeline configuration
 	pipeline, _, _, err := compiler.Parse(p.GetData(), p.GetType(), new(yaml.Template))
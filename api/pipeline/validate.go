@@ -3,20 +3,39 @@
 package pipeline
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
 	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/compiler/types/yaml"
+	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/internal"
-	"github.com/go-vela/server/router/middleware/pipeline"
+	pMiddleware "github.com/go-vela/server/router/middleware/pipeline"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/util"
 )
 
+// lineRegexp matches the line reported by errors parsing the configuration.
+var lineRegexp = regexp.MustCompile(`line (\d+)`)
+
+// Validation represents the result of validating a pipeline.
+//
+// swagger:model PipelineValidation
+type Validation struct {
+	Valid       bool                 `json:"valid"       yaml:"valid"`
+	Diagnostics pipeline.Diagnostics `json:"diagnostics" yaml:"diagnostics"`
+}
+
 // swagger:operation POST /api/v1/pipelines/{org}/{repo}/{pipeline}/validate pipelines ValidatePipeline
 //
 // Get, expand and validate a pipeline
@@ -49,11 +68,17 @@ import (
 //   enum:
 //   - json
 //   - yaml
+// - in: query
+//   name: diagnostics
+//   description: Return the problems found as structured diagnostics in JSON
+//   type: boolean
+//   default: false
 // security:
 //   - ApiKeyAuth: []
 // responses:
 //   '200':
-//     description: Successfully retrieved, expanded and validated the pipeline
+//     description: Successfully retrieved, expanded and validated the pipeline,
+//       or the diagnostics for the pipeline when requested
 //     schema:
 //       type: string
 //   '400':
@@ -75,11 +100,14 @@ import (
 
 // ValidatePipeline represents the API handler to capture,
 // expand and validate a pipeline configuration.
+//
+// When diagnostics are requested, the problems found are returned
+// with the path and position of the offending key in the pipeline.
 func ValidatePipeline(c *gin.Context) {
 	// capture middleware values
 	m := c.MustGet("metadata").(*internal.Metadata)
 	l := c.MustGet("logger").(*logrus.Entry)
-	p := pipeline.Retrieve(c)
+	p := pMiddleware.Retrieve(c)
 	r := repo.Retrieve(c)
 	u := user.Retrieve(c)
 	ctx := c.Request.Context()
@@ -92,12 +120,29 @@ func ValidatePipeline(c *gin.Context) {
 	r.SetPipelineType(p.GetType())
 
 	// create the compiler object
-	compiler := compiler.FromContext(c).Duplicate().WithCommit(p.GetCommit()).WithMetadata(m).WithRepo(r).WithUser(u)
+	compiler := compiler.FromContext(c).Duplicate().WithCommit(p.GetCommit()).WithMetadata(m).WithRepo(r).WithUser(u).WithSCM(scm.FromContext(c))
 
 	ruleData := prepareRuleData(c)
 
 	// validate the pipeline
-	pipeline, _, err := compiler.CompileLite(ctx, p.GetData(), ruleData, false)
+	build, compiled, err := compiler.CompileLite(ctx, p.GetData(), ruleData, false)
+
+	if ok, _ := strconv.ParseBool(c.Query("diagnostics")); ok {
+		result := &Validation{
+			Valid:       err == nil,
+			Diagnostics: diagnostics(err, compiled.GetWarnings()),
+		}
+
+		// positions can only be mapped for configurations written in yaml
+		if len(p.GetType()) == 0 || strings.EqualFold(p.GetType(), constants.PipelineTypeYAML) {
+			yaml.Locate(p.GetData(), result.Diagnostics)
+		}
+
+		c.JSON(http.StatusOK, result)
+
+		return
+	}
+
 	if err != nil {
 		retErr := fmt.Errorf("unable to validate pipeline %s: %w", entry, err)
 
@@ -106,5 +151,51 @@ func ValidatePipeline(c *gin.Context) {
 		return
 	}
 
-	writeOutput(c, pipeline)
+	writeOutput(c, build)
+}
+
+// diagnostics is a helper function to capture the diagnostics from the error
+// validating a pipeline along with the warnings for the pipeline.
+func diagnostics(err error, warnings []string) pipeline.Diagnostics {
+	diags := pipeline.Diagnostics{}
+
+	var validation pipeline.Diagnostics
+
+	switch {
+	case errors.As(err, &validation):
+		diags = append(diags, validation...)
+	case err != nil:
+		diag := &pipeline.Diagnostic{
+			Severity: pipeline.DiagnosticError,
+			Code:     "invalid-pipeline",
+			Message:  err.Error(),
+		}
+
+		// capture the line for errors parsing the configuration
+		if match := lineRegexp.FindStringSubmatch(err.Error()); match != nil {
+			diag.Line, _ = strconv.Atoi(match[1])
+		}
+
+		diags = append(diags, diag)
+	}
+
+	// warnings are formatted as <line>:<message>, prefixed by [<template>]: for templates
+	for _, warning := range warnings {
+		diag := &pipeline.Diagnostic{
+			Severity: pipeline.DiagnosticWarning,
+			Code:     "warning",
+			Message:  warning,
+		}
+
+		if line, message, ok := strings.Cut(warning, ":"); ok {
+			if n, err := strconv.Atoi(line); err == nil {
+				diag.Line = n
+				diag.Message = message
+			}
+		}
+
+		diags = append(diags, diag)
+	}
+
+	return diags
 }
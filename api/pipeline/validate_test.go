@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-vela/server/compiler/types/pipeline"
+)
+
+func TestPipeline_diagnostics(t *testing.T) {
+	// setup types
+	validation := pipeline.Diagnostics{}
+	validation.Errorf("missing-image", "steps[name=test].image", "no image provided for step test")
+
+	// setup tests
+	tests := []struct {
+		name     string
+		err      error
+		warnings []string
+		want     pipeline.Diagnostics
+	}{
+		{
+			name: "validation error",
+			err:  validation,
+			want: validation,
+		},
+		{
+			name: "parse error",
+			err:  errors.New("unable to unmarshal yaml: yaml: line 3: mapping values are not allowed in this context"),
+			want: pipeline.Diagnostics{
+				{
+					Severity: pipeline.DiagnosticError,
+					Code:     "invalid-pipeline",
+					Message:  "unable to unmarshal yaml: yaml: line 3: mapping values are not allowed in this context",
+					Line:     3,
+				},
+			},
+		},
+		{
+			name:     "warnings",
+			warnings: []string{"7:duplicate << keys in single YAML map", "[go]:4:duplicate << keys in single YAML map"},
+			want: pipeline.Diagnostics{
+				{
+					Severity: pipeline.DiagnosticWarning,
+					Code:     "warning",
+					Message:  "duplicate << keys in single YAML map",
+					Line:     7,
+				},
+				{
+					Severity: pipeline.DiagnosticWarning,
+					Code:     "warning",
+					Message:  "[go]:4:duplicate << keys in single YAML map",
+				},
+			},
+		},
+		{
+			name: "valid",
+			want: pipeline.Diagnostics{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := diagnostics(test.err, test.warnings)
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("diagnostics() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -8,12 +8,15 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
+	"github.com/go-vela/server/api/build"
 	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
 )
 
 // swagger:operation POST /api/v1/queue/info queue Info
 //
-// Get queue credentials
+// Get queue credentials and the usage of worker pool quotas
 //
 // ---
 // produces:
@@ -22,7 +25,7 @@ import (
 //   - ApiKeyAuth: []
 // responses:
 //   '200':
-//     description: Successfully retrieved queue credentials
+//     description: Successfully retrieved queue credentials and worker pools
 //     schema:
 //       "$ref": "#/definitions/QueueInfo"
 //   '401':
@@ -31,9 +34,11 @@ import (
 //       "$ref": "#/definitions/Error"
 
 // Info represents the API handler to
-// retrieve queue credentials as part of worker onboarding.
+// retrieve queue credentials as part of worker onboarding,
+// along with the usage of the org quotas in worker pools.
 func Info(c *gin.Context) {
 	l := c.MustGet("logger").(*logrus.Entry)
+	ctx := c.Request.Context()
 
 	l.Info("requesting queue credentials with registration token")
 
@@ -48,5 +53,13 @@ func Info(c *gin.Context) {
 		QueueAddress:   &a,
 	}
 
+	// capture the usage of worker pools without failing onboarding
+	pools, err := build.ListPools(ctx, queue.FromContext(c), database.FromContext(c))
+	if err != nil {
+		l.Warnf("unable to list worker pools: %v", err)
+	} else if len(pools) > 0 {
+		wr.SetPools(pools)
+	}
+
 	c.JSON(http.StatusOK, wr)
 }
@@ -185,6 +185,23 @@ func CreateRepo(c *gin.Context) {
 		r.SetApproveBuild(defaultRepoApproveBuild)
 	}
 
+	// set the orphan policy field based off the input provided
+	if len(input.GetOrphanPolicy()) > 0 {
+		// ensure the orphan policy setting matches one of the expected values
+		if input.GetOrphanPolicy() != constants.OrphanPolicyFail &&
+			input.GetOrphanPolicy() != constants.OrphanPolicyRequeue {
+			retErr := fmt.Errorf("orphan_policy of %s is invalid", input.GetOrphanPolicy())
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		r.SetOrphanPolicy(input.GetOrphanPolicy())
+	} else {
+		r.SetOrphanPolicy(constants.OrphanPolicyFail)
+	}
+
 	// fields restricted to platform admins
 	if u.GetAdmin() {
 		// trusted default is false
@@ -220,7 +237,8 @@ func CreateRepo(c *gin.Context) {
 		// ensure the pipeline type matches one of the expected values
 		if input.GetPipelineType() != constants.PipelineTypeYAML &&
 			input.GetPipelineType() != constants.PipelineTypeGo &&
-			input.GetPipelineType() != constants.PipelineTypeStarlark {
+			input.GetPipelineType() != constants.PipelineTypeStarlark &&
+			input.GetPipelineType() != constants.PipelineTypeJsonnet {
 			retErr := fmt.Errorf("unable to create new repo %s: invalid pipeline_type provided %s", r.GetFullName(), input.GetPipelineType())
 
 			util.HandleError(c, http.StatusBadRequest, retErr)
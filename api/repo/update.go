@@ -159,6 +159,21 @@ func UpdateRepo(c *gin.Context) {
 		r.SetApproveBuild(input.GetApproveBuild())
 	}
 
+	if len(input.GetOrphanPolicy()) > 0 {
+		// ensure the orphan policy setting matches one of the expected values
+		if input.GetOrphanPolicy() != constants.OrphanPolicyFail &&
+			input.GetOrphanPolicy() != constants.OrphanPolicyRequeue {
+			retErr := fmt.Errorf("orphan_policy of %s is invalid", input.GetOrphanPolicy())
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		// update orphan policy if set
+		r.SetOrphanPolicy(input.GetOrphanPolicy())
+	}
+
 	if input.Private != nil {
 		// update private if set
 		r.SetPrivate(input.GetPrivate())
@@ -203,7 +218,8 @@ func UpdateRepo(c *gin.Context) {
 		// ensure the pipeline type matches one of the expected values
 		if input.GetPipelineType() != constants.PipelineTypeYAML &&
 			input.GetPipelineType() != constants.PipelineTypeGo &&
-			input.GetPipelineType() != constants.PipelineTypeStarlark {
+			input.GetPipelineType() != constants.PipelineTypeStarlark &&
+			input.GetPipelineType() != constants.PipelineTypeJsonnet {
 			retErr := fmt.Errorf("pipeline_type of %s is invalid", input.GetPipelineType())
 
 			util.HandleError(c, http.StatusBadRequest, retErr)
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/schema"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/server/version"
+)
+
+// swagger:operation GET /api/v1/schema/pipeline base GetPipelineSchema
+//
+// Get the JSON schema for pipelines compiled by the running Vela server
+//
+// ---
+// produces:
+// - application/schema+json
+// parameters:
+// - in: query
+//   name: version
+//   description: Vela version the schema is requested for, must match the running server
+//   type: string
+// - in: header
+//   name: If-None-Match
+//   description: ETag of a previously retrieved schema
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the pipeline schema
+//     headers:
+//       ETag:
+//         description: Identifier for the version of the schema
+//         type: string
+//     schema:
+//       type: object
+//   '304':
+//     description: The schema has not changed since it was retrieved
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: The schema is not available for the requested version
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to generate the schema
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetPipelineSchema represents the API handler to report the JSON schema
+// for pipelines, including hints for the compiler settings of the platform.
+func GetPipelineSchema(c *gin.Context) {
+	v := version.New()
+
+	// clients may request the schema for the version of the server they target
+	if requested := c.Query("version"); len(requested) > 0 &&
+		strings.TrimPrefix(requested, "v") != strings.TrimPrefix(v.Semantic(), "v") {
+		retErr := fmt.Errorf("unable to get pipeline schema for version %s: server is running %s", requested, v.Semantic())
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	s, err := schema.NewPipelineSchema()
+	if err != nil {
+		retErr := fmt.Errorf("unable to generate pipeline schema: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	cs := compiler.FromContext(c).GetSettings()
+
+	schema.ApplySettings(s, &cs)
+
+	s.Comments = fmt.Sprintf("Generated by Vela server %s", v.Semantic())
+
+	body, err := json.Marshal(s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to marshal pipeline schema: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// the schema changes with the version and the settings of the server
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:16]))
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "no-cache")
+
+	if match := c.GetHeader("If-None-Match"); len(match) > 0 {
+		for tag := range strings.SplitSeq(match, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+
+			if tag == etag || tag == "*" {
+				c.Status(http.StatusNotModified)
+
+				return
+			}
+		}
+	}
+
+	c.Data(http.StatusOK, "application/schema+json", body)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/repo"
@@ -138,5 +139,16 @@ func UpdateService(c *gin.Context) {
 		return
 	}
 
+	// compact the appended logs once the service is complete
+	if s.GetStatus() != constants.StatusPending && s.GetStatus() != constants.StatusRunning {
+		lg := new(types.Log)
+		lg.SetServiceID(s.GetID())
+
+		err = database.FromContext(c).CompactLog(ctx, lg)
+		if err != nil {
+			l.Errorf("unable to compact logs for service %s: %v", entry, err)
+		}
+	}
+
 	c.JSON(http.StatusOK, s)
 }
@@ -3,13 +3,16 @@
 package step
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 
+	"github.com/go-vela/server/api/annotation"
 	"github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/cache"
 	"github.com/go-vela/server/constants"
@@ -162,8 +165,37 @@ func UpdateStep(c *gin.Context) {
 		return
 	}
 
+	annotations := []*types.Annotation{}
+
+	// compact the appended logs once the step is complete
+	if s.GetStatus() != constants.StatusPending && s.GetStatus() != constants.StatusRunning {
+		lg := new(types.Log)
+		lg.SetStepID(s.GetID())
+
+		err = database.FromContext(c).CompactLog(ctx, lg)
+		if err != nil {
+			l.Errorf("unable to compact logs for step %s: %v", entry, err)
+		}
+
+		// parse the compacted log for annotations
+		if c.GetBool("log-annotations") {
+			annotations, err = annotate(c, b, s)
+			if err != nil {
+				l.Errorf("unable to annotate logs for step %s: %v", entry, err)
+			}
+		}
+	}
+
 	c.JSON(http.StatusOK, s)
 
+	// send the annotations for the step to the scm
+	if len(annotations) > 0 && c.GetBool("log-annotations-scm") && b.GetEvent() != constants.EventSchedule {
+		err = scm.FromContext(c).Annotate(ctx, b, s, annotations)
+		if err != nil {
+			l.Errorf("unable to send annotations for step %s: %v", entry, err)
+		}
+	}
+
 	// check if the build is in a "final" state
 	// and if build is not a scheduled event
 	if scmStatusReq && b.GetEvent() != constants.EventSchedule {
@@ -191,3 +223,20 @@ func UpdateStep(c *gin.Context) {
 		}
 	}
 }
+
+// annotate is a helper function to parse the log
+// for a finished step into annotations for the step.
+func annotate(c *gin.Context, b *types.Build, s *types.Step) ([]*types.Annotation, error) {
+	ctx := c.Request.Context()
+
+	lg, err := database.FromContext(c).GetLogForStep(ctx, s)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return annotation.Record(ctx, database.FromContext(c), b, s, lg)
+}
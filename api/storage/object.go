@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/storage/filesystem"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation GET /_storage/{bucket}/{object} storage GetObject
+//
+// Download an object stored by the filesystem storage driver using a signed URL.
+//
+// ---
+// produces:
+// - application/octet-stream
+// parameters:
+//   - name: bucket
+//     in: path
+//     description: Bucket name
+//     required: true
+//     type: string
+//   - name: object
+//     in: path
+//     description: Object name
+//     required: true
+//     type: string
+//   - name: X-Vela-Expires
+//     in: query
+//     description: Expiration of the signed URL as a unix timestamp
+//     required: true
+//     type: integer
+//   - name: X-Vela-Signature
+//     in: query
+//     description: Signature of the signed URL
+//     required: true
+//     type: string
+// responses:
+//   200:
+//     description: Successfully downloaded the object
+//   403:
+//     description: Signature is invalid or expired
+//     schema:
+//       $ref: '#/definitions/Error'
+//   404:
+//     description: Object not found or filesystem storage is not enabled
+//     schema:
+//       $ref: '#/definitions/Error'
+
+// GetObject represents the API handler to download an object
+// from the filesystem storage driver using a signed URL.
+func GetObject(c *gin.Context) {
+	l := c.MustGet("logger").(*logrus.Entry)
+
+	client, object, ok := verifyObjectRequest(c, http.MethodGet)
+	if !ok {
+		return
+	}
+
+	l.Debugf("downloading object %s from bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	info, err := client.StatObject(c.Request.Context(), object)
+	if err != nil {
+		util.HandleError(c, http.StatusNotFound, fmt.Errorf("unable to find object %s", object.ObjectName))
+
+		return
+	}
+
+	f, err := client.OpenObject(c.Request.Context(), object)
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, fmt.Errorf("unable to open object %s: %w", object.ObjectName, err))
+
+		return
+	}
+	defer f.Close()
+
+	c.Header("Content-Type", info.ContentType)
+	http.ServeContent(c.Writer, c.Request, object.ObjectName, time.Time{}, f)
+}
+
+// swagger:operation PUT /_storage/{bucket}/{object} storage PutObject
+//
+// Upload an object to the filesystem storage driver using a signed URL.
+//
+// ---
+// consumes:
+// - application/octet-stream
+// parameters:
+//   - name: bucket
+//     in: path
+//     description: Bucket name
+//     required: true
+//     type: string
+//   - name: object
+//     in: path
+//     description: Object name
+//     required: true
+//     type: string
+//   - name: X-Vela-Expires
+//     in: query
+//     description: Expiration of the signed URL as a unix timestamp
+//     required: true
+//     type: integer
+//   - name: X-Vela-Signature
+//     in: query
+//     description: Signature of the signed URL
+//     required: true
+//     type: string
+// responses:
+//   200:
+//     description: Successfully uploaded the object
+//   403:
+//     description: Signature is invalid or expired
+//     schema:
+//       $ref: '#/definitions/Error'
+//   404:
+//     description: Filesystem storage is not enabled
+//     schema:
+//       $ref: '#/definitions/Error'
+//   500:
+//     description: Unable to write the object
+//     schema:
+//       $ref: '#/definitions/Error'
+
+// PutObject represents the API handler to upload an object
+// to the filesystem storage driver using a signed URL.
+func PutObject(c *gin.Context) {
+	l := c.MustGet("logger").(*logrus.Entry)
+
+	client, object, ok := verifyObjectRequest(c, http.MethodPut)
+	if !ok {
+		return
+	}
+
+	l.Debugf("uploading object %s to bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	err := client.WriteObject(c.Request.Context(), object, c.Request.Body)
+	if err != nil {
+		util.HandleError(c, http.StatusInternalServerError, fmt.Errorf("unable to write object %s: %w", object.ObjectName, err))
+
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+// verifyObjectRequest is a helper function to resolve the filesystem
+// storage client and verify the signature of the requested object.
+func verifyObjectRequest(c *gin.Context, method string) (*filesystem.Client, *api.Object, bool) {
+	if !c.GetBool("storage-enable") {
+		util.HandleError(c, http.StatusNotFound, errors.New("storage is not enabled"))
+
+		return nil, nil, false
+	}
+
+	// signed URLs are only served for the filesystem storage driver
+	client, ok := storage.FromGinContext(c).(*filesystem.Client)
+	if !ok {
+		util.HandleError(c, http.StatusNotFound, errors.New("filesystem storage is not enabled"))
+
+		return nil, nil, false
+	}
+
+	object := &api.Object{
+		ObjectName: strings.TrimPrefix(c.Param("object"), "/"),
+		Bucket:     api.Bucket{BucketName: c.Param("bucket")},
+	}
+
+	err := client.VerifySignature(
+		method,
+		object.Bucket.BucketName,
+		object.ObjectName,
+		c.Query(filesystem.ExpiresParam),
+		c.Query(filesystem.SignatureParam),
+	)
+	if err != nil {
+		util.HandleError(c, http.StatusForbidden, err)
+
+		return nil, nil, false
+	}
+
+	return client, object, true
+}
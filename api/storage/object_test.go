@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/storage/filesystem"
+)
+
+func TestStorage_Object(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	client, err := filesystem.NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create filesystem client: %v", err)
+	}
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.Use(func(c *gin.Context) {
+		c.Set("logger", logrus.NewEntry(logrus.StandardLogger()))
+		c.Set("storage-enable", true)
+		storage.ToContext(c, client)
+	})
+
+	engine.GET(filesystem.PathPrefix+"/:bucket/*object", GetObject)
+	engine.PUT(filesystem.PathPrefix+"/:bucket/*object", PutObject)
+
+	putURL, err := client.PresignedPutObject(t.Context(), "octocat/hello-world/1/test.txt", time.Minute)
+	if err != nil {
+		t.Fatalf("PresignedPutObject returned err: %v", err)
+	}
+
+	u, _ := url.Parse(putURL)
+
+	// upload using the signed URL
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodPut, u.RequestURI(), strings.NewReader("hello")))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("PutObject returned %v, want %v", w.Code, http.StatusOK)
+	}
+
+	// a PUT signature must not grant downloads
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("GetObject returned %v, want %v", w.Code, http.StatusForbidden)
+	}
+
+	// an unsigned request must be rejected
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodPut, u.Path, strings.NewReader("bye")))
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("PutObject returned %v, want %v", w.Code, http.StatusForbidden)
+	}
+
+	getURL, err := client.PresignedGetObject(t.Context(), &api.Object{
+		ObjectName: "octocat/hello-world/1/test.txt",
+		Bucket:     api.Bucket{BucketName: client.GetBucket()},
+	})
+	if err != nil {
+		t.Fatalf("PresignedGetObject returned err: %v", err)
+	}
+
+	u, _ = url.Parse(getURL)
+
+	// download using the signed URL
+	w = httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("GetObject returned %v, want %v", w.Code, http.StatusOK)
+	}
+
+	if w.Body.String() != "hello" {
+		t.Errorf("GetObject body is %s, want %s", w.Body.String(), "hello")
+	}
+}
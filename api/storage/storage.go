@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -196,5 +197,12 @@ func GetPresignedPutURL(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, &types.PresignURL{URL: putURL})
+	// the upload must send the headers signed into the URL, such as server-side encryption
+	headers := make(map[string]string)
+
+	for key, values := range storage.FromGinContext(c).UploadHeaders() {
+		headers[key] = strings.Join(values, ",")
+	}
+
+	c.JSON(http.StatusOK, &types.PresignURL{URL: putURL, Headers: headers})
 }
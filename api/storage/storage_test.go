@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/storage"
+	"github.com/go-vela/server/storage/s3"
+)
+
+func TestStorage_GetPresignedPutURL(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	// setup types
+	r := new(api.Repo)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+	r.SetTimeout(30)
+
+	b := new(api.Build)
+	b.SetNumber(1)
+
+	// setup tests
+	tests := []struct {
+		name        string
+		kmsKeyID    string
+		wantHeaders map[string]string
+	}{
+		{
+			name:     "without encryption",
+			kmsKeyID: "",
+		},
+		{
+			name:     "sse-kms",
+			kmsKeyID: "vela-key",
+			wantHeaders: map[string]string{
+				"X-Amz-Server-Side-Encryption":                "aws:kms",
+				"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id": "vela-key",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := s3.NewTest("https://s3.us-east-1.amazonaws.com", "s3key", "s3secret", "vela", true, test.kmsKeyID)
+			if err != nil {
+				t.Fatalf("unable to create s3 client: %v", err)
+			}
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			engine.Use(func(c *gin.Context) {
+				c.Set("logger", logrus.NewEntry(logrus.StandardLogger()))
+				c.Set("storage-enable", true)
+				storage.ToContext(c, client)
+				repo.ToContext(c, r)
+				build.ToContext(c, b)
+			})
+
+			engine.PUT("/api/v1/repos/:org/:repo/builds/:build/storage/:name/upload-url", GetPresignedPutURL)
+
+			engine.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, "/api/v1/repos/octocat/hello-world/builds/1/storage/test.xml/upload-url", nil))
+
+			if resp.Code != http.StatusOK {
+				t.Fatalf("GetPresignedPutURL returned %v, want %v", resp.Code, http.StatusOK)
+			}
+
+			got := new(api.PresignURL)
+
+			err = json.Unmarshal(resp.Body.Bytes(), got)
+			if err != nil {
+				t.Fatalf("unable to unmarshal response: %v", err)
+			}
+
+			u, err := url.Parse(got.URL)
+			if err != nil {
+				t.Fatalf("unable to parse URL: %v", err)
+			}
+
+			// every header signed into the URL must be returned to the caller
+			for _, signed := range strings.Split(u.Query().Get("X-Amz-SignedHeaders"), ";") {
+				if signed == "host" {
+					continue
+				}
+
+				if _, ok := got.Headers[http.CanonicalHeaderKey(signed)]; !ok {
+					t.Errorf("GetPresignedPutURL is missing signed header %s", signed)
+				}
+			}
+
+			for key, value := range test.wantHeaders {
+				if got.Headers[key] != value {
+					t.Errorf("GetPresignedPutURL header %s is %s, want %s", key, got.Headers[key], value)
+				}
+			}
+		})
+	}
+}
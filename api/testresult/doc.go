@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testresult provides the test result handlers for the Vela API.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/api/testresult"
+package testresult
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+// FlakyWindow is the number of previous builds on a
+// branch considered when flagging a test as flaky.
+const FlakyWindow = 10
+
+// outcome is the set of statuses a test had within a build.
+type outcome struct {
+	passed bool
+	failed bool
+}
+
+// markFlaky flags the provided test results as flaky when the test
+// either passed and failed within the same build, or flipped from
+// passing to failing and back (or the reverse) across the history
+// of the branch. Skipped results are ignored.
+func markFlaky(results, history []*types.TestResult) {
+	// capture the outcome of each test for each build in order
+	builds := make(map[string][]int64)
+	outcomes := make(map[string]map[int64]*outcome)
+
+	record := func(t *types.TestResult) {
+		if t.GetStatus() == constants.TestStatusSkipped {
+			return
+		}
+
+		key := t.GetSuite() + "\x00" + t.GetName()
+
+		if outcomes[key] == nil {
+			outcomes[key] = make(map[int64]*outcome)
+		}
+
+		o, ok := outcomes[key][t.GetBuildID()]
+		if !ok {
+			o = new(outcome)
+			outcomes[key][t.GetBuildID()] = o
+			builds[key] = append(builds[key], t.GetBuildID())
+		}
+
+		switch t.GetStatus() {
+		case constants.TestStatusPassed:
+			o.passed = true
+		case constants.TestStatusFailed:
+			o.failed = true
+		}
+	}
+
+	// history is ordered by build, followed by the current build
+	for _, t := range history {
+		record(t)
+	}
+
+	for _, t := range results {
+		record(t)
+	}
+
+	for _, t := range results {
+		key := t.GetSuite() + "\x00" + t.GetName()
+
+		t.SetFlaky(isFlaky(builds[key], outcomes[key]))
+	}
+}
+
+// isFlaky is a helper function to determine if the outcomes of a test across builds are flaky.
+func isFlaky(builds []int64, outcomes map[int64]*outcome) bool {
+	flips := 0
+
+	var previous *outcome
+
+	for _, id := range builds {
+		o := outcomes[id]
+
+		// a test that passed and failed within a build was retried
+		if o.passed && o.failed {
+			return true
+		}
+
+		if previous != nil && previous.failed != o.failed {
+			flips++
+		}
+
+		previous = o
+	}
+
+	// a single flip is a test that broke or was fixed
+	return flips >= 2
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+func TestTestResult_markFlaky(t *testing.T) {
+	// setup types
+	pass := constants.TestStatusPassed
+	fail := constants.TestStatusFailed
+	skip := constants.TestStatusSkipped
+
+	// setup tests
+	tests := []struct {
+		name    string
+		history []string
+		current []string
+		want    bool
+	}{
+		{name: "always passing", history: []string{pass, pass, pass}, current: []string{pass}, want: false},
+		{name: "always failing", history: []string{fail, fail}, current: []string{fail}, want: false},
+		{name: "broken", history: []string{pass, pass}, current: []string{fail}, want: false},
+		{name: "fixed", history: []string{fail, fail}, current: []string{pass}, want: false},
+		{name: "flipped", history: []string{pass, fail}, current: []string{pass}, want: true},
+		{name: "flipped across skips", history: []string{fail, skip, pass, skip}, current: []string{fail}, want: true},
+		{name: "retried", history: []string{pass}, current: []string{fail, pass}, want: true},
+		{name: "no history", history: nil, current: []string{fail}, want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history := []*types.TestResult{}
+
+			for i, status := range test.history {
+				history = append(history, testResult(int64(i+1), "TestFoo", status))
+			}
+
+			// include an unrelated test in the history
+			history = append(history, testResult(1, "TestBar", fail), testResult(2, "TestBar", pass))
+
+			results := []*types.TestResult{}
+
+			for _, status := range test.current {
+				results = append(results, testResult(int64(len(test.history)+1), "TestFoo", status))
+			}
+
+			markFlaky(results, history)
+
+			for _, result := range results {
+				if result.GetFlaky() != test.want {
+					t.Errorf("markFlaky for %s is %v, want %v", test.name, result.GetFlaky(), test.want)
+				}
+			}
+		})
+	}
+}
+
+// testResult is a test helper function to create a
+// TestResult type for the provided build and status.
+func testResult(buildID int64, name, status string) *types.TestResult {
+	t := new(types.TestResult)
+
+	t.SetBuildID(buildID)
+	t.SetSuite("github.com/octocat/hello-world")
+	t.SetName(name)
+	t.SetStatus(status)
+	t.SetDuration(10)
+
+	return t
+}
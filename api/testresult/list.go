@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/util"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/tests tests ListTestResults
+//
+// Get the test results parsed for a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the organization
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repository
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the test results
+//     schema:
+//       "$ref": "#/definitions/TestReport"
+//   '400':
+//     description: Invalid request payload or path
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: Unauthorized
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Not found
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unexpected server error
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListTestResults represents the API handler to get
+// a summary and the list of test results for a build.
+func ListTestResults(c *gin.Context) {
+	// capture middleware values
+	l := c.MustGet("logger").(*logrus.Entry)
+	b := build.Retrieve(c)
+	r := repo.Retrieve(c)
+	ctx := c.Request.Context()
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	l.Debugf("listing test results for build %s", entry)
+
+	// send API call to capture the list of test results for the build
+	t, err := database.FromContext(c).ListTestResultsForBuild(ctx, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list test results for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, Summarize(t))
+}
+
+// Summarize creates a test report with the
+// counts and durations of the provided results.
+func Summarize(results []*types.TestResult) *types.TestReport {
+	report := &types.TestReport{
+		Tests: results,
+	}
+
+	for _, t := range results {
+		report.Total++
+		report.Duration += t.GetDuration()
+
+		switch t.GetStatus() {
+		case constants.TestStatusPassed:
+			report.Passed++
+		case constants.TestStatusFailed:
+			report.Failed++
+		case constants.TestStatusSkipped:
+			report.Skipped++
+		}
+
+		if t.GetFlaky() {
+			report.Flaky++
+		}
+	}
+
+	return report
+}
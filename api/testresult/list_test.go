@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+func TestTestResult_Summarize(t *testing.T) {
+	// setup types
+	flaky := testResult(1, "TestFlaky", constants.TestStatusPassed)
+	flaky.SetFlaky(true)
+
+	results := []*types.TestResult{
+		testResult(1, "TestPass", constants.TestStatusPassed),
+		testResult(1, "TestFail", constants.TestStatusFailed),
+		testResult(1, "TestSkip", constants.TestStatusSkipped),
+		flaky,
+	}
+
+	want := &types.TestReport{
+		Total:    4,
+		Passed:   2,
+		Failed:   1,
+		Skipped:  1,
+		Flaky:    1,
+		Duration: 40,
+		Tests:    results,
+	}
+
+	// run test
+	got := Summarize(results)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Summarize is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/testreport"
+	"github.com/go-vela/server/storage"
+)
+
+// Parse reads the test report uploaded for an
+// artifact from the storage in the provided format.
+func Parse(ctx context.Context, st storage.Storage, object *types.Object, format string) ([]*types.TestResult, error) {
+	rc, err := st.GetObject(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rc.Close()
+
+	return testreport.Parse(format, rc)
+}
+
+// Record stores the test results parsed from the test report uploaded
+// for an artifact, replacing any results previously parsed from it,
+// and flags the tests that are flaky on the branch of the build.
+func Record(ctx context.Context, db database.Interface, r *types.Repo, b *types.Build, a *types.Artifact, results []*types.TestResult) error {
+	now := time.Now().UTC().Unix()
+
+	for _, result := range results {
+		result.SetID(0)
+		result.SetBuildID(b.GetID())
+		result.SetRepoID(r.GetID())
+		result.SetArtifactID(a.GetID())
+		result.SetStep(a.GetStep())
+		result.SetBranch(b.GetBranch())
+		result.SetCreatedAt(now)
+	}
+
+	history, err := db.ListTestResultHistory(ctx, r, b.GetBranch(), b.GetID(), FlakyWindow)
+	if err != nil {
+		return fmt.Errorf("unable to list test result history: %w", err)
+	}
+
+	markFlaky(results, history)
+
+	err = db.DeleteTestResultsForArtifact(ctx, a)
+	if err != nil {
+		return fmt.Errorf("unable to remove previous test results: %w", err)
+	}
+
+	return db.CreateTestResults(ctx, results)
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage/filesystem"
+)
+
+func TestTestResult_Record(t *testing.T) {
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Fatalf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	st, err := filesystem.NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create filesystem client: %v", err)
+	}
+
+	owner := new(types.User)
+	owner.SetID(1)
+
+	r := new(types.Repo)
+	r.SetOwner(owner)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+	r.SetFullName("octocat/hello-world")
+	r.SetVisibility("public")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+	r.SetHash("baz")
+
+	r, err = db.CreateRepo(t.Context(), r)
+	if err != nil {
+		t.Fatalf("unable to create repo: %v", err)
+	}
+
+	// TestDivide passes, fails and passes again across the builds on main
+	reports := []string{
+		`<testsuite name="math"><testcase name="TestAdd"/><testcase name="TestDivide"/></testsuite>`,
+		`<testsuite name="math"><testcase name="TestAdd"/><testcase name="TestDivide"><failure message="boom"/></testcase></testsuite>`,
+		`<testsuite name="math"><testcase name="TestAdd"/><testcase name="TestDivide"/></testsuite>`,
+	}
+
+	var got []*types.TestResult
+
+	for i, report := range reports {
+		b := new(types.Build)
+		b.SetRepo(r)
+		b.SetNumber(int64(i + 1))
+		b.SetBranch("main")
+		b.SetEvent(constants.EventPush)
+
+		b, err = db.CreateBuild(t.Context(), b)
+		if err != nil {
+			t.Fatalf("unable to create build: %v", err)
+		}
+
+		object := &types.Object{
+			ObjectName: fmt.Sprintf("octocat/hello-world/%d/junit.xml", b.GetNumber()),
+			Bucket:     types.Bucket{BucketName: st.GetBucket()},
+		}
+
+		err = st.WriteObject(t.Context(), object, strings.NewReader(report))
+		if err != nil {
+			t.Fatalf("unable to write report: %v", err)
+		}
+
+		a := new(types.Artifact)
+		a.SetBuildID(b.GetID())
+		a.SetRepoID(r.GetID())
+		a.SetName("junit.xml")
+		a.SetStep("test")
+		a.SetObjectPath(object.ObjectName)
+		a.SetCreatedAt(1)
+
+		a, err = db.CreateArtifact(t.Context(), a)
+		if err != nil {
+			t.Fatalf("unable to create artifact: %v", err)
+		}
+
+		results, err := Parse(t.Context(), st, object, constants.TestReportJUnit)
+		if err != nil {
+			t.Fatalf("Parse returned err: %v", err)
+		}
+
+		// record twice to ensure the results are replaced
+		for range 2 {
+			err = Record(t.Context(), db, r, b, a, results)
+			if err != nil {
+				t.Fatalf("Record returned err: %v", err)
+			}
+		}
+
+		got, err = db.ListTestResultsForBuild(t.Context(), b)
+		if err != nil {
+			t.Fatalf("unable to list test results: %v", err)
+		}
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("Record stored %d results, want %d", len(got), 2)
+	}
+
+	for _, result := range got {
+		if result.GetStep() != "test" || result.GetBranch() != "main" || result.GetArtifactID() == 0 {
+			t.Errorf("Record stored %v, want step, branch and artifact populated", result)
+		}
+
+		want := result.GetName() == "TestDivide"
+		if result.GetFlaky() != want {
+			t.Errorf("Record flaky for %s is %v, want %v", result.GetName(), result.GetFlaky(), want)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+)
+
+// Annotation is the API representation of a diagnostic,
+// such as a compiler error, detected in the log for a step.
+//
+// swagger:model Annotation
+type Annotation struct {
+	ID        *int64  `json:"id,omitempty"`
+	RepoID    *int64  `json:"repo_id,omitempty"`
+	BuildID   *int64  `json:"build_id,omitempty"`
+	StepID    *int64  `json:"step_id,omitempty"`
+	File      *string `json:"file,omitempty"`
+	Line      *int64  `json:"line,omitempty"`
+	Column    *int64  `json:"column,omitempty"`
+	Severity  *string `json:"severity,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetID() int64 {
+	// return zero value if Annotation type or ID field is nil
+	if a == nil || a.ID == nil {
+		return 0
+	}
+
+	return *a.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetRepoID() int64 {
+	// return zero value if Annotation type or RepoID field is nil
+	if a == nil || a.RepoID == nil {
+		return 0
+	}
+
+	return *a.RepoID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetBuildID() int64 {
+	// return zero value if Annotation type or BuildID field is nil
+	if a == nil || a.BuildID == nil {
+		return 0
+	}
+
+	return *a.BuildID
+}
+
+// GetStepID returns the StepID field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetStepID() int64 {
+	// return zero value if Annotation type or StepID field is nil
+	if a == nil || a.StepID == nil {
+		return 0
+	}
+
+	return *a.StepID
+}
+
+// GetFile returns the File field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetFile() string {
+	// return zero value if Annotation type or File field is nil
+	if a == nil || a.File == nil {
+		return ""
+	}
+
+	return *a.File
+}
+
+// GetLine returns the Line field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetLine() int64 {
+	// return zero value if Annotation type or Line field is nil
+	if a == nil || a.Line == nil {
+		return 0
+	}
+
+	return *a.Line
+}
+
+// GetColumn returns the Column field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetColumn() int64 {
+	// return zero value if Annotation type or Column field is nil
+	if a == nil || a.Column == nil {
+		return 0
+	}
+
+	return *a.Column
+}
+
+// GetSeverity returns the Severity field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetSeverity() string {
+	// return zero value if Annotation type or Severity field is nil
+	if a == nil || a.Severity == nil {
+		return ""
+	}
+
+	return *a.Severity
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetMessage() string {
+	// return zero value if Annotation type or Message field is nil
+	if a == nil || a.Message == nil {
+		return ""
+	}
+
+	return *a.Message
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided Annotation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Annotation) GetCreatedAt() int64 {
+	// return zero value if Annotation type or CreatedAt field is nil
+	if a == nil || a.CreatedAt == nil {
+		return 0
+	}
+
+	return *a.CreatedAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetID(v int64) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetRepoID(v int64) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.RepoID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetBuildID(v int64) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.BuildID = &v
+}
+
+// SetStepID sets the StepID field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetStepID(v int64) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.StepID = &v
+}
+
+// SetFile sets the File field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetFile(v string) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.File = &v
+}
+
+// SetLine sets the Line field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetLine(v int64) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.Line = &v
+}
+
+// SetColumn sets the Column field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetColumn(v int64) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.Column = &v
+}
+
+// SetSeverity sets the Severity field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetSeverity(v string) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.Severity = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetMessage(v string) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.Message = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided Annotation type is nil, it
+// will set nothing and immediately return.
+func (a *Annotation) SetCreatedAt(v int64) {
+	// return if Annotation type is nil
+	if a == nil {
+		return
+	}
+
+	a.CreatedAt = &v
+}
+
+// String implements the Stringer interface for the Annotation type.
+func (a *Annotation) String() string {
+	return fmt.Sprintf(`{
+  BuildID: %d,
+  Column: %d,
+  CreatedAt: %d,
+  File: %s,
+  ID: %d,
+  Line: %d,
+  Message: %s,
+  RepoID: %d,
+  Severity: %s,
+  StepID: %d,
+}`,
+		a.GetBuildID(),
+		a.GetColumn(),
+		a.GetCreatedAt(),
+		a.GetFile(),
+		a.GetID(),
+		a.GetLine(),
+		a.GetMessage(),
+		a.GetRepoID(),
+		a.GetSeverity(),
+		a.GetStepID(),
+	)
+}
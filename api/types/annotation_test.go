@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestTypes_Annotation_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		annotation *Annotation
+		want       *Annotation
+	}{
+		{
+			annotation: testAnnotation(),
+			want:       testAnnotation(),
+		},
+		{
+			annotation: new(Annotation),
+			want:       new(Annotation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.annotation.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.annotation.GetID(), test.want.GetID())
+		}
+
+		if test.annotation.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.annotation.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.annotation.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.annotation.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.annotation.GetStepID() != test.want.GetStepID() {
+			t.Errorf("GetStepID is %v, want %v", test.annotation.GetStepID(), test.want.GetStepID())
+		}
+
+		if test.annotation.GetFile() != test.want.GetFile() {
+			t.Errorf("GetFile is %v, want %v", test.annotation.GetFile(), test.want.GetFile())
+		}
+
+		if test.annotation.GetLine() != test.want.GetLine() {
+			t.Errorf("GetLine is %v, want %v", test.annotation.GetLine(), test.want.GetLine())
+		}
+
+		if test.annotation.GetColumn() != test.want.GetColumn() {
+			t.Errorf("GetColumn is %v, want %v", test.annotation.GetColumn(), test.want.GetColumn())
+		}
+
+		if test.annotation.GetSeverity() != test.want.GetSeverity() {
+			t.Errorf("GetSeverity is %v, want %v", test.annotation.GetSeverity(), test.want.GetSeverity())
+		}
+
+		if test.annotation.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.annotation.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.annotation.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.annotation.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestTypes_Annotation_Setters(t *testing.T) {
+	// setup types
+	var a *Annotation
+
+	// setup tests
+	tests := []struct {
+		annotation *Annotation
+		want       *Annotation
+	}{
+		{
+			annotation: testAnnotation(),
+			want:       testAnnotation(),
+		},
+		{
+			annotation: a,
+			want:       new(Annotation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.annotation.SetID(test.want.GetID())
+		test.annotation.SetRepoID(test.want.GetRepoID())
+		test.annotation.SetBuildID(test.want.GetBuildID())
+		test.annotation.SetStepID(test.want.GetStepID())
+		test.annotation.SetFile(test.want.GetFile())
+		test.annotation.SetLine(test.want.GetLine())
+		test.annotation.SetColumn(test.want.GetColumn())
+		test.annotation.SetSeverity(test.want.GetSeverity())
+		test.annotation.SetMessage(test.want.GetMessage())
+		test.annotation.SetCreatedAt(test.want.GetCreatedAt())
+
+		if test.annotation.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.annotation.GetID(), test.want.GetID())
+		}
+
+		if test.annotation.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.annotation.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.annotation.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.annotation.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.annotation.GetStepID() != test.want.GetStepID() {
+			t.Errorf("SetStepID is %v, want %v", test.annotation.GetStepID(), test.want.GetStepID())
+		}
+
+		if test.annotation.GetFile() != test.want.GetFile() {
+			t.Errorf("SetFile is %v, want %v", test.annotation.GetFile(), test.want.GetFile())
+		}
+
+		if test.annotation.GetLine() != test.want.GetLine() {
+			t.Errorf("SetLine is %v, want %v", test.annotation.GetLine(), test.want.GetLine())
+		}
+
+		if test.annotation.GetColumn() != test.want.GetColumn() {
+			t.Errorf("SetColumn is %v, want %v", test.annotation.GetColumn(), test.want.GetColumn())
+		}
+
+		if test.annotation.GetSeverity() != test.want.GetSeverity() {
+			t.Errorf("SetSeverity is %v, want %v", test.annotation.GetSeverity(), test.want.GetSeverity())
+		}
+
+		if test.annotation.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.annotation.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.annotation.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.annotation.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestTypes_Annotation_String(t *testing.T) {
+	// setup types
+	a := testAnnotation()
+
+	want := fmt.Sprintf(`{
+  BuildID: %d,
+  Column: %d,
+  CreatedAt: %d,
+  File: %s,
+  ID: %d,
+  Line: %d,
+  Message: %s,
+  RepoID: %d,
+  Severity: %s,
+  StepID: %d,
+}`,
+		a.GetBuildID(),
+		a.GetColumn(),
+		a.GetCreatedAt(),
+		a.GetFile(),
+		a.GetID(),
+		a.GetLine(),
+		a.GetMessage(),
+		a.GetRepoID(),
+		a.GetSeverity(),
+		a.GetStepID(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testAnnotation is a test helper function to create an Annotation
+// type with all fields set to a fake value.
+func testAnnotation() *Annotation {
+	a := new(Annotation)
+
+	a.SetID(1)
+	a.SetRepoID(1)
+	a.SetBuildID(1)
+	a.SetStepID(1)
+	a.SetFile("main.go")
+	a.SetLine(12)
+	a.SetColumn(3)
+	a.SetSeverity(constants.AnnotationError)
+	a.SetMessage("undefined: foo")
+	a.SetCreatedAt(time.Now().UTC().Unix())
+
+	return a
+}
@@ -0,0 +1,349 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+)
+
+// Artifact is the API representation of a file uploaded by a build.
+//
+// swagger:model Artifact
+type Artifact struct {
+	ID          *int64  `json:"id,omitempty"`
+	BuildID     *int64  `json:"build_id,omitempty"`
+	RepoID      *int64  `json:"repo_id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Step        *string `json:"step,omitempty"`
+	ObjectPath  *string `json:"object_path,omitempty"`
+	Size        *int64  `json:"size,omitempty"`
+	Checksum    *string `json:"checksum,omitempty"`
+	ContentType *string `json:"content_type,omitempty"`
+	CreatedAt   *int64  `json:"created_at,omitempty"`
+	ExpiresAt   *int64  `json:"expires_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetID() int64 {
+	// return zero value if Artifact type or ID field is nil
+	if a == nil || a.ID == nil {
+		return 0
+	}
+
+	return *a.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetBuildID() int64 {
+	// return zero value if Artifact type or BuildID field is nil
+	if a == nil || a.BuildID == nil {
+		return 0
+	}
+
+	return *a.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetRepoID() int64 {
+	// return zero value if Artifact type or RepoID field is nil
+	if a == nil || a.RepoID == nil {
+		return 0
+	}
+
+	return *a.RepoID
+}
+
+// GetName returns the Name field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetName() string {
+	// return zero value if Artifact type or Name field is nil
+	if a == nil || a.Name == nil {
+		return ""
+	}
+
+	return *a.Name
+}
+
+// GetStep returns the Step field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetStep() string {
+	// return zero value if Artifact type or Step field is nil
+	if a == nil || a.Step == nil {
+		return ""
+	}
+
+	return *a.Step
+}
+
+// GetObjectPath returns the ObjectPath field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetObjectPath() string {
+	// return zero value if Artifact type or ObjectPath field is nil
+	if a == nil || a.ObjectPath == nil {
+		return ""
+	}
+
+	return *a.ObjectPath
+}
+
+// GetSize returns the Size field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetSize() int64 {
+	// return zero value if Artifact type or Size field is nil
+	if a == nil || a.Size == nil {
+		return 0
+	}
+
+	return *a.Size
+}
+
+// GetChecksum returns the Checksum field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetChecksum() string {
+	// return zero value if Artifact type or Checksum field is nil
+	if a == nil || a.Checksum == nil {
+		return ""
+	}
+
+	return *a.Checksum
+}
+
+// GetContentType returns the ContentType field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetContentType() string {
+	// return zero value if Artifact type or ContentType field is nil
+	if a == nil || a.ContentType == nil {
+		return ""
+	}
+
+	return *a.ContentType
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetCreatedAt() int64 {
+	// return zero value if Artifact type or CreatedAt field is nil
+	if a == nil || a.CreatedAt == nil {
+		return 0
+	}
+
+	return *a.CreatedAt
+}
+
+// GetExpiresAt returns the ExpiresAt field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetExpiresAt() int64 {
+	// return zero value if Artifact type or ExpiresAt field is nil
+	if a == nil || a.ExpiresAt == nil {
+		return 0
+	}
+
+	return *a.ExpiresAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetID(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetBuildID(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetRepoID(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.RepoID = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetName(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Name = &v
+}
+
+// SetStep sets the Step field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetStep(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Step = &v
+}
+
+// SetObjectPath sets the ObjectPath field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetObjectPath(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.ObjectPath = &v
+}
+
+// SetSize sets the Size field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetSize(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Size = &v
+}
+
+// SetChecksum sets the Checksum field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetChecksum(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Checksum = &v
+}
+
+// SetContentType sets the ContentType field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetContentType(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.ContentType = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetCreatedAt(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.CreatedAt = &v
+}
+
+// SetExpiresAt sets the ExpiresAt field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetExpiresAt(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.ExpiresAt = &v
+}
+
+// String implements the Stringer interface for the Artifact type.
+func (a *Artifact) String() string {
+	return fmt.Sprintf(`{
+  BuildID: %d,
+  Checksum: %s,
+  ContentType: %s,
+  CreatedAt: %d,
+  ExpiresAt: %d,
+  ID: %d,
+  Name: %s,
+  ObjectPath: %s,
+  RepoID: %d,
+  Size: %d,
+  Step: %s,
+}`,
+		a.GetBuildID(),
+		a.GetChecksum(),
+		a.GetContentType(),
+		a.GetCreatedAt(),
+		a.GetExpiresAt(),
+		a.GetID(),
+		a.GetName(),
+		a.GetObjectPath(),
+		a.GetRepoID(),
+		a.GetSize(),
+		a.GetStep(),
+	)
+}
+
+// ArtifactRetentionReport represents the artifacts that
+// would be removed by the artifact retention rules.
+//
+// swagger:model ArtifactRetentionReport
+type ArtifactRetentionReport struct {
+	Artifacts []*Artifact `json:"artifacts"`
+	Count     int64       `json:"count"`
+	Bytes     int64       `json:"bytes"`
+}
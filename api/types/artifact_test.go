@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTypes_Artifact_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		artifact *Artifact
+		want     *Artifact
+	}{
+		{
+			artifact: testArtifact(),
+			want:     testArtifact(),
+		},
+		{
+			artifact: new(Artifact),
+			want:     new(Artifact),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.artifact.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.artifact.GetID(), test.want.GetID())
+		}
+
+		if test.artifact.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.artifact.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.artifact.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.artifact.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.artifact.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.artifact.GetName(), test.want.GetName())
+		}
+
+		if test.artifact.GetStep() != test.want.GetStep() {
+			t.Errorf("GetStep is %v, want %v", test.artifact.GetStep(), test.want.GetStep())
+		}
+
+		if test.artifact.GetObjectPath() != test.want.GetObjectPath() {
+			t.Errorf("GetObjectPath is %v, want %v", test.artifact.GetObjectPath(), test.want.GetObjectPath())
+		}
+
+		if test.artifact.GetSize() != test.want.GetSize() {
+			t.Errorf("GetSize is %v, want %v", test.artifact.GetSize(), test.want.GetSize())
+		}
+
+		if test.artifact.GetChecksum() != test.want.GetChecksum() {
+			t.Errorf("GetChecksum is %v, want %v", test.artifact.GetChecksum(), test.want.GetChecksum())
+		}
+
+		if test.artifact.GetContentType() != test.want.GetContentType() {
+			t.Errorf("GetContentType is %v, want %v", test.artifact.GetContentType(), test.want.GetContentType())
+		}
+
+		if test.artifact.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.artifact.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.artifact.GetExpiresAt() != test.want.GetExpiresAt() {
+			t.Errorf("GetExpiresAt is %v, want %v", test.artifact.GetExpiresAt(), test.want.GetExpiresAt())
+		}
+	}
+}
+
+func TestTypes_Artifact_Setters(t *testing.T) {
+	// setup types
+	var a *Artifact
+
+	// setup tests
+	tests := []struct {
+		artifact *Artifact
+		want     *Artifact
+	}{
+		{
+			artifact: testArtifact(),
+			want:     testArtifact(),
+		},
+		{
+			artifact: a,
+			want:     new(Artifact),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.artifact.SetID(test.want.GetID())
+		test.artifact.SetBuildID(test.want.GetBuildID())
+		test.artifact.SetRepoID(test.want.GetRepoID())
+		test.artifact.SetName(test.want.GetName())
+		test.artifact.SetStep(test.want.GetStep())
+		test.artifact.SetObjectPath(test.want.GetObjectPath())
+		test.artifact.SetSize(test.want.GetSize())
+		test.artifact.SetChecksum(test.want.GetChecksum())
+		test.artifact.SetContentType(test.want.GetContentType())
+		test.artifact.SetCreatedAt(test.want.GetCreatedAt())
+		test.artifact.SetExpiresAt(test.want.GetExpiresAt())
+
+		if test.artifact.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.artifact.GetID(), test.want.GetID())
+		}
+
+		if test.artifact.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.artifact.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.artifact.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.artifact.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.artifact.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.artifact.GetName(), test.want.GetName())
+		}
+
+		if test.artifact.GetStep() != test.want.GetStep() {
+			t.Errorf("SetStep is %v, want %v", test.artifact.GetStep(), test.want.GetStep())
+		}
+
+		if test.artifact.GetObjectPath() != test.want.GetObjectPath() {
+			t.Errorf("SetObjectPath is %v, want %v", test.artifact.GetObjectPath(), test.want.GetObjectPath())
+		}
+
+		if test.artifact.GetSize() != test.want.GetSize() {
+			t.Errorf("SetSize is %v, want %v", test.artifact.GetSize(), test.want.GetSize())
+		}
+
+		if test.artifact.GetChecksum() != test.want.GetChecksum() {
+			t.Errorf("SetChecksum is %v, want %v", test.artifact.GetChecksum(), test.want.GetChecksum())
+		}
+
+		if test.artifact.GetContentType() != test.want.GetContentType() {
+			t.Errorf("SetContentType is %v, want %v", test.artifact.GetContentType(), test.want.GetContentType())
+		}
+
+		if test.artifact.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.artifact.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.artifact.GetExpiresAt() != test.want.GetExpiresAt() {
+			t.Errorf("SetExpiresAt is %v, want %v", test.artifact.GetExpiresAt(), test.want.GetExpiresAt())
+		}
+	}
+}
+
+func TestTypes_Artifact_String(t *testing.T) {
+	// setup types
+	a := testArtifact()
+
+	want := fmt.Sprintf(`{
+  BuildID: %d,
+  Checksum: %s,
+  ContentType: %s,
+  CreatedAt: %d,
+  ExpiresAt: %d,
+  ID: %d,
+  Name: %s,
+  ObjectPath: %s,
+  RepoID: %d,
+  Size: %d,
+  Step: %s,
+}`,
+		a.GetBuildID(),
+		a.GetChecksum(),
+		a.GetContentType(),
+		a.GetCreatedAt(),
+		a.GetExpiresAt(),
+		a.GetID(),
+		a.GetName(),
+		a.GetObjectPath(),
+		a.GetRepoID(),
+		a.GetSize(),
+		a.GetStep(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testArtifact is a test helper function to create an Artifact
+// type with all fields set to a fake value.
+func testArtifact() *Artifact {
+	a := new(Artifact)
+
+	a.SetID(1)
+	a.SetBuildID(1)
+	a.SetRepoID(1)
+	a.SetName("coverage.out")
+	a.SetStep("test")
+	a.SetObjectPath("github/octocat/1/coverage.out")
+	a.SetSize(1024)
+	a.SetChecksum("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+	a.SetContentType("text/plain")
+	a.SetCreatedAt(time.Now().UTC().Unix())
+	a.SetExpiresAt(time.Now().UTC().Add(24 * time.Hour).Unix())
+
+	return a
+}
@@ -275,3 +275,13 @@ type LogCleanupResponse struct {
 	AffectedPartitions []string `json:"affected_partitions,omitempty"`
 	Message            string   `json:"message"`
 }
+
+// LogOffloadResponse represents the response body for log offload operations.
+//
+// swagger:model LogOffloadResponse
+type LogOffloadResponse struct {
+	OffloadedCount  int64   `json:"offloaded_count"`
+	OffloadedBytes  int64   `json:"offloaded_bytes"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Message         string  `json:"message"`
+}
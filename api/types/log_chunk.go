@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// LogChunk is the API representation of data
+// appended to a log for a step or service.
+//
+// swagger:model LogChunk
+type LogChunk struct {
+	Offset int64  `json:"offset"`
+	Data   []byte `json:"data"`
+}
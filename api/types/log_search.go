@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// LogSearchResult is the API representation of
+// a line of a log matching a log search.
+//
+// swagger:model LogSearchResult
+type LogSearchResult struct {
+	Repo      string   `json:"repo,omitempty"`
+	RepoID    int64    `json:"repo_id"`
+	Build     int64    `json:"build,omitempty"`
+	BuildID   int64    `json:"build_id"`
+	Step      int32    `json:"step,omitempty"`
+	StepID    int64    `json:"step_id,omitempty"`
+	Service   int32    `json:"service,omitempty"`
+	ServiceID int64    `json:"service_id,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Line      int64    `json:"line"`
+	Text      string   `json:"text"`
+	Before    []string `json:"before,omitempty"`
+	After     []string `json:"after,omitempty"`
+}
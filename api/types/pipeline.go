@@ -10,22 +10,24 @@ import (
 //
 // swagger:model Pipeline
 type Pipeline struct {
-	ID              *int64    `json:"id,omitempty"`
-	Repo            *Repo     `json:"repo,omitempty"`
-	Commit          *string   `json:"commit,omitempty"`
-	Flavor          *string   `json:"flavor,omitempty"`
-	Platform        *string   `json:"platform,omitempty"`
-	Ref             *string   `json:"ref,omitempty"`
-	Type            *string   `json:"type,omitempty"`
-	Version         *string   `json:"version,omitempty"`
-	ExternalSecrets *bool     `json:"external_secrets,omitempty"`
-	InternalSecrets *bool     `json:"internal_secrets,omitempty"`
-	Services        *bool     `json:"services,omitempty"`
-	Stages          *bool     `json:"stages,omitempty"`
-	Steps           *bool     `json:"steps,omitempty"`
-	Templates       *bool     `json:"templates,omitempty"`
-	Artifact        *bool     `json:"artifacts,omitempty"`
-	Warnings        *[]string `json:"warnings,omitempty"`
+	ID                *int64              `json:"id,omitempty"`
+	Repo              *Repo               `json:"repo,omitempty"`
+	Commit            *string             `json:"commit,omitempty"`
+	GeneratedDigest   *string             `json:"generated_digest,omitempty"`
+	Flavor            *string             `json:"flavor,omitempty"`
+	Platform          *string             `json:"platform,omitempty"`
+	Ref               *string             `json:"ref,omitempty"`
+	Type              *string             `json:"type,omitempty"`
+	Version           *string             `json:"version,omitempty"`
+	ExternalSecrets   *bool               `json:"external_secrets,omitempty"`
+	InternalSecrets   *bool               `json:"internal_secrets,omitempty"`
+	Services          *bool               `json:"services,omitempty"`
+	Stages            *bool               `json:"stages,omitempty"`
+	Steps             *bool               `json:"steps,omitempty"`
+	Templates         *bool               `json:"templates,omitempty"`
+	Artifact          *bool               `json:"artifacts,omitempty"`
+	Warnings          *[]string           `json:"warnings,omitempty"`
+	ResolvedTemplates *[]ResolvedTemplate `json:"resolved_templates,omitempty"`
 	// swagger:strfmt base64
 	Data *[]byte `json:"data,omitempty"`
 }
@@ -69,6 +71,19 @@ func (p *Pipeline) GetCommit() string {
 	return *p.Commit
 }
 
+// GetGeneratedDigest returns the GeneratedDigest field.
+//
+// When the provided Pipeline type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Pipeline) GetGeneratedDigest() string {
+	// return zero value if Pipeline type or GeneratedDigest field is nil
+	if p == nil || p.GeneratedDigest == nil {
+		return ""
+	}
+
+	return *p.GeneratedDigest
+}
+
 // GetFlavor returns the Flavor field.
 //
 // When the provided Pipeline type is nil, or the field within
@@ -238,6 +253,19 @@ func (p *Pipeline) GetWarnings() []string {
 	return *p.Warnings
 }
 
+// GetResolvedTemplates returns the ResolvedTemplates field.
+//
+// When the provided Pipeline type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Pipeline) GetResolvedTemplates() []ResolvedTemplate {
+	// return zero value if Pipeline type or ResolvedTemplates field is nil
+	if p == nil || p.ResolvedTemplates == nil {
+		return []ResolvedTemplate{}
+	}
+
+	return *p.ResolvedTemplates
+}
+
 // GetData returns the Data field.
 //
 // When the provided Pipeline type is nil, or the field within
@@ -290,6 +318,19 @@ func (p *Pipeline) SetCommit(v string) {
 	p.Commit = &v
 }
 
+// SetGeneratedDigest sets the GeneratedDigest field.
+//
+// When the provided Pipeline type is nil, it
+// will set nothing and immediately return.
+func (p *Pipeline) SetGeneratedDigest(v string) {
+	// return if Pipeline type is nil
+	if p == nil {
+		return
+	}
+
+	p.GeneratedDigest = &v
+}
+
 // SetFlavor sets the Flavor field.
 //
 // When the provided Pipeline type is nil, it
@@ -459,6 +500,19 @@ func (p *Pipeline) SetWarnings(v []string) {
 	p.Warnings = &v
 }
 
+// SetResolvedTemplates sets the ResolvedTemplates field.
+//
+// When the provided Pipeline type is nil, it
+// will set nothing and immediately return.
+func (p *Pipeline) SetResolvedTemplates(v []ResolvedTemplate) {
+	// return if Pipeline type is nil
+	if p == nil {
+		return
+	}
+
+	p.ResolvedTemplates = &v
+}
+
 // SetData sets the Data field.
 //
 // When the provided Pipeline type is nil, it
@@ -478,6 +532,7 @@ func (p *Pipeline) String() string {
   Commit: %s,
   Data: %s,
   Flavor: %s,
+  GeneratedDigest: %s,
   ID: %d,
   Platform: %s,
   Ref: %s,
@@ -492,10 +547,12 @@ func (p *Pipeline) String() string {
   Type: %s,
   Version: %s,
   Warnings: %v,
+  ResolvedTemplates: %v,
 }`,
 		p.GetCommit(),
 		p.GetData(),
 		p.GetFlavor(),
+		p.GetGeneratedDigest(),
 		p.GetID(),
 		p.GetPlatform(),
 		p.GetRef(),
@@ -510,5 +567,6 @@ func (p *Pipeline) String() string {
 		p.GetType(),
 		p.GetVersion(),
 		p.GetWarnings(),
+		p.GetResolvedTemplates(),
 	)
 }
@@ -38,6 +38,10 @@ func TestAPI_Pipeline_Getters(t *testing.T) {
 			t.Errorf("GetCommit is %v, want %v", test.pipeline.GetCommit(), test.want.GetCommit())
 		}
 
+		if test.pipeline.GetGeneratedDigest() != test.want.GetGeneratedDigest() {
+			t.Errorf("GetGeneratedDigest is %v, want %v", test.pipeline.GetGeneratedDigest(), test.want.GetGeneratedDigest())
+		}
+
 		if test.pipeline.GetFlavor() != test.want.GetFlavor() {
 			t.Errorf("GetFlavor is %v, want %v", test.pipeline.GetFlavor(), test.want.GetFlavor())
 		}
@@ -86,6 +90,10 @@ func TestAPI_Pipeline_Getters(t *testing.T) {
 			t.Errorf("GetWarnings is %v, want %v", test.pipeline.GetWarnings(), test.want.GetWarnings())
 		}
 
+		if !reflect.DeepEqual(test.pipeline.GetResolvedTemplates(), test.want.GetResolvedTemplates()) {
+			t.Errorf("GetResolvedTemplates is %v, want %v", test.pipeline.GetResolvedTemplates(), test.want.GetResolvedTemplates())
+		}
+
 		if !reflect.DeepEqual(test.pipeline.GetData(), test.want.GetData()) {
 			t.Errorf("GetData is %v, want %v", test.pipeline.GetData(), test.want.GetData())
 		}
@@ -116,6 +124,7 @@ func TestAPI_Pipeline_Setters(t *testing.T) {
 		test.pipeline.SetID(test.want.GetID())
 		test.pipeline.SetRepo(test.want.GetRepo())
 		test.pipeline.SetCommit(test.want.GetCommit())
+		test.pipeline.SetGeneratedDigest(test.want.GetGeneratedDigest())
 		test.pipeline.SetFlavor(test.want.GetFlavor())
 		test.pipeline.SetPlatform(test.want.GetPlatform())
 		test.pipeline.SetRef(test.want.GetRef())
@@ -128,6 +137,7 @@ func TestAPI_Pipeline_Setters(t *testing.T) {
 		test.pipeline.SetSteps(test.want.GetSteps())
 		test.pipeline.SetTemplates(test.want.GetTemplates())
 		test.pipeline.SetWarnings(test.want.GetWarnings())
+		test.pipeline.SetResolvedTemplates(test.want.GetResolvedTemplates())
 		test.pipeline.SetData(test.want.GetData())
 
 		if test.pipeline.GetID() != test.want.GetID() {
@@ -142,6 +152,10 @@ func TestAPI_Pipeline_Setters(t *testing.T) {
 			t.Errorf("SetCommit is %v, want %v", test.pipeline.GetCommit(), test.want.GetCommit())
 		}
 
+		if test.pipeline.GetGeneratedDigest() != test.want.GetGeneratedDigest() {
+			t.Errorf("SetGeneratedDigest is %v, want %v", test.pipeline.GetGeneratedDigest(), test.want.GetGeneratedDigest())
+		}
+
 		if test.pipeline.GetFlavor() != test.want.GetFlavor() {
 			t.Errorf("SetFlavor is %v, want %v", test.pipeline.GetFlavor(), test.want.GetFlavor())
 		}
@@ -190,6 +204,10 @@ func TestAPI_Pipeline_Setters(t *testing.T) {
 			t.Errorf("SetWarnings is %v, want %v", test.pipeline.GetWarnings(), test.want.GetWarnings())
 		}
 
+		if !reflect.DeepEqual(test.pipeline.GetResolvedTemplates(), test.want.GetResolvedTemplates()) {
+			t.Errorf("SetResolvedTemplates is %v, want %v", test.pipeline.GetResolvedTemplates(), test.want.GetResolvedTemplates())
+		}
+
 		if !reflect.DeepEqual(test.pipeline.GetData(), test.want.GetData()) {
 			t.Errorf("SetData is %v, want %v", test.pipeline.GetData(), test.want.GetData())
 		}
@@ -204,6 +222,7 @@ func TestAPI_Pipeline_String(t *testing.T) {
   Commit: %s,
   Data: %s,
   Flavor: %s,
+  GeneratedDigest: %s,
   ID: %d,
   Platform: %s,
   Ref: %s,
@@ -218,10 +237,12 @@ func TestAPI_Pipeline_String(t *testing.T) {
   Type: %s,
   Version: %s,
   Warnings: %v,
+  ResolvedTemplates: %v,
 }`,
 		p.GetCommit(),
 		p.GetData(),
 		p.GetFlavor(),
+		p.GetGeneratedDigest(),
 		p.GetID(),
 		p.GetPlatform(),
 		p.GetRef(),
@@ -236,6 +257,7 @@ func TestAPI_Pipeline_String(t *testing.T) {
 		p.GetType(),
 		p.GetVersion(),
 		p.GetWarnings(),
+		p.GetResolvedTemplates(),
 	)
 
 	// run test
@@ -268,6 +290,7 @@ func testPipeline() *Pipeline {
 	p.SetArtifact(false)
 	p.SetData(testPipelineData())
 	p.SetWarnings([]string{"42:this is a warning"})
+	p.SetResolvedTemplates([]ResolvedTemplate{*testResolvedTemplate()})
 
 	return p
 }
@@ -6,8 +6,9 @@ package types
 //
 // swagger:model QueueInfo
 type QueueInfo struct {
-	QueuePublicKey *string `json:"queue_public_key,omitempty"`
-	QueueAddress   *string `json:"queue_address,omitempty"`
+	QueuePublicKey *string      `json:"queue_public_key,omitempty"`
+	QueueAddress   *string      `json:"queue_address,omitempty"`
+	Pools          *[]QueuePool `json:"pools,omitempty"`
 }
 
 // GetPublicKey returns the QueuePublicKey field.
@@ -36,6 +37,19 @@ func (w *QueueInfo) GetQueueAddress() string {
 	return *w.QueueAddress
 }
 
+// GetPools returns the Pools field.
+//
+// When the provided QueueInfo type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *QueueInfo) GetPools() []QueuePool {
+	// return zero value if QueueInfo type or Pools field is nil
+	if w == nil || w.Pools == nil {
+		return []QueuePool{}
+	}
+
+	return *w.Pools
+}
+
 // SetPublicKey sets the QueuePublicKey field.
 //
 // When the provided QueueInfo type is nil, it
@@ -61,3 +75,16 @@ func (w *QueueInfo) SetQueueAddress(v string) {
 
 	w.QueueAddress = &v
 }
+
+// SetPools sets the Pools field.
+//
+// When the provided QueueInfo type is nil, it
+// will set nothing and immediately return.
+func (w *QueueInfo) SetPools(v []QueuePool) {
+	// return if QueueInfo type is nil
+	if w == nil {
+		return
+	}
+
+	w.Pools = &v
+}
@@ -3,6 +3,7 @@
 package types
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -31,6 +32,10 @@ func TestTypes_QueueInfo_Getters(t *testing.T) {
 		if test.qR.GetPublicKey() != test.want.GetPublicKey() {
 			t.Errorf("GetPublicKey is %v, want %v", test.qR.GetPublicKey(), test.want.GetPublicKey())
 		}
+
+		if !reflect.DeepEqual(test.qR.GetPools(), test.want.GetPools()) {
+			t.Errorf("GetPools is %v, want %v", test.qR.GetPools(), test.want.GetPools())
+		}
 	}
 }
 
@@ -57,6 +62,7 @@ func TestTypes_QueueInfo_Setters(t *testing.T) {
 	for _, test := range tests {
 		test.qR.SetQueueAddress(test.want.GetQueueAddress())
 		test.qR.SetPublicKey(test.want.GetPublicKey())
+		test.qR.SetPools(test.want.GetPools())
 
 		if test.qR.GetQueueAddress() != test.want.GetQueueAddress() {
 			t.Errorf("GetQueueAddress is %v, want %v", test.qR.GetQueueAddress(), test.want.GetQueueAddress())
@@ -65,6 +71,10 @@ func TestTypes_QueueInfo_Setters(t *testing.T) {
 		if test.qR.GetPublicKey() != test.want.GetPublicKey() {
 			t.Errorf("GetPublicKey is %v, want %v", test.qR.GetPublicKey(), test.want.GetPublicKey())
 		}
+
+		if !reflect.DeepEqual(test.qR.GetPools(), test.want.GetPools()) {
+			t.Errorf("GetPools is %v, want %v", test.qR.GetPools(), test.want.GetPools())
+		}
 	}
 }
 
@@ -74,6 +84,7 @@ func testQueueInfo() *QueueInfo {
 	w := new(QueueInfo)
 	w.SetQueueAddress("http://localhost:8080")
 	w.SetPublicKey("CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=")
+	w.SetPools([]QueuePool{*testQueuePool()})
 
 	return w
 }
@@ -0,0 +1,208 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+// QueuePool is the API representation of the usage
+// of a worker pool in the queue.
+//
+// swagger:model QueuePool
+type QueuePool struct {
+	Name   *string       `json:"name,omitempty"`
+	Routes *[]string     `json:"routes,omitempty"`
+	Quotas *[]QueueQuota `json:"quotas,omitempty"`
+}
+
+// QueueQuota is the API representation of the usage of the
+// quota of an org in a worker pool. Active counts the builds of
+// the org running or published to the queue and Held counts the
+// builds of the org waiting for the org to be under quota.
+//
+// swagger:model QueueQuota
+type QueueQuota struct {
+	Org    *string `json:"org,omitempty"`
+	Limit  *int32  `json:"limit,omitempty"`
+	Active *int64  `json:"active,omitempty"`
+	Held   *int64  `json:"held,omitempty"`
+}
+
+// GetName returns the Name field.
+//
+// When the provided QueuePool type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *QueuePool) GetName() string {
+	// return zero value if QueuePool type or Name field is nil
+	if p == nil || p.Name == nil {
+		return ""
+	}
+
+	return *p.Name
+}
+
+// GetRoutes returns the Routes field.
+//
+// When the provided QueuePool type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *QueuePool) GetRoutes() []string {
+	// return zero value if QueuePool type or Routes field is nil
+	if p == nil || p.Routes == nil {
+		return []string{}
+	}
+
+	return *p.Routes
+}
+
+// GetQuotas returns the Quotas field.
+//
+// When the provided QueuePool type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *QueuePool) GetQuotas() []QueueQuota {
+	// return zero value if QueuePool type or Quotas field is nil
+	if p == nil || p.Quotas == nil {
+		return []QueueQuota{}
+	}
+
+	return *p.Quotas
+}
+
+// SetName sets the Name field.
+//
+// When the provided QueuePool type is nil, it
+// will set nothing and immediately return.
+func (p *QueuePool) SetName(v string) {
+	// return if QueuePool type is nil
+	if p == nil {
+		return
+	}
+
+	p.Name = &v
+}
+
+// SetRoutes sets the Routes field.
+//
+// When the provided QueuePool type is nil, it
+// will set nothing and immediately return.
+func (p *QueuePool) SetRoutes(v []string) {
+	// return if QueuePool type is nil
+	if p == nil {
+		return
+	}
+
+	p.Routes = &v
+}
+
+// SetQuotas sets the Quotas field.
+//
+// When the provided QueuePool type is nil, it
+// will set nothing and immediately return.
+func (p *QueuePool) SetQuotas(v []QueueQuota) {
+	// return if QueuePool type is nil
+	if p == nil {
+		return
+	}
+
+	p.Quotas = &v
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided QueueQuota type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (q *QueueQuota) GetOrg() string {
+	// return zero value if QueueQuota type or Org field is nil
+	if q == nil || q.Org == nil {
+		return ""
+	}
+
+	return *q.Org
+}
+
+// GetLimit returns the Limit field.
+//
+// When the provided QueueQuota type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (q *QueueQuota) GetLimit() int32 {
+	// return zero value if QueueQuota type or Limit field is nil
+	if q == nil || q.Limit == nil {
+		return 0
+	}
+
+	return *q.Limit
+}
+
+// GetActive returns the Active field.
+//
+// When the provided QueueQuota type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (q *QueueQuota) GetActive() int64 {
+	// return zero value if QueueQuota type or Active field is nil
+	if q == nil || q.Active == nil {
+		return 0
+	}
+
+	return *q.Active
+}
+
+// GetHeld returns the Held field.
+//
+// When the provided QueueQuota type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (q *QueueQuota) GetHeld() int64 {
+	// return zero value if QueueQuota type or Held field is nil
+	if q == nil || q.Held == nil {
+		return 0
+	}
+
+	return *q.Held
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided QueueQuota type is nil, it
+// will set nothing and immediately return.
+func (q *QueueQuota) SetOrg(v string) {
+	// return if QueueQuota type is nil
+	if q == nil {
+		return
+	}
+
+	q.Org = &v
+}
+
+// SetLimit sets the Limit field.
+//
+// When the provided QueueQuota type is nil, it
+// will set nothing and immediately return.
+func (q *QueueQuota) SetLimit(v int32) {
+	// return if QueueQuota type is nil
+	if q == nil {
+		return
+	}
+
+	q.Limit = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided QueueQuota type is nil, it
+// will set nothing and immediately return.
+func (q *QueueQuota) SetActive(v int64) {
+	// return if QueueQuota type is nil
+	if q == nil {
+		return
+	}
+
+	q.Active = &v
+}
+
+// SetHeld sets the Held field.
+//
+// When the provided QueueQuota type is nil, it
+// will set nothing and immediately return.
+func (q *QueueQuota) SetHeld(v int64) {
+	// return if QueueQuota type is nil
+	if q == nil {
+		return
+	}
+
+	q.Held = &v
+}
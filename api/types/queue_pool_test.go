@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTypes_QueuePool_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		pool *QueuePool
+		want *QueuePool
+	}{
+		{
+			pool: testQueuePool(),
+			want: testQueuePool(),
+		},
+		{
+			pool: new(QueuePool),
+			want: new(QueuePool),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.pool.GetName(), test.want.GetName()) {
+			t.Errorf("GetName is %v, want %v", test.pool.GetName(), test.want.GetName())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetRoutes(), test.want.GetRoutes()) {
+			t.Errorf("GetRoutes is %v, want %v", test.pool.GetRoutes(), test.want.GetRoutes())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetQuotas(), test.want.GetQuotas()) {
+			t.Errorf("GetQuotas is %v, want %v", test.pool.GetQuotas(), test.want.GetQuotas())
+		}
+	}
+}
+
+func TestTypes_QueuePool_Setters(t *testing.T) {
+	// setup types
+	var v *QueuePool
+
+	// setup tests
+	tests := []struct {
+		pool *QueuePool
+		want *QueuePool
+	}{
+		{
+			pool: testQueuePool(),
+			want: testQueuePool(),
+		},
+		{
+			pool: v,
+			want: new(QueuePool),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.pool.SetName(test.want.GetName())
+		test.pool.SetRoutes(test.want.GetRoutes())
+		test.pool.SetQuotas(test.want.GetQuotas())
+
+		if !reflect.DeepEqual(test.pool.GetName(), test.want.GetName()) {
+			t.Errorf("SetName is %v, want %v", test.pool.GetName(), test.want.GetName())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetRoutes(), test.want.GetRoutes()) {
+			t.Errorf("SetRoutes is %v, want %v", test.pool.GetRoutes(), test.want.GetRoutes())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetQuotas(), test.want.GetQuotas()) {
+			t.Errorf("SetQuotas is %v, want %v", test.pool.GetQuotas(), test.want.GetQuotas())
+		}
+	}
+}
+
+func TestTypes_QueueQuota_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		quota *QueueQuota
+		want  *QueueQuota
+	}{
+		{
+			quota: testQueueQuota(),
+			want:  testQueueQuota(),
+		},
+		{
+			quota: new(QueueQuota),
+			want:  new(QueueQuota),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.quota.GetOrg(), test.want.GetOrg()) {
+			t.Errorf("GetOrg is %v, want %v", test.quota.GetOrg(), test.want.GetOrg())
+		}
+
+		if !reflect.DeepEqual(test.quota.GetLimit(), test.want.GetLimit()) {
+			t.Errorf("GetLimit is %v, want %v", test.quota.GetLimit(), test.want.GetLimit())
+		}
+
+		if !reflect.DeepEqual(test.quota.GetActive(), test.want.GetActive()) {
+			t.Errorf("GetActive is %v, want %v", test.quota.GetActive(), test.want.GetActive())
+		}
+
+		if !reflect.DeepEqual(test.quota.GetHeld(), test.want.GetHeld()) {
+			t.Errorf("GetHeld is %v, want %v", test.quota.GetHeld(), test.want.GetHeld())
+		}
+	}
+}
+
+func TestTypes_QueueQuota_Setters(t *testing.T) {
+	// setup types
+	var v *QueueQuota
+
+	// setup tests
+	tests := []struct {
+		quota *QueueQuota
+		want  *QueueQuota
+	}{
+		{
+			quota: testQueueQuota(),
+			want:  testQueueQuota(),
+		},
+		{
+			quota: v,
+			want:  new(QueueQuota),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.quota.SetOrg(test.want.GetOrg())
+		test.quota.SetLimit(test.want.GetLimit())
+		test.quota.SetActive(test.want.GetActive())
+		test.quota.SetHeld(test.want.GetHeld())
+
+		if !reflect.DeepEqual(test.quota.GetOrg(), test.want.GetOrg()) {
+			t.Errorf("SetOrg is %v, want %v", test.quota.GetOrg(), test.want.GetOrg())
+		}
+
+		if !reflect.DeepEqual(test.quota.GetLimit(), test.want.GetLimit()) {
+			t.Errorf("SetLimit is %v, want %v", test.quota.GetLimit(), test.want.GetLimit())
+		}
+
+		if !reflect.DeepEqual(test.quota.GetActive(), test.want.GetActive()) {
+			t.Errorf("SetActive is %v, want %v", test.quota.GetActive(), test.want.GetActive())
+		}
+
+		if !reflect.DeepEqual(test.quota.GetHeld(), test.want.GetHeld()) {
+			t.Errorf("SetHeld is %v, want %v", test.quota.GetHeld(), test.want.GetHeld())
+		}
+	}
+}
+
+// testQueuePool is a test helper function to create a QueuePool
+// type with all fields set to a fake value.
+func testQueuePool() *QueuePool {
+	p := new(QueuePool)
+	p.SetName("secure")
+	p.SetRoutes([]string{"secure"})
+	p.SetQuotas([]QueueQuota{*testQueueQuota()})
+
+	return p
+}
+
+// testQueueQuota is a test helper function to create a QueueQuota
+// type with all fields set to a fake value.
+func testQueueQuota() *QueueQuota {
+	q := new(QueueQuota)
+	q.SetOrg("payments")
+	q.SetLimit(20)
+	q.SetActive(20)
+	q.SetHeld(3)
+
+	return q
+}
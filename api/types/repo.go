@@ -35,6 +35,7 @@ type Repo struct {
 	PreviousName     *string         `json:"previous_name,omitempty"`
 	ApproveBuild     *string         `json:"approve_build,omitempty"`
 	ApprovalTimeout  *int32          `json:"approval_timeout,omitempty"`
+	OrphanPolicy     *string         `json:"orphan_policy,omitempty"`
 	InstallID        *int64          `json:"install_id,omitempty"`
 	CustomProps      *map[string]any `json:"custom_props,omitempty"`
 }
@@ -392,6 +393,19 @@ func (r *Repo) GetApprovalTimeout() int32 {
 	return *r.ApprovalTimeout
 }
 
+// GetOrphanPolicy returns the OrphanPolicy field.
+//
+// When the provided Repo type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *Repo) GetOrphanPolicy() string {
+	// return zero value if Repo type or OrphanPolicy field is nil
+	if r == nil || r.OrphanPolicy == nil {
+		return ""
+	}
+
+	return *r.OrphanPolicy
+}
+
 // GetInstallID returns the InstallID field.
 //
 // When the provided Repo type is nil, or the field within
@@ -730,6 +744,19 @@ func (r *Repo) SetApprovalTimeout(v int32) {
 	r.ApprovalTimeout = &v
 }
 
+// SetOrphanPolicy sets the OrphanPolicy field.
+//
+// When the provided Repo type is nil, it
+// will set nothing and immediately return.
+func (r *Repo) SetOrphanPolicy(v string) {
+	// return if Repo type is nil
+	if r == nil {
+		return
+	}
+
+	r.OrphanPolicy = &v
+}
+
 // SetInstallID sets the InstallID field.
 //
 // When the provided Repo type is nil, it
@@ -774,6 +801,7 @@ func (r *Repo) String() string {
   MergeQueueEvents: %v,
   Name: %s,
   Org: %s,
+  OrphanPolicy: %s,
   Owner: %v,
   PipelineType: %s,
   PreviousName: %s,
@@ -800,6 +828,7 @@ func (r *Repo) String() string {
 		r.GetMergeQueueEvents(),
 		r.GetName(),
 		r.GetOrg(),
+		r.GetOrphanPolicy(),
 		r.GetOwner(),
 		r.GetPipelineType(),
 		r.GetPreviousName(),
@@ -163,6 +163,10 @@ func TestTypes_Repo_Getters(t *testing.T) {
 			t.Errorf("GetApprovalTimeout is %v, want %v", test.repo.GetApprovalTimeout(), test.want.GetApprovalTimeout())
 		}
 
+		if test.repo.GetOrphanPolicy() != test.want.GetOrphanPolicy() {
+			t.Errorf("GetOrphanPolicy is %v, want %v", test.repo.GetOrphanPolicy(), test.want.GetOrphanPolicy())
+		}
+
 		if test.repo.GetInstallID() != test.want.GetInstallID() {
 			t.Errorf("GetInstallID is %v, want %v", test.repo.GetInstallID(), test.want.GetInstallID())
 		}
@@ -217,6 +221,7 @@ func TestTypes_Repo_Setters(t *testing.T) {
 		test.repo.SetPreviousName(test.want.GetPreviousName())
 		test.repo.SetApproveBuild(test.want.GetApproveBuild())
 		test.repo.SetApprovalTimeout(test.want.GetApprovalTimeout())
+		test.repo.SetOrphanPolicy(test.want.GetOrphanPolicy())
 		test.repo.SetInstallID(test.want.GetInstallID())
 		test.repo.SetCustomProps(test.want.GetCustomProps())
 
@@ -308,6 +313,10 @@ func TestTypes_Repo_Setters(t *testing.T) {
 			t.Errorf("SetApprovalTimeout is %v, want %v", test.repo.GetApprovalTimeout(), test.want.GetApprovalTimeout())
 		}
 
+		if test.repo.GetOrphanPolicy() != test.want.GetOrphanPolicy() {
+			t.Errorf("SetOrphanPolicy is %v, want %v", test.repo.GetOrphanPolicy(), test.want.GetOrphanPolicy())
+		}
+
 		if test.repo.GetInstallID() != test.want.GetInstallID() {
 			t.Errorf("SetInstallID is %v, want %v", test.repo.GetInstallID(), test.want.GetInstallID())
 		}
@@ -338,6 +347,7 @@ func TestTypes_Repo_String(t *testing.T) {
   MergeQueueEvents: %s,
   Name: %s,
   Org: %s,
+  OrphanPolicy: %s,
   Owner: %v,
   PipelineType: %s,
   PreviousName: %s,
@@ -364,6 +374,7 @@ func TestTypes_Repo_String(t *testing.T) {
 		r.GetMergeQueueEvents(),
 		r.GetName(),
 		r.GetOrg(),
+		r.GetOrphanPolicy(),
 		r.GetOwner(),
 		r.GetPipelineType(),
 		r.GetPreviousName(),
@@ -414,6 +425,7 @@ func testRepo() *Repo {
 	r.SetPreviousName("")
 	r.SetApproveBuild(constants.ApproveNever)
 	r.SetApprovalTimeout(7)
+	r.SetOrphanPolicy(constants.OrphanPolicyRequeue)
 	r.SetInstallID(123)
 	r.SetCustomProps(map[string]any{"foo": "bar"})
 
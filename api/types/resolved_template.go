@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+)
+
+// ResolvedTemplate is the API representation of a template
+// pinned to an immutable reference during compilation.
+//
+// swagger:model ResolvedTemplate
+type ResolvedTemplate struct {
+	Name   *string `json:"name,omitempty"   yaml:"name,omitempty"`
+	Source *string `json:"source,omitempty" yaml:"source,omitempty"`
+	Type   *string `json:"type,omitempty"   yaml:"type,omitempty"`
+	Ref    *string `json:"ref,omitempty"    yaml:"ref,omitempty"`
+}
+
+// GetName returns the Name field.
+//
+// When the provided ResolvedTemplate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *ResolvedTemplate) GetName() string {
+	// return zero value if ResolvedTemplate type or Name field is nil
+	if t == nil || t.Name == nil {
+		return ""
+	}
+
+	return *t.Name
+}
+
+// GetSource returns the Source field.
+//
+// When the provided ResolvedTemplate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *ResolvedTemplate) GetSource() string {
+	// return zero value if ResolvedTemplate type or Source field is nil
+	if t == nil || t.Source == nil {
+		return ""
+	}
+
+	return *t.Source
+}
+
+// GetType returns the Type field.
+//
+// When the provided ResolvedTemplate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *ResolvedTemplate) GetType() string {
+	// return zero value if ResolvedTemplate type or Type field is nil
+	if t == nil || t.Type == nil {
+		return ""
+	}
+
+	return *t.Type
+}
+
+// GetRef returns the Ref field.
+//
+// When the provided ResolvedTemplate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *ResolvedTemplate) GetRef() string {
+	// return zero value if ResolvedTemplate type or Ref field is nil
+	if t == nil || t.Ref == nil {
+		return ""
+	}
+
+	return *t.Ref
+}
+
+// SetName sets the Name field.
+//
+// When the provided ResolvedTemplate type is nil, it
+// will set nothing and immediately return.
+func (t *ResolvedTemplate) SetName(v string) {
+	// return if ResolvedTemplate type is nil
+	if t == nil {
+		return
+	}
+
+	t.Name = &v
+}
+
+// SetSource sets the Source field.
+//
+// When the provided ResolvedTemplate type is nil, it
+// will set nothing and immediately return.
+func (t *ResolvedTemplate) SetSource(v string) {
+	// return if ResolvedTemplate type is nil
+	if t == nil {
+		return
+	}
+
+	t.Source = &v
+}
+
+// SetType sets the Type field.
+//
+// When the provided ResolvedTemplate type is nil, it
+// will set nothing and immediately return.
+func (t *ResolvedTemplate) SetType(v string) {
+	// return if ResolvedTemplate type is nil
+	if t == nil {
+		return
+	}
+
+	t.Type = &v
+}
+
+// SetRef sets the Ref field.
+//
+// When the provided ResolvedTemplate type is nil, it
+// will set nothing and immediately return.
+func (t *ResolvedTemplate) SetRef(v string) {
+	// return if ResolvedTemplate type is nil
+	if t == nil {
+		return
+	}
+
+	t.Ref = &v
+}
+
+// String implements the Stringer interface for the ResolvedTemplate type.
+func (t *ResolvedTemplate) String() string {
+	return fmt.Sprintf(`{
+  Name: %s,
+  Ref: %s,
+  Source: %s,
+  Type: %s,
+}`,
+		t.GetName(),
+		t.GetRef(),
+		t.GetSource(),
+		t.GetType(),
+	)
+}
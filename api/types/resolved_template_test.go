@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAPI_ResolvedTemplate_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		template *ResolvedTemplate
+		want     *ResolvedTemplate
+	}{
+		{
+			template: testResolvedTemplate(),
+			want:     testResolvedTemplate(),
+		},
+		{
+			template: new(ResolvedTemplate),
+			want:     new(ResolvedTemplate),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.template.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.template.GetName(), test.want.GetName())
+		}
+
+		if test.template.GetSource() != test.want.GetSource() {
+			t.Errorf("GetSource is %v, want %v", test.template.GetSource(), test.want.GetSource())
+		}
+
+		if test.template.GetType() != test.want.GetType() {
+			t.Errorf("GetType is %v, want %v", test.template.GetType(), test.want.GetType())
+		}
+
+		if test.template.GetRef() != test.want.GetRef() {
+			t.Errorf("GetRef is %v, want %v", test.template.GetRef(), test.want.GetRef())
+		}
+	}
+}
+
+func TestAPI_ResolvedTemplate_Setters(t *testing.T) {
+	// setup types
+	var tmpl *ResolvedTemplate
+
+	// setup tests
+	tests := []struct {
+		template *ResolvedTemplate
+		want     *ResolvedTemplate
+	}{
+		{
+			template: testResolvedTemplate(),
+			want:     testResolvedTemplate(),
+		},
+		{
+			template: tmpl,
+			want:     new(ResolvedTemplate),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.template.SetName(test.want.GetName())
+		test.template.SetSource(test.want.GetSource())
+		test.template.SetType(test.want.GetType())
+		test.template.SetRef(test.want.GetRef())
+
+		if test.template.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.template.GetName(), test.want.GetName())
+		}
+
+		if test.template.GetSource() != test.want.GetSource() {
+			t.Errorf("SetSource is %v, want %v", test.template.GetSource(), test.want.GetSource())
+		}
+
+		if test.template.GetType() != test.want.GetType() {
+			t.Errorf("SetType is %v, want %v", test.template.GetType(), test.want.GetType())
+		}
+
+		if test.template.GetRef() != test.want.GetRef() {
+			t.Errorf("SetRef is %v, want %v", test.template.GetRef(), test.want.GetRef())
+		}
+	}
+}
+
+func TestAPI_ResolvedTemplate_String(t *testing.T) {
+	// setup types
+	tmpl := testResolvedTemplate()
+
+	want := fmt.Sprintf(`{
+  Name: %s,
+  Ref: %s,
+  Source: %s,
+  Type: %s,
+}`,
+		tmpl.GetName(),
+		tmpl.GetRef(),
+		tmpl.GetSource(),
+		tmpl.GetType(),
+	)
+
+	// run test
+	got := tmpl.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testResolvedTemplate is a test helper function to create a ResolvedTemplate
+// type with all fields set to a fake value.
+func testResolvedTemplate() *ResolvedTemplate {
+	tmpl := new(ResolvedTemplate)
+
+	tmpl.SetName("template")
+	tmpl.SetSource("github.com/github/octocat/template.yml@main")
+	tmpl.SetType("github")
+	tmpl.SetRef("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+	return tmpl
+}
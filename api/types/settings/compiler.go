@@ -70,12 +70,260 @@ func (ir *ImageRestriction) String() string {
 	)
 }
 
+// PolicyRule is the API representation of an admission
+// rule evaluated against compiled pipelines.
+type PolicyRule struct {
+	Name   *string `json:"name,omitempty"   yaml:"name,omitempty"`
+	Org    *string `json:"org,omitempty"    yaml:"org,omitempty"`
+	Rule   *string `json:"rule,omitempty"   yaml:"rule,omitempty"`
+	Action *string `json:"action,omitempty" yaml:"action,omitempty"`
+	Reason *string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// GetName returns the Name field.
+//
+// When the provided PolicyRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (pr *PolicyRule) GetName() string {
+	if pr == nil || pr.Name == nil {
+		return ""
+	}
+
+	return *pr.Name
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided PolicyRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (pr *PolicyRule) GetOrg() string {
+	if pr == nil || pr.Org == nil {
+		return ""
+	}
+
+	return *pr.Org
+}
+
+// GetRule returns the Rule field.
+//
+// When the provided PolicyRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (pr *PolicyRule) GetRule() string {
+	if pr == nil || pr.Rule == nil {
+		return ""
+	}
+
+	return *pr.Rule
+}
+
+// GetAction returns the Action field.
+//
+// When the provided PolicyRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (pr *PolicyRule) GetAction() string {
+	if pr == nil || pr.Action == nil {
+		return ""
+	}
+
+	return *pr.Action
+}
+
+// GetReason returns the Reason field.
+//
+// When the provided PolicyRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (pr *PolicyRule) GetReason() string {
+	if pr == nil || pr.Reason == nil {
+		return ""
+	}
+
+	return *pr.Reason
+}
+
+// SetName sets the Name field.
+//
+// When the provided PolicyRule type is nil, it
+// will set nothing and immediately return.
+func (pr *PolicyRule) SetName(v string) {
+	if pr == nil {
+		return
+	}
+
+	pr.Name = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided PolicyRule type is nil, it
+// will set nothing and immediately return.
+func (pr *PolicyRule) SetOrg(v string) {
+	if pr == nil {
+		return
+	}
+
+	pr.Org = &v
+}
+
+// SetRule sets the Rule field.
+//
+// When the provided PolicyRule type is nil, it
+// will set nothing and immediately return.
+func (pr *PolicyRule) SetRule(v string) {
+	if pr == nil {
+		return
+	}
+
+	pr.Rule = &v
+}
+
+// SetAction sets the Action field.
+//
+// When the provided PolicyRule type is nil, it
+// will set nothing and immediately return.
+func (pr *PolicyRule) SetAction(v string) {
+	if pr == nil {
+		return
+	}
+
+	pr.Action = &v
+}
+
+// SetReason sets the Reason field.
+//
+// When the provided PolicyRule type is nil, it
+// will set nothing and immediately return.
+func (pr *PolicyRule) SetReason(v string) {
+	if pr == nil {
+		return
+	}
+
+	pr.Reason = &v
+}
+
+// String implements the Stringer interface for the PolicyRule type.
+func (pr *PolicyRule) String() string {
+	return fmt.Sprintf(`{
+  Action: %s,
+  Name: %s,
+  Org: %s,
+  Reason: %s,
+  Rule: %s,
+}`,
+		pr.GetAction(),
+		pr.GetName(),
+		pr.GetOrg(),
+		pr.GetReason(),
+		pr.GetRule(),
+	)
+}
+
 type Compiler struct {
-	CloneImage        *string             `json:"clone_image,omitempty"         yaml:"clone_image,omitempty"`
-	TemplateDepth     *int                `json:"template_depth,omitempty"      yaml:"template_depth,omitempty"`
-	StarlarkExecLimit *int64              `json:"starlark_exec_limit,omitempty" yaml:"starlark_exec_limit,omitempty"`
-	BlockedImages     *[]ImageRestriction `json:"blocked_images,omitempty"      yaml:"blocked_images,omitempty"`
-	WarnImages        *[]ImageRestriction `json:"warn_images,omitempty"         yaml:"warn_images,omitempty"`
+	CloneImage             *string             `json:"clone_image,omitempty"              yaml:"clone_image,omitempty"`
+	TemplateDepth          *int                `json:"template_depth,omitempty"           yaml:"template_depth,omitempty"`
+	StarlarkExecLimit      *int64              `json:"starlark_exec_limit,omitempty"      yaml:"starlark_exec_limit,omitempty"`
+	JsonnetExecTimeout     *int64              `json:"jsonnet_exec_timeout,omitempty"     yaml:"jsonnet_exec_timeout,omitempty"`
+	BlockedImages          *[]ImageRestriction `json:"blocked_images,omitempty"           yaml:"blocked_images,omitempty"`
+	WarnImages             *[]ImageRestriction `json:"warn_images,omitempty"              yaml:"warn_images,omitempty"`
+	HTTPSTemplateAllowlist *[]string           `json:"https_template_allowlist,omitempty" yaml:"https_template_allowlist,omitempty"`
+	OCITemplateAllowlist   *[]string           `json:"oci_template_allowlist,omitempty"   yaml:"oci_template_allowlist,omitempty"`
+	PolicyRules            *[]PolicyRule       `json:"policy_rules,omitempty"             yaml:"policy_rules,omitempty"`
+	WorkerFlavors          *[]string           `json:"worker_flavors,omitempty"           yaml:"worker_flavors,omitempty"`
+}
+
+// GetWorkerFlavors returns the WorkerFlavors field.
+//
+// When the provided Compiler type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (cs *Compiler) GetWorkerFlavors() []string {
+	if cs == nil || cs.WorkerFlavors == nil {
+		return []string{}
+	}
+
+	return *cs.WorkerFlavors
+}
+
+// SetWorkerFlavors sets the WorkerFlavors field.
+//
+// When the provided Compiler type is nil, it
+// will set nothing and immediately return.
+func (cs *Compiler) SetWorkerFlavors(v []string) {
+	if cs == nil {
+		return
+	}
+
+	cs.WorkerFlavors = &v
+}
+
+// GetPolicyRules returns the PolicyRules field.
+//
+// When the provided Compiler type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (cs *Compiler) GetPolicyRules() []PolicyRule {
+	if cs == nil || cs.PolicyRules == nil {
+		return []PolicyRule{}
+	}
+
+	return *cs.PolicyRules
+}
+
+// SetPolicyRules sets the PolicyRules field.
+//
+// When the provided Compiler type is nil, it
+// will set nothing and immediately return.
+func (cs *Compiler) SetPolicyRules(v []PolicyRule) {
+	if cs == nil {
+		return
+	}
+
+	cs.PolicyRules = &v
+}
+
+// GetHTTPSTemplateAllowlist returns the HTTPSTemplateAllowlist field.
+//
+// When the provided Compiler type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (cs *Compiler) GetHTTPSTemplateAllowlist() []string {
+	if cs == nil || cs.HTTPSTemplateAllowlist == nil {
+		return []string{}
+	}
+
+	return *cs.HTTPSTemplateAllowlist
+}
+
+// GetOCITemplateAllowlist returns the OCITemplateAllowlist field.
+//
+// When the provided Compiler type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (cs *Compiler) GetOCITemplateAllowlist() []string {
+	if cs == nil || cs.OCITemplateAllowlist == nil {
+		return []string{}
+	}
+
+	return *cs.OCITemplateAllowlist
+}
+
+// SetHTTPSTemplateAllowlist sets the HTTPSTemplateAllowlist field.
+//
+// When the provided Compiler type is nil, it
+// will set nothing and immediately return.
+func (cs *Compiler) SetHTTPSTemplateAllowlist(v []string) {
+	if cs == nil {
+		return
+	}
+
+	cs.HTTPSTemplateAllowlist = &v
+}
+
+// SetOCITemplateAllowlist sets the OCITemplateAllowlist field.
+//
+// When the provided Compiler type is nil, it
+// will set nothing and immediately return.
+func (cs *Compiler) SetOCITemplateAllowlist(v []string) {
+	if cs == nil {
+		return
+	}
+
+	cs.OCITemplateAllowlist = &v
 }
 
 // GetBlockedImages returns the BlockedImages field.
@@ -163,6 +411,19 @@ func (cs *Compiler) GetStarlarkExecLimit() int64 {
 	return *cs.StarlarkExecLimit
 }
 
+// GetJsonnetExecTimeout returns the JsonnetExecTimeout field.
+//
+// When the provided Compiler type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (cs *Compiler) GetJsonnetExecTimeout() int64 {
+	// return zero value if Compiler type or JsonnetExecTimeout field is nil
+	if cs == nil || cs.JsonnetExecTimeout == nil {
+		return 0
+	}
+
+	return *cs.JsonnetExecTimeout
+}
+
 // SetCloneImage sets the CloneImage field.
 //
 // When the provided Compiler type is nil, it
@@ -202,20 +463,43 @@ func (cs *Compiler) SetStarlarkExecLimit(v int64) {
 	cs.StarlarkExecLimit = &v
 }
 
+// SetJsonnetExecTimeout sets the JsonnetExecTimeout field.
+//
+// When the provided Compiler type is nil, it
+// will set nothing and immediately return.
+func (cs *Compiler) SetJsonnetExecTimeout(v int64) {
+	// return if Compiler type is nil
+	if cs == nil {
+		return
+	}
+
+	cs.JsonnetExecTimeout = &v
+}
+
 // String implements the Stringer interface for the Compiler type.
 func (cs *Compiler) String() string {
 	return fmt.Sprintf(`{
   CloneImage: %s,
   TemplateDepth: %d,
   StarlarkExecLimit: %d,
+  JsonnetExecTimeout: %d,
   BlockedImages: %v,
   WarnImages: %v,
+  HTTPSTemplateAllowlist: %v,
+  OCITemplateAllowlist: %v,
+  PolicyRules: %v,
+  WorkerFlavors: %v,
 }`,
 		cs.GetCloneImage(),
 		cs.GetTemplateDepth(),
 		cs.GetStarlarkExecLimit(),
+		cs.GetJsonnetExecTimeout(),
 		cs.GetBlockedImages(),
 		cs.GetWarnImages(),
+		cs.GetHTTPSTemplateAllowlist(),
+		cs.GetOCITemplateAllowlist(),
+		cs.GetPolicyRules(),
+		cs.GetWorkerFlavors(),
 	)
 }
 
@@ -225,8 +509,13 @@ func CompilerMockEmpty() Compiler {
 	cs.SetCloneImage("")
 	cs.SetTemplateDepth(0)
 	cs.SetStarlarkExecLimit(0)
+	cs.SetJsonnetExecTimeout(0)
 	cs.SetBlockedImages(nil)
 	cs.SetWarnImages(nil)
+	cs.SetHTTPSTemplateAllowlist(nil)
+	cs.SetOCITemplateAllowlist(nil)
+	cs.SetPolicyRules(nil)
+	cs.SetWorkerFlavors(nil)
 
 	return cs
 }
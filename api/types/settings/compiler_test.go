@@ -48,6 +48,121 @@ func TestTypes_ImageRestriction_String(t *testing.T) {
 	}
 }
 
+func TestTypes_PolicyRule_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rule *PolicyRule
+		want *PolicyRule
+	}{
+		{
+			rule: testPolicyRule(),
+			want: testPolicyRule(),
+		},
+		{
+			rule: new(PolicyRule),
+			want: new(PolicyRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.rule.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.rule.GetName(), test.want.GetName())
+		}
+
+		if test.rule.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.rule.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.rule.GetRule() != test.want.GetRule() {
+			t.Errorf("GetRule is %v, want %v", test.rule.GetRule(), test.want.GetRule())
+		}
+
+		if test.rule.GetAction() != test.want.GetAction() {
+			t.Errorf("GetAction is %v, want %v", test.rule.GetAction(), test.want.GetAction())
+		}
+
+		if test.rule.GetReason() != test.want.GetReason() {
+			t.Errorf("GetReason is %v, want %v", test.rule.GetReason(), test.want.GetReason())
+		}
+	}
+}
+
+func TestTypes_PolicyRule_Setters(t *testing.T) {
+	// setup types
+	var pr *PolicyRule
+
+	// setup tests
+	tests := []struct {
+		rule *PolicyRule
+		want *PolicyRule
+	}{
+		{
+			rule: testPolicyRule(),
+			want: testPolicyRule(),
+		},
+		{
+			rule: pr,
+			want: new(PolicyRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.rule.SetName(test.want.GetName())
+		test.rule.SetOrg(test.want.GetOrg())
+		test.rule.SetRule(test.want.GetRule())
+		test.rule.SetAction(test.want.GetAction())
+		test.rule.SetReason(test.want.GetReason())
+
+		if test.rule.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.rule.GetName(), test.want.GetName())
+		}
+
+		if test.rule.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.rule.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.rule.GetRule() != test.want.GetRule() {
+			t.Errorf("SetRule is %v, want %v", test.rule.GetRule(), test.want.GetRule())
+		}
+
+		if test.rule.GetAction() != test.want.GetAction() {
+			t.Errorf("SetAction is %v, want %v", test.rule.GetAction(), test.want.GetAction())
+		}
+
+		if test.rule.GetReason() != test.want.GetReason() {
+			t.Errorf("SetReason is %v, want %v", test.rule.GetReason(), test.want.GetReason())
+		}
+	}
+}
+
+func TestTypes_PolicyRule_String(t *testing.T) {
+	// setup types
+	pr := testPolicyRule()
+
+	want := fmt.Sprintf(`{
+  Action: %s,
+  Name: %s,
+  Org: %s,
+  Reason: %s,
+  Rule: %s,
+}`,
+		pr.GetAction(),
+		pr.GetName(),
+		pr.GetOrg(),
+		pr.GetReason(),
+		pr.GetRule(),
+	)
+
+	// run test
+	got := pr.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
 func TestTypes_Compiler_Getters(t *testing.T) {
 	// setup tests
 	tests := []struct {
@@ -78,6 +193,10 @@ func TestTypes_Compiler_Getters(t *testing.T) {
 			t.Errorf("GetStarlarkExecLimit is %v, want %v", test.compiler.GetStarlarkExecLimit(), test.want.GetStarlarkExecLimit())
 		}
 
+		if !reflect.DeepEqual(test.compiler.GetJsonnetExecTimeout(), test.want.GetJsonnetExecTimeout()) {
+			t.Errorf("GetJsonnetExecTimeout is %v, want %v", test.compiler.GetJsonnetExecTimeout(), test.want.GetJsonnetExecTimeout())
+		}
+
 		if !reflect.DeepEqual(test.compiler.GetBlockedImages(), test.want.GetBlockedImages()) {
 			t.Errorf("GetBlockedImages is %v, want %v", test.compiler.GetBlockedImages(), test.want.GetBlockedImages())
 		}
@@ -85,6 +204,22 @@ func TestTypes_Compiler_Getters(t *testing.T) {
 		if !reflect.DeepEqual(test.compiler.GetWarnImages(), test.want.GetWarnImages()) {
 			t.Errorf("GetWarnImages is %v, want %v", test.compiler.GetWarnImages(), test.want.GetWarnImages())
 		}
+
+		if !reflect.DeepEqual(test.compiler.GetHTTPSTemplateAllowlist(), test.want.GetHTTPSTemplateAllowlist()) {
+			t.Errorf("GetHTTPSTemplateAllowlist is %v, want %v", test.compiler.GetHTTPSTemplateAllowlist(), test.want.GetHTTPSTemplateAllowlist())
+		}
+
+		if !reflect.DeepEqual(test.compiler.GetOCITemplateAllowlist(), test.want.GetOCITemplateAllowlist()) {
+			t.Errorf("GetOCITemplateAllowlist is %v, want %v", test.compiler.GetOCITemplateAllowlist(), test.want.GetOCITemplateAllowlist())
+		}
+
+		if !reflect.DeepEqual(test.compiler.GetPolicyRules(), test.want.GetPolicyRules()) {
+			t.Errorf("GetPolicyRules is %v, want %v", test.compiler.GetPolicyRules(), test.want.GetPolicyRules())
+		}
+
+		if !reflect.DeepEqual(test.compiler.GetWorkerFlavors(), test.want.GetWorkerFlavors()) {
+			t.Errorf("GetWorkerFlavors is %v, want %v", test.compiler.GetWorkerFlavors(), test.want.GetWorkerFlavors())
+		}
 	}
 }
 
@@ -127,6 +262,12 @@ func TestTypes_Compiler_Setters(t *testing.T) {
 			t.Errorf("SetStarlarkExecLimit is %v, want %v", test.compiler.GetStarlarkExecLimit(), test.want.GetStarlarkExecLimit())
 		}
 
+		test.compiler.SetJsonnetExecTimeout(test.want.GetJsonnetExecTimeout())
+
+		if !reflect.DeepEqual(test.compiler.GetJsonnetExecTimeout(), test.want.GetJsonnetExecTimeout()) {
+			t.Errorf("SetJsonnetExecTimeout is %v, want %v", test.compiler.GetJsonnetExecTimeout(), test.want.GetJsonnetExecTimeout())
+		}
+
 		test.compiler.SetBlockedImages(test.want.GetBlockedImages())
 
 		if !reflect.DeepEqual(test.compiler.GetBlockedImages(), test.want.GetBlockedImages()) {
@@ -138,6 +279,30 @@ func TestTypes_Compiler_Setters(t *testing.T) {
 		if !reflect.DeepEqual(test.compiler.GetWarnImages(), test.want.GetWarnImages()) {
 			t.Errorf("SetWarnImages is %v, want %v", test.compiler.GetWarnImages(), test.want.GetWarnImages())
 		}
+
+		test.compiler.SetHTTPSTemplateAllowlist(test.want.GetHTTPSTemplateAllowlist())
+
+		if !reflect.DeepEqual(test.compiler.GetHTTPSTemplateAllowlist(), test.want.GetHTTPSTemplateAllowlist()) {
+			t.Errorf("SetHTTPSTemplateAllowlist is %v, want %v", test.compiler.GetHTTPSTemplateAllowlist(), test.want.GetHTTPSTemplateAllowlist())
+		}
+
+		test.compiler.SetOCITemplateAllowlist(test.want.GetOCITemplateAllowlist())
+
+		if !reflect.DeepEqual(test.compiler.GetOCITemplateAllowlist(), test.want.GetOCITemplateAllowlist()) {
+			t.Errorf("SetOCITemplateAllowlist is %v, want %v", test.compiler.GetOCITemplateAllowlist(), test.want.GetOCITemplateAllowlist())
+		}
+
+		test.compiler.SetPolicyRules(test.want.GetPolicyRules())
+
+		if !reflect.DeepEqual(test.compiler.GetPolicyRules(), test.want.GetPolicyRules()) {
+			t.Errorf("SetPolicyRules is %v, want %v", test.compiler.GetPolicyRules(), test.want.GetPolicyRules())
+		}
+
+		test.compiler.SetWorkerFlavors(test.want.GetWorkerFlavors())
+
+		if !reflect.DeepEqual(test.compiler.GetWorkerFlavors(), test.want.GetWorkerFlavors()) {
+			t.Errorf("SetWorkerFlavors is %v, want %v", test.compiler.GetWorkerFlavors(), test.want.GetWorkerFlavors())
+		}
 	}
 }
 
@@ -149,14 +314,24 @@ func TestTypes_Compiler_String(t *testing.T) {
   CloneImage: %s,
   TemplateDepth: %d,
   StarlarkExecLimit: %d,
+  JsonnetExecTimeout: %d,
   BlockedImages: %v,
   WarnImages: %v,
+  HTTPSTemplateAllowlist: %v,
+  OCITemplateAllowlist: %v,
+  PolicyRules: %v,
+  WorkerFlavors: %v,
 }`,
 		cs.GetCloneImage(),
 		cs.GetTemplateDepth(),
 		cs.GetStarlarkExecLimit(),
+		cs.GetJsonnetExecTimeout(),
 		cs.GetBlockedImages(),
 		cs.GetWarnImages(),
+		cs.GetHTTPSTemplateAllowlist(),
+		cs.GetOCITemplateAllowlist(),
+		cs.GetPolicyRules(),
+		cs.GetWorkerFlavors(),
 	)
 
 	// run test
@@ -175,12 +350,31 @@ func testCompilerSettings() *Compiler {
 	cs.SetCloneImage("target/vela-git-slim:latest")
 	cs.SetTemplateDepth(1)
 	cs.SetStarlarkExecLimit(100)
+	cs.SetJsonnetExecTimeout(30)
 	cs.SetBlockedImages([]ImageRestriction{
 		{Image: new("docker.io/blocked/image:latest"), Reason: new("this image is blocked")},
 	})
 	cs.SetWarnImages([]ImageRestriction{
 		{Image: new("docker.io/deprecated/image:latest"), Reason: new("this image is deprecated")},
 	})
+	cs.SetHTTPSTemplateAllowlist([]string{"templates.example.com"})
+	cs.SetOCITemplateAllowlist([]string{"ghcr.io"})
+	cs.SetPolicyRules([]PolicyRule{*testPolicyRule()})
+	cs.SetWorkerFlavors([]string{"large"})
 
 	return cs
 }
+
+// testPolicyRule is a test helper function to create a PolicyRule
+// type with all fields set to a fake value.
+func testPolicyRule() *PolicyRule {
+	pr := new(PolicyRule)
+
+	pr.SetName("no-privileged")
+	pr.SetOrg("github")
+	pr.SetRule("repo.trusted || none(containers, .privileged)")
+	pr.SetAction("block")
+	pr.SetReason("privileged containers require a trusted repo")
+
+	return pr
+}
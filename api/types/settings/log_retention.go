@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import "fmt"
+
+// LogRetentionRule is the API representation of a rule
+// controlling how long the logs of builds are kept.
+//
+// The repos a rule applies to are defined by its RuleScope.
+//
+// Logs are kept for KeepDays, or indefinitely when zero. The logs of
+// failed builds are kept for KeepFailedDays instead when provided and
+// the logs of deployments are kept indefinitely with KeepDeployments.
+//
+// swagger:model LogRetentionRule
+type LogRetentionRule struct {
+	RuleScope       `yaml:",inline"`
+	KeepDays        *int64 `json:"keep_days,omitempty"        yaml:"keep_days,omitempty"`
+	KeepFailedDays  *int64 `json:"keep_failed_days,omitempty" yaml:"keep_failed_days,omitempty"`
+	KeepDeployments *bool  `json:"keep_deployments,omitempty" yaml:"keep_deployments,omitempty"`
+}
+
+// GetKeepDays returns the KeepDays field.
+//
+// When the provided LogRetentionRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (lr *LogRetentionRule) GetKeepDays() int64 {
+	if lr == nil || lr.KeepDays == nil {
+		return 0
+	}
+
+	return *lr.KeepDays
+}
+
+// GetKeepFailedDays returns the KeepFailedDays field.
+//
+// When the provided LogRetentionRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (lr *LogRetentionRule) GetKeepFailedDays() int64 {
+	if lr == nil || lr.KeepFailedDays == nil {
+		return 0
+	}
+
+	return *lr.KeepFailedDays
+}
+
+// GetKeepDeployments returns the KeepDeployments field.
+//
+// When the provided LogRetentionRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (lr *LogRetentionRule) GetKeepDeployments() bool {
+	if lr == nil || lr.KeepDeployments == nil {
+		return false
+	}
+
+	return *lr.KeepDeployments
+}
+
+// SetKeepDays sets the KeepDays field.
+//
+// When the provided LogRetentionRule type is nil, it
+// will set nothing and immediately return.
+func (lr *LogRetentionRule) SetKeepDays(v int64) {
+	if lr == nil {
+		return
+	}
+
+	lr.KeepDays = &v
+}
+
+// SetKeepFailedDays sets the KeepFailedDays field.
+//
+// When the provided LogRetentionRule type is nil, it
+// will set nothing and immediately return.
+func (lr *LogRetentionRule) SetKeepFailedDays(v int64) {
+	if lr == nil {
+		return
+	}
+
+	lr.KeepFailedDays = &v
+}
+
+// SetKeepDeployments sets the KeepDeployments field.
+//
+// When the provided LogRetentionRule type is nil, it
+// will set nothing and immediately return.
+func (lr *LogRetentionRule) SetKeepDeployments(v bool) {
+	if lr == nil {
+		return
+	}
+
+	lr.KeepDeployments = &v
+}
+
+// Validate verifies the fields of the LogRetentionRule are populated correctly.
+func (lr *LogRetentionRule) Validate() error {
+	if err := lr.RuleScope.Validate(); err != nil {
+		return fmt.Errorf("invalid log retention rule: %w", err)
+	}
+
+	if lr.GetKeepDays() < 0 {
+		return fmt.Errorf("log retention rule keep_days must be greater than or equal to zero, got: %d", lr.GetKeepDays())
+	}
+
+	if lr.GetKeepFailedDays() < 0 {
+		return fmt.Errorf("log retention rule keep_failed_days must be greater than or equal to zero, got: %d", lr.GetKeepFailedDays())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface for the LogRetentionRule type.
+func (lr *LogRetentionRule) String() string {
+	return fmt.Sprintf(`{
+  KeepDays: %d,
+  KeepDeployments: %t,
+  KeepFailedDays: %d,
+  Org: %s,
+  Repo: %s,
+}`,
+		lr.GetKeepDays(),
+		lr.GetKeepDeployments(),
+		lr.GetKeepFailedDays(),
+		lr.GetOrg(),
+		lr.GetRepo(),
+	)
+}
+
+// MatchLogRetentionRule returns the most specific log retention
+// rule applying to the provided repo, or nil when no rule applies.
+func MatchLogRetentionRule(rules []LogRetentionRule, org, repo string) *LogRetentionRule {
+	return matchRule(rules, org, repo)
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTypes_LogRetentionRule_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rule *LogRetentionRule
+		want *LogRetentionRule
+	}{
+		{
+			rule: testLogRetentionRule(),
+			want: testLogRetentionRule(),
+		},
+		{
+			rule: new(LogRetentionRule),
+			want: new(LogRetentionRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.rule.GetKeepDays() != test.want.GetKeepDays() {
+			t.Errorf("GetKeepDays is %v, want %v", test.rule.GetKeepDays(), test.want.GetKeepDays())
+		}
+
+		if test.rule.GetKeepFailedDays() != test.want.GetKeepFailedDays() {
+			t.Errorf("GetKeepFailedDays is %v, want %v", test.rule.GetKeepFailedDays(), test.want.GetKeepFailedDays())
+		}
+
+		if test.rule.GetKeepDeployments() != test.want.GetKeepDeployments() {
+			t.Errorf("GetKeepDeployments is %v, want %v", test.rule.GetKeepDeployments(), test.want.GetKeepDeployments())
+		}
+	}
+}
+
+func TestTypes_LogRetentionRule_Setters(t *testing.T) {
+	// setup types
+	var lr *LogRetentionRule
+
+	// setup tests
+	tests := []struct {
+		rule *LogRetentionRule
+		want *LogRetentionRule
+	}{
+		{
+			rule: testLogRetentionRule(),
+			want: testLogRetentionRule(),
+		},
+		{
+			rule: lr,
+			want: new(LogRetentionRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.rule.SetKeepDays(test.want.GetKeepDays())
+		test.rule.SetKeepFailedDays(test.want.GetKeepFailedDays())
+		test.rule.SetKeepDeployments(test.want.GetKeepDeployments())
+
+		if test.rule.GetKeepDays() != test.want.GetKeepDays() {
+			t.Errorf("SetKeepDays is %v, want %v", test.rule.GetKeepDays(), test.want.GetKeepDays())
+		}
+
+		if test.rule.GetKeepFailedDays() != test.want.GetKeepFailedDays() {
+			t.Errorf("SetKeepFailedDays is %v, want %v", test.rule.GetKeepFailedDays(), test.want.GetKeepFailedDays())
+		}
+
+		if test.rule.GetKeepDeployments() != test.want.GetKeepDeployments() {
+			t.Errorf("SetKeepDeployments is %v, want %v", test.rule.GetKeepDeployments(), test.want.GetKeepDeployments())
+		}
+	}
+}
+
+func TestTypes_LogRetentionRule_Validate(t *testing.T) {
+	// setup types
+	repoOnly := new(LogRetentionRule)
+	repoOnly.SetRepo("hello-world")
+
+	negativeDays := testLogRetentionRule()
+	negativeDays.SetKeepDays(-1)
+
+	negativeFailedDays := testLogRetentionRule()
+	negativeFailedDays.SetKeepFailedDays(-1)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		rule    *LogRetentionRule
+	}{
+		{name: "valid", failure: false, rule: testLogRetentionRule()},
+		{name: "empty", failure: false, rule: new(LogRetentionRule)},
+		{name: "repo without org", failure: true, rule: repoOnly},
+		{name: "negative keep days", failure: true, rule: negativeDays},
+		{name: "negative keep failed days", failure: true, rule: negativeFailedDays},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.rule.Validate()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Validate should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Validate returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestTypes_LogRetentionRule_String(t *testing.T) {
+	// setup types
+	lr := testLogRetentionRule()
+
+	want := fmt.Sprintf(`{
+  KeepDays: %d,
+  KeepDeployments: %t,
+  KeepFailedDays: %d,
+  Org: %s,
+  Repo: %s,
+}`,
+		lr.GetKeepDays(),
+		lr.GetKeepDeployments(),
+		lr.GetKeepFailedDays(),
+		lr.GetOrg(),
+		lr.GetRepo(),
+	)
+
+	// run test
+	got := lr.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+func TestTypes_MatchLogRetentionRule(t *testing.T) {
+	// setup types
+	platform := LogRetentionRule{}
+	platform.SetKeepDays(30)
+
+	org := LogRetentionRule{}
+	org.SetOrg("octocat")
+	org.SetKeepDays(60)
+
+	repo := *testLogRetentionRule()
+
+	// setup tests
+	tests := []struct {
+		name  string
+		rules []LogRetentionRule
+		org   string
+		repo  string
+		want  *LogRetentionRule
+	}{
+		{name: "repo rule", rules: []LogRetentionRule{platform, org, repo}, org: "octocat", repo: "hello-world", want: &repo},
+		{name: "org rule", rules: []LogRetentionRule{platform, repo, org}, org: "octocat", repo: "other", want: &org},
+		{name: "platform rule", rules: []LogRetentionRule{repo, org, platform}, org: "github", repo: "hello-world", want: &platform},
+		{name: "no platform rule", rules: []LogRetentionRule{repo, org}, org: "github", repo: "hello-world", want: nil},
+		{name: "no rules", rules: nil, org: "octocat", repo: "hello-world", want: nil},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MatchLogRetentionRule(test.rules, test.org, test.repo)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("MatchLogRetentionRule is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// testLogRetentionRule is a test helper function to create a LogRetentionRule
+// type with all fields set to a fake value.
+func testLogRetentionRule() *LogRetentionRule {
+	lr := new(LogRetentionRule)
+
+	lr.SetOrg("octocat")
+	lr.SetRepo("hello-world")
+	lr.SetKeepDays(30)
+	lr.SetKeepFailedDays(90)
+	lr.SetKeepDeployments(true)
+
+	return lr
+}
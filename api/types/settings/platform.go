@@ -16,16 +16,18 @@ type Platform struct {
 	*Compiler           `json:"compiler,omitempty"              yaml:"compiler,omitempty"`
 	*Queue              `json:"queue,omitempty"                 yaml:"queue,omitempty"`
 	*SCM                `json:"scm,omitempty"                   yaml:"scm,omitempty"`
-	RepoAllowlist       *[]string `json:"repo_allowlist,omitempty"        yaml:"repo_allowlist,omitempty"`
-	ScheduleAllowlist   *[]string `json:"schedule_allowlist,omitempty"    yaml:"schedule_allowlist,omitempty"`
-	MaxDashboardRepos   *int32    `json:"max_dashboard_repos,omitempty"   yaml:"max_dashboard_repos,omitempty"`
-	QueueRestartLimit   *int32    `json:"queue_restart_limit,omitempty"   yaml:"queue_restart_limit,omitempty"`
-	EnableRepoSecrets   *bool     `json:"enable_repo_secrets,omitempty"   yaml:"enable_repo_secrets,omitempty"`
-	EnableOrgSecrets    *bool     `json:"enable_org_secrets,omitempty"    yaml:"enable_org_secrets,omitempty"`
-	EnableSharedSecrets *bool     `json:"enable_shared_secrets,omitempty" yaml:"enable_shared_secrets,omitempty"`
-	CreatedAt           *int64    `json:"created_at,omitempty"            yaml:"created_at,omitempty"`
-	UpdatedAt           *int64    `json:"updated_at,omitempty"            yaml:"updated_at,omitempty"`
-	UpdatedBy           *string   `json:"updated_by,omitempty"            yaml:"updated_by,omitempty"`
+	RepoAllowlist       *[]string           `json:"repo_allowlist,omitempty"        yaml:"repo_allowlist,omitempty"`
+	ScheduleAllowlist   *[]string           `json:"schedule_allowlist,omitempty"    yaml:"schedule_allowlist,omitempty"`
+	MaxDashboardRepos   *int32              `json:"max_dashboard_repos,omitempty"   yaml:"max_dashboard_repos,omitempty"`
+	QueueRestartLimit   *int32              `json:"queue_restart_limit,omitempty"   yaml:"queue_restart_limit,omitempty"`
+	EnableRepoSecrets   *bool               `json:"enable_repo_secrets,omitempty"   yaml:"enable_repo_secrets,omitempty"`
+	EnableOrgSecrets    *bool               `json:"enable_org_secrets,omitempty"    yaml:"enable_org_secrets,omitempty"`
+	EnableSharedSecrets *bool               `json:"enable_shared_secrets,omitempty" yaml:"enable_shared_secrets,omitempty"`
+	ArtifactRetention   *[]RetentionRule    `json:"artifact_retention,omitempty"    yaml:"artifact_retention,omitempty"`
+	LogRetention        *[]LogRetentionRule `json:"log_retention,omitempty"         yaml:"log_retention,omitempty"`
+	CreatedAt           *int64              `json:"created_at,omitempty"            yaml:"created_at,omitempty"`
+	UpdatedAt           *int64              `json:"updated_at,omitempty"            yaml:"updated_at,omitempty"`
+	UpdatedBy           *string             `json:"updated_by,omitempty"            yaml:"updated_by,omitempty"`
 }
 
 // FromCLICommand returns a new Platform record from a cli command.
@@ -199,6 +201,32 @@ func (ps *Platform) GetEnableSharedSecrets() bool {
 	return *ps.EnableSharedSecrets
 }
 
+// GetArtifactRetention returns the ArtifactRetention field.
+//
+// When the provided Platform type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (ps *Platform) GetArtifactRetention() []RetentionRule {
+	// return zero value if Platform type or ArtifactRetention field is nil
+	if ps == nil || ps.ArtifactRetention == nil {
+		return []RetentionRule{}
+	}
+
+	return *ps.ArtifactRetention
+}
+
+// GetLogRetention returns the LogRetention field.
+//
+// When the provided Platform type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (ps *Platform) GetLogRetention() []LogRetentionRule {
+	// return zero value if Platform type or LogRetention field is nil
+	if ps == nil || ps.LogRetention == nil {
+		return []LogRetentionRule{}
+	}
+
+	return *ps.LogRetention
+}
+
 // GetCreatedAt returns the CreatedAt field.
 //
 // When the provided Platform type is nil, or the field within
@@ -381,6 +409,32 @@ func (ps *Platform) SetEnableSharedSecrets(v bool) {
 	ps.EnableSharedSecrets = &v
 }
 
+// SetArtifactRetention sets the ArtifactRetention field.
+//
+// When the provided Platform type is nil, it
+// will set nothing and immediately return.
+func (ps *Platform) SetArtifactRetention(v []RetentionRule) {
+	// return if Platform type is nil
+	if ps == nil {
+		return
+	}
+
+	ps.ArtifactRetention = &v
+}
+
+// SetLogRetention sets the LogRetention field.
+//
+// When the provided Platform type is nil, it
+// will set nothing and immediately return.
+func (ps *Platform) SetLogRetention(v []LogRetentionRule) {
+	// return if Platform type is nil
+	if ps == nil {
+		return
+	}
+
+	ps.LogRetention = &v
+}
+
 // SetCreatedAt sets the CreatedAt field.
 //
 // When the provided Platform type is nil, it
@@ -441,6 +495,8 @@ func (ps *Platform) FromSettings(_ps *Platform) {
 	ps.SetEnableRepoSecrets(_ps.GetEnableRepoSecrets())
 	ps.SetEnableOrgSecrets(_ps.GetEnableOrgSecrets())
 	ps.SetEnableSharedSecrets(_ps.GetEnableSharedSecrets())
+	ps.SetArtifactRetention(_ps.GetArtifactRetention())
+	ps.SetLogRetention(_ps.GetLogRetention())
 
 	ps.SetCreatedAt(_ps.GetCreatedAt())
 	ps.SetUpdatedAt(_ps.GetUpdatedAt())
@@ -465,6 +521,8 @@ func (ps *Platform) String() string {
   EnableRepoSecrets: %t,
   EnableOrgSecrets: %t,
   EnableSharedSecrets: %t,
+  ArtifactRetention: %v,
+  LogRetention: %v,
   CreatedAt: %d,
   UpdatedAt: %d,
   UpdatedBy: %s,
@@ -480,6 +538,8 @@ func (ps *Platform) String() string {
 		ps.GetEnableRepoSecrets(),
 		ps.GetEnableOrgSecrets(),
 		ps.GetEnableSharedSecrets(),
+		ps.GetArtifactRetention(),
+		ps.GetLogRetention(),
 		ps.GetCreatedAt(),
 		ps.GetUpdatedAt(),
 		ps.GetUpdatedBy(),
@@ -501,6 +561,8 @@ func PlatformMockEmpty() Platform {
 	ps.SetEnableRepoSecrets(false)
 	ps.SetEnableOrgSecrets(false)
 	ps.SetEnableSharedSecrets(false)
+	ps.SetArtifactRetention([]RetentionRule{})
+	ps.SetLogRetention([]LogRetentionRule{})
 
 	return ps
 }
@@ -48,6 +48,14 @@ func TestTypes_Platform_Getters(t *testing.T) {
 			t.Errorf("GetScheduleAllowlist is %v, want %v", test.platform.GetScheduleAllowlist(), test.want.GetScheduleAllowlist())
 		}
 
+		if !reflect.DeepEqual(test.platform.GetArtifactRetention(), test.want.GetArtifactRetention()) {
+			t.Errorf("GetArtifactRetention is %v, want %v", test.platform.GetArtifactRetention(), test.want.GetArtifactRetention())
+		}
+
+		if !reflect.DeepEqual(test.platform.GetLogRetention(), test.want.GetLogRetention()) {
+			t.Errorf("GetLogRetention is %v, want %v", test.platform.GetLogRetention(), test.want.GetLogRetention())
+		}
+
 		if test.platform.GetMaxDashboardRepos() != test.want.GetMaxDashboardRepos() {
 			t.Errorf("GetMaxDashboardRepos is %v, want %v", test.platform.GetMaxDashboardRepos(), test.want.GetMaxDashboardRepos())
 		}
@@ -121,6 +129,18 @@ func TestTypes_Platform_Setters(t *testing.T) {
 			t.Errorf("SetScheduleAllowlist is %v, want %v", test.platform.GetScheduleAllowlist(), test.want.GetScheduleAllowlist())
 		}
 
+		test.platform.SetArtifactRetention(test.want.GetArtifactRetention())
+
+		if !reflect.DeepEqual(test.platform.GetArtifactRetention(), test.want.GetArtifactRetention()) {
+			t.Errorf("SetArtifactRetention is %v, want %v", test.platform.GetArtifactRetention(), test.want.GetArtifactRetention())
+		}
+
+		test.platform.SetLogRetention(test.want.GetLogRetention())
+
+		if !reflect.DeepEqual(test.platform.GetLogRetention(), test.want.GetLogRetention()) {
+			t.Errorf("SetLogRetention is %v, want %v", test.platform.GetLogRetention(), test.want.GetLogRetention())
+		}
+
 		test.platform.SetMaxDashboardRepos(test.want.GetMaxDashboardRepos())
 
 		if test.platform.GetMaxDashboardRepos() != test.want.GetMaxDashboardRepos() {
@@ -169,6 +189,8 @@ func TestTypes_Platform_Update(t *testing.T) {
 	sUpdate.SetEnableRepoSecrets(true)
 	sUpdate.SetEnableOrgSecrets(true)
 	sUpdate.SetEnableSharedSecrets(true)
+	sUpdate.SetArtifactRetention([]RetentionRule{})
+	sUpdate.SetLogRetention([]LogRetentionRule{})
 
 	// setup tests
 	tests := []struct {
@@ -214,6 +236,8 @@ func TestTypes_Platform_String(t *testing.T) {
   EnableRepoSecrets: %t,
   EnableOrgSecrets: %t,
   EnableSharedSecrets: %t,
+  ArtifactRetention: %v,
+  LogRetention: %v,
   CreatedAt: %d,
   UpdatedAt: %d,
   UpdatedBy: %s,
@@ -229,6 +253,8 @@ func TestTypes_Platform_String(t *testing.T) {
 		s.GetEnableRepoSecrets(),
 		s.GetEnableOrgSecrets(),
 		s.GetEnableSharedSecrets(),
+		s.GetArtifactRetention(),
+		s.GetLogRetention(),
 		s.GetCreatedAt(),
 		s.GetUpdatedAt(),
 		s.GetUpdatedBy(),
@@ -259,6 +285,22 @@ func testPlatformSettings() *Platform {
 	s.SetEnableOrgSecrets(false)
 	s.SetEnableSharedSecrets(false)
 
+	// setup artifact retention
+	rule := RetentionRule{}
+	rule.SetKeepBuilds(10)
+	rule.SetKeepDays(30)
+	rule.SetKeepTags(true)
+
+	s.SetArtifactRetention([]RetentionRule{rule})
+
+	// setup log retention
+	logRule := LogRetentionRule{}
+	logRule.SetKeepDays(30)
+	logRule.SetKeepFailedDays(90)
+	logRule.SetKeepDeployments(true)
+
+	s.SetLogRetention([]LogRetentionRule{logRule})
+
 	// setup types
 	// setup compiler
 	cs := new(Compiler)
@@ -266,6 +308,7 @@ func testPlatformSettings() *Platform {
 	cs.SetCloneImage("target/vela-git-slim:latest")
 	cs.SetTemplateDepth(1)
 	cs.SetStarlarkExecLimit(100)
+	cs.SetJsonnetExecTimeout(30)
 
 	// setup queue
 	qs := new(Queue)
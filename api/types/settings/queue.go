@@ -5,7 +5,8 @@ package settings
 import "fmt"
 
 type Queue struct {
-	Routes *[]string `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Routes *[]string     `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Pools  *[]WorkerPool `json:"pools,omitempty"  yaml:"pools,omitempty"`
 }
 
 // GetRoutes returns the Routes field.
@@ -21,6 +22,19 @@ func (qs *Queue) GetRoutes() []string {
 	return *qs.Routes
 }
 
+// GetPools returns the Pools field.
+//
+// When the provided Queue type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (qs *Queue) GetPools() []WorkerPool {
+	// return zero value if Queue type or Pools field is nil
+	if qs == nil || qs.Pools == nil {
+		return []WorkerPool{}
+	}
+
+	return *qs.Pools
+}
+
 // SetRoutes sets the Routes field.
 //
 // When the provided Queue type is nil, it
@@ -34,12 +48,27 @@ func (qs *Queue) SetRoutes(v []string) {
 	qs.Routes = &v
 }
 
+// SetPools sets the Pools field.
+//
+// When the provided Queue type is nil, it
+// will set nothing and immediately return.
+func (qs *Queue) SetPools(v []WorkerPool) {
+	// return if Queue type is nil
+	if qs == nil {
+		return
+	}
+
+	qs.Pools = &v
+}
+
 // String implements the Stringer interface for the Queue type.
 func (qs *Queue) String() string {
 	return fmt.Sprintf(`{
   Routes: %v,
+  Pools: %v,
 }`,
 		qs.GetRoutes(),
+		qs.GetPools(),
 	)
 }
 
@@ -47,6 +76,7 @@ func (qs *Queue) String() string {
 func QueueMockEmpty() Queue {
 	qs := Queue{}
 	qs.SetRoutes([]string{})
+	qs.SetPools([]WorkerPool{})
 
 	return qs
 }
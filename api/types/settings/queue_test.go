@@ -29,6 +29,10 @@ func TestTypes_Queue_Getters(t *testing.T) {
 		if !reflect.DeepEqual(test.queue.GetRoutes(), test.want.GetRoutes()) {
 			t.Errorf("GetRoutes is %v, want %v", test.queue.GetRoutes(), test.want.GetRoutes())
 		}
+
+		if !reflect.DeepEqual(test.queue.GetPools(), test.want.GetPools()) {
+			t.Errorf("GetPools is %v, want %v", test.queue.GetPools(), test.want.GetPools())
+		}
 	}
 }
 
@@ -54,10 +58,15 @@ func TestTypes_Queue_Setters(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		test.queue.SetRoutes(test.want.GetRoutes())
+		test.queue.SetPools(test.want.GetPools())
 
 		if !reflect.DeepEqual(test.queue.GetRoutes(), test.want.GetRoutes()) {
 			t.Errorf("SetRoutes is %v, want %v", test.queue.GetRoutes(), test.want.GetRoutes())
 		}
+
+		if !reflect.DeepEqual(test.queue.GetPools(), test.want.GetPools()) {
+			t.Errorf("SetPools is %v, want %v", test.queue.GetPools(), test.want.GetPools())
+		}
 	}
 }
 
@@ -67,8 +76,10 @@ func TestTypes_Queue_String(t *testing.T) {
 
 	want := fmt.Sprintf(`{
   Routes: %s,
+  Pools: %v,
 }`,
 		qs.GetRoutes(),
+		qs.GetPools(),
 	)
 
 	// run test
@@ -86,5 +97,7 @@ func testQueueSettings() *Queue {
 
 	qs.SetRoutes([]string{"vela"})
 
+	qs.SetPools([]WorkerPool{*testWorkerPool()})
+
 	return qs
 }
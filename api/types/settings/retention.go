@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import "fmt"
+
+// RetentionRule is the API representation of a rule
+// controlling how long build artifacts are kept.
+//
+// The repos a rule applies to are defined by its RuleScope.
+//
+// swagger:model RetentionRule
+type RetentionRule struct {
+	RuleScope       `yaml:",inline"`
+	KeepBuilds      *int64 `json:"keep_builds,omitempty"      yaml:"keep_builds,omitempty"`
+	KeepDays        *int64 `json:"keep_days,omitempty"        yaml:"keep_days,omitempty"`
+	KeepTags        *bool  `json:"keep_tags,omitempty"        yaml:"keep_tags,omitempty"`
+	KeepDeployments *bool  `json:"keep_deployments,omitempty" yaml:"keep_deployments,omitempty"`
+}
+
+// GetKeepBuilds returns the KeepBuilds field.
+//
+// When the provided RetentionRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (rr *RetentionRule) GetKeepBuilds() int64 {
+	if rr == nil || rr.KeepBuilds == nil {
+		return 0
+	}
+
+	return *rr.KeepBuilds
+}
+
+// GetKeepDays returns the KeepDays field.
+//
+// When the provided RetentionRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (rr *RetentionRule) GetKeepDays() int64 {
+	if rr == nil || rr.KeepDays == nil {
+		return 0
+	}
+
+	return *rr.KeepDays
+}
+
+// GetKeepTags returns the KeepTags field.
+//
+// When the provided RetentionRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (rr *RetentionRule) GetKeepTags() bool {
+	if rr == nil || rr.KeepTags == nil {
+		return false
+	}
+
+	return *rr.KeepTags
+}
+
+// GetKeepDeployments returns the KeepDeployments field.
+//
+// When the provided RetentionRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (rr *RetentionRule) GetKeepDeployments() bool {
+	if rr == nil || rr.KeepDeployments == nil {
+		return false
+	}
+
+	return *rr.KeepDeployments
+}
+
+// SetKeepBuilds sets the KeepBuilds field.
+//
+// When the provided RetentionRule type is nil, it
+// will set nothing and immediately return.
+func (rr *RetentionRule) SetKeepBuilds(v int64) {
+	if rr == nil {
+		return
+	}
+
+	rr.KeepBuilds = &v
+}
+
+// SetKeepDays sets the KeepDays field.
+//
+// When the provided RetentionRule type is nil, it
+// will set nothing and immediately return.
+func (rr *RetentionRule) SetKeepDays(v int64) {
+	if rr == nil {
+		return
+	}
+
+	rr.KeepDays = &v
+}
+
+// SetKeepTags sets the KeepTags field.
+//
+// When the provided RetentionRule type is nil, it
+// will set nothing and immediately return.
+func (rr *RetentionRule) SetKeepTags(v bool) {
+	if rr == nil {
+		return
+	}
+
+	rr.KeepTags = &v
+}
+
+// SetKeepDeployments sets the KeepDeployments field.
+//
+// When the provided RetentionRule type is nil, it
+// will set nothing and immediately return.
+func (rr *RetentionRule) SetKeepDeployments(v bool) {
+	if rr == nil {
+		return
+	}
+
+	rr.KeepDeployments = &v
+}
+
+// Validate verifies the fields of the RetentionRule are populated correctly.
+func (rr *RetentionRule) Validate() error {
+	if err := rr.RuleScope.Validate(); err != nil {
+		return fmt.Errorf("invalid retention rule: %w", err)
+	}
+
+	if rr.GetKeepBuilds() < 0 {
+		return fmt.Errorf("retention rule keep_builds must be greater than or equal to zero, got: %d", rr.GetKeepBuilds())
+	}
+
+	if rr.GetKeepDays() < 0 {
+		return fmt.Errorf("retention rule keep_days must be greater than or equal to zero, got: %d", rr.GetKeepDays())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface for the RetentionRule type.
+func (rr *RetentionRule) String() string {
+	return fmt.Sprintf(`{
+  KeepBuilds: %d,
+  KeepDays: %d,
+  KeepDeployments: %t,
+  KeepTags: %t,
+  Org: %s,
+  Repo: %s,
+}`,
+		rr.GetKeepBuilds(),
+		rr.GetKeepDays(),
+		rr.GetKeepDeployments(),
+		rr.GetKeepTags(),
+		rr.GetOrg(),
+		rr.GetRepo(),
+	)
+}
+
+// MatchRetentionRule returns the most specific retention rule
+// applying to the provided repo, or nil when no rule applies.
+func MatchRetentionRule(rules []RetentionRule, org, repo string) *RetentionRule {
+	return matchRule(rules, org, repo)
+}
@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTypes_RetentionRule_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rule *RetentionRule
+		want *RetentionRule
+	}{
+		{
+			rule: testRetentionRule(),
+			want: testRetentionRule(),
+		},
+		{
+			rule: new(RetentionRule),
+			want: new(RetentionRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.rule.GetKeepBuilds() != test.want.GetKeepBuilds() {
+			t.Errorf("GetKeepBuilds is %v, want %v", test.rule.GetKeepBuilds(), test.want.GetKeepBuilds())
+		}
+
+		if test.rule.GetKeepDays() != test.want.GetKeepDays() {
+			t.Errorf("GetKeepDays is %v, want %v", test.rule.GetKeepDays(), test.want.GetKeepDays())
+		}
+
+		if test.rule.GetKeepTags() != test.want.GetKeepTags() {
+			t.Errorf("GetKeepTags is %v, want %v", test.rule.GetKeepTags(), test.want.GetKeepTags())
+		}
+
+		if test.rule.GetKeepDeployments() != test.want.GetKeepDeployments() {
+			t.Errorf("GetKeepDeployments is %v, want %v", test.rule.GetKeepDeployments(), test.want.GetKeepDeployments())
+		}
+	}
+}
+
+func TestTypes_RetentionRule_Setters(t *testing.T) {
+	// setup types
+	var rr *RetentionRule
+
+	// setup tests
+	tests := []struct {
+		rule *RetentionRule
+		want *RetentionRule
+	}{
+		{
+			rule: testRetentionRule(),
+			want: testRetentionRule(),
+		},
+		{
+			rule: rr,
+			want: new(RetentionRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.rule.SetKeepBuilds(test.want.GetKeepBuilds())
+		test.rule.SetKeepDays(test.want.GetKeepDays())
+		test.rule.SetKeepTags(test.want.GetKeepTags())
+		test.rule.SetKeepDeployments(test.want.GetKeepDeployments())
+
+		if test.rule.GetKeepBuilds() != test.want.GetKeepBuilds() {
+			t.Errorf("SetKeepBuilds is %v, want %v", test.rule.GetKeepBuilds(), test.want.GetKeepBuilds())
+		}
+
+		if test.rule.GetKeepDays() != test.want.GetKeepDays() {
+			t.Errorf("SetKeepDays is %v, want %v", test.rule.GetKeepDays(), test.want.GetKeepDays())
+		}
+
+		if test.rule.GetKeepTags() != test.want.GetKeepTags() {
+			t.Errorf("SetKeepTags is %v, want %v", test.rule.GetKeepTags(), test.want.GetKeepTags())
+		}
+
+		if test.rule.GetKeepDeployments() != test.want.GetKeepDeployments() {
+			t.Errorf("SetKeepDeployments is %v, want %v", test.rule.GetKeepDeployments(), test.want.GetKeepDeployments())
+		}
+	}
+}
+
+func TestTypes_RetentionRule_Validate(t *testing.T) {
+	// setup types
+	repoOnly := new(RetentionRule)
+	repoOnly.SetRepo("hello-world")
+
+	negativeBuilds := testRetentionRule()
+	negativeBuilds.SetKeepBuilds(-1)
+
+	negativeDays := testRetentionRule()
+	negativeDays.SetKeepDays(-1)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		rule    *RetentionRule
+	}{
+		{name: "valid", failure: false, rule: testRetentionRule()},
+		{name: "empty", failure: false, rule: new(RetentionRule)},
+		{name: "repo without org", failure: true, rule: repoOnly},
+		{name: "negative keep builds", failure: true, rule: negativeBuilds},
+		{name: "negative keep days", failure: true, rule: negativeDays},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.rule.Validate()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Validate should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Validate returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestTypes_RetentionRule_String(t *testing.T) {
+	// setup types
+	rr := testRetentionRule()
+
+	want := fmt.Sprintf(`{
+  KeepBuilds: %d,
+  KeepDays: %d,
+  KeepDeployments: %t,
+  KeepTags: %t,
+  Org: %s,
+  Repo: %s,
+}`,
+		rr.GetKeepBuilds(),
+		rr.GetKeepDays(),
+		rr.GetKeepDeployments(),
+		rr.GetKeepTags(),
+		rr.GetOrg(),
+		rr.GetRepo(),
+	)
+
+	// run test
+	got := rr.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+func TestTypes_MatchRetentionRule(t *testing.T) {
+	// setup types
+	platform := RetentionRule{}
+	platform.SetKeepBuilds(1)
+
+	org := RetentionRule{}
+	org.SetOrg("octocat")
+	org.SetKeepBuilds(2)
+
+	repo := *testRetentionRule()
+
+	// setup tests
+	tests := []struct {
+		name  string
+		rules []RetentionRule
+		org   string
+		repo  string
+		want  *RetentionRule
+	}{
+		{name: "repo rule", rules: []RetentionRule{platform, org, repo}, org: "octocat", repo: "hello-world", want: &repo},
+		{name: "org rule", rules: []RetentionRule{platform, repo, org}, org: "octocat", repo: "other", want: &org},
+		{name: "platform rule", rules: []RetentionRule{repo, org, platform}, org: "github", repo: "hello-world", want: &platform},
+		{name: "no platform rule", rules: []RetentionRule{repo, org}, org: "github", repo: "hello-world", want: nil},
+		{name: "no rules", rules: nil, org: "octocat", repo: "hello-world", want: nil},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := MatchRetentionRule(test.rules, test.org, test.repo)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("MatchRetentionRule is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// testRetentionRule is a test helper function to create a RetentionRule
+// type with all fields set to a fake value.
+func testRetentionRule() *RetentionRule {
+	rr := new(RetentionRule)
+
+	rr.SetOrg("octocat")
+	rr.SetRepo("hello-world")
+	rr.SetKeepBuilds(5)
+	rr.SetKeepDays(30)
+	rr.SetKeepTags(true)
+	rr.SetKeepDeployments(true)
+
+	return rr
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import "fmt"
+
+// RuleScope is the API representation of the
+// org and repo a platform rule applies to.
+//
+// A rule without an org applies to the whole platform, a rule
+// with only an org applies to every repo in the org and a rule
+// with both an org and a repo applies to that repo. The most
+// specific rule matching a repo is the one enforced.
+//
+// swagger:model RuleScope
+type RuleScope struct {
+	Org  *string `json:"org,omitempty"  yaml:"org,omitempty"`
+	Repo *string `json:"repo,omitempty" yaml:"repo,omitempty"`
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided RuleScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (rs *RuleScope) GetOrg() string {
+	if rs == nil || rs.Org == nil {
+		return ""
+	}
+
+	return *rs.Org
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided RuleScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (rs *RuleScope) GetRepo() string {
+	if rs == nil || rs.Repo == nil {
+		return ""
+	}
+
+	return *rs.Repo
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided RuleScope type is nil, it
+// will set nothing and immediately return.
+func (rs *RuleScope) SetOrg(v string) {
+	if rs == nil {
+		return
+	}
+
+	rs.Org = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided RuleScope type is nil, it
+// will set nothing and immediately return.
+func (rs *RuleScope) SetRepo(v string) {
+	if rs == nil {
+		return
+	}
+
+	rs.Repo = &v
+}
+
+// Validate verifies the fields of the RuleScope are populated correctly.
+func (rs *RuleScope) Validate() error {
+	if len(rs.GetRepo()) > 0 && len(rs.GetOrg()) == 0 {
+		return fmt.Errorf("rule for repo %s must provide an org", rs.GetRepo())
+	}
+
+	return nil
+}
+
+// scoped is the constraint for the rules matched by their scope.
+type scoped[T any] interface {
+	*T
+	GetOrg() string
+	GetRepo() string
+}
+
+// matchRule returns the rule with the most specific scope
+// applying to the provided repo, or nil when no rule applies.
+func matchRule[T any, P scoped[T]](rules []T, org, repo string) P {
+	var (
+		platform P
+		orgRule  P
+	)
+
+	for i := range rules {
+		rule := P(&rules[i])
+
+		switch {
+		case len(rule.GetOrg()) == 0:
+			if platform == nil {
+				platform = rule
+			}
+		case rule.GetOrg() != org:
+			continue
+		case len(rule.GetRepo()) == 0:
+			if orgRule == nil {
+				orgRule = rule
+			}
+		case rule.GetRepo() == repo:
+			return rule
+		}
+	}
+
+	if orgRule != nil {
+		return orgRule
+	}
+
+	return platform
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	"testing"
+)
+
+func TestTypes_RuleScope_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		scope *RuleScope
+		want  *RuleScope
+	}{
+		{
+			scope: testRuleScope(),
+			want:  testRuleScope(),
+		},
+		{
+			scope: new(RuleScope),
+			want:  new(RuleScope),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.scope.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.scope.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.scope.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.scope.GetRepo(), test.want.GetRepo())
+		}
+	}
+}
+
+func TestTypes_RuleScope_Setters(t *testing.T) {
+	// setup types
+	var rs *RuleScope
+
+	// setup tests
+	tests := []struct {
+		scope *RuleScope
+		want  *RuleScope
+	}{
+		{
+			scope: testRuleScope(),
+			want:  testRuleScope(),
+		},
+		{
+			scope: rs,
+			want:  new(RuleScope),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.scope.SetOrg(test.want.GetOrg())
+		test.scope.SetRepo(test.want.GetRepo())
+
+		if test.scope.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.scope.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.scope.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.scope.GetRepo(), test.want.GetRepo())
+		}
+	}
+}
+
+func TestTypes_RuleScope_Validate(t *testing.T) {
+	// setup types
+	org := new(RuleScope)
+	org.SetOrg("octocat")
+
+	repoOnly := new(RuleScope)
+	repoOnly.SetRepo("hello-world")
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		scope   *RuleScope
+	}{
+		{name: "repo", failure: false, scope: testRuleScope()},
+		{name: "org", failure: false, scope: org},
+		{name: "platform", failure: false, scope: new(RuleScope)},
+		{name: "repo without org", failure: true, scope: repoOnly},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.scope.Validate()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Validate should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Validate returned err: %v", err)
+			}
+		})
+	}
+}
+
+// testRuleScope is a test helper function to create a RuleScope
+// type with all fields set to a fake value.
+func testRuleScope() *RuleScope {
+	rs := new(RuleScope)
+
+	rs.SetOrg("octocat")
+	rs.SetRepo("hello-world")
+
+	return rs
+}
@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// WorkerPool is the API representation of a named set of
+// queue routes and worker labels shared by the builds of
+// many orgs.
+//
+// A build belongs to the pool when it is placed in one of the
+// routes of the pool, or in a route served by active workers
+// satisfying the labels of the pool. Each quota caps the builds
+// of an org running in the pool at once.
+//
+// swagger:model WorkerPool
+type WorkerPool struct {
+	Name   *string      `json:"name,omitempty"   yaml:"name,omitempty"`
+	Routes *[]string    `json:"routes,omitempty" yaml:"routes,omitempty"`
+	Labels *[]string    `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Quotas *[]PoolQuota `json:"quotas,omitempty" yaml:"quotas,omitempty"`
+}
+
+// PoolQuota is the API representation of the maximum
+// number of concurrent builds of an org in a worker pool.
+//
+// swagger:model PoolQuota
+type PoolQuota struct {
+	Org   *string `json:"org,omitempty"   yaml:"org,omitempty"`
+	Limit *int32  `json:"limit,omitempty" yaml:"limit,omitempty"`
+}
+
+// GetName returns the Name field.
+//
+// When the provided WorkerPool type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (wp *WorkerPool) GetName() string {
+	if wp == nil || wp.Name == nil {
+		return ""
+	}
+
+	return *wp.Name
+}
+
+// GetRoutes returns the Routes field.
+//
+// When the provided WorkerPool type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (wp *WorkerPool) GetRoutes() []string {
+	if wp == nil || wp.Routes == nil {
+		return []string{}
+	}
+
+	return *wp.Routes
+}
+
+// GetLabels returns the Labels field.
+//
+// When the provided WorkerPool type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (wp *WorkerPool) GetLabels() []string {
+	if wp == nil || wp.Labels == nil {
+		return []string{}
+	}
+
+	return *wp.Labels
+}
+
+// GetQuotas returns the Quotas field.
+//
+// When the provided WorkerPool type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (wp *WorkerPool) GetQuotas() []PoolQuota {
+	if wp == nil || wp.Quotas == nil {
+		return []PoolQuota{}
+	}
+
+	return *wp.Quotas
+}
+
+// SetName sets the Name field.
+//
+// When the provided WorkerPool type is nil, it
+// will set nothing and immediately return.
+func (wp *WorkerPool) SetName(v string) {
+	if wp == nil {
+		return
+	}
+
+	wp.Name = &v
+}
+
+// SetRoutes sets the Routes field.
+//
+// When the provided WorkerPool type is nil, it
+// will set nothing and immediately return.
+func (wp *WorkerPool) SetRoutes(v []string) {
+	if wp == nil {
+		return
+	}
+
+	wp.Routes = &v
+}
+
+// SetLabels sets the Labels field.
+//
+// When the provided WorkerPool type is nil, it
+// will set nothing and immediately return.
+func (wp *WorkerPool) SetLabels(v []string) {
+	if wp == nil {
+		return
+	}
+
+	wp.Labels = &v
+}
+
+// SetQuotas sets the Quotas field.
+//
+// When the provided WorkerPool type is nil, it
+// will set nothing and immediately return.
+func (wp *WorkerPool) SetQuotas(v []PoolQuota) {
+	if wp == nil {
+		return
+	}
+
+	wp.Quotas = &v
+}
+
+// QuotaForOrg returns the quota of the provided org
+// in the WorkerPool, or nil when the org has no quota.
+func (wp *WorkerPool) QuotaForOrg(org string) *PoolQuota {
+	quotas := wp.GetQuotas()
+
+	for i := range quotas {
+		if quotas[i].GetOrg() == org {
+			return &quotas[i]
+		}
+	}
+
+	return nil
+}
+
+// HasRoute determines if the provided queue route is one of the routes of the WorkerPool.
+func (wp *WorkerPool) HasRoute(route string) bool {
+	for _, r := range wp.GetRoutes() {
+		if strings.EqualFold(r, route) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HoldRoute returns the queue route holding the builds of the provided
+// org over its quota in the WorkerPool. Workers never poll the route,
+// so held builds wait until they are released.
+//
+// The pool and org are a hash tag so the route and the reserved
+// builds of the org are updated together in a Redis cluster.
+func (wp *WorkerPool) HoldRoute(org string) string {
+	return fmt.Sprintf("vela:pools:{%s:%s}:held", wp.GetName(), org)
+}
+
+// ReservedKey returns the queue key of the set of builds
+// holding a slot of the quota of the provided org in the WorkerPool.
+func (wp *WorkerPool) ReservedKey(org string) string {
+	return fmt.Sprintf("vela:pools:{%s:%s}:reserved", wp.GetName(), org)
+}
+
+// Validate verifies the fields of the WorkerPool are populated correctly.
+func (wp *WorkerPool) Validate() error {
+	if len(wp.GetName()) == 0 {
+		return errors.New("worker pool must provide a name")
+	}
+
+	// the name is part of the queue route holding builds over quota
+	if strings.ContainsAny(wp.GetName(), ": \t\n") {
+		return fmt.Errorf("worker pool name %s must not contain colons or whitespace", wp.GetName())
+	}
+
+	if len(wp.GetRoutes()) == 0 && len(wp.GetLabels()) == 0 {
+		return fmt.Errorf("worker pool %s must provide routes or labels", wp.GetName())
+	}
+
+	orgs := make(map[string]bool)
+
+	for _, quota := range wp.GetQuotas() {
+		if len(quota.GetOrg()) == 0 {
+			return fmt.Errorf("worker pool %s quota must provide an org", wp.GetName())
+		}
+
+		if orgs[quota.GetOrg()] {
+			return fmt.Errorf("worker pool %s has more than one quota for org %s", wp.GetName(), quota.GetOrg())
+		}
+
+		orgs[quota.GetOrg()] = true
+
+		if quota.GetLimit() <= 0 {
+			return fmt.Errorf("worker pool %s quota for org %s must have a limit greater than zero, got: %d", wp.GetName(), quota.GetOrg(), quota.GetLimit())
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface for the WorkerPool type.
+func (wp *WorkerPool) String() string {
+	return fmt.Sprintf(`{
+  Labels: %v,
+  Name: %s,
+  Quotas: %v,
+  Routes: %v,
+}`,
+		wp.GetLabels(),
+		wp.GetName(),
+		wp.GetQuotas(),
+		wp.GetRoutes(),
+	)
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided PoolQuota type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (pq *PoolQuota) GetOrg() string {
+	if pq == nil || pq.Org == nil {
+		return ""
+	}
+
+	return *pq.Org
+}
+
+// GetLimit returns the Limit field.
+//
+// When the provided PoolQuota type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (pq *PoolQuota) GetLimit() int32 {
+	if pq == nil || pq.Limit == nil {
+		return 0
+	}
+
+	return *pq.Limit
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided PoolQuota type is nil, it
+// will set nothing and immediately return.
+func (pq *PoolQuota) SetOrg(v string) {
+	if pq == nil {
+		return
+	}
+
+	pq.Org = &v
+}
+
+// SetLimit sets the Limit field.
+//
+// When the provided PoolQuota type is nil, it
+// will set nothing and immediately return.
+func (pq *PoolQuota) SetLimit(v int32) {
+	if pq == nil {
+		return
+	}
+
+	pq.Limit = &v
+}
@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package settings
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTypes_WorkerPool_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		pool *WorkerPool
+		want *WorkerPool
+	}{
+		{
+			pool: testWorkerPool(),
+			want: testWorkerPool(),
+		},
+		{
+			pool: new(WorkerPool),
+			want: new(WorkerPool),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.pool.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.pool.GetName(), test.want.GetName())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetRoutes(), test.want.GetRoutes()) {
+			t.Errorf("GetRoutes is %v, want %v", test.pool.GetRoutes(), test.want.GetRoutes())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("GetLabels is %v, want %v", test.pool.GetLabels(), test.want.GetLabels())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetQuotas(), test.want.GetQuotas()) {
+			t.Errorf("GetQuotas is %v, want %v", test.pool.GetQuotas(), test.want.GetQuotas())
+		}
+	}
+}
+
+func TestTypes_WorkerPool_Setters(t *testing.T) {
+	// setup types
+	var wp *WorkerPool
+
+	// setup tests
+	tests := []struct {
+		pool *WorkerPool
+		want *WorkerPool
+	}{
+		{
+			pool: testWorkerPool(),
+			want: testWorkerPool(),
+		},
+		{
+			pool: wp,
+			want: new(WorkerPool),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.pool.SetName(test.want.GetName())
+		test.pool.SetRoutes(test.want.GetRoutes())
+		test.pool.SetLabels(test.want.GetLabels())
+		test.pool.SetQuotas(test.want.GetQuotas())
+
+		if test.pool.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.pool.GetName(), test.want.GetName())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetRoutes(), test.want.GetRoutes()) {
+			t.Errorf("SetRoutes is %v, want %v", test.pool.GetRoutes(), test.want.GetRoutes())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("SetLabels is %v, want %v", test.pool.GetLabels(), test.want.GetLabels())
+		}
+
+		if !reflect.DeepEqual(test.pool.GetQuotas(), test.want.GetQuotas()) {
+			t.Errorf("SetQuotas is %v, want %v", test.pool.GetQuotas(), test.want.GetQuotas())
+		}
+	}
+}
+
+func TestTypes_WorkerPool_QuotaForOrg(t *testing.T) {
+	// setup types
+	wp := testWorkerPool()
+
+	// setup tests
+	tests := []struct {
+		org  string
+		want int32
+	}{
+		{org: "payments", want: 20},
+		{org: "octocat", want: 0},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := wp.QuotaForOrg(test.org)
+
+		if got.GetLimit() != test.want {
+			t.Errorf("QuotaForOrg for %s is %d, want %d", test.org, got.GetLimit(), test.want)
+		}
+	}
+}
+
+func TestTypes_WorkerPool_HasRoute(t *testing.T) {
+	// setup types
+	wp := new(WorkerPool)
+	wp.SetRoutes([]string{"secure"})
+
+	// setup tests
+	tests := []struct {
+		route string
+		want  bool
+	}{
+		{route: "secure", want: true},
+		{route: "Secure", want: true},
+		{route: "vela", want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := wp.HasRoute(test.route)
+
+		if got != test.want {
+			t.Errorf("HasRoute for %s is %v, want %v", test.route, got, test.want)
+		}
+	}
+}
+
+func TestTypes_WorkerPool_Keys(t *testing.T) {
+	// setup types
+	wp := testWorkerPool()
+
+	// run tests
+	if got := wp.HoldRoute("payments"); got != "vela:pools:{"+wp.GetName()+":payments}:held" {
+		t.Errorf("HoldRoute is %s, want %s", got, "vela:pools:{"+wp.GetName()+":payments}:held")
+	}
+
+	if got := wp.ReservedKey("payments"); got != "vela:pools:{"+wp.GetName()+":payments}:reserved" {
+		t.Errorf("ReservedKey is %s, want %s", got, "vela:pools:{"+wp.GetName()+":payments}:reserved")
+	}
+}
+
+func TestTypes_WorkerPool_Validate(t *testing.T) {
+	// setup types
+	noName := testWorkerPool()
+	noName.SetName("")
+
+	badName := testWorkerPool()
+	badName.SetName("secure:pool")
+
+	noRoutes := testWorkerPool()
+	noRoutes.SetRoutes(nil)
+	noRoutes.SetLabels(nil)
+
+	labelsOnly := testWorkerPool()
+	labelsOnly.SetRoutes(nil)
+
+	noOrg := testWorkerPool()
+	noOrg.SetQuotas([]PoolQuota{{Limit: new(int32(1))}})
+
+	duplicateOrg := testWorkerPool()
+	duplicateOrg.SetQuotas(append(duplicateOrg.GetQuotas(), duplicateOrg.GetQuotas()...))
+
+	noLimit := testWorkerPool()
+	noLimit.SetQuotas([]PoolQuota{{Org: new("payments")}})
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		pool    *WorkerPool
+	}{
+		{name: "valid", failure: false, pool: testWorkerPool()},
+		{name: "labels only", failure: false, pool: labelsOnly},
+		{name: "empty", failure: true, pool: new(WorkerPool)},
+		{name: "no name", failure: true, pool: noName},
+		{name: "name with colon", failure: true, pool: badName},
+		{name: "no routes or labels", failure: true, pool: noRoutes},
+		{name: "quota without org", failure: true, pool: noOrg},
+		{name: "duplicate org quota", failure: true, pool: duplicateOrg},
+		{name: "quota without limit", failure: true, pool: noLimit},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.pool.Validate()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Validate should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Validate returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestTypes_WorkerPool_String(t *testing.T) {
+	// setup types
+	wp := testWorkerPool()
+
+	want := fmt.Sprintf(`{
+  Labels: %v,
+  Name: %s,
+  Quotas: %v,
+  Routes: %v,
+}`,
+		wp.GetLabels(),
+		wp.GetName(),
+		wp.GetQuotas(),
+		wp.GetRoutes(),
+	)
+
+	// run test
+	got := wp.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testWorkerPool is a test helper function to create a WorkerPool
+// type with all fields set to a fake value.
+func testWorkerPool() *WorkerPool {
+	wp := new(WorkerPool)
+
+	quota := PoolQuota{}
+	quota.SetOrg("payments")
+	quota.SetLimit(20)
+
+	wp.SetName("secure")
+	wp.SetRoutes([]string{"secure"})
+	wp.SetLabels([]string{"gpu=true"})
+	wp.SetQuotas([]PoolQuota{quota})
+
+	return wp
+}
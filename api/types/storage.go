@@ -17,14 +17,20 @@ type Bucket struct {
 }
 
 type Object struct {
-	ObjectName string `json:"object_name,omitempty"`
-	Bucket     Bucket `json:"bucket"`
-	FilePath   string `json:"file_path,omitempty"`
+	ObjectName  string `json:"object_name,omitempty"`
+	Bucket      Bucket `json:"bucket"`
+	FilePath    string `json:"file_path,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
 }
 
 // PresignURL defines the structure for temporary presigned url.
 //
 // swagger:model PresignURL
+//
+// The headers must be sent with the request to the URL
+// since they are included in the signature.
 type PresignURL struct {
-	URL string `json:"url,omitempty"`
+	URL     string            `json:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
 }
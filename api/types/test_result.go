@@ -0,0 +1,412 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+)
+
+// TestResult is the API representation of the result of a
+// single test parsed from a test report uploaded for a build.
+//
+// swagger:model TestResult
+type TestResult struct {
+	ID         *int64  `json:"id,omitempty"`
+	BuildID    *int64  `json:"build_id,omitempty"`
+	RepoID     *int64  `json:"repo_id,omitempty"`
+	ArtifactID *int64  `json:"artifact_id,omitempty"`
+	Step       *string `json:"step,omitempty"`
+	Branch     *string `json:"branch,omitempty"`
+	Suite      *string `json:"suite,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	Status     *string `json:"status,omitempty"`
+	Message    *string `json:"message,omitempty"`
+	Duration   *int64  `json:"duration,omitempty"`
+	Flaky      *bool   `json:"flaky,omitempty"`
+	CreatedAt  *int64  `json:"created_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetID() int64 {
+	// return zero value if TestResult type or ID field is nil
+	if t == nil || t.ID == nil {
+		return 0
+	}
+
+	return *t.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetBuildID() int64 {
+	// return zero value if TestResult type or BuildID field is nil
+	if t == nil || t.BuildID == nil {
+		return 0
+	}
+
+	return *t.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetRepoID() int64 {
+	// return zero value if TestResult type or RepoID field is nil
+	if t == nil || t.RepoID == nil {
+		return 0
+	}
+
+	return *t.RepoID
+}
+
+// GetArtifactID returns the ArtifactID field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetArtifactID() int64 {
+	// return zero value if TestResult type or ArtifactID field is nil
+	if t == nil || t.ArtifactID == nil {
+		return 0
+	}
+
+	return *t.ArtifactID
+}
+
+// GetStep returns the Step field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetStep() string {
+	// return zero value if TestResult type or Step field is nil
+	if t == nil || t.Step == nil {
+		return ""
+	}
+
+	return *t.Step
+}
+
+// GetBranch returns the Branch field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetBranch() string {
+	// return zero value if TestResult type or Branch field is nil
+	if t == nil || t.Branch == nil {
+		return ""
+	}
+
+	return *t.Branch
+}
+
+// GetSuite returns the Suite field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetSuite() string {
+	// return zero value if TestResult type or Suite field is nil
+	if t == nil || t.Suite == nil {
+		return ""
+	}
+
+	return *t.Suite
+}
+
+// GetName returns the Name field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetName() string {
+	// return zero value if TestResult type or Name field is nil
+	if t == nil || t.Name == nil {
+		return ""
+	}
+
+	return *t.Name
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetStatus() string {
+	// return zero value if TestResult type or Status field is nil
+	if t == nil || t.Status == nil {
+		return ""
+	}
+
+	return *t.Status
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetMessage() string {
+	// return zero value if TestResult type or Message field is nil
+	if t == nil || t.Message == nil {
+		return ""
+	}
+
+	return *t.Message
+}
+
+// GetDuration returns the Duration field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetDuration() int64 {
+	// return zero value if TestResult type or Duration field is nil
+	if t == nil || t.Duration == nil {
+		return 0
+	}
+
+	return *t.Duration
+}
+
+// GetFlaky returns the Flaky field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetFlaky() bool {
+	// return zero value if TestResult type or Flaky field is nil
+	if t == nil || t.Flaky == nil {
+		return false
+	}
+
+	return *t.Flaky
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided TestResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TestResult) GetCreatedAt() int64 {
+	// return zero value if TestResult type or CreatedAt field is nil
+	if t == nil || t.CreatedAt == nil {
+		return 0
+	}
+
+	return *t.CreatedAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetID(v int64) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetBuildID(v int64) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetRepoID(v int64) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.RepoID = &v
+}
+
+// SetArtifactID sets the ArtifactID field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetArtifactID(v int64) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.ArtifactID = &v
+}
+
+// SetStep sets the Step field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetStep(v string) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Step = &v
+}
+
+// SetBranch sets the Branch field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetBranch(v string) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Branch = &v
+}
+
+// SetSuite sets the Suite field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetSuite(v string) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Suite = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetName(v string) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Name = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetStatus(v string) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Status = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetMessage(v string) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Message = &v
+}
+
+// SetDuration sets the Duration field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetDuration(v int64) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Duration = &v
+}
+
+// SetFlaky sets the Flaky field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetFlaky(v bool) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.Flaky = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided TestResult type is nil, it
+// will set nothing and immediately return.
+func (t *TestResult) SetCreatedAt(v int64) {
+	// return if TestResult type is nil
+	if t == nil {
+		return
+	}
+
+	t.CreatedAt = &v
+}
+
+// String implements the Stringer interface for the TestResult type.
+func (t *TestResult) String() string {
+	return fmt.Sprintf(`{
+  ArtifactID: %d,
+  Branch: %s,
+  BuildID: %d,
+  CreatedAt: %d,
+  Duration: %d,
+  Flaky: %t,
+  ID: %d,
+  Message: %s,
+  Name: %s,
+  RepoID: %d,
+  Status: %s,
+  Step: %s,
+  Suite: %s,
+}`,
+		t.GetArtifactID(),
+		t.GetBranch(),
+		t.GetBuildID(),
+		t.GetCreatedAt(),
+		t.GetDuration(),
+		t.GetFlaky(),
+		t.GetID(),
+		t.GetMessage(),
+		t.GetName(),
+		t.GetRepoID(),
+		t.GetStatus(),
+		t.GetStep(),
+		t.GetSuite(),
+	)
+}
+
+// TestReport is the API representation of the
+// test results parsed for a build.
+//
+// swagger:model TestReport
+type TestReport struct {
+	Total    int64         `json:"total"`
+	Passed   int64         `json:"passed"`
+	Failed   int64         `json:"failed"`
+	Skipped  int64         `json:"skipped"`
+	Flaky    int64         `json:"flaky"`
+	Duration int64         `json:"duration"`
+	Tests    []*TestResult `json:"tests"`
+}
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/constants"
+)
+
+func TestTypes_TestResult_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		result *TestResult
+		want   *TestResult
+	}{
+		{
+			result: testTestResult(),
+			want:   testTestResult(),
+		},
+		{
+			result: new(TestResult),
+			want:   new(TestResult),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.result.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.result.GetID(), test.want.GetID())
+		}
+
+		if test.result.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.result.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.result.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.result.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.result.GetArtifactID() != test.want.GetArtifactID() {
+			t.Errorf("GetArtifactID is %v, want %v", test.result.GetArtifactID(), test.want.GetArtifactID())
+		}
+
+		if test.result.GetStep() != test.want.GetStep() {
+			t.Errorf("GetStep is %v, want %v", test.result.GetStep(), test.want.GetStep())
+		}
+
+		if test.result.GetBranch() != test.want.GetBranch() {
+			t.Errorf("GetBranch is %v, want %v", test.result.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.result.GetSuite() != test.want.GetSuite() {
+			t.Errorf("GetSuite is %v, want %v", test.result.GetSuite(), test.want.GetSuite())
+		}
+
+		if test.result.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.result.GetName(), test.want.GetName())
+		}
+
+		if test.result.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.result.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.result.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.result.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.result.GetDuration() != test.want.GetDuration() {
+			t.Errorf("GetDuration is %v, want %v", test.result.GetDuration(), test.want.GetDuration())
+		}
+
+		if test.result.GetFlaky() != test.want.GetFlaky() {
+			t.Errorf("GetFlaky is %v, want %v", test.result.GetFlaky(), test.want.GetFlaky())
+		}
+
+		if test.result.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.result.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestTypes_TestResult_Setters(t *testing.T) {
+	// setup types
+	var tr *TestResult
+
+	// setup tests
+	tests := []struct {
+		result *TestResult
+		want   *TestResult
+	}{
+		{
+			result: testTestResult(),
+			want:   testTestResult(),
+		},
+		{
+			result: tr,
+			want:   new(TestResult),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.result.SetID(test.want.GetID())
+		test.result.SetBuildID(test.want.GetBuildID())
+		test.result.SetRepoID(test.want.GetRepoID())
+		test.result.SetArtifactID(test.want.GetArtifactID())
+		test.result.SetStep(test.want.GetStep())
+		test.result.SetBranch(test.want.GetBranch())
+		test.result.SetSuite(test.want.GetSuite())
+		test.result.SetName(test.want.GetName())
+		test.result.SetStatus(test.want.GetStatus())
+		test.result.SetMessage(test.want.GetMessage())
+		test.result.SetDuration(test.want.GetDuration())
+		test.result.SetFlaky(test.want.GetFlaky())
+		test.result.SetCreatedAt(test.want.GetCreatedAt())
+
+		if test.result.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.result.GetID(), test.want.GetID())
+		}
+
+		if test.result.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.result.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.result.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.result.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.result.GetArtifactID() != test.want.GetArtifactID() {
+			t.Errorf("SetArtifactID is %v, want %v", test.result.GetArtifactID(), test.want.GetArtifactID())
+		}
+
+		if test.result.GetStep() != test.want.GetStep() {
+			t.Errorf("SetStep is %v, want %v", test.result.GetStep(), test.want.GetStep())
+		}
+
+		if test.result.GetBranch() != test.want.GetBranch() {
+			t.Errorf("SetBranch is %v, want %v", test.result.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.result.GetSuite() != test.want.GetSuite() {
+			t.Errorf("SetSuite is %v, want %v", test.result.GetSuite(), test.want.GetSuite())
+		}
+
+		if test.result.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.result.GetName(), test.want.GetName())
+		}
+
+		if test.result.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.result.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.result.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.result.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.result.GetDuration() != test.want.GetDuration() {
+			t.Errorf("SetDuration is %v, want %v", test.result.GetDuration(), test.want.GetDuration())
+		}
+
+		if test.result.GetFlaky() != test.want.GetFlaky() {
+			t.Errorf("SetFlaky is %v, want %v", test.result.GetFlaky(), test.want.GetFlaky())
+		}
+
+		if test.result.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.result.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestTypes_TestResult_String(t *testing.T) {
+	// setup types
+	tr := testTestResult()
+
+	want := fmt.Sprintf(`{
+  ArtifactID: %d,
+  Branch: %s,
+  BuildID: %d,
+  CreatedAt: %d,
+  Duration: %d,
+  Flaky: %t,
+  ID: %d,
+  Message: %s,
+  Name: %s,
+  RepoID: %d,
+  Status: %s,
+  Step: %s,
+  Suite: %s,
+}`,
+		tr.GetArtifactID(),
+		tr.GetBranch(),
+		tr.GetBuildID(),
+		tr.GetCreatedAt(),
+		tr.GetDuration(),
+		tr.GetFlaky(),
+		tr.GetID(),
+		tr.GetMessage(),
+		tr.GetName(),
+		tr.GetRepoID(),
+		tr.GetStatus(),
+		tr.GetStep(),
+		tr.GetSuite(),
+	)
+
+	// run test
+	got := tr.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testTestResult is a test helper function to create a TestResult
+// type with all fields set to a fake value.
+func testTestResult() *TestResult {
+	tr := new(TestResult)
+
+	tr.SetID(1)
+	tr.SetBuildID(1)
+	tr.SetRepoID(1)
+	tr.SetArtifactID(1)
+	tr.SetStep("test")
+	tr.SetBranch("main")
+	tr.SetSuite("github.com/go-vela/server/api")
+	tr.SetName("TestAPI_Foo")
+	tr.SetStatus(constants.TestStatusFailed)
+	tr.SetMessage("expected 1, got 2")
+	tr.SetDuration(1500)
+	tr.SetFlaky(true)
+	tr.SetCreatedAt(time.Now().UTC().Unix())
+
+	return tr
+}
@@ -10,18 +10,21 @@ import (
 //
 // swagger:model Worker
 type Worker struct {
-	ID                  *int64    `json:"id,omitempty"`
-	Hostname            *string   `json:"hostname,omitempty"`
-	Address             *string   `json:"address,omitempty"`
-	Routes              *[]string `json:"routes,omitempty"`
-	Active              *bool     `json:"active,omitempty"`
-	Status              *string   `json:"status,omitempty"`
-	LastStatusUpdateAt  *int64    `json:"last_status_update_at,omitempty"`
-	RunningBuilds       *[]*Build `json:"running_builds,omitempty"`
-	LastBuildStartedAt  *int64    `json:"last_build_started_at,omitempty"`
-	LastBuildFinishedAt *int64    `json:"last_build_finished_at,omitempty"`
-	LastCheckedIn       *int64    `json:"last_checked_in,omitempty"`
-	BuildLimit          *int32    `json:"build_limit,omitempty"`
+	ID                  *int64             `json:"id,omitempty"`
+	Hostname            *string            `json:"hostname,omitempty"`
+	Address             *string            `json:"address,omitempty"`
+	Routes              *[]string          `json:"routes,omitempty"`
+	Labels              *map[string]string `json:"labels,omitempty"`
+	Active              *bool              `json:"active,omitempty"`
+	Draining            *bool              `json:"draining,omitempty"`
+	DrainStartedAt      *int64             `json:"drain_started_at,omitempty"`
+	Status              *string            `json:"status,omitempty"`
+	LastStatusUpdateAt  *int64             `json:"last_status_update_at,omitempty"`
+	RunningBuilds       *[]*Build          `json:"running_builds,omitempty"`
+	LastBuildStartedAt  *int64             `json:"last_build_started_at,omitempty"`
+	LastBuildFinishedAt *int64             `json:"last_build_finished_at,omitempty"`
+	LastCheckedIn       *int64             `json:"last_checked_in,omitempty"`
+	BuildLimit          *int32             `json:"build_limit,omitempty"`
 }
 
 // GetID returns the ID field.
@@ -76,6 +79,19 @@ func (w *Worker) GetRoutes() []string {
 	return *w.Routes
 }
 
+// GetLabels returns the Labels field.
+//
+// When the provided Worker type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *Worker) GetLabels() map[string]string {
+	// return zero value if Worker type or Labels field is nil
+	if w == nil || w.Labels == nil {
+		return map[string]string{}
+	}
+
+	return *w.Labels
+}
+
 // GetActive returns the Active field.
 //
 // When the provided Worker type is nil, or the field within
@@ -89,6 +105,32 @@ func (w *Worker) GetActive() bool {
 	return *w.Active
 }
 
+// GetDraining returns the Draining field.
+//
+// When the provided Worker type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *Worker) GetDraining() bool {
+	// return zero value if Worker type or Draining field is nil
+	if w == nil || w.Draining == nil {
+		return false
+	}
+
+	return *w.Draining
+}
+
+// GetDrainStartedAt returns the DrainStartedAt field.
+//
+// When the provided Worker type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *Worker) GetDrainStartedAt() int64 {
+	// return zero value if Worker type or DrainStartedAt field is nil
+	if w == nil || w.DrainStartedAt == nil {
+		return 0
+	}
+
+	return *w.DrainStartedAt
+}
+
 // GetStatus returns the Status field.
 //
 // When the provided Worker type is nil, or the field within
@@ -232,6 +274,19 @@ func (w *Worker) SetRoutes(v []string) {
 	w.Routes = &v
 }
 
+// SetLabels sets the Labels field.
+//
+// When the provided Worker type is nil, it
+// will set nothing and immediately return.
+func (w *Worker) SetLabels(v map[string]string) {
+	// return if Worker type is nil
+	if w == nil {
+		return
+	}
+
+	w.Labels = &v
+}
+
 // SetActive sets the Active field.
 //
 // When the provided Worker type is nil, it
@@ -245,6 +300,32 @@ func (w *Worker) SetActive(v bool) {
 	w.Active = &v
 }
 
+// SetDraining sets the Draining field.
+//
+// When the provided Worker type is nil, it
+// will set nothing and immediately return.
+func (w *Worker) SetDraining(v bool) {
+	// return if Worker type is nil
+	if w == nil {
+		return
+	}
+
+	w.Draining = &v
+}
+
+// SetDrainStartedAt sets the DrainStartedAt field.
+//
+// When the provided Worker type is nil, it
+// will set nothing and immediately return.
+func (w *Worker) SetDrainStartedAt(v int64) {
+	// return if Worker type is nil
+	if w == nil {
+		return
+	}
+
+	w.DrainStartedAt = &v
+}
+
 // SetStatus sets the Status field.
 //
 // When the provided Worker type is nil, it
@@ -343,7 +424,10 @@ func (w *Worker) String() string {
   Hostname: %s,
   Address: %s,
   Routes: %s,
+  Labels: %v,
   Active: %t,
+  Draining: %t,
+  DrainStartedAt: %v,
   Status: %s,
   LastStatusUpdateAt: %v,
   LastBuildStartedAt: %v,
@@ -356,7 +440,10 @@ func (w *Worker) String() string {
 		w.GetHostname(),
 		w.GetAddress(),
 		w.GetRoutes(),
+		w.GetLabels(),
 		w.GetActive(),
+		w.GetDraining(),
+		w.GetDrainStartedAt(),
 		w.GetStatus(),
 		w.GetLastStatusUpdateAt(),
 		w.GetLastBuildStartedAt(),
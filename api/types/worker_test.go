@@ -43,10 +43,22 @@ func TestTypes_Worker_Getters(t *testing.T) {
 			t.Errorf("GetRoutes is %v, want %v", test.worker.GetRoutes(), test.want.GetRoutes())
 		}
 
+		if !reflect.DeepEqual(test.worker.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("GetLabels is %v, want %v", test.worker.GetLabels(), test.want.GetLabels())
+		}
+
 		if test.worker.GetActive() != test.want.GetActive() {
 			t.Errorf("GetActive is %v, want %v", test.worker.GetActive(), test.want.GetActive())
 		}
 
+		if test.worker.GetDraining() != test.want.GetDraining() {
+			t.Errorf("GetDraining is %v, want %v", test.worker.GetDraining(), test.want.GetDraining())
+		}
+
+		if test.worker.GetDrainStartedAt() != test.want.GetDrainStartedAt() {
+			t.Errorf("GetDrainStartedAt is %v, want %v", test.worker.GetDrainStartedAt(), test.want.GetDrainStartedAt())
+		}
+
 		if test.worker.GetStatus() != test.want.GetStatus() {
 			t.Errorf("GetStatus is %v, want %v", test.worker.GetStatus(), test.want.GetStatus())
 		}
@@ -102,7 +114,10 @@ func TestTypes_Worker_Setters(t *testing.T) {
 		test.worker.SetHostname(test.want.GetHostname())
 		test.worker.SetAddress(test.want.GetAddress())
 		test.worker.SetRoutes(test.want.GetRoutes())
+		test.worker.SetLabels(test.want.GetLabels())
 		test.worker.SetActive(test.want.GetActive())
+		test.worker.SetDraining(test.want.GetDraining())
+		test.worker.SetDrainStartedAt(test.want.GetDrainStartedAt())
 		test.worker.SetStatus(test.want.GetStatus())
 		test.worker.SetLastStatusUpdateAt(test.want.GetLastStatusUpdateAt())
 		test.worker.SetRunningBuilds(test.want.GetRunningBuilds())
@@ -127,10 +142,22 @@ func TestTypes_Worker_Setters(t *testing.T) {
 			t.Errorf("SetRoutes is %v, want %v", test.worker.GetRoutes(), test.want.GetRoutes())
 		}
 
+		if !reflect.DeepEqual(test.worker.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("SetLabels is %v, want %v", test.worker.GetLabels(), test.want.GetLabels())
+		}
+
 		if test.worker.GetActive() != test.want.GetActive() {
 			t.Errorf("SetActive is %v, want %v", test.worker.GetActive(), test.want.GetActive())
 		}
 
+		if test.worker.GetDraining() != test.want.GetDraining() {
+			t.Errorf("SetDraining is %v, want %v", test.worker.GetDraining(), test.want.GetDraining())
+		}
+
+		if test.worker.GetDrainStartedAt() != test.want.GetDrainStartedAt() {
+			t.Errorf("SetDrainStartedAt is %v, want %v", test.worker.GetDrainStartedAt(), test.want.GetDrainStartedAt())
+		}
+
 		if test.worker.GetStatus() != test.want.GetStatus() {
 			t.Errorf("SetStatus is %v, want %v", test.worker.GetStatus(), test.want.GetStatus())
 		}
@@ -166,7 +193,10 @@ func TestTypes_Worker_String(t *testing.T) {
   Hostname: %s,
   Address: %s,
   Routes: %s,
+  Labels: %v,
   Active: %t,
+  Draining: %t,
+  DrainStartedAt: %v,
   Status: %s,
   LastStatusUpdateAt: %v,
   LastBuildStartedAt: %v,
@@ -179,7 +209,10 @@ func TestTypes_Worker_String(t *testing.T) {
 		w.GetHostname(),
 		w.GetAddress(),
 		w.GetRoutes(),
+		w.GetLabels(),
 		w.GetActive(),
+		w.GetDraining(),
+		w.GetDrainStartedAt(),
 		w.GetStatus(),
 		w.GetLastStatusUpdateAt(),
 		w.GetLastBuildStartedAt(),
@@ -209,7 +242,10 @@ func testWorker() *Worker {
 	w.SetHostname("worker_0")
 	w.SetAddress("http://localhost:8080")
 	w.SetRoutes([]string{"vela"})
+	w.SetLabels(map[string]string{"arch": "arm64", "gpu": "false"})
 	w.SetActive(true)
+	w.SetDraining(true)
+	w.SetDrainStartedAt(time.Time{}.UTC().Unix())
 	w.SetStatus("available")
 	w.SetLastStatusUpdateAt(time.Time{}.UTC().Unix())
 	w.SetRunningBuilds([]*Build{b})
@@ -82,6 +82,16 @@ func CreateWorker(c *gin.Context) {
 		return
 	}
 
+	// verify the capability labels of the worker are valid
+	err = validateLabels(input.GetLabels())
+	if err != nil {
+		retErr := fmt.Errorf("unable to add worker %s: %w", input.GetHostname(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	input.SetLastCheckedIn(time.Now().Unix())
 
 	l.Debugf("creating new worker %s", input.GetHostname())
@@ -10,6 +10,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/router/middleware/worker"
 	"github.com/go-vela/server/util"
 )
@@ -70,5 +71,13 @@ func DeleteWorker(c *gin.Context) {
 		return
 	}
 
+	// stop refusing items off the queue to a worker registering again with the hostname
+	if w.GetDraining() {
+		err = queue.FromContext(c).Drain(ctx, w.GetHostname(), false)
+		if err != nil {
+			l.Errorf("unable to clear draining for worker %s: %v", w.GetHostname(), err)
+		}
+	}
+
 	c.JSON(http.StatusOK, fmt.Sprintf("worker %s deleted", w.GetHostname()))
 }
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// validateLabels is a helper function to verify the capability
+// labels of a worker can be matched by a pipeline label selector.
+func validateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid worker label key %s: %s", key, strings.Join(errs, "; "))
+		}
+
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid worker label value %s for key %s: %s", value, key, strings.Join(errs, "; "))
+		}
+	}
+
+	return nil
+}
@@ -60,6 +60,9 @@ import (
 //       "$ref": "#/definitions/Error"
 
 // ListWorkers represents the API handler to get a list of workers.
+//
+// The running builds of every worker are included, which shows the
+// progress of draining workers along with when the drain started.
 func ListWorkers(c *gin.Context) {
 	// capture middleware values
 	l := c.MustGet("logger").(*logrus.Entry)
@@ -5,12 +5,16 @@ package worker
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 
 	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/claims"
 	"github.com/go-vela/server/router/middleware/worker"
 	"github.com/go-vela/server/util"
 )
@@ -49,6 +53,10 @@ import (
 //     description: Unauthorized
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Only platform admins can drain a worker
+//     schema:
+//       "$ref": "#/definitions/Error"
 //   '404':
 //     description: Not found
 //     schema:
@@ -60,9 +68,14 @@ import (
 
 // UpdateWorker represents the API handler to
 // update a worker.
+//
+// Draining a worker is restricted to platform admins. A draining worker
+// finishes its running builds but is refused new items off the queue,
+// and moves to maintenance once it has no running builds left.
 func UpdateWorker(c *gin.Context) {
 	// capture middleware values
 	l := c.MustGet("logger").(*logrus.Entry)
+	cl := claims.Retrieve(c)
 	w := worker.Retrieve(c)
 	ctx := c.Request.Context()
 
@@ -90,6 +103,21 @@ func UpdateWorker(c *gin.Context) {
 		w.SetRoutes(input.GetRoutes())
 	}
 
+	if input.Labels != nil {
+		// verify the capability labels of the worker are valid
+		err = validateLabels(input.GetLabels())
+		if err != nil {
+			retErr := fmt.Errorf("unable to update worker %s: %w", w.GetHostname(), err)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		// update labels if set
+		w.SetLabels(input.GetLabels())
+	}
+
 	if input.Active != nil {
 		// update active if set
 		w.SetActive(input.GetActive())
@@ -105,6 +133,49 @@ func UpdateWorker(c *gin.Context) {
 		w.SetStatus(input.GetStatus())
 	}
 
+	if input.Draining != nil && input.GetDraining() != w.GetDraining() {
+		// verify the draining is updated by a platform admin
+		if !cl.IsAdmin {
+			retErr := fmt.Errorf("unable to update worker %s: only platform admins can drain a worker", w.GetHostname())
+
+			util.HandleError(c, http.StatusForbidden, retErr)
+
+			return
+		}
+
+		// refuse or allow items off the queue for the worker
+		err = queue.FromContext(c).Drain(ctx, w.GetHostname(), input.GetDraining())
+		if err != nil {
+			retErr := fmt.Errorf("unable to set draining for worker %s: %w", w.GetHostname(), err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		// update draining if set
+		w.SetDraining(input.GetDraining())
+
+		if w.GetDraining() {
+			w.SetDrainStartedAt(time.Now().UTC().Unix())
+		} else {
+			w.SetDrainStartedAt(0)
+
+			// the worker reports its status again once it is no longer draining
+			if w.GetStatus() == constants.WorkerStatusMaintenance {
+				w.SetStatus(constants.WorkerStatusIdle)
+			}
+		}
+	}
+
+	// a draining worker moves to maintenance once its running builds finished
+	if w.GetDraining() && len(w.GetRunningBuilds()) == 0 && w.GetStatus() != constants.WorkerStatusMaintenance {
+		l.Infof("worker %s drained, moving to maintenance", w.GetHostname())
+
+		w.SetStatus(constants.WorkerStatusMaintenance)
+		w.SetLastStatusUpdateAt(time.Now().UTC().Unix())
+	}
+
 	if input.GetLastStatusUpdateAt() > 0 {
 		// update lastStatusUpdateAt if set
 		w.SetLastStatusUpdateAt(input.GetLastStatusUpdateAt())
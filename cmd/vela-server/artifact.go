@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/artifact"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/storage"
+)
+
+// sweepBatchSize is the number of expired artifacts removed at a time.
+const sweepBatchSize = 100
+
+// helper function to remove expired artifacts and artifacts outside of
+// the artifact retention rules from the storage and the database.
+func sweepArtifacts(ctx context.Context, db database.Interface, st storage.Storage) error {
+	logrus.Debug("sweeping expired artifacts")
+
+	now := time.Now().UTC().Unix()
+
+	for {
+		artifacts, err := db.ListExpiredArtifacts(ctx, now, sweepBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range artifacts {
+			err = removeArtifact(ctx, db, st, a)
+			if err != nil {
+				return err
+			}
+		}
+
+		logrus.Debugf("swept %d expired artifacts", len(artifacts))
+
+		if len(artifacts) < sweepBatchSize {
+			break
+		}
+	}
+
+	ps, err := db.GetSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	// enforce the artifact retention rules from the platform settings
+	report, err := artifact.PlanRetention(ctx, db, ps.GetArtifactRetention(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, a := range report.Artifacts {
+		err = removeArtifact(ctx, db, st, a)
+		if err != nil {
+			return err
+		}
+	}
+
+	logrus.Debugf("swept %d artifacts (%d bytes) outside of retention rules", report.Count, report.Bytes)
+
+	return nil
+}
+
+// helper function to remove an artifact from the storage and the database.
+func removeArtifact(ctx context.Context, db database.Interface, st storage.Storage, a *api.Artifact) error {
+	// remove the object before the record so a failure is retried on the next sweep
+	err := st.DeleteObject(ctx, &api.Object{
+		ObjectName: a.GetObjectPath(),
+		Bucket:     api.Bucket{BucketName: st.GetBucket()},
+	})
+	if err != nil {
+		return err
+	}
+
+	return db.DeleteArtifact(ctx, a)
+}
@@ -346,6 +346,37 @@ var Flags = []cli.Flag{
 		Sources: cli.EnvVars("VELA_COMPILER_STARLARK_EXEC_LIMIT", "COMPILER_STARLARK_EXEC_LIMIT"),
 		Value:   7500,
 	},
+	&cli.Int64Flag{
+		Name:    "compiler-jsonnet-exec-timeout",
+		Usage:   "set the jsonnet execution timeout, in seconds, for compiling jsonnet pipelines",
+		Sources: cli.EnvVars("VELA_COMPILER_JSONNET_EXEC_TIMEOUT", "COMPILER_JSONNET_EXEC_TIMEOUT"),
+		Value:   30,
+	},
+	&cli.StringSliceFlag{
+		Name:    "compiler-https-template-allowlist",
+		Usage:   "hosts permitted to serve https templates, used by compiler, supports glob patterns",
+		Sources: cli.EnvVars("VELA_COMPILER_HTTPS_TEMPLATE_ALLOWLIST", "COMPILER_HTTPS_TEMPLATE_ALLOWLIST"),
+	},
+	&cli.StringSliceFlag{
+		Name:    "compiler-oci-template-allowlist",
+		Usage:   "registries permitted to serve oci templates, used by compiler, supports glob patterns",
+		Sources: cli.EnvVars("VELA_COMPILER_OCI_TEMPLATE_ALLOWLIST", "COMPILER_OCI_TEMPLATE_ALLOWLIST"),
+	},
+	&cli.StringSliceFlag{
+		Name:    "compiler-worker-flavors",
+		Usage:   "worker flavors permitted to be requested by pipelines, used by compiler, permits any flavor when empty",
+		Sources: cli.EnvVars("VELA_COMPILER_WORKER_FLAVORS", "COMPILER_WORKER_FLAVORS"),
+	},
+	&cli.StringFlag{
+		Name:    "compiler-oci-username",
+		Usage:   "oci registry username, used by compiler, for pulling registry templates",
+		Sources: cli.EnvVars("VELA_COMPILER_OCI_USERNAME", "COMPILER_OCI_USERNAME"),
+	},
+	&cli.StringFlag{
+		Name:    "compiler-oci-password",
+		Usage:   "oci registry password, used by compiler, for pulling registry templates",
+		Sources: cli.EnvVars("VELA_COMPILER_OCI_PASSWORD", "COMPILER_OCI_PASSWORD"),
+	},
 	&cli.StringFlag{
 		Name:    "modification-addr",
 		Usage:   "modification address, used by compiler, endpoint to send pipeline for modification",
@@ -387,6 +418,24 @@ var Flags = []cli.Flag{
 		Sources: cli.EnvVars("VELA_WORKER_ACTIVE_INTERVAL", "WORKER_ACTIVE_INTERVAL"),
 		Value:   5 * time.Minute,
 	},
+	&cli.DurationFlag{
+		Name:    "worker-reap-threshold",
+		Usage:   "time since the last check in after which a worker is marked as errored and its running builds are failed or requeued",
+		Sources: cli.EnvVars("VELA_WORKER_REAP_THRESHOLD", "WORKER_REAP_THRESHOLD"),
+		Value:   15 * time.Minute,
+	},
+	&cli.DurationFlag{
+		Name:    "worker-reap-interval",
+		Usage:   "interval at which workers that stopped checking in are reaped by the server",
+		Sources: cli.EnvVars("VELA_WORKER_REAP_INTERVAL", "WORKER_REAP_INTERVAL"),
+		Value:   1 * time.Minute,
+	},
+	&cli.DurationFlag{
+		Name:    "worker-pool-release-interval",
+		Usage:   "interval at which builds held over the quota of their org in a worker pool are released to the queue",
+		Sources: cli.EnvVars("VELA_WORKER_POOL_RELEASE_INTERVAL", "WORKER_POOL_RELEASE_INTERVAL"),
+		Value:   30 * time.Second,
+	},
 	// schedule flags
 	&cli.DurationFlag{
 		Name:    "schedule-minimum-frequency",
@@ -406,4 +455,50 @@ var Flags = []cli.Flag{
 		Sources: cli.EnvVars("VELA_SCHEDULE_ALLOWLIST"),
 		Value:   []string{},
 	},
+	// artifact flags
+	&cli.DurationFlag{
+		Name:    "artifact-retention",
+		Usage:   "duration artifacts uploaded by builds are kept before expiring (0 keeps artifacts indefinitely)",
+		Sources: cli.EnvVars("VELA_ARTIFACT_RETENTION", "ARTIFACT_RETENTION"),
+		Value:   30 * 24 * time.Hour,
+	},
+	&cli.DurationFlag{
+		Name:    "artifact-sweep-interval",
+		Usage:   "interval at which expired artifacts will be removed from the storage by the server",
+		Sources: cli.EnvVars("VELA_ARTIFACT_SWEEP_INTERVAL", "ARTIFACT_SWEEP_INTERVAL"),
+		Value:   1 * time.Hour,
+	},
+	// log flags
+	&cli.BoolFlag{
+		Name:    "log-annotations",
+		Usage:   "parse the logs of finished steps for errors and warnings to annotate the steps",
+		Sources: cli.EnvVars("VELA_LOG_ANNOTATIONS", "LOG_ANNOTATIONS"),
+		Value:   true,
+	},
+	&cli.BoolFlag{
+		Name:    "log-annotations-scm",
+		Usage:   "send the annotations for finished steps to the scm as check runs (requires the scm app integration)",
+		Sources: cli.EnvVars("VELA_LOG_ANNOTATIONS_SCM", "LOG_ANNOTATIONS_SCM"),
+	},
+	&cli.BoolFlag{
+		Name:    "log-offload",
+		Usage:   "move the logs of finished builds from the database to the storage",
+		Sources: cli.EnvVars("VELA_LOG_OFFLOAD", "LOG_OFFLOAD"),
+	},
+	&cli.BoolFlag{
+		Name:    "log-search",
+		Usage:   "index the logs of finished builds in the database to search the logs for a repo or org",
+		Sources: cli.EnvVars("VELA_LOG_SEARCH", "LOG_SEARCH"),
+	},
+	&cli.DurationFlag{
+		Name:    "log-retention-interval",
+		Usage:   "interval at which logs outside of the log retention rules will be removed from the database by the server",
+		Sources: cli.EnvVars("VELA_LOG_RETENTION_INTERVAL", "LOG_RETENTION_INTERVAL"),
+		Value:   24 * time.Hour,
+	},
+	&cli.BoolFlag{
+		Name:    "log-retention-vacuum",
+		Usage:   "run VACUUM on the logs table after removing logs outside of the log retention rules",
+		Sources: cli.EnvVars("VELA_LOG_RETENTION_VACUUM", "LOG_RETENTION_VACUUM"),
+	},
 }
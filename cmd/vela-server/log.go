@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	apiLog "github.com/go-vela/server/api/log"
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/database"
+	dbLog "github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/storage"
+)
+
+// retentionBatchSize is the number of logs removed at a time by the log retention.
+const retentionBatchSize = 1000
+
+// helper function to remove the logs outside of the log retention
+// rules from the database and report what each run removed.
+func applyLogRetention(ctx context.Context, db database.Interface, st storage.Storage, withVacuum bool) error {
+	logrus.Debug("applying log retention rules")
+
+	ps, err := db.GetSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	// nothing is removed without log retention rules
+	rules := ps.GetLogRetention()
+	if len(rules) == 0 {
+		return nil
+	}
+
+	repos, err := db.ListRepos(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+
+	policies := []*dbLog.RetentionPolicy{}
+	names := make(map[int64]string)
+
+	for _, r := range repos {
+		rule := settings.MatchLogRetentionRule(rules, r.GetOrg(), r.GetName())
+		if rule == nil {
+			continue
+		}
+
+		policy := logRetentionPolicy(rule, r.GetID(), start)
+		if policy == nil {
+			continue
+		}
+
+		policies = append(policies, policy)
+		names[r.GetID()] = r.GetFullName()
+	}
+
+	// remove the objects logs were moved to along with the logs
+	result, err := db.ApplyLogRetention(ctx, policies, retentionBatchSize, withVacuum, db.Driver(), apiLog.RemoveObject(st))
+	if err != nil {
+		// another server or an admin cleanup is already removing logs
+		if errors.Is(err, dbLog.ErrCleanupInProgress) {
+			logrus.Debug("skipping log retention rules while another cleanup operation is in progress")
+
+			return nil
+		}
+
+		return err
+	}
+
+	// report the logs removed for each repo along with the totals of the run
+	for id, deleted := range result.DeletedByRepo {
+		logrus.WithFields(logrus.Fields{
+			"repo":          names[id],
+			"deleted_count": deleted,
+		}).Info("removed logs outside of log retention rules")
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"repos":               len(policies),
+		"deleted_count":       result.DeletedCount,
+		"affected_partitions": result.AffectedPartitions,
+		"vacuum_performed":    withVacuum && result.DeletedCount > 0,
+		"duration_seconds":    time.Since(start).Seconds(),
+	}).Info("log retention run completed")
+
+	return nil
+}
+
+// helper function to create the retention policy enforcing the log
+// retention rule for a repo, or nil when the rule keeps every log.
+func logRetentionPolicy(rule *settings.LogRetentionRule, repoID int64, now time.Time) *dbLog.RetentionPolicy {
+	policy := &dbLog.RetentionPolicy{
+		RepoID:          repoID,
+		KeepDeployments: rule.GetKeepDeployments(),
+	}
+
+	if rule.GetKeepDays() > 0 {
+		policy.Before = now.Add(-time.Duration(rule.GetKeepDays()) * 24 * time.Hour).Unix()
+	}
+
+	// the logs of failed builds are kept as long as other logs unless specified
+	policy.FailedBefore = policy.Before
+
+	if rule.GetKeepFailedDays() > 0 {
+		policy.FailedBefore = now.Add(-time.Duration(rule.GetKeepFailedDays()) * 24 * time.Hour).Unix()
+	}
+
+	if policy.Before == 0 && policy.FailedBefore == 0 {
+		return nil
+	}
+
+	return policy
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/build"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/cache"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+)
+
+// helper function to mark the workers that stopped checking in as errored
+// and to fail or requeue the builds left running on them.
+func reapWorkers(ctx context.Context, threshold time.Duration, settings *settings.Platform, compiler compiler.Engine, database database.Interface, cache cache.Service, metadata *internal.Metadata, queue queue.Service, scm scm.Service) error {
+	logrus.Debug("reaping workers that stopped checking in")
+
+	before := time.Now().Add(-threshold).Unix()
+
+	workers, err := database.ListWorkers(ctx, "all", before, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workers {
+		// skip workers already reaped
+		if w.GetStatus() == constants.WorkerStatusError && len(w.GetRunningBuilds()) == 0 {
+			continue
+		}
+
+		builds := w.GetRunningBuilds()
+
+		// mark the worker as errored before recovering its builds so the
+		// builds are only recovered by the server that reaped the worker
+		reaped, err := database.ReapWorker(ctx, w)
+		if err != nil {
+			return fmt.Errorf("unable to reap worker %s: %w", w.GetHostname(), err)
+		}
+
+		if !reaped {
+			logrus.Debugf("worker %s was updated since it was listed, skipping", w.GetHostname())
+
+			continue
+		}
+
+		logrus.Warnf("worker %s stopped checking in, reaping %d running builds", w.GetHostname(), len(builds))
+
+		for _, rB := range builds {
+			b, err := database.GetBuild(ctx, rB.GetID())
+			if err != nil {
+				logrus.WithError(err).Warnf("unable to get build %d left running on worker %s", rB.GetID(), w.GetHostname())
+
+				continue
+			}
+
+			err = recoverOrphanedBuild(ctx, w, b, settings, compiler, database, cache, metadata, queue, scm)
+			if err != nil {
+				logrus.WithError(err).Warnf("unable to recover build %d left running on worker %s", rB.GetID(), w.GetHostname())
+			}
+		}
+	}
+
+	return nil
+}
+
+// helper function to error out a build left running on a lost worker
+// and to requeue it if the orphan policy of the repo allows it.
+func recoverOrphanedBuild(ctx context.Context, w *api.Worker, b *api.Build, settings *settings.Platform, compiler compiler.Engine, database database.Interface, cache cache.Service, metadata *internal.Metadata, queue queue.Service, scm scm.Service) error {
+	// skip builds completed before the worker was lost
+	if b.GetStatus() != constants.StatusRunning && b.GetStatus() != constants.StatusPending {
+		return nil
+	}
+
+	lost := fmt.Sprintf("worker %s was lost after it last checked in at %s", w.GetHostname(), time.Unix(w.GetLastCheckedIn(), 0).UTC().Format(time.RFC3339))
+
+	msg := lost
+
+	if b.GetRepo().GetOrphanPolicy() == constants.OrphanPolicyRequeue {
+		msg = requeueOrphanedBuild(ctx, b, lost, settings, compiler, database, cache, metadata, queue, scm)
+	}
+
+	// the build may still have a build executable if it was never requested by the worker
+	_, err := database.PopBuildExecutable(ctx, b.GetID())
+	if err != nil {
+		logrus.Tracef("no build executable to remove for build %d: %v", b.GetID(), err)
+	}
+
+	b.SetStatus(constants.StatusError)
+	b.SetFinished(time.Now().UTC().Unix())
+	b.SetError(msg)
+
+	b, err = database.UpdateBuild(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	err = killOrphanedResources(ctx, database, b)
+	if err != nil {
+		return err
+	}
+
+	// report the finished build the same way the build API does
+	err = build.ReportStatus(ctx, database, scm, b)
+	if err != nil {
+		logrus.WithError(err).Warnf("unable to set commit status for build %d", b.GetID())
+	}
+
+	err = cache.EvictInstallStatusToken(ctx, b.GetID())
+	if err != nil {
+		logrus.WithError(err).Warnf("unable to evict installation token for build %d from cache", b.GetID())
+	}
+
+	err = cache.EvictBuildInstallTokens(ctx, b.GetID())
+	if err != nil {
+		logrus.WithError(err).Warnf("unable to evict installation tokens for build %d from cache", b.GetID())
+	}
+
+	// release the builds held over worker pool quotas now that a build finished
+	return build.ReleaseHeld(ctx, queue, database)
+}
+
+// helper function to requeue a build left running on a lost worker
+// returning the error message explaining what happened to the build.
+func requeueOrphanedBuild(ctx context.Context, b *api.Build, lost string, settings *settings.Platform, compiler compiler.Engine, database database.Interface, cache cache.Service, metadata *internal.Metadata, queue queue.Service, scm scm.Service) string {
+	// check to see if queue has reached configured capacity to allow requeues
+	if settings.GetQueueRestartLimit() > 0 {
+		queueLength, err := queue.RouteLength(ctx, b.GetRoute())
+		if err != nil {
+			return fmt.Sprintf("%s; unable to requeue build: unable to get queue length for %s: %v", lost, b.GetRoute(), err)
+		}
+
+		if queueLength >= int64(settings.GetQueueRestartLimit()) {
+			return fmt.Sprintf("%s; unable to requeue build: queue length %d exceeds configured limit %d", lost, queueLength, settings.GetQueueRestartLimit())
+		}
+	}
+
+	restart, err := build.Requeue(ctx, b, metadata, database, cache, scm, compiler, queue)
+	if err != nil {
+		return fmt.Sprintf("%s; unable to requeue build: %v", lost, err)
+	}
+
+	logrus.Infof("requeued build %s/%d left running on a lost worker as build %d", b.GetRepo().GetFullName(), b.GetNumber(), restart.GetNumber())
+
+	return fmt.Sprintf("%s; build requeued as build %d", lost, restart.GetNumber())
+}
+
+// helper function to kill the steps and services of a build
+// left running on a lost worker.
+func killOrphanedResources(ctx context.Context, database database.Interface, b *api.Build) error {
+	page := 1
+	perPage := 100
+
+	for page > 0 {
+		// retrieve build steps (per page) from the database
+		steps, err := database.ListStepsForBuild(ctx, b, map[string]any{}, page, perPage)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range steps {
+			// skip completed steps
+			if s.GetStatus() != constants.StatusRunning && s.GetStatus() != constants.StatusPending {
+				continue
+			}
+
+			s.SetStatus(constants.StatusKilled)
+			s.SetFinished(time.Now().UTC().Unix())
+
+			_, err = database.UpdateStep(ctx, s)
+			if err != nil {
+				return err
+			}
+		}
+
+		// assume no more pages exist if under 100 results are returned
+		if len(steps) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	page = 1
+
+	for page > 0 {
+		// retrieve build services (per page) from the database
+		services, err := database.ListServicesForBuild(ctx, b, map[string]any{}, page, perPage)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range services {
+			// skip completed services
+			if s.GetStatus() != constants.StatusRunning && s.GetStatus() != constants.StatusPending {
+				continue
+			}
+
+			s.SetStatus(constants.StatusKilled)
+			s.SetFinished(time.Now().UTC().Unix())
+
+			_, err = database.UpdateService(ctx, s)
+			if err != nil {
+				return err
+			}
+		}
+
+		// assume no more pages exist if under 100 results are returned
+		if len(services) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	return nil
+}
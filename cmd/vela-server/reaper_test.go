@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/api/types/settings"
+	cache "github.com/go-vela/server/cache/redis"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/queue/redis"
+	"github.com/go-vela/server/scm/github"
+)
+
+func TestMain_reapWorkers(t *testing.T) {
+	// setup types
+	ctx := context.Background()
+
+	owner := testutils.APIUser().Crop()
+	owner.SetID(1)
+	owner.SetName("octocat")
+	owner.SetToken("foo")
+
+	_fail := testutils.APIRepo()
+	_fail.SetID(1)
+	_fail.SetOwner(owner)
+	_fail.SetHash("baz")
+	_fail.SetOrg("foo")
+	_fail.SetName("fail")
+	_fail.SetFullName("foo/fail")
+	_fail.SetVisibility("public")
+	_fail.SetOrphanPolicy(constants.OrphanPolicyFail)
+
+	_requeue := testutils.APIRepo()
+	_requeue.SetID(2)
+	_requeue.SetOwner(owner)
+	_requeue.SetHash("baz")
+	_requeue.SetOrg("foo")
+	_requeue.SetName("requeue")
+	_requeue.SetFullName("foo/requeue")
+	_requeue.SetVisibility("public")
+	_requeue.SetOrphanPolicy(constants.OrphanPolicyRequeue)
+
+	_failBuild := testutils.APIBuild()
+	_failBuild.SetID(1)
+	_failBuild.SetRepo(_fail)
+	_failBuild.SetNumber(1)
+	_failBuild.SetStatus(constants.StatusRunning)
+	_failBuild.SetRoute("vela")
+	_failBuild.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+	_requeueBuild := testutils.APIBuild()
+	_requeueBuild.SetID(2)
+	_requeueBuild.SetRepo(_requeue)
+	_requeueBuild.SetNumber(1)
+	_requeueBuild.SetStatus(constants.StatusRunning)
+	_requeueBuild.SetRoute("vela")
+	_requeueBuild.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+	_step := testutils.APIStep()
+	_step.SetID(1)
+	_step.SetBuildID(1)
+	_step.SetRepoID(1)
+	_step.SetNumber(1)
+	_step.SetName("test")
+	_step.SetImage("alpine")
+	_step.SetStatus(constants.StatusRunning)
+
+	_lost := new(api.Worker)
+	_lost.SetHostname("worker_lost")
+	_lost.SetAddress("http://worker_lost:8080")
+	_lost.SetActive(true)
+	_lost.SetStatus(constants.WorkerStatusBusy)
+	_lost.SetLastCheckedIn(time.Now().Add(-time.Hour).Unix())
+	_lost.SetRunningBuilds([]*api.Build{_failBuild, _requeueBuild})
+
+	_alive := new(api.Worker)
+	_alive.SetHostname("worker_alive")
+	_alive.SetAddress("http://worker_alive:8080")
+	_alive.SetActive(true)
+	_alive.SetStatus(constants.WorkerStatusIdle)
+	_alive.SetLastCheckedIn(time.Now().Unix())
+
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	_, err = db.CreateUser(ctx, owner)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	for _, r := range []*api.Repo{_fail, _requeue} {
+		_, err = db.CreateRepo(ctx, r)
+		if err != nil {
+			t.Errorf("unable to create test repo: %v", err)
+		}
+	}
+
+	for _, b := range []*api.Build{_failBuild, _requeueBuild} {
+		_, err = db.CreateBuild(ctx, b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	_, err = db.CreateStep(ctx, _step)
+	if err != nil {
+		t.Errorf("unable to create test step: %v", err)
+	}
+
+	for _, w := range []*api.Worker{_lost, _alive} {
+		_, err = db.CreateWorker(ctx, w)
+		if err != nil {
+			t.Errorf("unable to create test worker: %v", err)
+		}
+	}
+
+	q, err := redis.NewTest("", "", "vela")
+	if err != nil {
+		t.Errorf("unable to create test queue: %v", err)
+	}
+
+	// the build queued on the route exceeds the restart
+	// limit, so the requeue policy falls back to failing
+	err = q.Redis.RPush(ctx, "vela", "{}").Err()
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	ps := new(settings.Platform)
+	ps.SetQueueRestartLimit(1)
+
+	c, err := cache.NewTest("c94bc43c11613ceb6c9f6ac73451e41de90806b2ca6953010b547b20fde9ad90")
+	if err != nil {
+		t.Errorf("unable to create test cache: %v", err)
+	}
+
+	// capture the commit statuses set for the recovered builds
+	gin.SetMode(gin.TestMode)
+
+	var (
+		mu       sync.Mutex
+		statuses []string
+	)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.POST("/api/v3/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		statuses = append(statuses, c.Param("repo"))
+
+		c.Status(http.StatusCreated)
+	})
+
+	srv := httptest.NewServer(engine)
+	defer srv.Close()
+
+	client, _ := github.NewTest(srv.URL)
+
+	// a worker listed before it was reaped is not reaped again
+	stale, err := db.GetWorkerForHostname(ctx, "worker_lost")
+	if err != nil {
+		t.Errorf("unable to get lost worker: %v", err)
+	}
+
+	// run test
+	err = reapWorkers(ctx, 15*time.Minute, ps, nil, db, c, nil, q, client)
+	if err != nil {
+		t.Errorf("reapWorkers returned err: %v", err)
+	}
+
+	reaped, err := db.ReapWorker(ctx, stale)
+	if err != nil {
+		t.Errorf("unable to reap stale worker: %v", err)
+	}
+
+	if reaped {
+		t.Errorf("stale worker was reaped again")
+	}
+
+	if len(statuses) != 2 {
+		t.Errorf("reapWorkers set %d commit statuses, want 2", len(statuses))
+	}
+
+	lost, err := db.GetWorkerForHostname(ctx, "worker_lost")
+	if err != nil {
+		t.Errorf("unable to get lost worker: %v", err)
+	}
+
+	if lost.GetStatus() != constants.WorkerStatusError {
+		t.Errorf("lost worker status is %s, want %s", lost.GetStatus(), constants.WorkerStatusError)
+	}
+
+	if len(lost.GetRunningBuilds()) != 0 {
+		t.Errorf("lost worker has %d running builds, want 0", len(lost.GetRunningBuilds()))
+	}
+
+	alive, err := db.GetWorkerForHostname(ctx, "worker_alive")
+	if err != nil {
+		t.Errorf("unable to get alive worker: %v", err)
+	}
+
+	if alive.GetStatus() != constants.WorkerStatusIdle {
+		t.Errorf("alive worker status is %s, want %s", alive.GetStatus(), constants.WorkerStatusIdle)
+	}
+
+	for _, test := range []struct {
+		id   int64
+		want string
+	}{
+		{id: 1, want: "worker worker_lost was lost"},
+		{id: 2, want: "queue length 1 exceeds configured limit 1"},
+	} {
+		b, err := db.GetBuild(ctx, test.id)
+		if err != nil {
+			t.Errorf("unable to get build %d: %v", test.id, err)
+		}
+
+		if b.GetStatus() != constants.StatusError {
+			t.Errorf("build %d status is %s, want %s", test.id, b.GetStatus(), constants.StatusError)
+		}
+
+		if !strings.Contains(b.GetError(), test.want) {
+			t.Errorf("build %d error is %s, want it to contain %s", test.id, b.GetError(), test.want)
+		}
+	}
+
+	s, err := db.GetStep(ctx, 1)
+	if err != nil {
+		t.Errorf("unable to get step: %v", err)
+	}
+
+	if s.GetStatus() != constants.StatusKilled {
+		t.Errorf("step status is %s, want %s", s.GetStatus(), constants.StatusKilled)
+	}
+}
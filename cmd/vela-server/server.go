@@ -20,6 +20,7 @@ import (
 	"gorm.io/gorm"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/go-vela/server/api/build"
 	"github.com/go-vela/server/api/types/settings"
 	"github.com/go-vela/server/cache"
 	"github.com/go-vela/server/compiler/native"
@@ -220,6 +221,11 @@ func server(ctx context.Context, cmd *cli.Command) error {
 		middleware.TracingClient(tc),
 		middleware.TracingInstrumentation(tc),
 		middleware.StorageEnable(cmd.Bool("storage.enable")),
+		middleware.ArtifactRetention(cmd.Duration("artifact-retention")),
+		middleware.LogAnnotations(cmd.Bool("log-annotations")),
+		middleware.LogAnnotationsSCM(cmd.Bool("log-annotations-scm")),
+		middleware.LogOffload(cmd.Bool("log-offload")),
+		middleware.LogSearch(cmd.Bool("log-search")),
 	)
 
 	addr, err := url.Parse(cmd.String("server-addr"))
@@ -329,6 +335,83 @@ func server(ctx context.Context, cmd *cli.Command) error {
 		}
 	})
 
+	// spawn go routine for removing expired artifacts from the storage
+	if st != nil {
+		g.Go(func() error {
+			interval := cmd.Duration("artifact-sweep-interval")
+
+			logrus.Infof("sweeping expired artifacts every %v", interval)
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				// pass in parent non-cancelable and timeout-less context
+				err := sweepArtifacts(ctx, database, st)
+				if err != nil {
+					logrus.WithError(err).Warn("unable to sweep expired artifacts")
+				}
+
+				<-ticker.C
+			}
+		})
+	}
+
+	// spawn go routine for removing logs outside of the log retention rules
+	g.Go(func() error {
+		interval := cmd.Duration("log-retention-interval")
+
+		logrus.Infof("applying log retention rules every %v", interval)
+
+		for {
+			// spread the log retention of multiple servers over the interval
+			time.Sleep(wait.Jitter(interval, 0.5))
+
+			// pass in parent non-cancelable and timeout-less context
+			err := applyLogRetention(ctx, database, st, cmd.Bool("log-retention-vacuum"))
+			if err != nil {
+				logrus.WithError(err).Warn("unable to apply log retention rules")
+			}
+		}
+	})
+
+	// spawn go routine for reaping workers that stopped checking in
+	g.Go(func() error {
+		interval := cmd.Duration("worker-reap-interval")
+		threshold := cmd.Duration("worker-reap-threshold")
+
+		logrus.Infof("reaping workers that stopped checking in for %v every %v", threshold, interval)
+
+		for {
+			// prevent multiple servers from reaping workers at the same time
+			time.Sleep(wait.Jitter(interval, 0.5))
+
+			// pass in parent non-cancelable and timeout-less context
+			err := reapWorkers(ctx, threshold, ps, compiler, database, cache, metadata, queue, scm)
+			if err != nil {
+				logrus.WithError(err).Warn("unable to reap workers")
+			}
+		}
+	})
+
+	// spawn go routine for releasing builds held over worker pool quotas
+	g.Go(func() error {
+		interval := cmd.Duration("worker-pool-release-interval")
+
+		logrus.Infof("releasing builds held over worker pool quotas every %v", interval)
+
+		for {
+			// spread the releases of multiple servers over the interval
+			time.Sleep(wait.Jitter(interval, 0.5))
+
+			// pass in parent non-cancelable and timeout-less context
+			err := build.ReleaseHeld(ctx, queue, database)
+			if err != nil {
+				logrus.WithError(err).Warn("unable to release builds held over worker pool quotas")
+			}
+		}
+	})
+
 	// spawn goroutine for starting the scheduler
 	g.Go(func() error {
 		logrus.Info("starting scheduler")
@@ -28,6 +28,13 @@ func setupStorage(_ context.Context, c *cli.Command) (storage.Storage, error) {
 		SecretKey: c.String("storage.secret.key"),
 		Bucket:    c.String("storage.bucket.name"),
 		Secure:    c.Bool("storage.use.ssl"),
+		Region:    c.String("storage.region"),
+		PathStyle: c.Bool("storage.path.style"),
+		KMSKeyID:  c.String("storage.kms.key.id"),
+
+		Root:       c.String("storage.filesystem.root"),
+		Address:    c.String("server-addr"),
+		SigningKey: c.String("storage.filesystem.signing.key"),
 	}
 	// setup the storage
 	//
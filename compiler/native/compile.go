@@ -82,6 +82,8 @@ func (c *Client) Compile(ctx context.Context, v any) (*pipeline.Build, *api.Pipe
 		Target:  c.build.GetDeploy(),
 		Label:   c.labels,
 		Status:  c.build.GetStatus(),
+		Message: c.build.GetMessage(),
+		Email:   c.build.GetEmail(),
 		Env:     make(raw.StringSliceMap),
 	}
 
@@ -208,6 +210,11 @@ func (c *Client) CompileLite(ctx context.Context, v any, ruleData *pipeline.Rule
 		}
 	}
 
+	// record the immutable references the templates resolved to
+	if len(c.resolvedTemplates) > 0 {
+		_pipeline.SetResolvedTemplates(c.resolvedTemplates)
+	}
+
 	// validate the yaml configuration
 	err = c.ValidateYAML(p)
 	if err != nil {
@@ -354,6 +361,11 @@ func (c *Client) compileSteps(ctx context.Context, p *yaml.Build, _pipeline *api
 
 	_pipeline.SetWarnings(warnings)
 
+	// record the immutable references the templates resolved to
+	if len(c.resolvedTemplates) > 0 {
+		_pipeline.SetResolvedTemplates(c.resolvedTemplates)
+	}
+
 	if c.ModificationService.Endpoint != "" {
 		// send config to external endpoint for modification
 		//
@@ -423,6 +435,12 @@ func (c *Client) compileSteps(ctx context.Context, p *yaml.Build, _pipeline *api
 		return nil, _pipeline, err
 	}
 
+	// check an active worker satisfies the requested worker labels
+	err = c.checkWorkerLabels(ctx, &build.Worker)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	// check image restrictions (blocked → error, warned → warning)
 	imageWarnings, err := c.checkImageRestrictions(build)
 	if err != nil {
@@ -433,6 +451,16 @@ func (c *Client) compileSteps(ctx context.Context, p *yaml.Build, _pipeline *api
 		_pipeline.SetWarnings(append(_pipeline.GetWarnings(), imageWarnings...))
 	}
 
+	// check policy rules (block → error, warn → warning)
+	policyWarnings, err := c.checkPolicies(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	if len(policyWarnings) > 0 {
+		_pipeline.SetWarnings(append(_pipeline.GetWarnings(), policyWarnings...))
+	}
+
 	return build, _pipeline, nil
 }
 
@@ -466,6 +494,11 @@ func (c *Client) compileStages(ctx context.Context, p *yaml.Build, _pipeline *ap
 
 	_pipeline.SetWarnings(warnings)
 
+	// record the immutable references the templates resolved to
+	if len(c.resolvedTemplates) > 0 {
+		_pipeline.SetResolvedTemplates(c.resolvedTemplates)
+	}
+
 	if c.ModificationService.Endpoint != "" {
 		// send config to external endpoint for modification
 		//
@@ -535,6 +568,12 @@ func (c *Client) compileStages(ctx context.Context, p *yaml.Build, _pipeline *ap
 		return nil, _pipeline, err
 	}
 
+	// check an active worker satisfies the requested worker labels
+	err = c.checkWorkerLabels(ctx, &build.Worker)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	// check image restrictions (blocked → error, warned → warning)
 	imageWarnings, err := c.checkImageRestrictions(build)
 	if err != nil {
@@ -545,6 +584,16 @@ func (c *Client) compileStages(ctx context.Context, p *yaml.Build, _pipeline *ap
 		_pipeline.SetWarnings(append(_pipeline.GetWarnings(), imageWarnings...))
 	}
 
+	// check policy rules (block → error, warn → warning)
+	policyWarnings, err := c.checkPolicies(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	if len(policyWarnings) > 0 {
+		_pipeline.SetWarnings(append(_pipeline.GetWarnings(), policyWarnings...))
+	}
+
 	return build, _pipeline, nil
 }
 
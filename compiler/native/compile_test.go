@@ -629,6 +629,8 @@ func TestNative_Compile_StagesPipelineTemplate(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -846,6 +848,8 @@ func TestNative_Compile_StagesPipelineTemplate(t *testing.T) {
 		t.Errorf("Creating compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithMetadata(m)
 
 	got, _, err := compiler.Compile(context.Background(), yaml)
@@ -887,6 +891,8 @@ func TestNative_Compile_StepsPipelineTemplate(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1070,6 +1076,8 @@ func TestNative_Compile_StepsPipelineTemplate(t *testing.T) {
 		t.Errorf("Creating compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithMetadata(m)
 
 	got, _, err := compiler.Compile(context.Background(), yaml)
@@ -1100,6 +1108,8 @@ func TestNative_Compile_StepsPipelineTemplate_VelaFunction_TemplateName(t *testi
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1179,6 +1189,8 @@ func TestNative_Compile_StepsPipelineTemplate_VelaFunction_TemplateName(t *testi
 		t.Errorf("Creating compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithMetadata(m)
 
 	got, _, err := compiler.Compile(context.Background(), yaml)
@@ -1209,6 +1221,8 @@ func TestNative_Compile_StepsPipelineTemplate_VelaFunction_TemplateName_Inline(t
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1288,6 +1302,8 @@ func TestNative_Compile_StepsPipelineTemplate_VelaFunction_TemplateName_Inline(t
 		t.Errorf("Creating compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithMetadata(m)
 
 	got, _, err := compiler.Compile(context.Background(), yaml)
@@ -1317,6 +1333,8 @@ func TestNative_Compile_InvalidType(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1360,6 +1378,8 @@ func TestNative_Compile_InvalidType(t *testing.T) {
 		t.Errorf("Creating compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithMetadata(m)
 
 	_, _, err = compiler.Compile(context.Background(), invalidYaml)
@@ -2336,6 +2356,8 @@ func Test_client_modifyConfig(t *testing.T) {
 		c.JSON(http.StatusForbidden, response)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -2431,6 +2453,18 @@ func convertFileToGithubResponse(file string) (github.RepositoryContent, error)
 	return content, nil
 }
 
+// mockResolveRoutes is a helper function to register the routes used to
+// resolve template references and capture the lock file on the mock server.
+func mockResolveRoutes(engine *gin.Engine) {
+	engine.GET("/api/v3/repos/:org/:repo/commits/:ref", func(c *gin.Context) {
+		c.String(http.StatusOK, "48afb5bdc41ad69bf22588491333f7cf71135163")
+	})
+
+	engine.GET("/api/v3/repos/:org/:repo/contents/.vela.lock", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+}
+
 func generateTestEnv(m *internal.Metadata, pipelineType string) map[string]string {
 	output := environment(nil, m, nil, nil)
 	output["VELA_REPO_PIPELINE_TYPE"] = pipelineType
@@ -2455,6 +2489,8 @@ func Test_Compile_Inline(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -3206,6 +3242,8 @@ func Test_Compile_Inline(t *testing.T) {
 				t.Errorf("Creating compiler returned err: %v", err)
 			}
 
+			compiler.WithSCM(testSCM(t, s.URL))
+
 			compiler.WithMetadata(m)
 
 			if tt.args.pipelineType != "" {
@@ -3257,6 +3295,8 @@ func Test_CompileLite(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -4314,6 +4354,8 @@ func Test_CompileLite(t *testing.T) {
 				t.Errorf("Creating compiler returned err: %v", err)
 			}
 
+			compiler.WithSCM(testSCM(t, s.URL))
+
 			compiler.WithMetadata(m)
 
 			if tt.args.pipelineType != "" {
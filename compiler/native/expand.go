@@ -6,11 +6,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/compiler/registry"
+	"github.com/go-vela/server/compiler/template/jsonnet"
 	"github.com/go-vela/server/compiler/template/native"
 	"github.com/go-vela/server/compiler/template/starlark"
 	"github.com/go-vela/server/compiler/types/pipeline"
@@ -143,7 +146,7 @@ func (c *Client) ExpandSteps(ctx context.Context, s *yaml.Build, tmpls map[strin
 		// inject template name into variables
 		step.Template.Variables["VELA_TEMPLATE_NAME"] = step.Template.Name
 
-		tmplBuild, tmplWarnings, err := c.mergeTemplate(bytes, tmpl, step)
+		tmplBuild, tmplWarnings, err := c.mergeTemplate(ctx, bytes, tmpl, step)
 		if err != nil {
 			return s, warnings, err
 		}
@@ -262,7 +265,7 @@ func (c *Client) ExpandDeployment(ctx context.Context, b *yaml.Build, tmpls map[
 		b.Deployment.Template.Variables = make(map[string]any)
 	}
 
-	tmplBuild, _, err := c.mergeDeployTemplate(bytes, tmpl, &b.Deployment)
+	tmplBuild, _, err := c.mergeDeployTemplate(ctx, bytes, tmpl, &b.Deployment)
 	if err != nil {
 		return b, err
 	}
@@ -278,8 +281,7 @@ func (c *Client) getTemplate(ctx context.Context, tmpl *yaml.Template, name stri
 		err   error
 	)
 
-	switch {
-	case c.local:
+	if c.local {
 		a := &afero.Afero{
 			Fs: afero.NewOsFs(),
 		}
@@ -313,12 +315,14 @@ func (c *Client) getTemplate(ctx context.Context, tmpl *yaml.Template, name stri
 		}
 
 		// local exec may still request remote templates
-		if !strings.EqualFold(tmpl.Type, "github") {
+		if !strings.EqualFold(tmpl.Type, "github") &&
+			!strings.EqualFold(tmpl.Type, "https") &&
+			!strings.EqualFold(tmpl.Type, "oci") {
 			return nil, fmt.Errorf("unable to find template %s: not supplied in list %s", tmpl.Name, c.localTemplates)
 		}
+	}
 
-		fallthrough
-
+	switch {
 	case strings.EqualFold(tmpl.Type, "github"):
 		// parse source from template
 		src, err := c.Github.Parse(tmpl.Source)
@@ -341,7 +345,7 @@ func (c *Client) getTemplate(ctx context.Context, tmpl *yaml.Template, name stri
 			}
 
 			// use private (authenticated) github instance to pull from
-			bytes, err = c.PrivateGithub.Template(ctx, c.repo, c.user, src, c.token)
+			bytes, err = c.fetchTemplate(ctx, c.PrivateGithub, tmpl, src, c.user, c.token)
 			if err != nil {
 				return bytes, err
 			}
@@ -353,7 +357,7 @@ func (c *Client) getTemplate(ctx context.Context, tmpl *yaml.Template, name stri
 				"host": src.Host,
 			}).Tracef("Using GitHub client to pull template")
 
-			bytes, err = c.Github.Template(ctx, c.repo, nil, src, "")
+			bytes, err = c.fetchTemplate(ctx, c.Github, tmpl, src, nil, "")
 			if err != nil {
 				return bytes, err
 			}
@@ -374,7 +378,7 @@ func (c *Client) getTemplate(ctx context.Context, tmpl *yaml.Template, name stri
 				"path": src.Name,
 			}).Tracef("Using GitHub client to pull template")
 
-			bytes, err = c.Github.Template(ctx, c.repo, nil, src, "")
+			bytes, err = c.fetchTemplate(ctx, c.Github, tmpl, src, nil, "")
 			if err != nil {
 				return bytes, err
 			}
@@ -390,12 +394,24 @@ func (c *Client) getTemplate(ctx context.Context, tmpl *yaml.Template, name stri
 			}
 
 			// use private (authenticated) github instance to pull from
-			bytes, err = c.PrivateGithub.Template(ctx, c.repo, c.user, src, c.token)
+			bytes, err = c.fetchTemplate(ctx, c.PrivateGithub, tmpl, src, c.user, c.token)
 			if err != nil {
 				return bytes, err
 			}
 		}
 
+	case strings.EqualFold(tmpl.Type, "https"):
+		bytes, err = c.getRemoteTemplate(ctx, c.HTTPS, tmpl, name, c.GetHTTPSTemplateAllowlist())
+		if err != nil {
+			return bytes, err
+		}
+
+	case strings.EqualFold(tmpl.Type, "oci"):
+		bytes, err = c.getRemoteTemplate(ctx, c.OCI, tmpl, name, c.GetOCITemplateAllowlist())
+		if err != nil {
+			return bytes, err
+		}
+
 	default:
 		return bytes, fmt.Errorf("unsupported template type: %v", tmpl.Type)
 	}
@@ -405,29 +421,88 @@ func (c *Client) getTemplate(ctx context.Context, tmpl *yaml.Template, name stri
 	return bytes, nil
 }
 
+// getRemoteTemplate is a helper function to capture a template from a
+// registry that is only permitted for hosts within the provided allowlist.
+func (c *Client) getRemoteTemplate(ctx context.Context, svc registry.Service, tmpl *yaml.Template, name string, allowlist []string) ([]byte, error) {
+	if svc == nil {
+		return nil, fmt.Errorf("unable to fetch template %s: %s templates are not configured", name, tmpl.Type)
+	}
+
+	// parse source from template
+	src, err := svc.Parse(tmpl.Source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template source provided for %s: %w", name, err)
+	}
+
+	if !registry.AllowedHost(src.Host, allowlist) {
+		return nil, fmt.Errorf("unable to fetch template %s: host %s is not permitted for %s templates", name, src.Host, tmpl.Type)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"host": src.Host,
+		"repo": src.Repo,
+		"path": src.Name,
+		"ref":  src.Ref,
+	}).Tracef("Using %s client to pull template", tmpl.Type)
+
+	// restrict every host contacted while fetching the template to the allowlist
+	ctx = registry.WithAllowlist(ctx, allowlist)
+
+	return c.fetchTemplate(ctx, svc, tmpl, src, c.user, "")
+}
+
+// fetchTemplate is a helper function to resolve the template source to an
+// immutable reference before capturing the template from the registry.
+//
 //nolint:lll // ignore long line length due to input arguments
-func (c *Client) mergeTemplate(bytes []byte, tmpl *yaml.Template, step *yaml.Step) (*yaml.Build, []string, error) {
+func (c *Client) fetchTemplate(ctx context.Context, svc registry.Service, tmpl *yaml.Template, src *registry.Source, u *api.User, token string) ([]byte, error) {
+	ref, err := svc.Resolve(ctx, c.repo, u, src, token)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.pinTemplate(ctx, tmpl, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	// capture the template at the resolved reference so
+	// the content always matches the recorded reference
+	src.Ref = ref
+
+	return svc.Template(ctx, c.repo, u, src, token)
+}
+
+//nolint:lll // ignore long line length due to input arguments
+func (c *Client) mergeTemplate(ctx context.Context, bytes []byte, tmpl *yaml.Template, step *yaml.Step) (*yaml.Build, []string, error) {
 	switch tmpl.Format {
 	case constants.PipelineTypeGo, constants.PipelineTypeGoAlt, "":
 		//nolint:lll // ignore long line length due to return
 		return native.Render(string(bytes), step.Name, step.Template.Name, step.Environment, step.Template.Variables)
 	case constants.PipelineTypeStarlark:
 		//nolint:lll // ignore long line length due to return
-		return starlark.Render(string(bytes), step.Name, step.Template.Name, step.Environment, step.Template.Variables, c.GetStarlarkExecLimit())
+		return starlark.Render(string(bytes), step.Name, step.Template.Name, step.Environment, step.Template.Variables, c.GetStarlarkExecLimit(), c.templateLoader(ctx, tmpl))
+	case constants.PipelineTypeJsonnet:
+		//nolint:lll // ignore long line length due to return
+		return jsonnet.Render(string(bytes), step.Name, step.Template.Name, step.Environment, step.Template.Variables, time.Duration(c.GetJsonnetExecTimeout())*time.Second, c.templateLoader(ctx, tmpl))
 	default:
 		//nolint:lll // ignore long line length due to return
 		return &yaml.Build{}, nil, fmt.Errorf("format of %s is unsupported", tmpl.Format)
 	}
 }
 
-func (c *Client) mergeDeployTemplate(bytes []byte, tmpl *yaml.Template, d *yaml.Deployment) (*yaml.Build, []string, error) {
+//nolint:lll // ignore long line length due to input arguments
+func (c *Client) mergeDeployTemplate(ctx context.Context, bytes []byte, tmpl *yaml.Template, d *yaml.Deployment) (*yaml.Build, []string, error) {
 	switch tmpl.Format {
 	case constants.PipelineTypeGo, constants.PipelineTypeGoAlt, "":
 		//nolint:lll // ignore long line length due to return
 		return native.Render(string(bytes), "", d.Template.Name, make(raw.StringSliceMap), d.Template.Variables)
 	case constants.PipelineTypeStarlark:
 		//nolint:lll // ignore long line length due to return
-		return starlark.Render(string(bytes), "", d.Template.Name, make(raw.StringSliceMap), d.Template.Variables, c.GetStarlarkExecLimit())
+		return starlark.Render(string(bytes), "", d.Template.Name, make(raw.StringSliceMap), d.Template.Variables, c.GetStarlarkExecLimit(), c.templateLoader(ctx, tmpl))
+	case constants.PipelineTypeJsonnet:
+		//nolint:lll // ignore long line length due to return
+		return jsonnet.Render(string(bytes), "", d.Template.Name, make(raw.StringSliceMap), d.Template.Variables, time.Duration(c.GetJsonnetExecTimeout())*time.Second, c.templateLoader(ctx, tmpl))
 	default:
 		//nolint:lll // ignore long line length due to return
 		return &yaml.Build{}, nil, fmt.Errorf("format of %s is unsupported", tmpl.Format)
@@ -6,6 +6,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry/https"
 	"github.com/go-vela/server/compiler/types/pipeline"
 	"github.com/go-vela/server/compiler/types/raw"
 	"github.com/go-vela/server/compiler/types/yaml"
@@ -35,6 +37,8 @@ func TestNative_ExpandStages(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -142,6 +146,8 @@ func TestNative_ExpandStages(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.PrivateGithub = nil
 
 	_, _, err = compiler.ExpandStages(
@@ -165,6 +171,8 @@ func TestNative_ExpandStages(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	build, _, err := compiler.ExpandStages(
 		context.Background(),
 		&yaml.Build{
@@ -214,6 +222,8 @@ func TestNative_ExpandSteps(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -341,6 +351,8 @@ func TestNative_ExpandSteps(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithCommit("123abc456def").WithRepo(testRepo)
 
 	for _, test := range tests {
@@ -393,6 +405,8 @@ func TestNative_ExpandStepsWarnings(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -446,6 +460,8 @@ func TestNative_ExpandStepsWarnings(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithCommit("123abc456def").WithRepo(testRepo)
 
 	for _, test := range tests {
@@ -486,6 +502,8 @@ func TestNative_ExpandDeployment(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -546,6 +564,8 @@ func TestNative_ExpandDeployment(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithCommit("123abc456def").WithRepo(testRepo)
 
 	for _, test := range tests {
@@ -584,6 +604,8 @@ func TestNative_ExpandStepsMulti(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -792,6 +814,8 @@ func TestNative_ExpandStepsMulti(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	ruledata := new(pipeline.RuleData)
 	ruledata.Branch = "main"
 
@@ -840,6 +864,8 @@ func TestNative_ExpandStepsStarlark(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -885,6 +911,103 @@ func TestNative_ExpandStepsStarlark(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
+	build, _, err := compiler.ExpandSteps(context.Background(),
+		&yaml.Build{
+			Steps:       steps,
+			Secrets:     yaml.SecretSlice{},
+			Services:    yaml.ServiceSlice{},
+			Environment: raw.StringSliceMap{},
+		},
+		tmpls, new(pipeline.RuleData), nil, compiler.GetTemplateDepth())
+	if err != nil {
+		t.Errorf("ExpandSteps returned err: %v", err)
+	}
+
+	if diff := cmp.Diff(build.Steps, wantSteps); diff != "" {
+		t.Errorf("ExpandSteps() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(build.Secrets, wantSecrets); diff != "" {
+		t.Errorf("ExpandSteps() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(build.Services, wantServices); diff != "" {
+		t.Errorf("ExpandSteps() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(build.Environment, wantEnvironment); diff != "" {
+		t.Errorf("ExpandSteps() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNative_ExpandStepsJsonnet(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/contents/:path", func(c *gin.Context) {
+		body, err := convertFileToGithubResponse(c.Param("path"))
+		if err != nil {
+			t.Error(err)
+		}
+
+		c.JSON(http.StatusOK, body)
+	})
+
+	mockResolveRoutes(engine)
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	tmpls := map[string]*yaml.Template{
+		"go": {
+			Name:   "go",
+			Source: "github.example.com/foo/bar/template.jsonnet",
+			Format: "jsonnet",
+			Type:   "github",
+		},
+	}
+
+	steps := yaml.StepSlice{
+		&yaml.Step{
+			Name: "sample",
+			Template: yaml.StepTemplate{
+				Name:      "go",
+				Variables: map[string]any{},
+			},
+		},
+	}
+
+	wantSteps := yaml.StepSlice{
+		&yaml.Step{
+			Commands: []string{"go build", "go test"},
+			Image:    "golang:latest",
+			Name:     "sample_build",
+			Pull:     "not_present",
+		},
+	}
+
+	wantSecrets := yaml.SecretSlice{}
+	wantServices := yaml.ServiceSlice{}
+	wantEnvironment := raw.StringSliceMap{
+		"star": "test3",
+		"bar":  "test4",
+	}
+
+	// run test
+	compiler, err := FromCLICommand(context.Background(), testCommand(t, s.URL))
+	if err != nil {
+		t.Errorf("Creating new compiler returned err: %v", err)
+	}
+
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	build, _, err := compiler.ExpandSteps(context.Background(),
 		&yaml.Build{
 			Steps:       steps,
@@ -931,6 +1054,8 @@ func TestNative_ExpandSteps_TemplateCallTemplate(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1061,6 +1186,8 @@ func TestNative_ExpandSteps_TemplateCallTemplate(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithBuild(testBuild).WithRepo(testRepo)
 
 	for _, test := range tests {
@@ -1131,6 +1258,8 @@ func TestNative_ExpandStepsDuplicateCalls(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1288,6 +1417,8 @@ func TestNative_ExpandStepsDuplicateCalls(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithCommit("123abc456def").WithRepo(testRepo)
 
 	for _, test := range tests {
@@ -1340,6 +1471,8 @@ func TestNative_ExpandSteps_TemplateCallTemplate_CircularFail(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1391,6 +1524,8 @@ func TestNative_ExpandSteps_TemplateCallTemplate_CircularFail(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithBuild(testBuild).WithRepo(testRepo)
 
 	for _, test := range tests {
@@ -1424,6 +1559,8 @@ func TestNative_ExpandSteps_CallTemplateWithRenderInline(t *testing.T) {
 		c.JSON(http.StatusOK, body)
 	})
 
+	mockResolveRoutes(engine)
+
 	s := httptest.NewServer(engine)
 	defer s.Close()
 
@@ -1475,6 +1612,8 @@ func TestNative_ExpandSteps_CallTemplateWithRenderInline(t *testing.T) {
 		t.Errorf("Creating new compiler returned err: %v", err)
 	}
 
+	compiler.WithSCM(testSCM(t, s.URL))
+
 	compiler.WithBuild(testBuild).WithRepo(testRepo)
 
 	for _, test := range tests {
@@ -1493,6 +1632,117 @@ func TestNative_ExpandSteps_CallTemplateWithRenderInline(t *testing.T) {
 	}
 }
 
+func TestNative_ExpandStepsHTTPS(t *testing.T) {
+	// setup mock server
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/templates/template.star", func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "testdata/template.star")
+	})
+
+	s := httptest.NewTLSServer(mux)
+	defer s.Close()
+
+	// setup mock scm without a lock file
+	scmServer := httptest.NewServer(http.NotFoundHandler())
+	defer scmServer.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Errorf("Parsing url returned err: %v", err)
+	}
+
+	// setup types
+	tmpls := map[string]*yaml.Template{
+		"go": {
+			Name:   "go",
+			Source: s.URL + "/templates/template.star",
+			Format: "starlark",
+			Type:   "https",
+		},
+	}
+
+	steps := yaml.StepSlice{
+		&yaml.Step{
+			Name: "sample",
+			Template: yaml.StepTemplate{
+				Name:      "go",
+				Variables: map[string]any{},
+			},
+		},
+	}
+
+	wantSteps := yaml.StepSlice{
+		&yaml.Step{
+			Commands: []string{"go build", "go test"},
+			Image:    "golang:latest",
+			Name:     "sample_build",
+			Pull:     "not_present",
+		},
+	}
+
+	// setup tests
+	tests := []struct {
+		name      string
+		allowlist []string
+		failure   bool
+	}{
+		{
+			name:      "allowed host",
+			allowlist: []string{"127.0.0.1:*"},
+		},
+		{
+			name:      "host not allowed",
+			allowlist: []string{"templates.example.com"},
+			failure:   true,
+		},
+		{
+			name:      "empty allowlist",
+			allowlist: []string{},
+			failure:   true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compiler, err := FromCLICommand(context.Background(), testCommand(t, ""))
+			if err != nil {
+				t.Errorf("Creating new compiler returned err: %v", err)
+			}
+
+			compiler.HTTPS, _ = https.New(s.Client())
+			compiler.SetHTTPSTemplateAllowlist(test.allowlist)
+			compiler.WithSCM(testSCM(t, scmServer.URL))
+
+			build, _, err := compiler.ExpandSteps(context.Background(),
+				&yaml.Build{
+					Steps:       steps,
+					Secrets:     yaml.SecretSlice{},
+					Services:    yaml.ServiceSlice{},
+					Environment: raw.StringSliceMap{},
+				},
+				tmpls, new(pipeline.RuleData), nil, compiler.GetTemplateDepth())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ExpandSteps should have returned err for host %s", u.Host)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ExpandSteps returned err: %v", err)
+			}
+
+			if diff := cmp.Diff(build.Steps, wantSteps); diff != "" {
+				t.Errorf("ExpandSteps() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestNative_mapFromTemplates(t *testing.T) {
 	// setup types
 	str := "foo"
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+
+	"github.com/go-vela/server/compiler/registry"
+	"github.com/go-vela/server/compiler/types/yaml"
+)
+
+// templateLoader returns the function used to capture files imported
+// with load() or import from the same source as the provided template.
+func (c *Client) templateLoader(ctx context.Context, tmpl *yaml.Template) func(string) ([]byte, error) {
+	return func(module string) ([]byte, error) {
+		if len(module) == 0 || path.IsAbs(module) || strings.Contains(module, "@") {
+			return nil, fmt.Errorf("invalid file %s loaded by template %s: must be a path relative to the template", module, tmpl.Name)
+		}
+
+		// loaded files are cached by the template they are loaded from
+		key := fmt.Sprintf("%s:%s:%s", strings.ToLower(tmpl.Type), tmpl.Source, module)
+
+		if bytes, ok := c.TemplateCache[key]; ok {
+			return bytes, nil
+		}
+
+		bytes, err := c.getTemplateFile(ctx, tmpl, module)
+		if err != nil {
+			return nil, err
+		}
+
+		c.TemplateCache[key] = bytes
+
+		return bytes, nil
+	}
+}
+
+// getTemplateFile is a helper function to capture a file relative to the
+// provided template. Files for github and file type templates are captured
+// at the same reference the template resolved to.
+func (c *Client) getTemplateFile(ctx context.Context, tmpl *yaml.Template, module string) ([]byte, error) {
+	if c.local {
+		a := &afero.Afero{
+			Fs: afero.NewOsFs(),
+		}
+
+		// iterate over locally provided templates
+		for _, t := range c.localTemplates {
+			parts := strings.Split(t, ":")
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("local templates must be provided in the form <name>:<path>, got %s", t)
+			}
+
+			// if local template has a match, read file relative to the path provided
+			if strings.EqualFold(tmpl.Name, parts[0]) {
+				return a.ReadFile(filepath.Join(filepath.Dir(parts[1]), filepath.FromSlash(module)))
+			}
+		}
+
+		// file type templates are retrieved locally relative to `source`
+		if strings.EqualFold(tmpl.Type, "file") {
+			return a.ReadFile(filepath.Join(filepath.Dir(tmpl.Source), filepath.FromSlash(module)))
+		}
+	}
+
+	switch {
+	case strings.EqualFold(tmpl.Type, "github"):
+		src, err := c.Github.Parse(tmpl.Source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template source provided for %s: %w", tmpl.Name, err)
+		}
+
+		return c.getGithubTemplateFile(ctx, tmpl, src, module)
+
+	case strings.EqualFold(tmpl.Type, "file"):
+		src := &registry.Source{
+			Org:  c.repo.GetOrg(),
+			Repo: c.repo.GetName(),
+			Name: tmpl.Source,
+			Ref:  c.commit,
+		}
+
+		return c.getGithubTemplateFile(ctx, tmpl, src, module)
+
+	case strings.EqualFold(tmpl.Type, "https"):
+		// strip the checksum since it only pins the template itself
+		source := tmpl.Source
+		if idx := strings.LastIndex(source, "@"); idx > 0 {
+			source = source[:idx]
+		}
+
+		base, err := url.Parse(source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template source provided for %s: %w", tmpl.Name, err)
+		}
+
+		ref, err := url.Parse(module)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file %s loaded by template %s: %w", module, tmpl.Name, err)
+		}
+
+		// loaded files are resolved and pinned like any other https template
+		file := &yaml.Template{
+			Name:   module,
+			Source: base.ResolveReference(ref).String(),
+			Type:   tmpl.Type,
+		}
+
+		return c.getRemoteTemplate(ctx, c.HTTPS, file, tmpl.Name, c.GetHTTPSTemplateAllowlist())
+
+	default:
+		return nil, fmt.Errorf("unable to load %s: loading files is not supported for %s templates", module, tmpl.Type)
+	}
+}
+
+// getGithubTemplateFile is a helper function to capture a file relative
+// to the provided source of a github or file type template.
+//
+//nolint:lll // ignore long line length due to input arguments
+func (c *Client) getGithubTemplateFile(ctx context.Context, tmpl *yaml.Template, src *registry.Source, module string) ([]byte, error) {
+	src.Name = path.Join(path.Dir(src.Name), module)
+
+	// capture the file at the reference the template resolved to
+	if ref := c.resolvedRef(tmpl); len(ref) > 0 {
+		src.Ref = ref
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"org":  src.Org,
+		"repo": src.Repo,
+		"path": src.Name,
+		"host": src.Host,
+	}).Tracef("Using GitHub client to pull file for template %s", tmpl.Name)
+
+	if c.UsePrivateGithub {
+		// verify private GitHub is actually set up
+		if c.PrivateGithub == nil {
+			return nil, fmt.Errorf("unable to fetch file %s: missing credentials", src.Name)
+		}
+
+		return c.PrivateGithub.Template(ctx, c.repo, c.user, src, c.token)
+	}
+
+	return c.Github.Template(ctx, c.repo, nil, src, "")
+}
+
+// resolvedRef is a helper function to capture the
+// immutable reference the provided template resolved to.
+func (c *Client) resolvedRef(tmpl *yaml.Template) string {
+	typ := strings.ToLower(tmpl.Type)
+
+	for _, t := range c.resolvedTemplates {
+		if t.GetType() == typ && t.GetSource() == tmpl.Source {
+			return t.GetRef()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/compiler/types/raw"
+	"github.com/go-vela/server/compiler/types/yaml"
+)
+
+func TestNative_ExpandSteps_StarlarkLoad(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	refs := make(map[string]string)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/contents/:path", func(c *gin.Context) {
+		refs[c.Param("path")] = c.Query("ref")
+
+		body, err := convertFileToGithubResponse(c.Param("path"))
+		if err != nil {
+			t.Error(err)
+		}
+
+		c.JSON(http.StatusOK, body)
+	})
+
+	mockResolveRoutes(engine)
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	tmpls := map[string]*yaml.Template{
+		"go": {
+			Name:   "go",
+			Source: "github.example.com/foo/bar/template_load.star@main",
+			Format: "starlark",
+			Type:   "github",
+		},
+	}
+
+	steps := yaml.StepSlice{
+		&yaml.Step{
+			Name: "sample",
+			Template: yaml.StepTemplate{
+				Name:      "go",
+				Variables: map[string]any{},
+			},
+		},
+	}
+
+	wantSteps := yaml.StepSlice{
+		&yaml.Step{
+			Commands: []string{"go build"},
+			Image:    "golang:latest",
+			Name:     "sample_build",
+			Pull:     "not_present",
+		},
+		&yaml.Step{
+			Commands: []string{"go test"},
+			Image:    "golang:latest",
+			Name:     "sample_test",
+			Pull:     "not_present",
+		},
+	}
+
+	// run test
+	compiler, err := FromCLICommand(context.Background(), testCommand(t, s.URL))
+	if err != nil {
+		t.Errorf("Creating new compiler returned err: %v", err)
+	}
+
+	compiler.WithSCM(testSCM(t, s.URL))
+
+	build, _, err := compiler.ExpandSteps(context.Background(),
+		&yaml.Build{
+			Steps:       steps,
+			Secrets:     yaml.SecretSlice{},
+			Services:    yaml.ServiceSlice{},
+			Environment: raw.StringSliceMap{},
+		},
+		tmpls, new(pipeline.RuleData), nil, compiler.GetTemplateDepth())
+	if err != nil {
+		t.Errorf("ExpandSteps returned err: %v", err)
+	}
+
+	if diff := cmp.Diff(build.Steps, wantSteps); diff != "" {
+		t.Errorf("ExpandSteps() mismatch (-want +got):\n%s", diff)
+	}
+
+	// the loaded file is captured at the reference the template resolved to
+	if got := refs["helpers.star"]; got != "48afb5bdc41ad69bf22588491333f7cf71135163" {
+		t.Errorf("loaded file captured at ref %s, want %s", got, "48afb5bdc41ad69bf22588491333f7cf71135163")
+	}
+}
+
+func TestNative_templateLoader_Invalid(t *testing.T) {
+	// setup types
+	c := &Client{
+		TemplateCache: make(map[string][]byte),
+	}
+
+	tests := []struct {
+		name   string
+		tmpl   *yaml.Template
+		module string
+	}{
+		{
+			name:   "absolute path",
+			tmpl:   &yaml.Template{Name: "go", Source: "github.example.com/foo/bar/template.star", Type: "github"},
+			module: "/etc/passwd",
+		},
+		{
+			name:   "path with reference",
+			tmpl:   &yaml.Template{Name: "go", Source: "github.example.com/foo/bar/template.star", Type: "github"},
+			module: "helpers.star@main",
+		},
+		{
+			name:   "oci template",
+			tmpl:   &yaml.Template{Name: "go", Source: "ghcr.io/foo/bar:v1", Type: "oci"},
+			module: "helpers.star",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := c.templateLoader(context.Background(), test.tmpl)(test.module)
+			if err == nil {
+				t.Errorf("templateLoader should have returned err")
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+	yml "go.yaml.in/yaml/v3"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/types/yaml"
+)
+
+// lockFile is the path to the optional file pinning
+// the templates for a pipeline to immutable references.
+const lockFile = ".vela.lock"
+
+// getLock is a helper function to capture the optional lock
+// file for the repo. The lock file is only captured once.
+func (c *Client) getLock(ctx context.Context) (*yaml.Lock, error) {
+	if c.lockLoaded {
+		return c.lock, nil
+	}
+
+	var (
+		bytes []byte
+		err   error
+	)
+
+	if c.local {
+		a := &afero.Afero{
+			Fs: afero.NewOsFs(),
+		}
+
+		bytes, err = a.ReadFile(lockFile)
+	} else {
+		if c.scm == nil {
+			return nil, fmt.Errorf("unable to capture %s: no scm provided", lockFile)
+		}
+
+		logrus.WithFields(logrus.Fields{
+			"org":  c.repo.GetOrg(),
+			"repo": c.repo.GetName(),
+			"path": lockFile,
+		}).Tracef("capturing %s for repo", lockFile)
+
+		// the lock file is captured with the token of the repo owner, or the
+		// installation token, so it is found for private repos as well
+		bytes, err = c.scm.File(ctx, c.repo.GetOwner(), c.repo, lockFile, c.commit, c.token)
+	}
+
+	// the lock file is optional, but only a file that does not
+	// exist is treated as missing rather than any failed fetch
+	if errors.Is(err, fs.ErrNotExist) {
+		c.lockLoaded = true
+
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to capture %s: %w", lockFile, err)
+	}
+
+	lock := new(yaml.Lock)
+
+	err = yml.Unmarshal(bytes, lock)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", lockFile, err)
+	}
+
+	c.lock = lock
+	c.lockLoaded = true
+
+	return c.lock, nil
+}
+
+// pinTemplate is a helper function to record the immutable reference a
+// template resolved to and verify it against the optional lock file.
+func (c *Client) pinTemplate(ctx context.Context, tmpl *yaml.Template, ref string) error {
+	lock, err := c.getLock(ctx)
+	if err != nil {
+		return err
+	}
+
+	typ := strings.ToLower(tmpl.Type)
+
+	if lock != nil {
+		pinned := lock.Find(typ, tmpl.Source)
+		if pinned == nil {
+			return fmt.Errorf("template %s (%s) is not pinned in %s", tmpl.Name, tmpl.Source, lockFile)
+		}
+
+		if pinned.Ref != ref {
+			return fmt.Errorf("template %s (%s) resolved to %s, but %s pins %s", tmpl.Name, tmpl.Source, ref, lockFile, pinned.Ref)
+		}
+	}
+
+	// templates fetched more than once are only recorded once
+	for _, t := range c.resolvedTemplates {
+		if t.GetType() == typ && t.GetSource() == tmpl.Source {
+			return nil
+		}
+	}
+
+	t := new(api.ResolvedTemplate)
+
+	t.SetName(tmpl.Name)
+	t.SetSource(tmpl.Source)
+	t.SetType(typ)
+	t.SetRef(ref)
+
+	c.resolvedTemplates = append(c.resolvedTemplates, *t)
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package native
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v84/github"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/types/yaml"
+)
+
+func TestNative_getLock(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/contents/:path", func(c *gin.Context) {
+		switch c.Param("repo") {
+		case "private":
+			c.Status(http.StatusUnauthorized)
+
+			return
+		case "unlocked":
+			c.Status(http.StatusNotFound)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, github.RepositoryContent{
+			Encoding: new(""),
+			Content:  new("version: \"1\"\ntemplates:\n  - name: gradle\n    source: github.example.com/foo/bar/long_template.yml\n    type: github\n    ref: 48afb5bdc41ad69bf22588491333f7cf71135163\n"),
+		})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup tests
+	tests := []struct {
+		name    string
+		repo    string
+		want    *yaml.Lock
+		failure bool
+	}{
+		{
+			name: "lock file",
+			repo: "locked",
+			want: &yaml.Lock{
+				Version: "1",
+				Templates: yaml.LockTemplateSlice{
+					{
+						Name:   "gradle",
+						Source: "github.example.com/foo/bar/long_template.yml",
+						Type:   "github",
+						Ref:    "48afb5bdc41ad69bf22588491333f7cf71135163",
+					},
+				},
+			},
+		},
+		{
+			name: "no lock file",
+			repo: "unlocked",
+			want: nil,
+		},
+		{
+			name:    "unauthorized",
+			repo:    "private",
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compiler, err := FromCLICommand(context.Background(), testCommand(t, s.URL))
+			if err != nil {
+				t.Errorf("Creating new compiler returned err: %v", err)
+			}
+
+			r := new(api.Repo)
+			r.SetOrg("foo")
+			r.SetName(test.repo)
+
+			compiler.WithRepo(r).WithSCM(testSCM(t, s.URL)).WithCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+			got, err := compiler.getLock(context.Background())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("getLock should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("getLock returned err: %v", err)
+			}
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("getLock mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNative_pinTemplate(t *testing.T) {
+	// setup types
+	tmpl := &yaml.Template{
+		Name:   "gradle",
+		Source: "github.example.com/foo/bar/long_template.yml",
+		Type:   "GitHub",
+	}
+
+	lock := &yaml.Lock{
+		Version: "1",
+		Templates: yaml.LockTemplateSlice{
+			{
+				Name:   "gradle",
+				Source: "github.example.com/foo/bar/long_template.yml",
+				Type:   "github",
+				Ref:    "48afb5bdc41ad69bf22588491333f7cf71135163",
+			},
+		},
+	}
+
+	want := []api.ResolvedTemplate{
+		{
+			Name:   new("gradle"),
+			Source: new("github.example.com/foo/bar/long_template.yml"),
+			Type:   new("github"),
+			Ref:    new("48afb5bdc41ad69bf22588491333f7cf71135163"),
+		},
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		lock    *yaml.Lock
+		tmpl    *yaml.Template
+		ref     string
+		failure bool
+	}{
+		{
+			name: "no lock file",
+			tmpl: tmpl,
+			ref:  "48afb5bdc41ad69bf22588491333f7cf71135163",
+		},
+		{
+			name: "matches lock file",
+			lock: lock,
+			tmpl: tmpl,
+			ref:  "48afb5bdc41ad69bf22588491333f7cf71135163",
+		},
+		{
+			name:    "differs from lock file",
+			lock:    lock,
+			tmpl:    tmpl,
+			ref:     "a8b6b8d4e1a2d3c7f0b9e6d5c4b3a2f1e0d9c8b7",
+			failure: true,
+		},
+		{
+			name: "missing from lock file",
+			lock: lock,
+			tmpl: &yaml.Template{
+				Name:   "gradle",
+				Source: "github.example.com/foo/bar/template.yml",
+				Type:   "github",
+			},
+			ref:     "48afb5bdc41ad69bf22588491333f7cf71135163",
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &Client{
+				lock:       test.lock,
+				lockLoaded: true,
+			}
+
+			// pin the template twice to ensure it is only recorded once
+			for range 2 {
+				err := c.pinTemplate(context.Background(), test.tmpl, test.ref)
+
+				if test.failure {
+					if err == nil {
+						t.Errorf("pinTemplate should have returned err")
+					}
+
+					return
+				}
+
+				if err != nil {
+					t.Errorf("pinTemplate returned err: %v", err)
+				}
+			}
+
+			if diff := cmp.Diff(want, c.resolvedTemplates); diff != "" {
+				t.Errorf("pinTemplate mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
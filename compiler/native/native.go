@@ -16,6 +16,9 @@ import (
 	"github.com/go-vela/server/compiler"
 	"github.com/go-vela/server/compiler/registry"
 	"github.com/go-vela/server/compiler/registry/github"
+	"github.com/go-vela/server/compiler/registry/https"
+	"github.com/go-vela/server/compiler/registry/oci"
+	"github.com/go-vela/server/compiler/types/yaml"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/internal"
 	"github.com/go-vela/server/internal/image"
@@ -33,26 +36,31 @@ type Client struct {
 	Github           registry.Service
 	PrivateGithub    registry.Service
 	UsePrivateGithub bool
+	HTTPS            registry.Service
+	OCI              registry.Service
 
 	ModificationService ModificationConfig
 	TemplateCache       map[string][]byte
 
 	settings.Compiler
 
-	build          *api.Build
-	comment        string
-	commit         string
-	files          []string
-	local          bool
-	localTemplates []string
-	metadata       *internal.Metadata
-	repo           *api.Repo
-	user           *api.User
-	labels         []string
-	db             database.Interface
-	scm            scm.Service
-	cache          cache.Service
-	token          string
+	build             *api.Build
+	comment           string
+	commit            string
+	files             []string
+	local             bool
+	localTemplates    []string
+	metadata          *internal.Metadata
+	repo              *api.Repo
+	user              *api.User
+	labels            []string
+	db                database.Interface
+	scm               scm.Service
+	cache             cache.Service
+	token             string
+	lock              *yaml.Lock
+	lockLoaded        bool
+	resolvedTemplates []api.ResolvedTemplate
 }
 
 // FromCLICommand returns a Pipeline implementation that integrates with the supported registries.
@@ -78,6 +86,18 @@ func FromCLICommand(ctx context.Context, cmd *cli.Command) (*Client, error) {
 
 	c.Github = github
 
+	// setup https template service
+	c.HTTPS, err = setupHTTPS()
+	if err != nil {
+		return nil, err
+	}
+
+	// setup oci template service
+	c.OCI, err = setupOCI(cmd.String("compiler-oci-username"), cmd.String("compiler-oci-password"))
+	if err != nil {
+		return nil, err
+	}
+
 	c.Compiler = settings.Compiler{}
 
 	cloneImage := cmd.String("clone-image")
@@ -97,6 +117,9 @@ func FromCLICommand(ctx context.Context, cmd *cli.Command) (*Client, error) {
 	// set the starlark execution step limit for compiling starlark pipelines
 	c.SetStarlarkExecLimit(cmd.Int64("compiler-starlark-exec-limit"))
 
+	// set the jsonnet execution timeout for compiling jsonnet pipelines
+	c.SetJsonnetExecTimeout(cmd.Int64("compiler-jsonnet-exec-timeout"))
+
 	if cmd.Bool("github-driver") {
 		logrus.Tracef("setting up Private GitHub Client for %s", cmd.String("github-url"))
 		// setup private github service
@@ -111,6 +134,14 @@ func FromCLICommand(ctx context.Context, cmd *cli.Command) (*Client, error) {
 
 	c.SetBlockedImages(nil)
 	c.SetWarnImages(nil)
+	c.SetPolicyRules(nil)
+
+	// set the hosts permitted to serve https and oci templates
+	c.SetHTTPSTemplateAllowlist(cmd.StringSlice("compiler-https-template-allowlist"))
+	c.SetOCITemplateAllowlist(cmd.StringSlice("compiler-oci-template-allowlist"))
+
+	// set the worker flavors permitted to be requested by pipelines
+	c.SetWorkerFlavors(cmd.StringSlice("compiler-worker-flavors"))
 
 	c.TemplateCache = make(map[string][]byte)
 
@@ -131,6 +162,20 @@ func setupPrivateGithub(ctx context.Context, addr, token string) (registry.Servi
 	return github.New(ctx, addr, token)
 }
 
+// setupHTTPS is a helper function to setup the
+// HTTPS registry service.
+func setupHTTPS() (registry.Service, error) {
+	logrus.Tracef("creating %s registry client from CLI configuration", "https")
+	return https.New(nil)
+}
+
+// setupOCI is a helper function to setup the
+// OCI registry service from the CLI arguments.
+func setupOCI(username, password string) (registry.Service, error) {
+	logrus.Tracef("creating %s registry client from CLI configuration", "oci")
+	return oci.New(nil, username, password)
+}
+
 // Duplicate creates a clone of the Engine.
 func (c *Client) Duplicate() compiler.Engine {
 	cc := new(Client)
@@ -139,12 +184,19 @@ func (c *Client) Duplicate() compiler.Engine {
 	cc.Github = c.Github
 	cc.PrivateGithub = c.PrivateGithub
 	cc.UsePrivateGithub = c.UsePrivateGithub
+	cc.HTTPS = c.HTTPS
+	cc.OCI = c.OCI
 	cc.ModificationService = c.ModificationService
 	cc.CloneImage = c.CloneImage
 	cc.TemplateDepth = c.TemplateDepth
 	cc.StarlarkExecLimit = c.StarlarkExecLimit
+	cc.JsonnetExecTimeout = c.JsonnetExecTimeout
 	cc.BlockedImages = c.BlockedImages
 	cc.WarnImages = c.WarnImages
+	cc.HTTPSTemplateAllowlist = c.HTTPSTemplateAllowlist
+	cc.OCITemplateAllowlist = c.OCITemplateAllowlist
+	cc.PolicyRules = c.PolicyRules
+	cc.WorkerFlavors = c.WorkerFlavors
 	cc.TemplateCache = make(map[string][]byte)
 
 	return cc
@@ -13,6 +13,8 @@ import (
 	"github.com/go-vela/server/api/types/settings"
 	"github.com/go-vela/server/compiler/registry/github"
 	"github.com/go-vela/server/internal"
+	"github.com/go-vela/server/scm"
+	scmGithub "github.com/go-vela/server/scm/github"
 )
 
 func TestNative_New(t *testing.T) {
@@ -35,6 +37,8 @@ func TestNative_New(t *testing.T) {
 	}
 
 	got.Github = nil
+	got.HTTPS = nil
+	got.OCI = nil
 
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("New is %v, want %v", got, want)
@@ -67,6 +71,8 @@ func TestNative_New_PrivateGithub(t *testing.T) {
 
 	got.Github = nil
 	got.PrivateGithub = nil
+	got.HTTPS = nil
+	got.OCI = nil
 
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("New is %v, want %v", got, want)
@@ -99,6 +105,8 @@ func TestNative_DuplicateRetainSettings(t *testing.T) {
 
 	got.Github = nil
 	got.PrivateGithub = nil
+	got.HTTPS = nil
+	got.OCI = nil
 
 	if !reflect.DeepEqual(got.Duplicate(), want) {
 		t.Errorf("New is %v, want %v", got, want)
@@ -119,6 +127,7 @@ func TestNative_DuplicateStripBuild(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	// modify engine with WithBuild and then call Duplicate
 	// to get a copy of the Engine without build attached.
@@ -142,6 +151,7 @@ func TestNative_WithBuild(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithBuild(b), want) {
 		t.Errorf("WithBuild is %v, want %v", got, want)
@@ -162,6 +172,7 @@ func TestNative_WithFiles(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithFiles(f), want) {
 		t.Errorf("WithFiles is %v, want %v", got, want)
@@ -181,6 +192,7 @@ func TestNative_WithComment(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithComment(comment), want) {
 		t.Errorf("WithComment is %v, want %v", got, want)
@@ -200,6 +212,7 @@ func TestNative_WithLocal(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithLocal(local), want) {
 		t.Errorf("WithLocal is %v, want %v", got, want)
@@ -219,6 +232,7 @@ func TestNative_WithLocalTemplates(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithLocalTemplates(localTemplates), want) {
 		t.Errorf("WithLocalTemplates is %v, want %v", got, want)
@@ -256,6 +270,7 @@ func TestNative_WithMetadata(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithMetadata(m), want) {
 		t.Errorf("WithMetadata is %v, want %v", got, want)
@@ -278,6 +293,7 @@ func TestNative_WithPrivateGitHub(t *testing.T) {
 	got.WithPrivateGitHub(context.Background(), "http://foo.example.com", "someToken")
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 	got.PrivateGithub = want.PrivateGithub
 
 	if !reflect.DeepEqual(got, want) {
@@ -300,6 +316,7 @@ func TestNative_WithRepo(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithRepo(r), want) {
 		t.Errorf("WithRepo is %v, want %v", got, want)
@@ -321,6 +338,7 @@ func TestNative_WithUser(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithUser(u), want) {
 		t.Errorf("WithUser is %v, want %v", got, want)
@@ -340,6 +358,7 @@ func TestNative_WithLabels(t *testing.T) {
 	}
 
 	got.Github = want.Github
+	got.HTTPS = want.HTTPS
 
 	if !reflect.DeepEqual(got.WithLabels(labels), want) {
 		t.Errorf("WithLocalTemplates is %v, want %v", got, want)
@@ -376,3 +395,16 @@ func testCommand(t *testing.T, url string) *cli.Command {
 
 	return c
 }
+
+// testSCM is a helper function to create the scm
+// capturing the lock file from the mock server.
+func testSCM(t *testing.T, url string) scm.Service {
+	t.Helper()
+
+	client, err := scmGithub.NewTest(url)
+	if err != nil {
+		t.Fatalf("unable to create test scm: %v", err)
+	}
+
+	return client
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
+	"github.com/go-vela/server/compiler/template/jsonnet"
 	"github.com/go-vela/server/compiler/template/native"
 	"github.com/go-vela/server/compiler/template/starlark"
 	typesRaw "github.com/go-vela/server/compiler/types/raw"
@@ -74,7 +76,21 @@ func (c *Client) Parse(v any, pipelineType string, template *yaml.Template) (*ya
 		// capture the raw pipeline configuration
 		raw = []byte(parsedRaw)
 
-		p, warnings, err = starlark.RenderBuild(template.Name, parsedRaw, c.EnvironmentBuild(), template.Variables, c.GetStarlarkExecLimit())
+		p, warnings, err = starlark.RenderBuild(template.Name, parsedRaw, c.EnvironmentBuild(), template.Variables, c.GetStarlarkExecLimit(), nil)
+		if err != nil {
+			return nil, raw, nil, err
+		}
+	case constants.PipelineTypeJsonnet:
+		// expand the base configuration
+		parsedRaw, err := c.ParseRaw(v)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		// capture the raw pipeline configuration
+		raw = []byte(parsedRaw)
+
+		p, warnings, err = jsonnet.RenderBuild(template.Name, parsedRaw, c.EnvironmentBuild(), template.Variables, time.Duration(c.GetJsonnetExecTimeout())*time.Second, nil)
 		if err != nil {
 			return nil, raw, nil, err
 		}
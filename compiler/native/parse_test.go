@@ -904,6 +904,7 @@ func Test_client_Parse(t *testing.T) {
 	}{
 		{"yaml", args{pipelineType: constants.PipelineTypeYAML, file: "testdata/pipeline_type_default.yml"}, want, false},
 		{"starlark", args{pipelineType: constants.PipelineTypeStarlark, file: "testdata/pipeline_type.star"}, want, false},
+		{"jsonnet", args{pipelineType: constants.PipelineTypeJsonnet, file: "testdata/pipeline_type.jsonnet"}, want, false},
 		{"go", args{pipelineType: constants.PipelineTypeGo, file: "testdata/pipeline_type_go.yml"}, want, false},
 		{"empty", args{pipelineType: "", file: "testdata/pipeline_type_default.yml"}, want, false},
 		{"nil", args{pipelineType: "nil", file: "testdata/pipeline_type_default.yml"}, nil, true},
@@ -17,7 +17,12 @@ func (c *Client) SetSettings(s *settings.Platform) {
 		c.SetCloneImage(s.GetCloneImage())
 		c.SetTemplateDepth(s.GetTemplateDepth())
 		c.SetStarlarkExecLimit(s.GetStarlarkExecLimit())
+		c.SetJsonnetExecTimeout(s.GetJsonnetExecTimeout())
 		c.SetBlockedImages(s.GetBlockedImages())
 		c.SetWarnImages(s.GetWarnImages())
+		c.SetHTTPSTemplateAllowlist(s.GetHTTPSTemplateAllowlist())
+		c.SetOCITemplateAllowlist(s.GetOCITemplateAllowlist())
+		c.SetPolicyRules(s.GetPolicyRules())
+		c.SetWorkerFlavors(s.GetWorkerFlavors())
 	}
 }
@@ -0,0 +1,7 @@
+{
+  step(word):: {
+    name: word,
+    image: 'golang:latest',
+    commands: ['go build', 'go test'],
+  },
+}
@@ -3,9 +3,12 @@
 package native
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 
@@ -15,99 +18,126 @@ import (
 	"github.com/go-vela/server/internal/image"
 )
 
+// diagnostic codes reported when validating a pipeline.
+const (
+	codeMissingVersion       = "missing-version"
+	codeMissingSteps         = "missing-steps"
+	codeStagesAndSteps       = "stages-and-steps"
+	codeRenderInlineTemplate = "render-inline-template"
+	codeWorkerFlavor         = "worker-flavor"
+	codeWorkerLabels         = "worker-labels"
+	codeWorkerUnavailable    = "worker-unavailable"
+	codeMissingName          = "missing-name"
+	codeMissingImage         = "missing-image"
+	codeMissingCommands      = "missing-commands"
+	codeSelfNeeds            = "self-needs"
+	codeDuplicateStage       = "duplicate-stage"
+	codeDuplicateStep        = "duplicate-step"
+	codeDuplicateReportAs    = "duplicate-report-as"
+	codeReportAsLimit        = "report-as-limit"
+	codeGitTokenRepoLimit    = "git-token-repository-limit"
+	codeGitTokenLimit        = "git-token-limit"
+)
+
 // ValidateYAML verifies the yaml configuration is valid.
+//
+// The returned error is a pipeline.Diagnostics
+// describing every problem found.
 func (c *Client) ValidateYAML(p *yaml.Build) error {
-	var result error
+	diags := pipeline.Diagnostics{}
+
 	// check a version is provided
 	if len(p.Version) == 0 {
-		result = multierror.Append(result, fmt.Errorf("no \"version:\" YAML property provided"))
+		diags.Errorf(codeMissingVersion, "version", "no \"version:\" YAML property provided")
 	}
 
 	// check that stages or steps are provided
 	if len(p.Stages) == 0 && len(p.Steps) == 0 && (!p.Metadata.RenderInline && len(p.Templates) == 0) {
-		result = multierror.Append(result, fmt.Errorf("no stages, steps or templates provided"))
+		diags.Errorf(codeMissingSteps, "", "no stages, steps or templates provided")
 	}
 
 	// check that stages and steps aren't provided
 	if len(p.Stages) > 0 && len(p.Steps) > 0 {
-		result = multierror.Append(result, fmt.Errorf("stages and steps provided"))
+		diags.Errorf(codeStagesAndSteps, "steps", "stages and steps provided")
+	}
+
+	// check the requested worker flavor is permitted by the platform
+	if flavors := c.GetWorkerFlavors(); len(p.Worker.Flavor) > 0 && len(flavors) > 0 && !slices.Contains(flavors, p.Worker.Flavor) {
+		diags.Errorf(codeWorkerFlavor, "worker.flavor", "worker flavor %s is not permitted, must be one of: %s", p.Worker.Flavor, strings.Join(flavors, ", "))
+	}
+
+	// check the requested worker labels are a valid selector
+	if _, err := p.Worker.ToPipeline().Selector(); err != nil {
+		diags.Errorf(codeWorkerLabels, "worker.labels", "%v", err)
 	}
 
 	if p.Metadata.RenderInline {
-		for _, step := range p.Steps {
+		for i, step := range p.Steps {
 			if step.Template.Name != "" {
-				result = multierror.Append(result, fmt.Errorf("step %s: cannot combine render_inline and a step that references a template", step.Name))
+				diags.Errorf(codeRenderInlineTemplate, stepPath("", i, step.Name)+".template",
+					"step %s: cannot combine render_inline and a step that references a template", step.Name)
 			}
 		}
 
 		for _, stage := range p.Stages {
-			for _, step := range stage.Steps {
+			for i, step := range stage.Steps {
 				if step.Template.Name != "" {
-					result = multierror.Append(result, fmt.Errorf("step %s.%s: cannot combine render_inline and a step that references a template", stage.Name, step.Name))
+					diags.Errorf(codeRenderInlineTemplate, stepPath(stage.Name, i, step.Name)+".template",
+						"step %s.%s: cannot combine render_inline and a step that references a template", stage.Name, step.Name)
 				}
 			}
 		}
 	}
 
 	// validate the services block provided
-	err := validateYAMLServices(p.Services)
-	if err != nil {
-		result = multierror.Append(result, err)
-	}
+	validateYAMLServices(p.Services, &diags)
 
 	// validate the stages block provided
-	err = validateYAMLStages(p.Stages)
-	if err != nil {
-		result = multierror.Append(result, err)
-	}
+	validateYAMLStages(p.Stages, &diags)
 
 	// validate the steps block provided
-	err = validateYAMLSteps(p.Steps)
-	if err != nil {
-		result = multierror.Append(result, err)
-	}
+	validateYAMLSteps(p.Steps, "", &diags)
 
 	// validate the secrets block provided
-	err = validateYAMLSecrets(p.Secrets)
-	if err != nil {
-		result = multierror.Append(result, err)
-	}
+	validateYAMLSecrets(p.Secrets, &diags)
 
-	return result
+	return diags.Err()
 }
 
 // validateYAMLStages is a helper function that verifies the
 // stages block in the yaml configuration is valid.
-func validateYAMLStages(s yaml.StageSlice) error {
+func validateYAMLStages(s yaml.StageSlice, diags *pipeline.Diagnostics) {
 	for _, stage := range s {
 		if len(stage.Name) == 0 {
-			return fmt.Errorf("no name provided for stage")
+			diags.Errorf(codeMissingName, "stages", "no name provided for stage")
+
+			continue
 		}
 
 		// validate that a stage is not referencing itself in needs
 		if slices.Contains(stage.Needs, stage.Name) {
-			return fmt.Errorf("stage %s references itself in 'needs' declaration", stage.Name)
+			diags.Errorf(codeSelfNeeds, fmt.Sprintf("stages.%s.needs", stage.Name),
+				"stage %s references itself in 'needs' declaration", stage.Name)
 		}
 
-		err := validateYAMLSteps(stage.Steps)
-		if err != nil {
-			return err
-		}
+		validateYAMLSteps(stage.Steps, stage.Name, diags)
 	}
-
-	return nil
 }
 
 // validateYAMLSteps is a helper function that verifies the
 // steps block in the yaml configuration is valid.
-func validateYAMLSteps(s yaml.StepSlice) error {
-	for _, step := range s {
+func validateYAMLSteps(s yaml.StepSlice, stage string, diags *pipeline.Diagnostics) {
+	for i, step := range s {
+		path := stepPath(stage, i, step.Name)
+
 		if len(step.Name) == 0 {
-			return fmt.Errorf("no name provided for step")
+			diags.Errorf(codeMissingName, path, "no name provided for step")
+
+			continue
 		}
 
 		if len(step.Image) == 0 {
-			return fmt.Errorf("no image provided for step %s", step.Name)
+			diags.Errorf(codeMissingImage, path+".image", "no image provided for step %s", step.Name)
 		}
 
 		if step.Name == constants.CloneName || step.Name == constants.InitName {
@@ -117,50 +147,58 @@ func validateYAMLSteps(s yaml.StepSlice) error {
 		if len(step.Commands) == 0 && len(step.Environment) == 0 &&
 			len(step.Parameters) == 0 && len(step.Secrets) == 0 &&
 			len(step.Template.Name) == 0 && !step.Detach {
-			return fmt.Errorf("no commands, environment, parameters, secrets or template provided for step %s", step.Name)
+			diags.Errorf(codeMissingCommands, path,
+				"no commands, environment, parameters, secrets or template provided for step %s", step.Name)
 		}
 	}
-
-	return nil
 }
 
 // validateYAMLServices is a helper function that verifies the
 // services block in the yaml configuration is valid.
-func validateYAMLServices(s yaml.ServiceSlice) error {
-	for _, service := range s {
+func validateYAMLServices(s yaml.ServiceSlice, diags *pipeline.Diagnostics) {
+	for i, service := range s {
+		path := itemPath("services", i, service.Name)
+
 		if len(service.Name) == 0 {
-			return fmt.Errorf("no name provided for service")
+			diags.Errorf(codeMissingName, path, "no name provided for service")
+
+			continue
 		}
 
 		if len(service.Image) == 0 {
-			return fmt.Errorf("no image provided for service %s", service.Name)
+			diags.Errorf(codeMissingImage, path+".image", "no image provided for service %s", service.Name)
 		}
 	}
-
-	return nil
 }
 
 // validateYAMLSecretOrigin is a helper function that verifies the
 // secret origin block in the yaml configuration is valid.
-func validateYAMLSecrets(s yaml.SecretSlice) error {
-	for _, secret := range s {
-		if !secret.Origin.Empty() {
-			if len(secret.Origin.Name) == 0 {
-				return fmt.Errorf("no name provided for secret origin")
-			}
+func validateYAMLSecrets(s yaml.SecretSlice, diags *pipeline.Diagnostics) {
+	for i, secret := range s {
+		if secret.Origin.Empty() {
+			continue
+		}
 
-			if len(secret.Origin.Image) == 0 {
-				return fmt.Errorf("no image provided for secret origin %s", secret.Origin.Name)
-			}
+		path := itemPath("secrets", i, secret.Name) + ".origin"
+
+		if len(secret.Origin.Name) == 0 {
+			diags.Errorf(codeMissingName, path, "no name provided for secret origin")
+
+			continue
 		}
-	}
 
-	return nil
+		if len(secret.Origin.Image) == 0 {
+			diags.Errorf(codeMissingImage, path+".image", "no image provided for secret origin %s", secret.Origin.Name)
+		}
+	}
 }
 
 // ValidatePipeline verifies the final pipeline configuration is valid.
+//
+// The returned error is a pipeline.Diagnostics
+// describing every problem found.
 func (c *Client) ValidatePipeline(p *pipeline.Build) error {
-	var result error
+	diags := pipeline.Diagnostics{}
 
 	// report count for custom report containers
 	reportCount := 0
@@ -169,43 +207,37 @@ func (c *Client) ValidatePipeline(p *pipeline.Build) error {
 	gitTokenCount := 0
 
 	// validate the services block provided
-	err := validatePipelineContainers(p.Services, &reportCount, &gitTokenCount, make(map[string]string), make(map[string]bool), "")
-	if err != nil {
-		result = multierror.Append(result, err)
-	}
+	validatePipelineContainers(p.Services, "services", &reportCount, &gitTokenCount, make(map[string]string), make(map[string]bool), "", &diags)
 
 	// validate the stages block provided
-	err = validatePipelineStages(p.Stages)
-	if err != nil {
-		result = multierror.Append(result, err)
-	}
+	validatePipelineStages(p.Stages, &diags)
 
 	// validate the steps block provided
-	err = validatePipelineContainers(p.Steps, &reportCount, &gitTokenCount, make(map[string]string), make(map[string]bool), "")
-	if err != nil {
-		result = multierror.Append(result, err)
-	}
+	validatePipelineContainers(p.Steps, "steps", &reportCount, &gitTokenCount, make(map[string]string), make(map[string]bool), "", &diags)
 
-	secretContainers := pipeline.ContainerSlice{}
+	// validate the secrets block provided
+	reportMap := make(map[string]string)
+	nameMap := make(map[string]bool)
 
-	for _, s := range p.Secrets {
-		if !s.Origin.Empty() {
-			secretContainers = append(secretContainers, s.Origin)
+	for i, s := range p.Secrets {
+		if s.Origin.Empty() {
+			continue
 		}
-	}
 
-	// validate the secrets block provided
-	err = validatePipelineContainers(secretContainers, &reportCount, &gitTokenCount, make(map[string]string), make(map[string]bool), "")
-	if err != nil {
-		result = multierror.Append(result, err)
+		section := itemPath("secrets", i, s.Name) + ".origin"
+
+		validatePipelineContainers(pipeline.ContainerSlice{s.Origin}, section, &reportCount, &gitTokenCount, reportMap, nameMap, "", &diags)
 	}
 
-	return result
+	// validate the limits across the pipeline
+	validatePipelineLimits(reportCount, gitTokenCount, &diags)
+
+	return diags.Err()
 }
 
 // validatePipelineStages is a helper function that verifies the
 // stages block in the final pipeline configuration is valid.
-func validatePipelineStages(s pipeline.StageSlice) error {
+func validatePipelineStages(s pipeline.StageSlice, diags *pipeline.Diagnostics) {
 	reportMap := make(map[string]string)
 	reportCount := 0
 
@@ -216,37 +248,48 @@ func validatePipelineStages(s pipeline.StageSlice) error {
 
 	for _, stage := range s {
 		if _, ok := stageNameMap[stage.Name]; ok {
-			return fmt.Errorf("stage `%s` is already defined", stage.Name)
+			diags.Errorf(codeDuplicateStage, "stages."+stage.Name, "stage `%s` is already defined", stage.Name)
+
+			continue
 		}
 
 		stageNameMap[stage.Name] = true
 
-		err := validatePipelineContainers(stage.Steps, &reportCount, &gitTokenCount, reportMap, stepNameMap, stage.Name)
-		if err != nil {
-			return err
-		}
+		validatePipelineContainers(stage.Steps, "stages."+stage.Name+".steps", &reportCount, &gitTokenCount, reportMap, stepNameMap, stage.Name, diags)
 	}
 
-	return nil
+	validatePipelineLimits(reportCount, gitTokenCount, diags)
 }
 
 // validatePipelineContainers is a helper function that
-// ensures custom report containers do not exceed the limit
-// and that the container names are unique.
-func validatePipelineContainers(s pipeline.ContainerSlice, reportCount, gitTokenCount *int, reportMap map[string]string, nameMap map[string]bool, stageName string) error {
+// ensures custom report containers and git token requests
+// are within their limits and that the container names are unique.
+//
+//nolint:lll // ignore long line length due to input arguments
+func validatePipelineContainers(s pipeline.ContainerSlice, section string, reportCount, gitTokenCount *int, reportMap map[string]string, nameMap map[string]bool, stageName string, diags *pipeline.Diagnostics) {
 	for _, ctn := range s {
 		if ctn.Name == constants.CloneName || ctn.Name == constants.InitName {
 			continue
 		}
 
+		path := fmt.Sprintf("%s[name=%s]", section, ctn.Name)
+
+		// secret origins are addressed by the secret they belong to
+		if strings.HasSuffix(section, ".origin") {
+			path = section
+		}
+
 		if _, ok := nameMap[stageName+"_"+ctn.Name]; ok {
-			return fmt.Errorf("step `%s` is already defined", ctn.Name)
+			diags.Errorf(codeDuplicateStep, path+".name", "step `%s` is already defined", ctn.Name)
+
+			continue
 		}
 
 		nameMap[stageName+"_"+ctn.Name] = true
 
 		if s, ok := reportMap[ctn.ReportAs]; ok {
-			return fmt.Errorf("report_as to %s for step %s is already targeted by step %s", ctn.ReportAs, ctn.Name, s)
+			diags.Errorf(codeDuplicateReportAs, path+".report_as",
+				"report_as to %s for step %s is already targeted by step %s", ctn.ReportAs, ctn.Name, s)
 		}
 
 		if len(ctn.ReportAs) > 0 {
@@ -258,20 +301,69 @@ func validatePipelineContainers(s pipeline.ContainerSlice, reportCount, gitToken
 			*gitTokenCount++
 
 			if len(ctn.Git.Token.Repositories) > constants.GitTokenRepoLimit {
-				return fmt.Errorf("git token repository count for step %s exceeds the limit of %d", ctn.Name, constants.GitTokenRepoLimit)
+				diags.Errorf(codeGitTokenRepoLimit, path+".git.token.repositories",
+					"git token repository count for step %s exceeds the limit of %d", ctn.Name, constants.GitTokenRepoLimit)
 			}
 		}
 	}
+}
+
+// validatePipelineLimits is a helper function that ensures the custom
+// report containers and git token requests do not exceed their limits.
+func validatePipelineLimits(reportCount, gitTokenCount int, diags *pipeline.Diagnostics) {
+	if reportCount > constants.ReportStepStatusLimit {
+		diags.Errorf(codeReportAsLimit, "", "report_as is limited to %d steps, counted %d", constants.ReportStepStatusLimit, reportCount)
+	}
+
+	if gitTokenCount > constants.GitTokenRequestLimit {
+		diags.Errorf(codeGitTokenLimit, "", "git token requests are limited to %d steps, counted %d", constants.GitTokenRequestLimit, gitTokenCount)
+	}
+}
+
+// stepPath is a helper function to create the path for a step,
+// which is selected by name when it has one, within the configuration.
+func stepPath(stage string, index int, name string) string {
+	if len(stage) > 0 {
+		return itemPath("stages."+stage+".steps", index, name)
+	}
+
+	return itemPath("steps", index, name)
+}
+
+// itemPath is a helper function to create the path for an item
+// of a list, which is selected by name when it has one.
+func itemPath(list string, index int, name string) string {
+	if len(name) > 0 {
+		return fmt.Sprintf("%s[name=%s]", list, name)
+	}
 
-	if *reportCount > constants.ReportStepStatusLimit {
-		return fmt.Errorf("report_as is limited to %d steps, counted %d", constants.ReportStepStatusLimit, reportCount)
+	return fmt.Sprintf("%s[%d]", list, index)
+}
+
+// checkWorkerLabels verifies at least one active worker satisfies the worker
+// labels requested by the compiled pipeline. The check is skipped without a
+// database, which is the case when compiling pipelines locally.
+func (c *Client) checkWorkerLabels(ctx context.Context, w *pipeline.Worker) error {
+	if len(w.Labels) == 0 || c.db == nil {
+		return nil
 	}
 
-	if *gitTokenCount > constants.GitTokenRequestLimit {
-		return fmt.Errorf("git token requests are limited to %d steps, counted %d", constants.GitTokenRequestLimit, gitTokenCount)
+	workers, err := c.db.ListWorkers(ctx, "true", time.Now().Unix(), 0)
+	if err != nil {
+		return fmt.Errorf("unable to list active workers: %w", err)
 	}
 
-	return nil
+	for _, worker := range workers {
+		if w.Matches(worker.GetLabels()) {
+			return nil
+		}
+	}
+
+	diags := pipeline.Diagnostics{}
+
+	diags.Errorf(codeWorkerUnavailable, "worker.labels", "no active worker satisfies worker labels %s", strings.Join(w.Labels, ", "))
+
+	return diags.Err()
 }
 
 // checkImageRestrictions inspects every container in the compiled pipeline against
@@ -325,6 +417,106 @@ func (c *Client) checkImageRestrictions(p *pipeline.Build) ([]string, error) {
 	return warnings, result
 }
 
+// checkPolicies evaluates the compiled pipeline against the platform's policy
+// rules. Rules scoped to an org only apply to repos within a matching org. Rules
+// that evaluate to false are violations which either cause compilation to fail
+// or produce non-fatal warning strings depending on the action for the rule.
+func (c *Client) checkPolicies(p *pipeline.Build) ([]string, error) {
+	var (
+		result   error
+		warnings []string
+	)
+
+	rules := c.GetPolicyRules()
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	data := &pipeline.PolicyData{
+		Repo: pipeline.PolicyRepo{
+			Org:          c.repo.GetOrg(),
+			Name:         c.repo.GetName(),
+			FullName:     c.repo.GetFullName(),
+			Trusted:      c.repo.GetTrusted(),
+			Visibility:   c.repo.GetVisibility(),
+			ApproveBuild: c.repo.GetApproveBuild(),
+		},
+		Build: pipeline.PolicyBuild{
+			Event:  c.build.GetEvent(),
+			Branch: c.build.GetBranch(),
+			Tag:    strings.TrimPrefix(c.build.GetRef(), "refs/tags/"),
+			Target: c.build.GetDeploy(),
+			Sender: c.build.GetSender(),
+		},
+	}
+
+	// collect all containers from the steps and services
+	containers := append(p.Steps, p.Services...)
+
+	// collect all containers from the secrets
+	for _, s := range p.Secrets {
+		if !s.Origin.Empty() {
+			containers = append(containers, s.Origin)
+		}
+	}
+
+	// collect all containers from the stages
+	for _, stage := range p.Stages {
+		containers = append(containers, stage.Steps...)
+	}
+
+	for _, ctn := range containers {
+		// skip injected init and clone containers
+		if ctn.Name == constants.CloneName || ctn.Name == constants.InitName {
+			continue
+		}
+
+		secrets := []string{}
+		for _, s := range ctn.Secrets {
+			secrets = append(secrets, s.Source)
+		}
+
+		data.Containers = append(data.Containers, pipeline.PolicyContainer{
+			Name:       ctn.Name,
+			Image:      ctn.Image,
+			Privileged: ctn.Privileged,
+			Detach:     ctn.Detach,
+			Pull:       ctn.Pull,
+			Secrets:    secrets,
+		})
+	}
+
+	for _, rule := range rules {
+		// skip rules scoped to a different org
+		if len(rule.GetOrg()) > 0 {
+			if ok, err := filepath.Match(rule.GetOrg(), c.repo.GetOrg()); err != nil || !ok {
+				continue
+			}
+		}
+
+		ok, err := data.Eval(rule.GetRule())
+		if err != nil {
+			err = fmt.Errorf("policy %s could not be evaluated: %w", rule.GetName(), err)
+		} else if !ok {
+			err = fmt.Errorf("policy %s violated: %s", rule.GetName(), rule.GetReason())
+		}
+
+		if err == nil {
+			continue
+		}
+
+		if rule.GetAction() == constants.PolicyActionWarn {
+			warnings = append(warnings, err.Error())
+
+			continue
+		}
+
+		result = multierror.Append(result, err)
+	}
+
+	return warnings, result
+}
+
 // matchesImagePattern reports whether the provided image matches the given pattern.
 // Patterns support glob wildcards via filepath.Match (e.g. "index.docker.io/org/*").
 // Both the raw image and its normalized (fully-qualified) form are tested so that
@@ -4,13 +4,20 @@ package native
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/api/types/settings"
 	"github.com/go-vela/server/compiler/types/pipeline"
 	"github.com/go-vela/server/compiler/types/raw"
 	"github.com/go-vela/server/compiler/types/yaml"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database"
 )
 
 func TestNative_ValidateYAML_NoVersion(t *testing.T) {
@@ -1021,6 +1028,73 @@ func TestNative_CheckImageRestrictions_EmptyLists(t *testing.T) {
 	}
 }
 
+func TestNative_CheckPolicies(t *testing.T) {
+	compiler, err := FromCLICommand(context.Background(), testCommand(t, "http://foo.example.com"))
+	if err != nil {
+		t.Errorf("Unable to create new compiler: %v", err)
+	}
+
+	compiler.Compiler = settings.Compiler{
+		PolicyRules: &[]settings.PolicyRule{
+			{
+				Name:   new("no-privileged"),
+				Rule:   new("repo.trusted || none(containers, .privileged)"),
+				Action: new(constants.PolicyActionBlock),
+				Reason: new("privileged containers require a trusted repo"),
+			},
+			{
+				Name:   new("no-latest"),
+				Rule:   new(`none(containers, .image endsWith ":latest")`),
+				Action: new(constants.PolicyActionWarn),
+				Reason: new("images should be pinned"),
+			},
+			{
+				Name:   new("other-org"),
+				Org:    new("other"),
+				Rule:   new("false"),
+				Action: new(constants.PolicyActionBlock),
+				Reason: new("should never apply"),
+			},
+		},
+	}
+
+	repo := new(api.Repo)
+	repo.SetOrg("octocat")
+	repo.SetName("hello-world")
+
+	compiler.WithRepo(repo).WithBuild(new(api.Build))
+
+	p := &pipeline.Build{
+		Steps: pipeline.ContainerSlice{
+			{Name: "clone", Image: "target/vela-git:latest"},
+			{Name: "test", Image: "golang:latest"},
+		},
+	}
+
+	warnings, err := compiler.checkPolicies(p)
+	if err != nil {
+		t.Errorf("checkPolicies returned unexpected err: %v", err)
+	}
+
+	if len(warnings) != 1 {
+		t.Errorf("checkPolicies should have returned 1 warning, got: %v", warnings)
+	}
+
+	p.Steps = append(p.Steps, &pipeline.Container{Name: "docker", Image: "docker:28", Privileged: true})
+
+	_, err = compiler.checkPolicies(p)
+	if err == nil {
+		t.Errorf("checkPolicies should have returned err for privileged container")
+	}
+
+	repo.SetTrusted(true)
+
+	_, err = compiler.checkPolicies(p)
+	if err != nil {
+		t.Errorf("checkPolicies returned unexpected err for trusted repo: %v", err)
+	}
+}
+
 func TestNative_MatchesImagePattern(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1076,3 +1150,228 @@ func TestNative_MatchesImagePattern(t *testing.T) {
 		})
 	}
 }
+
+func TestNative_ValidateYAML_Diagnostics(t *testing.T) {
+	// setup types
+	p := &yaml.Build{
+		Stages: yaml.StageSlice{
+			&yaml.Stage{
+				Name:  "test",
+				Needs: []string{"test"},
+				Steps: yaml.StepSlice{
+					&yaml.Step{
+						Name:     "unit",
+						Commands: raw.StringSlice{"go test ./..."},
+					},
+					&yaml.Step{
+						Name:  "lint",
+						Image: "golangci/golangci-lint",
+					},
+				},
+			},
+		},
+		Services: yaml.ServiceSlice{
+			&yaml.Service{
+				Image: "postgres",
+			},
+		},
+	}
+
+	want := pipeline.Diagnostics{
+		{Severity: pipeline.DiagnosticError, Code: codeMissingVersion, Message: "no \"version:\" YAML property provided", Path: "version"},
+		{Severity: pipeline.DiagnosticError, Code: codeMissingName, Message: "no name provided for service", Path: "services[0]"},
+		{Severity: pipeline.DiagnosticError, Code: codeSelfNeeds, Message: "stage test references itself in 'needs' declaration", Path: "stages.test.needs"},
+		{Severity: pipeline.DiagnosticError, Code: codeMissingImage, Message: "no image provided for step unit", Path: "stages.test.steps[name=unit].image"},
+		{
+			Severity: pipeline.DiagnosticError,
+			Code:     codeMissingCommands,
+			Message:  "no commands, environment, parameters, secrets or template provided for step lint",
+			Path:     "stages.test.steps[name=lint]",
+		},
+	}
+
+	// run test
+	compiler, err := FromCLICommand(context.Background(), testCommand(t, "http://foo.example.com"))
+	if err != nil {
+		t.Errorf("Unable to create new compiler: %v", err)
+	}
+
+	err = compiler.ValidateYAML(p)
+
+	var got pipeline.Diagnostics
+
+	if !errors.As(err, &got) {
+		t.Fatalf("ValidateYAML returned %v, want diagnostics", err)
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ValidateYAML() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNative_ValidateYAML_WorkerFlavor(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		flavors []string
+		flavor  string
+		wantErr bool
+	}{
+		{
+			name:    "permitted flavor",
+			flavors: []string{"small", "large"},
+			flavor:  "large",
+		},
+		{
+			name:    "flavor not permitted",
+			flavors: []string{"small", "large"},
+			flavor:  "huge",
+			wantErr: true,
+		},
+		{
+			name:   "any flavor permitted",
+			flavor: "huge",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &yaml.Build{
+				Version: "v1",
+				Worker:  yaml.Worker{Flavor: test.flavor},
+				Steps: yaml.StepSlice{
+					&yaml.Step{
+						Name:     "test",
+						Image:    "alpine",
+						Commands: raw.StringSlice{"echo hello"},
+					},
+				},
+			}
+
+			compiler, err := FromCLICommand(context.Background(), testCommand(t, "http://foo.example.com"))
+			if err != nil {
+				t.Errorf("Unable to create new compiler: %v", err)
+			}
+
+			compiler.SetWorkerFlavors(test.flavors)
+
+			err = compiler.ValidateYAML(p)
+			if (err != nil) != test.wantErr {
+				t.Errorf("ValidateYAML returned err %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNative_ValidateYAML_WorkerLabels(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		labels  raw.StringSlice
+		wantErr bool
+	}{
+		{
+			name:   "valid selector",
+			labels: raw.StringSlice{"gpu=true", "arch in (amd64,arm64)", "!spot"},
+		},
+		{
+			name:    "invalid selector",
+			labels:  raw.StringSlice{"arch in (amd64"},
+			wantErr: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &yaml.Build{
+				Version: "v1",
+				Worker:  yaml.Worker{Labels: test.labels},
+				Steps: yaml.StepSlice{
+					&yaml.Step{
+						Name:     "test",
+						Image:    "alpine",
+						Commands: raw.StringSlice{"echo hello"},
+					},
+				},
+			}
+
+			compiler, err := FromCLICommand(context.Background(), testCommand(t, "http://foo.example.com"))
+			if err != nil {
+				t.Errorf("Unable to create new compiler: %v", err)
+			}
+
+			err = compiler.ValidateYAML(p)
+			if (err != nil) != test.wantErr {
+				t.Errorf("ValidateYAML returned err %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNative_CheckWorkerLabels(t *testing.T) {
+	// setup types
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer db.Close()
+
+	active := new(api.Worker)
+	active.SetHostname("worker_labels_active")
+	active.SetAddress("http://worker_labels_active:8080")
+	active.SetActive(true)
+	active.SetLastCheckedIn(time.Now().Add(-time.Minute).Unix())
+	active.SetLabels(map[string]string{"arch": "arm64", "gpu": "false"})
+
+	inactive := new(api.Worker)
+	inactive.SetHostname("worker_labels_inactive")
+	inactive.SetAddress("http://worker_labels_inactive:8080")
+	inactive.SetActive(false)
+	inactive.SetLastCheckedIn(time.Now().Add(-time.Minute).Unix())
+	inactive.SetLabels(map[string]string{"arch": "amd64", "gpu": "true"})
+
+	for _, w := range []*api.Worker{active, inactive} {
+		_, err = db.CreateWorker(context.Background(), w)
+		if err != nil {
+			t.Errorf("unable to create test worker: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		labels  []string
+		wantErr bool
+	}{
+		{
+			name: "no labels",
+		},
+		{
+			name:   "satisfied by active worker",
+			labels: []string{"arch in (arm64)", "gpu!=true"},
+		},
+		{
+			name:    "only satisfied by inactive worker",
+			labels:  []string{"gpu=true"},
+			wantErr: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compiler, err := FromCLICommand(context.Background(), testCommand(t, "http://foo.example.com"))
+			if err != nil {
+				t.Errorf("Unable to create new compiler: %v", err)
+			}
+
+			err = compiler.WithDatabase(db).(*Client).checkWorkerLabels(context.Background(), &pipeline.Worker{Labels: test.labels})
+			if (err != nil) != test.wantErr {
+				t.Errorf("checkWorkerLabels returned err %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
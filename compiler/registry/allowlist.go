@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// maxRedirects is the maximum number of redirects
+// followed when capturing a template.
+const maxRedirects = 10
+
+// allowlistKey defines the key type for storing
+// the host allowlist in the context.
+type allowlistKey struct{}
+
+// WithAllowlist returns a context storing the hosts
+// permitted for fetching templates from a registry.
+func WithAllowlist(ctx context.Context, allowlist []string) context.Context {
+	return context.WithValue(ctx, allowlistKey{}, allowlist)
+}
+
+// AllowlistFromContext returns the hosts permitted for fetching templates
+// from a registry and whether an allowlist was stored in the context.
+func AllowlistFromContext(ctx context.Context) ([]string, bool) {
+	allowlist, ok := ctx.Value(allowlistKey{}).([]string)
+
+	return allowlist, ok
+}
+
+// AllowedHost determines if a host matches a pattern within the allowlist.
+func AllowedHost(host string, allowlist []string) bool {
+	for _, pattern := range allowlist {
+		if pattern == "*" {
+			return true
+		}
+
+		if match, err := filepath.Match(strings.ToLower(pattern), strings.ToLower(host)); err == nil && match {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PermittedHost determines if a host may be contacted while fetching a
+// template from the origin host. The host must match the allowlist stored
+// in the context, or the origin host when no allowlist was stored.
+func PermittedHost(ctx context.Context, origin, host string) bool {
+	allowlist, ok := AllowlistFromContext(ctx)
+	if !ok {
+		return strings.EqualFold(origin, host)
+	}
+
+	return AllowedHost(host, allowlist)
+}
+
+// CheckRedirect is the redirect policy for HTTP clients fetching templates.
+// Every hop must use https and target a permitted host, so an allowed host
+// cannot redirect a template request to a host outside of the allowlist.
+func CheckRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+
+	if !strings.EqualFold(req.URL.Scheme, "https") {
+		return errors.New("redirect to non-https url is not permitted")
+	}
+
+	if !PermittedHost(req.Context(), via[0].URL.Host, req.URL.Host) {
+		return fmt.Errorf("redirect to host %s is not permitted", req.URL.Host)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRegistry_AllowedHost(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		host      string
+		allowlist []string
+		want      bool
+	}{
+		{host: "templates.example.com", allowlist: []string{"templates.example.com"}, want: true},
+		{host: "Templates.Example.com", allowlist: []string{"templates.example.com"}, want: true},
+		{host: "foo.example.com", allowlist: []string{"*.example.com"}, want: true},
+		{host: "ghcr.io", allowlist: []string{"*"}, want: true},
+		{host: "evil.com", allowlist: []string{"*.example.com"}, want: false},
+		{host: "ghcr.io", allowlist: []string{}, want: false},
+		{host: "ghcr.io", allowlist: nil, want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := AllowedHost(test.host, test.allowlist)
+
+		if got != test.want {
+			t.Errorf("AllowedHost for %s is %v, want %v", test.host, got, test.want)
+		}
+	}
+}
+
+func TestRegistry_PermittedHost(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		ctx  context.Context
+		host string
+		want bool
+	}{
+		{name: "origin without allowlist", ctx: context.Background(), host: "ghcr.io", want: true},
+		{name: "other host without allowlist", ctx: context.Background(), host: "evil.com", want: false},
+		{name: "allowed host", ctx: WithAllowlist(context.Background(), []string{"*.ghcr.io"}), host: "pkg.ghcr.io", want: true},
+		{name: "host outside of allowlist", ctx: WithAllowlist(context.Background(), []string{"ghcr.io"}), host: "evil.com", want: false},
+		{name: "empty allowlist", ctx: WithAllowlist(context.Background(), []string{}), host: "ghcr.io", want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := PermittedHost(test.ctx, "ghcr.io", test.host)
+
+			if got != test.want {
+				t.Errorf("PermittedHost for %s is %v, want %v", test.host, got, test.want)
+			}
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 
 	"github.com/google/go-github/v84/github"
 	"golang.org/x/oauth2"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry"
 )
 
 const (
@@ -80,3 +83,22 @@ func (c *Client) newOAuthTokenClient(ctx context.Context, token string) *github.
 
 	return github
 }
+
+// templateClient is a helper function to return the GitHub client
+// used to capture templates from the provided source.
+func (c *Client) templateClient(ctx context.Context, r *api.Repo, u *api.User, s *registry.Source, token string) *github.Client {
+	// use default GitHub OAuth client we provide
+	cli := c.githubClient
+	if u != nil && (token == "" || r.GetOrg() != s.Org) {
+		// create GitHub OAuth client with user's token
+		cli = c.newOAuthTokenClient(ctx, u.GetToken())
+	}
+
+	// if install token provided and orgs match, use GitHub OAuth client with install token
+	if token != "" && r.GetOrg() == s.Org {
+		// create GitHub OAuth client with provided token
+		cli = c.newOAuthTokenClient(ctx, token)
+	}
+
+	return cli
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry"
+)
+
+// commitSHA represents the format of a full commit SHA.
+var commitSHA = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Resolve captures the commit SHA for the templated pipeline configuration from the GitHub repo.
+func (c *Client) Resolve(ctx context.Context, r *api.Repo, u *api.User, s *registry.Source, token string) (string, error) {
+	// a full commit SHA is already immutable
+	if commitSHA.MatchString(s.Ref) {
+		return s.Ref, nil
+	}
+
+	cli := c.templateClient(ctx, r, u, s, token)
+
+	// resolve the default branch when no ref is provided
+	ref := s.Ref
+	if len(ref) == 0 {
+		ref = "HEAD"
+	}
+
+	// send API call to capture the commit SHA for the reference
+	sha, resp, err := cli.Repositories.GetCommitSHA1(ctx, s.Org, s.Repo, ref, "")
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", fmt.Errorf("%w at %s/%s/%s@%s", registry.ErrNotFound, s.Org, s.Repo, s.Name, ref)
+		}
+
+		return "", fmt.Errorf("unexpected error resolving template %s/%s/%s@%s: %w", s.Org, s.Repo, s.Name, ref, err)
+	}
+
+	return sha, nil
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry"
+)
+
+func TestGithub_Resolve(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:owner/:name/commits/:ref", func(c *gin.Context) {
+		switch c.Param("ref") {
+		case "main", "HEAD":
+			c.String(http.StatusOK, "48afb5bdc41ad69bf22588491333f7cf71135163")
+		default:
+			c.Status(http.StatusNotFound)
+		}
+	})
+
+	s := httptest.NewServer(engine)
+
+	defer s.Close()
+
+	// setup types
+	r := &api.Repo{
+		Org:  new("github"),
+		Name: new("octocat"),
+	}
+
+	c, err := New(context.Background(), s.URL, "")
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		ref      string
+		want     string
+		notFound bool
+	}{
+		{
+			name: "branch",
+			ref:  "main",
+			want: "48afb5bdc41ad69bf22588491333f7cf71135163",
+		},
+		{
+			name: "default branch",
+			ref:  "",
+			want: "48afb5bdc41ad69bf22588491333f7cf71135163",
+		},
+		{
+			name: "commit",
+			ref:  "a8b6b8d4e1a2d3c7f0b9e6d5c4b3a2f1e0d9c8b7",
+			want: "a8b6b8d4e1a2d3c7f0b9e6d5c4b3a2f1e0d9c8b7",
+		},
+		{
+			name:     "missing branch",
+			ref:      "foo",
+			notFound: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src := &registry.Source{
+				Org:  "github",
+				Repo: "octocat",
+				Name: "template.yml",
+				Ref:  test.ref,
+			}
+
+			got, err := c.Resolve(context.Background(), r, nil, src, "")
+
+			if test.notFound {
+				if !errors.Is(err, registry.ErrNotFound) {
+					t.Errorf("Resolve returned err %v, want %v", err, registry.ErrNotFound)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Resolve returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Resolve is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
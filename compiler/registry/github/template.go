@@ -15,18 +15,7 @@ import (
 
 // Template captures the templated pipeline configuration from the GitHub repo.
 func (c *Client) Template(ctx context.Context, r *api.Repo, u *api.User, s *registry.Source, token string) ([]byte, error) {
-	// use default GitHub OAuth client we provide
-	cli := c.githubClient
-	if u != nil && (token == "" || r.GetOrg() != s.Org) {
-		// create GitHub OAuth client with user's token
-		cli = c.newOAuthTokenClient(ctx, u.GetToken())
-	}
-
-	// if install token provided and orgs match, use GitHub OAuth client with install token
-	if token != "" && r.GetOrg() == s.Org {
-		// create GitHub OAuth client with provided token
-		cli = c.newOAuthTokenClient(ctx, token)
-	}
+	cli := c.templateClient(ctx, r, u, s, token)
 
 	// create the options to pass
 	opts := &github.RepositoryContentGetOptions{}
@@ -56,10 +45,10 @@ func (c *Client) Template(ctx context.Context, r *api.Repo, u *api.User, s *regi
 
 		// return different error message depending on if a branch was provided
 		if len(s.Ref) == 0 {
-			return nil, fmt.Errorf("no Vela template found at %s/%s/%s", s.Org, s.Repo, s.Name)
+			return nil, fmt.Errorf("%w at %s/%s/%s", registry.ErrNotFound, s.Org, s.Repo, s.Name)
 		}
 
-		return nil, fmt.Errorf("no Vela template found at %s/%s/%s@%s", s.Org, s.Repo, s.Name, s.Ref)
+		return nil, fmt.Errorf("%w at %s/%s/%s@%s", registry.ErrNotFound, s.Org, s.Repo, s.Name, s.Ref)
 	}
 
 	// data is not nil if template exists
@@ -74,8 +63,8 @@ func (c *Client) Template(ctx context.Context, r *api.Repo, u *api.User, s *regi
 
 	// return different error message depending on if a branch was provided
 	if len(s.Ref) == 0 {
-		return nil, fmt.Errorf("no Vela template found at %s/%s/%s", s.Org, s.Repo, s.Name)
+		return nil, fmt.Errorf("%w at %s/%s/%s", registry.ErrNotFound, s.Org, s.Repo, s.Name)
 	}
 
-	return nil, fmt.Errorf("no Vela template found at %s/%s/%s@%s", s.Org, s.Repo, s.Name, s.Ref)
+	return nil, fmt.Errorf("%w at %s/%s/%s@%s", registry.ErrNotFound, s.Org, s.Repo, s.Name, s.Ref)
 }
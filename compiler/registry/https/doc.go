@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package https provides the ability for Vela to
+// integrate with plain HTTPS endpoints as a
+// template registry.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/compiler/registry/https"
+package https
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+const (
+	// defaultTimeout is the maximum duration for fetching a template.
+	defaultTimeout = 30 * time.Second
+)
+
+type Client struct {
+	httpClient *http.Client
+}
+
+// New returns a Registry implementation that integrates
+// with templates served from plain HTTPS URLs.
+func New(httpClient *http.Client) (*Client, error) {
+	// use a default HTTP client with a timeout if none was provided
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: defaultTimeout,
+		}
+	}
+
+	// copy the client to not alter the provided one
+	client := *httpClient
+
+	// restrict redirects to https and the permitted hosts
+	client.CheckRedirect = registry.CheckRedirect
+
+	return &Client{
+		httpClient: &client,
+	}, nil
+}
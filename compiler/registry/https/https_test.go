@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestHTTPS_New(t *testing.T) {
+	// setup types
+	custom := &http.Client{Transport: http.DefaultTransport}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		client *http.Client
+		want   *http.Client
+	}{
+		{
+			name:   "default client",
+			client: nil,
+			want:   &http.Client{Timeout: defaultTimeout},
+		},
+		{
+			name:   "custom client",
+			client: custom,
+			want:   custom,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.client)
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.httpClient.Timeout != test.want.Timeout {
+				t.Errorf("New timeout is %v, want %v", got.httpClient.Timeout, test.want.Timeout)
+			}
+
+			if test.client != nil && got.httpClient.Transport != test.want.Transport {
+				t.Errorf("New did not use provided client")
+			}
+
+			if got.httpClient.CheckRedirect == nil {
+				t.Errorf("New did not restrict redirects")
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+// checksumPrefix is the only supported checksum algorithm for pinning templates.
+const checksumPrefix = "sha256:"
+
+// Parse creates the registry source object from
+// a template path with an optional checksum pin.
+//
+// Sources are provided in the form:
+//   - https://<host>/<path>/<to>/<filename>
+//   - https://<host>/<path>/<to>/<filename>@sha256:<checksum>
+func (c *Client) Parse(path string) (*registry.Source, error) {
+	// ref will hold the checksum used to pin the template
+	ref := ""
+
+	// check for checksum provided in the filename
+	if idx := strings.LastIndex(path, "@"+checksumPrefix); idx > 0 {
+		ref = path[idx+1:]
+		path = path[:idx]
+
+		checksum, err := hex.DecodeString(strings.TrimPrefix(ref, checksumPrefix))
+		if err != nil || len(checksum) != 32 {
+			return nil, fmt.Errorf("invalid template checksum %s, must be a sha256 hex digest", ref)
+		}
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// only allow templates to be fetched over a secure connection
+	if !strings.EqualFold(u.Scheme, "https") {
+		return nil, fmt.Errorf("invalid template source %s, must use the https scheme", path)
+	}
+
+	if len(u.Host) == 0 || len(strings.Trim(u.Path, "/")) == 0 {
+		return nil, fmt.Errorf("invalid template source %s, must contain host/path_to_template", path)
+	}
+
+	return &registry.Source{
+		Host: u.Host,
+		Name: strings.TrimPrefix(u.Path, "/"),
+		Ref:  ref,
+	}, nil
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+func TestHTTPS_Parse(t *testing.T) {
+	// setup types
+	checksum := "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+	// setup tests
+	tests := []struct {
+		name    string
+		path    string
+		want    *registry.Source
+		failure bool
+	}{
+		{
+			name: "no checksum",
+			path: "https://templates.example.com/go/build.yml",
+			want: &registry.Source{
+				Host: "templates.example.com",
+				Name: "go/build.yml",
+			},
+		},
+		{
+			name: "with checksum",
+			path: "https://templates.example.com/go/build.yml@" + checksum,
+			want: &registry.Source{
+				Host: "templates.example.com",
+				Name: "go/build.yml",
+				Ref:  checksum,
+			},
+		},
+		{
+			name: "with port",
+			path: "https://templates.example.com:8443/build.yml",
+			want: &registry.Source{
+				Host: "templates.example.com:8443",
+				Name: "build.yml",
+			},
+		},
+		{
+			name:    "insecure scheme",
+			path:    "http://templates.example.com/go/build.yml",
+			failure: true,
+		},
+		{
+			name:    "missing scheme",
+			path:    "templates.example.com/go/build.yml",
+			failure: true,
+		},
+		{
+			name:    "missing path",
+			path:    "https://templates.example.com/",
+			failure: true,
+		},
+		{
+			name:    "invalid checksum",
+			path:    "https://templates.example.com/go/build.yml@sha256:foo",
+			failure: true,
+		},
+	}
+
+	c, err := New(nil)
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := c.Parse(test.path)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Parse should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry"
+)
+
+// Resolve captures the checksum of the templated pipeline configuration from the HTTPS URL.
+//
+// The repo, user and token are unused since templates are fetched anonymously.
+func (c *Client) Resolve(ctx context.Context, _ *api.Repo, _ *api.User, s *registry.Source, _ string) (string, error) {
+	// a pinned checksum is already immutable
+	if len(s.Ref) > 0 {
+		return s.Ref, nil
+	}
+
+	data, err := c.fetch(ctx, s)
+	if err != nil {
+		return "", err
+	}
+
+	return checksum(data), nil
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+func TestHTTPS_Resolve(t *testing.T) {
+	// setup mock server
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/templates/build.yml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("foo"))
+	})
+
+	s := httptest.NewTLSServer(mux)
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Errorf("Parsing url returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		source  *registry.Source
+		want    string
+		failure bool
+	}{
+		{
+			name: "floating",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "templates/build.yml",
+			},
+			want: "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		},
+		{
+			name: "pinned",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "templates/missing.yml",
+				Ref:  "sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9",
+			},
+			want: "sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9",
+		},
+		{
+			name: "not found",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "templates/missing.yml",
+			},
+			failure: true,
+		},
+	}
+
+	c, err := New(s.Client())
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := c.Resolve(context.Background(), nil, nil, test.source, "")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Resolve should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Resolve returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Resolve is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
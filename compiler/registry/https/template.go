@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry"
+)
+
+// Template captures the templated pipeline configuration from the HTTPS URL.
+//
+// The repo, user and token are unused since templates are fetched anonymously.
+func (c *Client) Template(ctx context.Context, _ *api.Repo, _ *api.User, s *registry.Source, _ string) ([]byte, error) {
+	data, err := c.fetch(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the template matches the pinned checksum
+	if len(s.Ref) > 0 {
+		got := checksum(data)
+
+		if !strings.EqualFold(got, s.Ref) {
+			return nil, fmt.Errorf("checksum mismatch for template https://%s/%s: got %s, want %s", s.Host, s.Name, got, s.Ref)
+		}
+	}
+
+	return data, nil
+}
+
+// fetch is a helper function to capture the content
+// from the HTTPS URL for the template source.
+func (c *Client) fetch(ctx context.Context, s *registry.Source) ([]byte, error) {
+	u := fmt.Sprintf("https://%s/%s", s.Host, s.Name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// send API call to capture the templated pipeline configuration
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error fetching template %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w at %s", registry.ErrNotFound, u)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching template %s: %s", u, resp.Status)
+	}
+
+	// read one byte past the limit to detect templates that are too large
+	data, err := io.ReadAll(io.LimitReader(resp.Body, registry.MaxTemplateSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read template %s: %w", u, err)
+	}
+
+	if len(data) > registry.MaxTemplateSize {
+		return nil, fmt.Errorf("template %s exceeds maximum size of %d bytes", u, registry.MaxTemplateSize)
+	}
+
+	return data, nil
+}
+
+// checksum is a helper function to calculate the pinned checksum of the content.
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return checksumPrefix + hex.EncodeToString(sum[:])
+}
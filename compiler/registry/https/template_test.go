@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package https
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+func TestHTTPS_Template(t *testing.T) {
+	// setup mock server
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/templates/build.yml", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("foo"))
+	})
+
+	s := httptest.NewTLSServer(mux)
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Errorf("Parsing url returned err: %v", err)
+	}
+
+	mux.HandleFunc("/redirect/same", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, s.URL+"/templates/build.yml", http.StatusFound)
+	})
+
+	mux.HandleFunc("/redirect/http", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://"+u.Host+"/templates/build.yml", http.StatusFound)
+	})
+
+	mux.HandleFunc("/redirect/host", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://evil.example.com/templates/build.yml", http.StatusFound)
+	})
+
+	// setup tests
+	tests := []struct {
+		name      string
+		allowlist []string
+		source    *registry.Source
+		want      []byte
+		failure   bool
+	}{
+		{
+			name: "success",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "templates/build.yml",
+			},
+			want: []byte("foo"),
+		},
+		{
+			name: "success with checksum",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "templates/build.yml",
+				Ref:  "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+			},
+			want: []byte("foo"),
+		},
+		{
+			name: "checksum mismatch",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "templates/build.yml",
+				Ref:  "sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9",
+			},
+			failure: true,
+		},
+		{
+			name: "redirect to same host",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "redirect/same",
+			},
+			want: []byte("foo"),
+		},
+		{
+			name: "redirect to http",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "redirect/http",
+			},
+			failure: true,
+		},
+		{
+			name: "redirect to other host",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "redirect/host",
+			},
+			failure: true,
+		},
+		{
+			name:      "redirect to host outside of allowlist",
+			allowlist: []string{u.Host},
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "redirect/host",
+			},
+			failure: true,
+		},
+		{
+			name: "not found",
+			source: &registry.Source{
+				Host: u.Host,
+				Name: "templates/missing.yml",
+			},
+			failure: true,
+		},
+	}
+
+	c, err := New(s.Client())
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if test.allowlist != nil {
+				ctx = registry.WithAllowlist(ctx, test.allowlist)
+			}
+
+			got, err := c.Template(ctx, nil, nil, test.source, "")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Template should have returned err")
+				}
+
+				if got != nil {
+					t.Errorf("Template is %v, want nil", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Template returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Template is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package oci provides the ability for Vela to
+// integrate with OCI registries that store
+// templates as artifacts.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/compiler/registry/oci"
+package oci
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultTimeout is the maximum duration for fetching a template.
+	defaultTimeout = 30 * time.Second
+
+	// mediaTypeManifest is the OCI image manifest media type.
+	mediaTypeManifest = "application/vnd.oci.image.manifest.v1+json"
+
+	// annotationTitle is the annotation used to identify a file within an artifact.
+	annotationTitle = "org.opencontainers.image.title"
+)
+
+type Client struct {
+	httpClient *http.Client
+	username   string
+	password   string
+}
+
+type (
+	// descriptor is the OCI representation of a content descriptor.
+	descriptor struct {
+		MediaType   string            `json:"mediaType"`
+		Digest      string            `json:"digest"`
+		Size        int64             `json:"size"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	}
+
+	// manifest is the OCI representation of an image manifest.
+	manifest struct {
+		SchemaVersion int          `json:"schemaVersion"`
+		MediaType     string       `json:"mediaType,omitempty"`
+		ArtifactType  string       `json:"artifactType,omitempty"`
+		Layers        []descriptor `json:"layers"`
+	}
+)
+
+// New returns a Registry implementation that integrates
+// with OCI registries storing templates as artifacts.
+//
+// When a username and password are provided, they are used
+// to authenticate with the registry. Otherwise, templates
+// are pulled anonymously.
+func New(httpClient *http.Client, username, password string) (*Client, error) {
+	// use a default HTTP client with a timeout if none was provided
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: defaultTimeout,
+		}
+	}
+
+	return &Client{
+		httpClient: httpClient,
+		username:   username,
+		password:   password,
+	}, nil
+}
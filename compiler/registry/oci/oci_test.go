@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOCI_New(t *testing.T) {
+	// run test
+	got, err := New(nil, "octocat", "superSecretPassword")
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	if got.httpClient == nil || got.httpClient.Timeout != defaultTimeout {
+		t.Errorf("New did not create default HTTP client")
+	}
+
+	if got.username != "octocat" || got.password != "superSecretPassword" {
+		t.Errorf("New did not set credentials")
+	}
+}
+
+func TestOCI_parseChallenge(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		challenge  string
+		wantScheme string
+		wantParams map[string]string
+	}{
+		{
+			challenge:  `Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:vela/templates:pull,push"`,
+			wantScheme: "Bearer",
+			wantParams: map[string]string{
+				"realm":   "https://auth.example.com/token",
+				"service": "registry.example.com",
+				"scope":   "repository:vela/templates:pull,push",
+			},
+		},
+		{
+			challenge:  `Basic realm="registry"`,
+			wantScheme: "Basic",
+			wantParams: map[string]string{
+				"realm": "registry",
+			},
+		},
+		{
+			challenge:  "",
+			wantScheme: "",
+			wantParams: map[string]string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		gotScheme, gotParams := parseChallenge(test.challenge)
+
+		if gotScheme != test.wantScheme {
+			t.Errorf("parseChallenge scheme is %v, want %v", gotScheme, test.wantScheme)
+		}
+
+		if !reflect.DeepEqual(gotParams, test.wantParams) {
+			t.Errorf("parseChallenge params is %v, want %v", gotParams, test.wantParams)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/distribution/reference"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+// Parse creates the registry source object from
+// a template path referencing an OCI artifact.
+//
+// Sources are provided in the form:
+//   - <registry>/<repository>:<tag>
+//   - <registry>/<repository>@sha256:<digest>
+//   - <registry>/<repository>:<tag>#<filename>
+//
+// The filename selects a layer by its title annotation
+// and may be omitted when the artifact has a single layer.
+func (c *Client) Parse(path string) (*registry.Source, error) {
+	// name will hold the file within the artifact
+	name := ""
+
+	path = strings.TrimPrefix(path, "oci://")
+
+	// check for filename provided after the reference
+	if idx := strings.LastIndex(path, "#"); idx > 0 {
+		name = path[idx+1:]
+		path = path[:idx]
+	}
+
+	named, err := reference.ParseNormalizedNamed(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template source %s: %w", path, err)
+	}
+
+	// default to the latest tag when no reference is provided
+	ref := "latest"
+
+	switch r := named.(type) {
+	case reference.Digested:
+		ref = r.Digest().String()
+	case reference.Tagged:
+		ref = r.Tag()
+	}
+
+	return &registry.Source{
+		Host: reference.Domain(named),
+		Repo: reference.Path(named),
+		Name: name,
+		Ref:  ref,
+	}, nil
+}
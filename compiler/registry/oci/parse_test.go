@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+func TestOCI_Parse(t *testing.T) {
+	// setup types
+	digest := "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+	// setup tests
+	tests := []struct {
+		name    string
+		path    string
+		want    *registry.Source
+		failure bool
+	}{
+		{
+			name: "tag",
+			path: "ghcr.io/go-vela/templates:v1",
+			want: &registry.Source{
+				Host: "ghcr.io",
+				Repo: "go-vela/templates",
+				Ref:  "v1",
+			},
+		},
+		{
+			name: "digest",
+			path: "ghcr.io/go-vela/templates@" + digest,
+			want: &registry.Source{
+				Host: "ghcr.io",
+				Repo: "go-vela/templates",
+				Ref:  digest,
+			},
+		},
+		{
+			name: "default tag with scheme and filename",
+			path: "oci://registry.example.com:5000/templates#go/build.yml",
+			want: &registry.Source{
+				Host: "registry.example.com:5000",
+				Repo: "templates",
+				Name: "go/build.yml",
+				Ref:  "latest",
+			},
+		},
+		{
+			name:    "invalid reference",
+			path:    "ghcr.io/Go-Vela/templates:v1",
+			failure: true,
+		},
+	}
+
+	c, err := New(nil, "", "")
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := c.Parse(test.path)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Parse should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
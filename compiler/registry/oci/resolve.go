@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry"
+)
+
+// Resolve captures the manifest digest of the templated pipeline configuration from the OCI artifact.
+//
+// The repo, user and token are unused since registry credentials are
+// configured on the client.
+func (c *Client) Resolve(ctx context.Context, _ *api.Repo, _ *api.User, s *registry.Source, _ string) (string, error) {
+	// a pinned digest is already immutable
+	if strings.HasPrefix(s.Ref, "sha256:") {
+		return s.Ref, nil
+	}
+
+	// send API call to capture the artifact manifest
+	data, err := c.get(ctx, s, fmt.Sprintf("manifests/%s", s.Ref), mediaTypeManifest)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve manifest for template %s/%s:%s: %w", s.Host, s.Repo, s.Ref, err)
+	}
+
+	return digest(data), nil
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+func TestOCI_Resolve(t *testing.T) {
+	// setup types
+	manifestBytes := []byte(`{"schemaVersion":2}`)
+
+	// setup mock server
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/v2/vela/templates/manifests/v1", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(manifestBytes)
+	})
+
+	s := httptest.NewTLSServer(mux)
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Errorf("Parsing url returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		source  *registry.Source
+		want    string
+		failure bool
+	}{
+		{
+			name:   "tag",
+			source: &registry.Source{Host: u.Host, Repo: "vela/templates", Ref: "v1"},
+			want:   digest(manifestBytes),
+		},
+		{
+			name:   "digest",
+			source: &registry.Source{Host: u.Host, Repo: "vela/templates", Ref: digest([]byte("foo"))},
+			want:   digest([]byte("foo")),
+		},
+		{
+			name:    "not found",
+			source:  &registry.Source{Host: u.Host, Repo: "vela/templates", Ref: "v2"},
+			failure: true,
+		},
+	}
+
+	c, err := New(s.Client(), "", "")
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := c.Resolve(context.Background(), nil, nil, test.source, "")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Resolve should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Resolve returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Resolve is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/registry"
+)
+
+// Template captures the templated pipeline configuration from the OCI artifact.
+//
+// The repo, user and token are unused since registry credentials are
+// configured on the client.
+func (c *Client) Template(ctx context.Context, _ *api.Repo, _ *api.User, s *registry.Source, _ string) ([]byte, error) {
+	ref := fmt.Sprintf("%s/%s@%s", s.Host, s.Repo, s.Ref)
+
+	// send API call to capture the artifact manifest
+	data, err := c.get(ctx, s, fmt.Sprintf("manifests/%s", s.Ref), mediaTypeManifest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch manifest for template %s: %w", ref, err)
+	}
+
+	// verify the manifest matches the pinned digest
+	if strings.HasPrefix(s.Ref, "sha256:") && digest(data) != s.Ref {
+		return nil, fmt.Errorf("digest mismatch for template %s: got %s", ref, digest(data))
+	}
+
+	m := new(manifest)
+
+	err = json.Unmarshal(data, m)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest for template %s: %w", ref, err)
+	}
+
+	layer, err := selectLayer(m, s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w at %s: %w", registry.ErrNotFound, ref, err)
+	}
+
+	if layer.Size > registry.MaxTemplateSize {
+		return nil, fmt.Errorf("template %s exceeds maximum size of %d bytes", ref, registry.MaxTemplateSize)
+	}
+
+	// send API call to capture the templated pipeline configuration
+	data, err = c.get(ctx, s, fmt.Sprintf("blobs/%s", layer.Digest), "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch template %s: %w", ref, err)
+	}
+
+	// verify the content matches the layer digest
+	if digest(data) != layer.Digest {
+		return nil, fmt.Errorf("digest mismatch for template %s: got %s, want %s", ref, digest(data), layer.Digest)
+	}
+
+	return data, nil
+}
+
+// get is a helper function to send an authenticated GET request
+// to the registry API for the repository in the source.
+func (c *Client) get(ctx context.Context, s *registry.Source, path, accept string) ([]byte, error) {
+	u := fmt.Sprintf("https://%s/v2/%s/%s", s.Host, s.Repo, path)
+
+	resp, err := c.do(ctx, u, accept, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// retry the request with credentials when challenged
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+
+		resp.Body.Close()
+
+		auth, err := c.authorize(ctx, s.Host, challenge)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = c.do(ctx, u, accept, auth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	// read one byte past the limit to detect content that is too large
+	data, err := io.ReadAll(io.LimitReader(resp.Body, registry.MaxTemplateSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > registry.MaxTemplateSize {
+		return nil, fmt.Errorf("content exceeds maximum size of %d bytes", registry.MaxTemplateSize)
+	}
+
+	return data, nil
+}
+
+// do is a helper function to send a GET request with
+// the provided accept and authorization headers.
+func (c *Client) do(ctx context.Context, u, accept, auth string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(accept) > 0 {
+		req.Header.Set("Accept", accept)
+	}
+
+	if len(auth) > 0 {
+		req.Header.Set("Authorization", auth)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// authorize is a helper function to create the authorization
+// header value for the provided registry challenge.
+//
+// The token realm named by the registry must use https and be a permitted
+// host, so credentials are never sent to a host outside of the allowlist.
+func (c *Client) authorize(ctx context.Context, host, challenge string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if len(c.username) == 0 {
+			return "", fmt.Errorf("registry requires credentials")
+		}
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+		req.SetBasicAuth(c.username, c.password)
+
+		return req.Header.Get("Authorization"), nil
+	case "bearer":
+		realm, ok := params["realm"]
+		if !ok {
+			return "", fmt.Errorf("registry challenge missing realm")
+		}
+
+		u, err := url.Parse(realm)
+		if err != nil {
+			return "", fmt.Errorf("invalid registry realm %s: %w", realm, err)
+		}
+
+		if !strings.EqualFold(u.Scheme, "https") {
+			return "", fmt.Errorf("registry realm %s must use https", realm)
+		}
+
+		if !registry.PermittedHost(ctx, host, u.Host) {
+			return "", fmt.Errorf("registry realm host %s is not permitted", u.Host)
+		}
+
+		q := u.Query()
+
+		if service, ok := params["service"]; ok {
+			q.Set("service", service)
+		}
+
+		if scope, ok := params["scope"]; ok {
+			q.Set("scope", scope)
+		}
+
+		u.RawQuery = q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return "", err
+		}
+
+		if len(c.username) > 0 {
+			req.SetBasicAuth(c.username, c.password)
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return "", fmt.Errorf("unable to fetch registry token: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unable to fetch registry token: %s", resp.Status)
+		}
+
+		token := struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}{}
+
+		err = json.NewDecoder(resp.Body).Decode(&token)
+		if err != nil {
+			return "", fmt.Errorf("unable to decode registry token: %w", err)
+		}
+
+		if len(token.Token) == 0 {
+			token.Token = token.AccessToken
+		}
+
+		return "Bearer " + token.Token, nil
+	default:
+		return "", fmt.Errorf("unsupported registry challenge: %s", challenge)
+	}
+}
+
+// parseChallenge is a helper function to parse the scheme
+// and parameters from a WWW-Authenticate header value.
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+
+	for len(rest) > 0 {
+		rest = strings.TrimLeft(rest, ", ")
+
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+
+		// quoted values may contain commas, eg. scope="repository:foo:pull,push"
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end < 0 {
+				break
+			}
+
+			params[strings.ToLower(strings.TrimSpace(key))] = value[1 : end+1]
+			rest = value[end+2:]
+
+			continue
+		}
+
+		value, rest, _ = strings.Cut(value, ",")
+		params[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+	}
+
+	return scheme, params
+}
+
+// selectLayer is a helper function to select the layer
+// containing the template from the artifact manifest.
+func selectLayer(m *manifest, name string) (*descriptor, error) {
+	if len(name) == 0 {
+		if len(m.Layers) != 1 {
+			return nil, fmt.Errorf("artifact contains %d layers, a filename must be provided", len(m.Layers))
+		}
+
+		return &m.Layers[0], nil
+	}
+
+	for i, layer := range m.Layers {
+		if layer.Annotations[annotationTitle] == name {
+			return &m.Layers[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("artifact does not contain %s", name)
+}
+
+// digest is a helper function to calculate the OCI digest of the content.
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
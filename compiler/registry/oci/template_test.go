@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/compiler/registry"
+)
+
+func TestOCI_Template(t *testing.T) {
+	// setup types
+	content := []byte("foo")
+	other := []byte("bar")
+
+	m := manifest{
+		SchemaVersion: 2,
+		MediaType:     mediaTypeManifest,
+		Layers: []descriptor{
+			{
+				MediaType:   "application/yaml",
+				Digest:      digest(content),
+				Size:        int64(len(content)),
+				Annotations: map[string]string{annotationTitle: "build.yml"},
+			},
+			{
+				MediaType:   "application/yaml",
+				Digest:      digest(other),
+				Size:        int64(len(other)),
+				Annotations: map[string]string{annotationTitle: "test.yml"},
+			},
+		},
+	}
+
+	manifestBytes, _ := json.Marshal(m)
+
+	// setup mock server
+	mux := http.NewServeMux()
+
+	var s *httptest.Server
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("scope") != "repository:vela/templates:pull" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"token":"foobar"}`))
+	})
+
+	mux.HandleFunc("/v2/vela/templates/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer foobar" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:vela/templates:pull"`, s.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/vela/templates/manifests/v1", "/v2/vela/templates/manifests/" + digest(manifestBytes):
+			_, _ = w.Write(manifestBytes)
+		case "/v2/vela/templates/blobs/" + digest(content):
+			_, _ = w.Write(content)
+		case "/v2/vela/templates/blobs/" + digest(other):
+			// serve tampered content for the layer
+			_, _ = w.Write([]byte("baz"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	s = httptest.NewTLSServer(mux)
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Errorf("Parsing url returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		source  *registry.Source
+		want    []byte
+		failure bool
+	}{
+		{
+			name:   "tag",
+			source: &registry.Source{Host: u.Host, Repo: "vela/templates", Name: "build.yml", Ref: "v1"},
+			want:   content,
+		},
+		{
+			name:   "digest",
+			source: &registry.Source{Host: u.Host, Repo: "vela/templates", Name: "build.yml", Ref: digest(manifestBytes)},
+			want:   content,
+		},
+		{
+			name:    "digest mismatch",
+			source:  &registry.Source{Host: u.Host, Repo: "vela/templates", Name: "build.yml", Ref: digest(other)},
+			failure: true,
+		},
+		{
+			name:    "layer digest mismatch",
+			source:  &registry.Source{Host: u.Host, Repo: "vela/templates", Name: "test.yml", Ref: "v1"},
+			failure: true,
+		},
+		{
+			name:    "missing filename",
+			source:  &registry.Source{Host: u.Host, Repo: "vela/templates", Ref: "v1"},
+			failure: true,
+		},
+		{
+			name:    "not found",
+			source:  &registry.Source{Host: u.Host, Repo: "vela/templates", Name: "build.yml", Ref: "v2"},
+			failure: true,
+		},
+	}
+
+	c, err := New(s.Client(), "", "")
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := c.Template(context.Background(), nil, nil, test.source, "")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Template should have returned err")
+				}
+
+				if got != nil {
+					t.Errorf("Template is %v, want nil", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Template returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Template is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestOCI_Template_Realm(t *testing.T) {
+	// setup mock token server capturing requests
+	var tokenRequests int
+
+	tokens := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		tokenRequests++
+
+		_, _ = w.Write([]byte(`{"token":"foobar"}`))
+	}))
+	defer tokens.Close()
+
+	// setup mock registry challenging with the token server as realm
+	s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, tokens.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		t.Errorf("Parsing url returned err: %v", err)
+	}
+
+	realm, err := url.Parse(tokens.URL)
+	if err != nil {
+		t.Errorf("Parsing url returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name      string
+		allowlist []string
+		requests  int
+	}{
+		{
+			name:     "realm on other host without allowlist",
+			requests: 0,
+		},
+		{
+			name:      "realm outside of allowlist",
+			allowlist: []string{u.Host},
+			requests:  0,
+		},
+		{
+			name:      "realm within allowlist",
+			allowlist: []string{u.Host, realm.Host},
+			requests:  1,
+		},
+	}
+
+	c, err := New(s.Client(), "foo", "bar")
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tokenRequests = 0
+
+			ctx := context.Background()
+
+			if test.allowlist != nil {
+				ctx = registry.WithAllowlist(ctx, test.allowlist)
+			}
+
+			source := &registry.Source{Host: u.Host, Repo: "vela/templates", Name: "build.yml", Ref: "v1"}
+
+			// the registry keeps challenging, so the template is never captured
+			_, err := c.Template(ctx, nil, nil, source, "")
+			if err == nil {
+				t.Errorf("Template should have returned err")
+			}
+
+			if tokenRequests != test.requests {
+				t.Errorf("token requests is %d, want %d", tokenRequests, test.requests)
+			}
+		})
+	}
+}
@@ -4,10 +4,19 @@ package registry
 
 import (
 	"context"
+	"errors"
 
 	api "github.com/go-vela/server/api/types"
 )
 
+// MaxTemplateSize is the maximum number of bytes
+// read for a pipeline configuration or template.
+const MaxTemplateSize = 10 << 20
+
+// ErrNotFound defines the error returned when
+// a template does not exist in the registry.
+var ErrNotFound = errors.New("no Vela template found")
+
 // Service represents the interface for Vela integrating
 // with the different supported template registries.
 type Service interface {
@@ -15,6 +24,10 @@ type Service interface {
 	// registry source object from a template path.
 	Parse(string) (*Source, error)
 
+	// Resolve defines a function that captures the
+	// immutable reference for a template source.
+	Resolve(context.Context, *api.Repo, *api.User, *Source, string) (string, error)
+
 	// Template defines a function that captures the
 	// templated pipeline configuration from a repo.
 	Template(context.Context, *api.Repo, *api.User, *Source, string) ([]byte, error)
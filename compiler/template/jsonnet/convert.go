@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/compiler/types/raw"
+)
+
+// convertTemplateVars takes template variables and converts
+// them to Jsonnet code for template reference.
+//
+// Example Usage within template: std.extVar("vars").message = "Hello, World!"
+func convertTemplateVars(m map[string]any) (string, error) {
+	if m == nil {
+		m = make(map[string]any)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("unable to convert template variables: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// convertPlatformVars takes the platform injected variables
+// within the step environment block and converts them to
+// Jsonnet code for template reference.
+//
+// Example Usage within template: std.extVar("vela").build.number = "1"
+func convertPlatformVars(slice raw.StringSliceMap, name string) (string, error) {
+	build := make(map[string]string)
+	deployment := make(map[string]string)
+	repo := make(map[string]string)
+	user := make(map[string]string)
+	system := map[string]string{
+		"template_name": name,
+	}
+
+	// iterate through the list of key/value pairs provided
+	for key, value := range slice {
+		// lowercase the key
+		key = strings.ToLower(key)
+
+		// check if the key has a 'deployment_parameter_*' prefix
+		if after, ok := strings.CutPrefix(key, "deployment_parameter_"); ok {
+			deployment[after] = value
+
+			continue
+		}
+
+		// check if the key has a 'vela_*' prefix
+		after, ok := strings.CutPrefix(key, "vela_")
+		if !ok {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(after, "build_"):
+			build[strings.TrimPrefix(after, "build_")] = value
+		case strings.HasPrefix(after, "repo_"):
+			repo[strings.TrimPrefix(after, "repo_")] = value
+		case strings.HasPrefix(after, "user_"):
+			user[strings.TrimPrefix(after, "user_")] = value
+		default:
+			system[after] = value
+		}
+	}
+
+	data, err := json.Marshal(map[string]map[string]string{
+		"build":      build,
+		"deployment": deployment,
+		"repo":       repo,
+		"user":       user,
+		"system":     system,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to convert platform variables: %w", err)
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-vela/server/compiler/types/raw"
+)
+
+func TestJsonnet_convertPlatformVars(t *testing.T) {
+	tests := []struct {
+		name         string
+		slice        raw.StringSliceMap
+		templateName string
+		want         map[string]map[string]string
+	}{
+		{
+			name: "with all vela prefixed vars",
+			slice: raw.StringSliceMap{
+				"VELA_BUILD_AUTHOR":           "octocat",
+				"VELA_REPO_FULL_NAME":         "go-vela/hello-world",
+				"VELA_USER_ADMIN":             "true",
+				"VELA_WORKSPACE":              "/vela/src/github.com/go-vela/hello-world",
+				"DEPLOYMENT_PARAMETER_TARGET": "production",
+				"FOO":                         "bar",
+			},
+			templateName: "foo",
+			want: map[string]map[string]string{
+				"build":      {"author": "octocat"},
+				"deployment": {"target": "production"},
+				"repo":       {"full_name": "go-vela/hello-world"},
+				"user":       {"admin": "true"},
+				"system":     {"template_name": "foo", "workspace": "/vela/src/github.com/go-vela/hello-world"},
+			},
+		},
+		{
+			name:         "with no vars",
+			slice:        raw.StringSliceMap{},
+			templateName: "foo",
+			want: map[string]map[string]string{
+				"build":      {},
+				"deployment": {},
+				"repo":       {},
+				"user":       {},
+				"system":     {"template_name": "foo"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := convertPlatformVars(tt.slice, tt.templateName)
+			if err != nil {
+				t.Errorf("convertPlatformVars() error = %v", err)
+			}
+
+			got := make(map[string]map[string]string)
+
+			err = json.Unmarshal([]byte(code), &got)
+			if err != nil {
+				t.Errorf("unable to unmarshal platform vars: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("convertPlatformVars() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJsonnet_convertTemplateVars(t *testing.T) {
+	got, err := convertTemplateVars(map[string]any{"tags": []any{"unit"}, "count": 1})
+	if err != nil {
+		t.Errorf("convertTemplateVars() error = %v", err)
+	}
+
+	if want := `{"count":1,"tags":["unit"]}`; got != want {
+		t.Errorf("convertTemplateVars() is %v, want %v", got, want)
+	}
+
+	got, err = convertTemplateVars(nil)
+	if err != nil {
+		t.Errorf("convertTemplateVars() error = %v", err)
+	}
+
+	if want := `{}`; got != want {
+		t.Errorf("convertTemplateVars() is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonnet provides the ability for Vela to
+// render a templated Jsonnet configuration into an
+// executable pipeline.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/compiler/template/jsonnet"
+package jsonnet
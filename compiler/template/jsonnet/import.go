@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/google/go-jsonnet"
+)
+
+// LoadFunc captures the content of a Jsonnet file
+// from the same source as the template being rendered.
+type LoadFunc func(path string) ([]byte, error)
+
+// importer resolves import statements within a template
+// against files from the same source as the template.
+type importer struct {
+	mu     sync.Mutex
+	closed bool
+	load   LoadFunc
+	cache  map[string]jsonnet.Contents
+}
+
+// newImporter creates an importer from the provided load function.
+func newImporter(load LoadFunc) *importer {
+	return &importer{
+		load:  load,
+		cache: make(map[string]jsonnet.Contents),
+	}
+}
+
+// Import captures the file imported by a template, resolved
+// relative to the file that contains the import statement.
+func (i *importer) Import(importedFrom, importedPath string) (jsonnet.Contents, string, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	// an abandoned evaluation must not load files once the caller moved on
+	if i.closed {
+		return jsonnet.Contents{}, "", fmt.Errorf("unable to import %s: %w", importedPath, ErrExecTimeout)
+	}
+
+	if i.load == nil {
+		return jsonnet.Contents{}, "", fmt.Errorf("unable to import %s: importing files is not supported for this template", importedPath)
+	}
+
+	if path.IsAbs(importedPath) {
+		return jsonnet.Contents{}, "", fmt.Errorf("unable to import %s: must be a path relative to the template", importedPath)
+	}
+
+	foundAt := path.Join(path.Dir(importedFrom), importedPath)
+
+	// the same contents must be returned for every import of a file
+	if contents, ok := i.cache[foundAt]; ok {
+		return contents, foundAt, nil
+	}
+
+	data, err := i.load(foundAt)
+	if err != nil {
+		return jsonnet.Contents{}, "", fmt.Errorf("unable to import %s: %w", importedPath, err)
+	}
+
+	contents := jsonnet.MakeContentsRaw(data)
+
+	i.cache[foundAt] = contents
+
+	return contents, foundAt, nil
+}
+
+// close stops the importer from loading any more files,
+// waiting for a file being loaded to be captured first.
+func (i *importer) close() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.closed = true
+}
@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/go-jsonnet"
+
+	"github.com/go-vela/server/compiler/types/raw"
+	types "github.com/go-vela/server/compiler/types/yaml"
+	"github.com/go-vela/server/internal"
+)
+
+// ErrInvalidPipelineReturn defines the error type when the
+// output is not a pipeline within the provided template.
+var ErrInvalidPipelineReturn = errors.New("invalid pipeline return in template")
+
+// ErrExecTimeout defines the error type when the
+// template does not finish within the execution timeout.
+var ErrExecTimeout = errors.New("template execution timed out")
+
+// MaxStack defines the maximum depth of the call
+// stack while evaluating a template.
+const MaxStack = 200
+
+// DefaultExecTimeout defines the maximum amount of time spent
+// evaluating a template when no timeout is provided.
+const DefaultExecTimeout = 30 * time.Second
+
+// MaxAbandoned defines the maximum number of evaluations exceeding
+// the timeout still running before new templates are refused.
+const MaxAbandoned = 8
+
+// abandoned tracks the evaluations exceeding
+// the timeout that are still running.
+var abandoned atomic.Int64
+
+// Render combines the template with the step in the yaml pipeline.
+//
+// The load function captures files imported by the template from
+// the same source and may be nil to disable importing files.
+//
+//nolint:lll // ignore function length due to input args
+func Render(tmpl string, name string, tName string, environment raw.StringSliceMap, variables map[string]any, timeout time.Duration, load LoadFunc) (*types.Build, []string, error) {
+	config, warnings, err := render(tmpl, name, tName, environment, variables, timeout, load)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ensure all templated steps have template prefix
+	for index, newStep := range config.Steps {
+		config.Steps[index].Name = fmt.Sprintf("%s_%s", name, newStep.Name)
+	}
+
+	return &types.Build{
+			Steps:       config.Steps,
+			Secrets:     config.Secrets,
+			Services:    config.Services,
+			Environment: config.Environment,
+			Deployment:  config.Deployment,
+		},
+		warnings,
+		nil
+}
+
+// RenderBuild renders the templated build.
+//
+//nolint:lll // ignore function length due to input args
+func RenderBuild(tmpl string, b string, envs map[string]string, variables map[string]any, timeout time.Duration, load LoadFunc) (*types.Build, []string, error) {
+	return render(b, tmpl, "", envs, variables, timeout, load)
+}
+
+// render is a helper function to evaluate the template
+// with the platform and user provided variables.
+//
+// Within the template, the variables are available as
+// std.extVar("vela") and std.extVar("vars"). When the
+// template is a function, they are also provided as the
+// ctx argument, i.e. function(ctx) ctx.vela.build.branch.
+//
+// A zero timeout evaluates the template with the default timeout.
+//
+//nolint:lll // ignore function length due to input args
+func render(tmpl string, name string, tName string, environment raw.StringSliceMap, variables map[string]any, timeout time.Duration, load LoadFunc) (*types.Build, []string, error) {
+	// load the user provided vars into jsonnet code
+	userVars, err := convertTemplateVars(variables)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// load the platform provided vars into jsonnet code
+	velaVars, err := convertPlatformVars(environment, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vm := jsonnet.MakeVM()
+
+	// bound the recursion allowed within the template
+	vm.MaxStack = MaxStack
+
+	// always replace the default importer so templates can't read from the server
+	imp := newImporter(load)
+
+	vm.Importer(imp)
+
+	vm.ExtCode("vela", velaVars)
+	vm.ExtCode("vars", userVars)
+	vm.TLACode("ctx", fmt.Sprintf(`{"vela": %s, "vars": %s}`, velaVars, userVars))
+
+	if timeout <= 0 {
+		timeout = DefaultExecTimeout
+	}
+
+	out, err := evaluate(vm, imp, tmpl, timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	// extract the pipeline from the jsonnet output
+	switch out := bytes.TrimSpace([]byte(out)); {
+	case bytes.HasPrefix(out, []byte("[")):
+		var items []json.RawMessage
+
+		err = json.Unmarshal(out, &items)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, item := range items {
+			buf.WriteString("---\n")
+			buf.Write(item)
+			buf.WriteString("\n")
+		}
+	case bytes.HasPrefix(out, []byte("{")):
+		buf.WriteString("---\n")
+		buf.Write(out)
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidPipelineReturn, out)
+	}
+
+	// unmarshal the template to the pipeline
+	config, warnings, err := internal.ParseYAML(buf.Bytes(), tName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to unmarshal yaml: %w", err)
+	}
+
+	return config, warnings, nil
+}
+
+// evaluate is a helper function to evaluate the template
+// with the vm, bounded by the execution timeout.
+//
+// The jsonnet vm can't be interrupted, so an evaluation
+// exceeding the timeout is abandoned and left to finish
+// in the background while the caller moves on. The importer
+// is closed so the abandoned evaluation can't load any more
+// files, and new templates are refused while too many
+// abandoned evaluations are still running.
+func evaluate(vm *jsonnet.VM, imp *importer, tmpl string, timeout time.Duration) (string, error) {
+	if abandoned.Load() >= MaxAbandoned {
+		return "", fmt.Errorf("%w: too many templates still running", ErrExecTimeout)
+	}
+
+	type result struct {
+		out string
+		err error
+	}
+
+	// buffer the channel so an abandoned evaluation doesn't block
+	done := make(chan result, 1)
+
+	// finished is claimed by whichever of the evaluation
+	// and the timeout happens first
+	var finished atomic.Bool
+
+	go func() {
+		out, err := vm.EvaluateAnonymousSnippet("templated-base", tmpl)
+
+		// the caller abandoned the evaluation
+		if !finished.CompareAndSwap(false, true) {
+			abandoned.Add(-1)
+		}
+
+		done <- result{out: out, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		return r.out, r.err
+	case <-timer.C:
+		abandoned.Add(1)
+
+		// the evaluation finished as the timeout expired
+		if !finished.CompareAndSwap(false, true) {
+			abandoned.Add(-1)
+
+			r := <-done
+
+			return r.out, r.err
+		}
+
+		imp.close()
+
+		return "", fmt.Errorf("%w after %s", ErrExecTimeout, timeout)
+	}
+}
@@ -0,0 +1,274 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonnet
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	goyaml "go.yaml.in/yaml/v3"
+
+	"github.com/go-vela/server/compiler/types/raw"
+	"github.com/go-vela/server/compiler/types/yaml"
+)
+
+func TestJsonnet_Render(t *testing.T) {
+	type args struct {
+		velaFile    string
+		jsonnetFile string
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		wantFile string
+		wantErr  bool
+	}{
+		{
+			name: "basic",
+			args: args{
+				velaFile:    "testdata/step/basic/step.yml",
+				jsonnetFile: "testdata/step/basic/template.jsonnet",
+			},
+			wantFile: "testdata/step/basic/want.yml",
+			wantErr:  false,
+		},
+		{
+			name: "platform vars",
+			args: args{
+				velaFile:    "testdata/step/with_vars_plat/step.yml",
+				jsonnetFile: "testdata/step/with_vars_plat/template.jsonnet",
+			},
+			wantFile: "testdata/step/with_vars_plat/want.yml",
+			wantErr:  false,
+		},
+		{
+			name: "with import",
+			args: args{
+				velaFile:    "testdata/step/with_import/step.yml",
+				jsonnetFile: "testdata/step/with_import/template.jsonnet",
+			},
+			wantFile: "testdata/step/with_import/want.yml",
+			wantErr:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sFile, err := os.ReadFile(tt.args.velaFile)
+			if err != nil {
+				t.Error(err)
+			}
+
+			b := &yaml.Build{}
+
+			err = goyaml.Unmarshal(sFile, b)
+			if err != nil {
+				t.Error(err)
+			}
+
+			b.Steps[0].Environment = raw.StringSliceMap{
+				"VELA_REPO_FULL_NAME": "octocat/hello-world",
+			}
+
+			tmpl, err := os.ReadFile(tt.args.jsonnetFile)
+			if err != nil {
+				t.Error(err)
+			}
+
+			tmplBuild, _, err := Render(string(tmpl), b.Steps[0].Name, b.Steps[0].Template.Name, b.Steps[0].Environment, b.Steps[0].Template.Variables, 0, testLoad(tt.args.jsonnetFile))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Render() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != true {
+				wFile, err := os.ReadFile(tt.wantFile)
+				if err != nil {
+					t.Error(err)
+				}
+
+				w := &yaml.Build{}
+
+				err = goyaml.Unmarshal(wFile, w)
+				if err != nil {
+					t.Error(err)
+				}
+
+				if diff := cmp.Diff(w.Steps, tmplBuild.Steps); diff != "" {
+					t.Errorf("Render() mismatch (-want +got):\n%s", diff)
+				}
+
+				if diff := cmp.Diff(w.Environment, tmplBuild.Environment); diff != "" {
+					t.Errorf("Render() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestJsonnet_RenderBuild(t *testing.T) {
+	type args struct {
+		velaFile string
+		tmpl     string
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		wantFile string
+		wantErr  bool
+	}{
+		{
+			name: "steps",
+			args: args{
+				velaFile: "testdata/build/basic/build.jsonnet",
+			},
+			wantFile: "testdata/build/basic/want.yml",
+			wantErr:  false,
+		},
+		{
+			name: "stages, with function",
+			args: args{
+				velaFile: "testdata/build/function/build.jsonnet",
+			},
+			wantFile: "testdata/build/function/want.yml",
+			wantErr:  false,
+		},
+		{
+			name: "invalid return",
+			args: args{
+				tmpl: `"foo"`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "syntax error",
+			args: args{
+				tmpl: `{ version: }`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "import without load function",
+			args: args{
+				tmpl: `import "/etc/passwd"`,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := tt.args.tmpl
+
+			if len(tt.args.velaFile) > 0 {
+				sFile, err := os.ReadFile(tt.args.velaFile)
+				if err != nil {
+					t.Error(err)
+				}
+
+				tmpl = string(sFile)
+			}
+
+			got, _, err := RenderBuild("build", tmpl, map[string]string{
+				"VELA_REPO_FULL_NAME": "octocat/hello-world",
+				"VELA_BUILD_BRANCH":   "main",
+				"VELA_REPO_ORG":       "octocat",
+			}, map[string]any{"message": "hello"}, 0, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RenderBuild() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr != true {
+				wFile, err := os.ReadFile(tt.wantFile)
+				if err != nil {
+					t.Error(err)
+				}
+
+				want := &yaml.Build{}
+
+				err = goyaml.Unmarshal(wFile, want)
+				if err != nil {
+					t.Error(err)
+				}
+
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("RenderBuild() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestJsonnet_RenderBuild_Limits(t *testing.T) {
+	tests := []struct {
+		name    string
+		tmpl    string
+		wantErr error
+	}{
+		{
+			name: "unbounded recursion",
+			tmpl: `local f(n) = 1 + f(n + 1); { version: "1", steps: [{ name: "test", image: "alpine", commands: [std.toString(f(0))] }] }`,
+		},
+		{
+			name:    "exceeds timeout",
+			tmpl:    `{ version: "1", steps: [{ name: "test", image: "alpine", commands: [std.toString(std.foldl(function(a, b) a + b, std.range(1, 5000000), 0))] }] }`,
+			wantErr: ErrExecTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := RenderBuild("build", tt.tmpl, map[string]string{}, map[string]any{}, 50*time.Millisecond, nil)
+			if err == nil {
+				t.Fatalf("RenderBuild() should have returned err")
+			}
+
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("RenderBuild() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJsonnet_Importer_Close(t *testing.T) {
+	// setup types
+	loads := 0
+
+	imp := newImporter(func(string) ([]byte, error) {
+		loads++
+
+		return []byte(`{}`), nil
+	})
+
+	_, _, err := imp.Import("templated-base", "lib.libsonnet")
+	if err != nil {
+		t.Fatalf("Import returned err: %v", err)
+	}
+
+	// an abandoned evaluation can't load any more files
+	imp.close()
+
+	_, _, err = imp.Import("templated-base", "other.libsonnet")
+	if !errors.Is(err, ErrExecTimeout) {
+		t.Errorf("Import after close error = %v, want %v", err, ErrExecTimeout)
+	}
+
+	if loads != 1 {
+		t.Errorf("loaded %d files, want 1", loads)
+	}
+}
+
+// testLoad returns a load function that captures
+// files relative to the directory of the template.
+func testLoad(tmpl string) LoadFunc {
+	return func(path string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(filepath.Dir(tmpl), path))
+	}
+}
@@ -0,0 +1,9 @@
+local image = import 'image.libsonnet';
+
+{
+  step(tag):: {
+    name: tag,
+    image: image,
+    commands: ['go test -tags %s ./...' % tag],
+  },
+}
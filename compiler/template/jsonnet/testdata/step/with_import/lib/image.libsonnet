@@ -0,0 +1 @@
+'golang:1.22'
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package starlark
+
+import (
+	"fmt"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+)
+
+// LoadFunc captures the content of a Starlark file
+// from the same source as the template being rendered.
+type LoadFunc func(path string) ([]byte, error)
+
+// loadEntry stores the result of loading a module
+// so each module is only executed once per render.
+type loadEntry struct {
+	globals starlark.StringDict
+	err     error
+}
+
+// newThread creates a Starlark thread limited to the provided number
+// of execution steps that resolves load() statements against the
+// standard library modules and the provided load function.
+//
+// Loaded files execute on the same thread, so their execution
+// steps count toward the limit of the template.
+func newThread(name string, limit int64, load LoadFunc) (*starlark.Thread, error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("starlark exec limit must be non-negative")
+	}
+
+	thread := &starlark.Thread{Name: name}
+
+	thread.SetMaxExecutionSteps(uint64(limit))
+
+	cache := make(map[string]*loadEntry)
+
+	thread.Load = func(thread *starlark.Thread, module string) (starlark.StringDict, error) {
+		// standard library modules are exposed under their own name
+		if m, ok := modules[module]; ok {
+			return starlark.StringDict{module: m}, nil
+		}
+
+		e, ok := cache[module]
+		if ok {
+			// a nil entry indicates the module is still being loaded
+			if e == nil {
+				return nil, fmt.Errorf("cycle in load graph for %s", module)
+			}
+
+			return e.globals, e.err
+		}
+
+		if load == nil {
+			return nil, fmt.Errorf("unable to load %s: loading files is not supported for this template", module)
+		}
+
+		// mark the module as being loaded
+		cache[module] = nil
+
+		data, err := load(module)
+		if err != nil {
+			err = fmt.Errorf("unable to load %s: %w", module, err)
+		} else {
+			var globals starlark.StringDict
+
+			globals, err = starlark.ExecFileOptions(syntax.LegacyFileOptions(), thread, module, data, predeclared())
+
+			e = &loadEntry{globals: globals, err: err}
+		}
+
+		if e == nil {
+			e = &loadEntry{err: err}
+		}
+
+		cache[module] = e
+
+		return e.globals, e.err
+	}
+
+	return thread, nil
+}
+
+// predeclared returns the values available to every template without load().
+func predeclared() starlark.StringDict {
+	return starlark.StringDict{"struct": starlark.NewBuiltin("struct", starlarkstruct.Make)}
+}
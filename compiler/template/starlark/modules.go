@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package starlark
+
+import (
+	"bytes"
+	"crypto/md5"  //nolint:gosec // md5 is offered for checksums, not security
+	"crypto/sha1" //nolint:gosec // sha1 is offered for checksums, not security
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"regexp"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/ghodss/yaml"
+	"go.starlark.net/lib/json"
+	"go.starlark.net/lib/time"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	goyaml "go.yaml.in/yaml/v3"
+)
+
+// modules contains the standard library modules that
+// templates are able to import with load(), i.e.
+//
+//	load("json", "json")
+//	load("semver", "semver").
+var modules = map[string]*starlarkstruct.Module{
+	"json":    json.Module,
+	"time":    time.Module,
+	"yaml":    yamlModule,
+	"re":      reModule,
+	"hashlib": hashlibModule,
+	"semver":  semverModule,
+}
+
+// yamlModule provides functions to encode and decode YAML documents.
+var yamlModule = &starlarkstruct.Module{
+	Name: "yaml",
+	Members: starlark.StringDict{
+		"decode": starlark.NewBuiltin("yaml.decode", yamlDecode),
+		"encode": starlark.NewBuiltin("yaml.encode", yamlEncode),
+	},
+}
+
+// reModule provides functions to match strings with regular expressions.
+var reModule = &starlarkstruct.Module{
+	Name: "re",
+	Members: starlark.StringDict{
+		"match":   starlark.NewBuiltin("re.match", reMatch),
+		"find":    starlark.NewBuiltin("re.find", reFind),
+		"findall": starlark.NewBuiltin("re.findall", reFindAll),
+		"sub":     starlark.NewBuiltin("re.sub", reSub),
+		"split":   starlark.NewBuiltin("re.split", reSplit),
+	},
+}
+
+// hashlibModule provides functions to compute hex encoded digests of strings.
+var hashlibModule = &starlarkstruct.Module{
+	Name: "hashlib",
+	Members: starlark.StringDict{
+		"md5":    hashBuiltin("hashlib.md5", md5.New),
+		"sha1":   hashBuiltin("hashlib.sha1", sha1.New),
+		"sha256": hashBuiltin("hashlib.sha256", sha256.New),
+		"sha512": hashBuiltin("hashlib.sha512", sha512.New),
+	},
+}
+
+// semverModule provides functions to parse and compare semantic versions.
+var semverModule = &starlarkstruct.Module{
+	Name: "semver",
+	Members: starlark.StringDict{
+		"parse":     starlark.NewBuiltin("semver.parse", semverParse),
+		"compare":   starlark.NewBuiltin("semver.compare", semverCompare),
+		"satisfies": starlark.NewBuiltin("semver.satisfies", semverSatisfies),
+	},
+}
+
+// yamlDecode parses a YAML document into Starlark values.
+func yamlDecode(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var s string
+
+	err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 1, &s)
+	if err != nil {
+		return nil, err
+	}
+
+	var v any
+
+	err = goyaml.Unmarshal([]byte(s), &v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	return toStarlark(v)
+}
+
+// yamlEncode renders a Starlark value as a YAML document.
+func yamlEncode(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var v starlark.Value
+
+	err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 1, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	err = writeJSON(buf, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	out, err := yaml.JSONToYAML(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	return starlark.String(out), nil
+}
+
+// unpackPattern is a helper function to capture and compile the
+// pattern along with the string from the provided arguments.
+func unpackPattern(fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (*regexp.Regexp, string, error) {
+	var pattern, s string
+
+	err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 2, &pattern, &s)
+	if err != nil {
+		return nil, "", err
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	return re, s, nil
+}
+
+// reMatch reports whether the string contains any match of the pattern.
+func reMatch(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	re, s, err := unpackPattern(fn, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	return starlark.Bool(re.MatchString(s)), nil
+}
+
+// reFind returns the first match of the pattern, or None when there is no match.
+func reFind(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	re, s, err := unpackPattern(fn, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return starlark.None, nil
+	}
+
+	return starlark.String(s[loc[0]:loc[1]]), nil
+}
+
+// reFindAll returns all matches of the pattern.
+func reFindAll(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	re, s, err := unpackPattern(fn, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []starlark.Value{}
+
+	for _, match := range re.FindAllString(s, -1) {
+		matches = append(matches, starlark.String(match))
+	}
+
+	return starlark.NewList(matches), nil
+}
+
+// reSub replaces all matches of the pattern with the replacement.
+func reSub(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern, repl, s string
+
+	err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 3, &pattern, &repl, &s)
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	return starlark.String(re.ReplaceAllString(s, repl)), nil
+}
+
+// reSplit splits the string around the matches of the pattern.
+func reSplit(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	re, s, err := unpackPattern(fn, args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := []starlark.Value{}
+
+	for _, part := range re.Split(s, -1) {
+		parts = append(parts, starlark.String(part))
+	}
+
+	return starlark.NewList(parts), nil
+}
+
+// hashBuiltin returns a builtin that computes the hex encoded digest of a string.
+func hashBuiltin(name string, h func() hash.Hash) *starlark.Builtin {
+	return starlark.NewBuiltin(name, func(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var s string
+
+		err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 1, &s)
+		if err != nil {
+			return nil, err
+		}
+
+		sum := h()
+		sum.Write([]byte(s))
+
+		return starlark.String(hex.EncodeToString(sum.Sum(nil))), nil
+	})
+}
+
+// semverParse parses a semantic version into a struct of its components.
+func semverParse(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var s string
+
+	err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 1, &s)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := semver.NewVersion(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"major":      starlark.MakeUint64(v.Major()),
+		"minor":      starlark.MakeUint64(v.Minor()),
+		"patch":      starlark.MakeUint64(v.Patch()),
+		"prerelease": starlark.String(v.Prerelease()),
+		"metadata":   starlark.String(v.Metadata()),
+		"version":    starlark.String(v.String()),
+	}), nil
+}
+
+// semverCompare returns -1, 0 or 1 when the first version is
+// less than, equal to or greater than the second version.
+func semverCompare(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var a, b string
+
+	err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 2, &a, &b)
+	if err != nil {
+		return nil, err
+	}
+
+	va, err := semver.NewVersion(a)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	vb, err := semver.NewVersion(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	return starlark.MakeInt(va.Compare(vb)), nil
+}
+
+// semverSatisfies reports whether the version satisfies the constraint, i.e. ">= 1.2, < 2".
+func semverSatisfies(_ *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var s, constraint string
+
+	err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 2, &s, &constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := semver.NewVersion(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn.Name(), err)
+	}
+
+	return starlark.Bool(c.Check(v)), nil
+}
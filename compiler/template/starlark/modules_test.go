@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package starlark
+
+import (
+	"testing"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+func TestStarlark_Modules(t *testing.T) {
+	tests := []struct {
+		name    string
+		module  string
+		expr    string
+		want    string
+		wantErr bool
+	}{
+		{"json encode", "json", `json.encode({"foo": [1, 2]})`, `"{\"foo\":[1,2]}"`, false},
+		{"json decode", "json", `json.decode('{"foo": "bar"}')["foo"]`, `"bar"`, false},
+		{"yaml decode", "yaml", `yaml.decode("foo:\n  - bar\n")["foo"][0]`, `"bar"`, false},
+		{"yaml encode", "yaml", `yaml.encode({"foo": ["bar"]})`, `"foo:\n- bar\n"`, false},
+		{"yaml decode invalid", "yaml", `yaml.decode("foo: [")`, "", true},
+		{"re match", "re", `re.match("^v[0-9]+", "v12")`, "True", false},
+		{"re find", "re", `re.find("[0-9]+", "abc123def")`, `"123"`, false},
+		{"re find no match", "re", `re.find("[0-9]+", "abc")`, "None", false},
+		{"re findall", "re", `re.findall("[0-9]", "a1b2")`, `["1", "2"]`, false},
+		{"re sub", "re", `re.sub("o", "0", "foo")`, `"f00"`, false},
+		{"re split", "re", `re.split(",\\s*", "a, b,c")`, `["a", "b", "c"]`, false},
+		{"re invalid pattern", "re", `re.match("(", "foo")`, "", true},
+		{"hashlib md5", "hashlib", `hashlib.md5("foo")`, `"acbd18db4cc2f85cedef654fccc4a4d8"`, false},
+		{"hashlib sha1", "hashlib", `hashlib.sha1("foo")`, `"0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"`, false},
+		{"hashlib sha256", "hashlib", `hashlib.sha256("foo")`, `"2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"`, false},
+		{"semver parse", "semver", `semver.parse("v1.2.3-rc.1").minor`, "2", false},
+		{"semver compare", "semver", `semver.compare("1.2.3", "1.10.0")`, "-1", false},
+		{"semver satisfies", "semver", `semver.satisfies("1.2.3", ">= 1.2, < 2")`, "True", false},
+		{"semver invalid", "semver", `semver.parse("foo")`, "", true},
+		{"time", "time", `time.parse_duration("1m").seconds`, "60.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thread, err := newThread(tt.name, 7500, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			src := "load(\"" + tt.module + "\", \"" + tt.module + "\")\nresult = " + tt.expr + "\n"
+
+			globals, err := starlark.ExecFileOptions(syntax.LegacyFileOptions(), thread, "test", src, predeclared())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("%s error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got := globals["result"].String(); got != tt.want {
+				t.Errorf("%s is %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
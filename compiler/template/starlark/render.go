@@ -8,7 +8,6 @@ import (
 	"fmt"
 
 	"go.starlark.net/starlark"
-	"go.starlark.net/starlarkstruct"
 	"go.starlark.net/syntax"
 
 	"github.com/go-vela/server/compiler/types/raw"
@@ -31,18 +30,18 @@ var (
 )
 
 // Render combines the template with the step in the yaml pipeline.
-func Render(tmpl string, name string, tName string, environment raw.StringSliceMap, variables map[string]any, limit int64) (*types.Build, []string, error) {
-	thread := &starlark.Thread{Name: name}
-
-	if limit < 0 {
-		return nil, nil, fmt.Errorf("starlark exec limit must be non-negative")
+//
+// The load function captures files imported with load() from the
+// same source as the template and may be nil to disable loading files.
+//
+//nolint:lll // ignore function length due to input args
+func Render(tmpl string, name string, tName string, environment raw.StringSliceMap, variables map[string]any, limit int64, load LoadFunc) (*types.Build, []string, error) {
+	thread, err := newThread(name, limit, load)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	thread.SetMaxExecutionSteps(uint64(limit))
-
-	predeclared := starlark.StringDict{"struct": starlark.NewBuiltin("struct", starlarkstruct.Make)}
-
-	globals, err := starlark.ExecFileOptions(syntax.LegacyFileOptions(), thread, "templated-base", tmpl, predeclared)
+	globals, err := starlark.ExecFileOptions(syntax.LegacyFileOptions(), thread, "templated-base", tmpl, predeclared())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -145,18 +144,13 @@ func Render(tmpl string, name string, tName string, environment raw.StringSliceM
 // RenderBuild renders the templated build.
 //
 //nolint:lll // ignore function length due to input args
-func RenderBuild(tmpl string, b string, envs map[string]string, variables map[string]any, limit int64) (*types.Build, []string, error) {
-	thread := &starlark.Thread{Name: "templated-base"}
-
-	if limit < 0 {
-		return nil, nil, fmt.Errorf("starlark exec limit must be non-negative")
+func RenderBuild(tmpl string, b string, envs map[string]string, variables map[string]any, limit int64, load LoadFunc) (*types.Build, []string, error) {
+	thread, err := newThread("templated-base", limit, load)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	thread.SetMaxExecutionSteps(uint64(limit))
-
-	predeclared := starlark.StringDict{"struct": starlark.NewBuiltin("struct", starlarkstruct.Make)}
-
-	globals, err := starlark.ExecFileOptions(syntax.LegacyFileOptions(), thread, "templated-base", b, predeclared)
+	globals, err := starlark.ExecFileOptions(syntax.LegacyFileOptions(), thread, "templated-base", b, predeclared())
 	if err != nil {
 		return nil, nil, err
 	}
@@ -4,6 +4,7 @@ package starlark
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -70,6 +71,24 @@ func TestStarlark_Render(t *testing.T) {
 			wantFile: "",
 			wantErr:  true,
 		},
+		{
+			name: "with load",
+			args: args{
+				velaFile:     "testdata/step/with_load/step.yml",
+				starlarkFile: "testdata/step/with_load/template.star",
+			},
+			wantFile: "testdata/step/with_load/want.yml",
+			wantErr:  false,
+		},
+		{
+			name: "load cycle",
+			args: args{
+				velaFile:     "testdata/step/load_cycle/step.yml",
+				starlarkFile: "testdata/step/load_cycle/template.star",
+			},
+			wantFile: "",
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -95,7 +114,7 @@ func TestStarlark_Render(t *testing.T) {
 				t.Error(err)
 			}
 
-			tmplBuild, _, err := Render(string(tmpl), b.Steps[0].Name, b.Steps[0].Template.Name, b.Steps[0].Environment, b.Steps[0].Template.Variables, 7500)
+			tmplBuild, _, err := Render(string(tmpl), b.Steps[0].Name, b.Steps[0].Template.Name, b.Steps[0].Environment, b.Steps[0].Template.Variables, 7500, testLoad(tt.args.starlarkFile))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Render() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -189,6 +208,15 @@ func TestNative_RenderBuild(t *testing.T) {
 			wantErr:   false,
 			execLimit: 7500,
 		},
+		{
+			name: "steps, with modules",
+			args: args{
+				velaFile: "testdata/build/with_modules/build.star",
+			},
+			wantFile:  "testdata/build/with_modules/want.yml",
+			wantErr:   false,
+			execLimit: 7500,
+		},
 		{
 			name: "large build - exec step limit good",
 			args: args{
@@ -220,7 +248,7 @@ func TestNative_RenderBuild(t *testing.T) {
 				"VELA_REPO_FULL_NAME": "octocat/hello-world",
 				"VELA_BUILD_BRANCH":   "main",
 				"VELA_REPO_ORG":       "octocat",
-			}, map[string]any{}, tt.execLimit)
+			}, map[string]any{}, tt.execLimit, testLoad(tt.args.velaFile))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("RenderBuild() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -246,3 +274,36 @@ func TestNative_RenderBuild(t *testing.T) {
 		})
 	}
 }
+
+func TestStarlark_Render_LoadExecLimit(t *testing.T) {
+	tmpl := `
+load("loop.star", "loop")
+
+def main(ctx):
+    loop()
+
+    return {'version': '1', 'steps': []}
+`
+
+	load := func(path string) ([]byte, error) {
+		return []byte("def loop():\n    for i in range(10000):\n        pass\n"), nil
+	}
+
+	_, _, err := Render(tmpl, "sample", "loop", raw.StringSliceMap{}, map[string]any{}, 7500, load)
+	if err == nil {
+		t.Errorf("Render() should have returned err for loaded file exceeding exec limit")
+	}
+
+	_, _, err = Render(tmpl, "sample", "loop", raw.StringSliceMap{}, map[string]any{}, 7500, nil)
+	if err == nil {
+		t.Errorf("Render() should have returned err for load without load function")
+	}
+}
+
+// testLoad returns a load function that captures
+// files relative to the directory of the template.
+func testLoad(tmpl string) LoadFunc {
+	return func(path string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(filepath.Dir(tmpl), path))
+	}
+}
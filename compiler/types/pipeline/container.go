@@ -55,6 +55,7 @@ type (
 		User        string            `json:"user,omitempty"        yaml:"user,omitempty"`
 		ReportAs    string            `json:"report_as,omitempty"   yaml:"report_as,omitempty"`
 		IDRequest   string            `json:"id_request,omitempty"  yaml:"id_request,omitempty"`
+		Generate    string            `json:"generate,omitempty"    yaml:"generate,omitempty"`
 		Git         *Git              `json:"git,omitempty"         yaml:"git,omitempty"`
 	}
 )
@@ -144,6 +145,7 @@ func (c *Container) Empty() bool {
 		len(c.User) == 0 &&
 		len(c.ReportAs) == 0 &&
 		len(c.IDRequest) == 0 &&
+		len(c.Generate) == 0 &&
 		reflect.DeepEqual(c.Artifacts, Artifacts{}) {
 		return true
 	}
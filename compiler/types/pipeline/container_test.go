@@ -838,6 +838,44 @@ func TestPipeline_Container_Execute(t *testing.T) {
 			},
 			want: true,
 		},
+		{ // status success container with compile time rules and build running
+			container: &Container{
+				Name:     "status-compile-time-running",
+				Image:    "alpine:latest",
+				Commands: []string{"echo \"Hey Vela\""},
+				Ruleset: Ruleset{
+					If: Rules{
+						Status:            []string{constants.StatusSuccess},
+						Message:           []string{"*deploy*"},
+						AuthorDomain:      []string{"example.com"},
+						ChangedFilesCount: "> 1",
+					},
+				},
+			},
+			ruleData: &RuleData{
+				Status: "running",
+			},
+			want: true,
+		},
+		{ // status success container with compile time rules and build failure
+			container: &Container{
+				Name:     "status-compile-time-failure",
+				Image:    "alpine:latest",
+				Commands: []string{"echo \"Hey Vela\""},
+				Ruleset: Ruleset{
+					If: Rules{
+						Status:            []string{constants.StatusSuccess},
+						Message:           []string{"*deploy*"},
+						AuthorDomain:      []string{"example.com"},
+						ChangedFilesCount: "> 1",
+					},
+				},
+			},
+			ruleData: &RuleData{
+				Status: "failure",
+			},
+			want: false,
+		},
 		{ // status with bad regexp
 			container: &Container{
 				Name:     "status-bad-regexp",
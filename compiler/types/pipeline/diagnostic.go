@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// DiagnosticError defines the severity for a diagnostic that fails the pipeline.
+	DiagnosticError = "error"
+
+	// DiagnosticWarning defines the severity for a diagnostic that does not fail the pipeline.
+	DiagnosticWarning = "warning"
+)
+
+type (
+	// Diagnostic is the pipeline representation
+	// of a problem found in the configuration.
+	//
+	// The path identifies the offending key within the
+	// configuration, i.e. stages.test.steps[0].image,
+	// and the line and column are its position in the
+	// source when known.
+	//
+	// swagger:model PipelineDiagnostic
+	Diagnostic struct {
+		Severity string `json:"severity"         yaml:"severity"`
+		Code     string `json:"code"             yaml:"code"`
+		Message  string `json:"message"          yaml:"message"`
+		Path     string `json:"path,omitempty"   yaml:"path,omitempty"`
+		Line     int    `json:"line,omitempty"   yaml:"line,omitempty"`
+		Column   int    `json:"column,omitempty" yaml:"column,omitempty"`
+	}
+
+	// Diagnostics is the pipeline representation
+	// of the problems found in the configuration.
+	Diagnostics []*Diagnostic
+)
+
+// Error implements the error interface for the Diagnostic type.
+func (d *Diagnostic) Error() string {
+	if d.Line > 0 {
+		return fmt.Sprintf("line %d: %s", d.Line, d.Message)
+	}
+
+	return d.Message
+}
+
+// Error implements the error interface for the Diagnostics type.
+func (d Diagnostics) Error() string {
+	errs := make([]error, 0, len(d))
+
+	for _, diag := range d {
+		errs = append(errs, diag)
+	}
+
+	return multierror.ListFormatFunc(errs)
+}
+
+// Errorf records a diagnostic with error severity
+// for the provided code and path.
+func (d *Diagnostics) Errorf(code, path, format string, args ...any) {
+	*d = append(*d, &Diagnostic{
+		Severity: DiagnosticError,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Path:     path,
+	})
+}
+
+// Warnf records a diagnostic with warning severity
+// for the provided code and path.
+func (d *Diagnostics) Warnf(code, path, format string, args ...any) {
+	*d = append(*d, &Diagnostic{
+		Severity: DiagnosticWarning,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Path:     path,
+	})
+}
+
+// Err returns the diagnostics as an error when any of
+// them has error severity, otherwise it returns nil.
+func (d Diagnostics) Err() error {
+	for _, diag := range d {
+		if diag.Severity == DiagnosticError {
+			return d
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPipeline_Diagnostics_Err(t *testing.T) {
+	// setup types
+	warnings := Diagnostics{}
+	warnings.Warnf("deprecated", "steps[0].pull", "pull: true is deprecated")
+
+	errs := Diagnostics{}
+	errs.Warnf("deprecated", "steps[0].pull", "pull: true is deprecated")
+	errs.Errorf("missing-image", "steps[0].image", "no image provided for step %s", "test")
+	errs[1].Line = 4
+
+	// run tests
+	if err := warnings.Err(); err != nil {
+		t.Errorf("Err for warnings is %v, want nil", err)
+	}
+
+	err := errs.Err()
+	if err == nil {
+		t.Fatalf("Err for errors is nil, want diagnostics")
+	}
+
+	var diags Diagnostics
+
+	if !errors.As(err, &diags) || len(diags) != 2 {
+		t.Errorf("Err is %v, want diagnostics", err)
+	}
+
+	want := "2 errors occurred:\n\t* pull: true is deprecated\n\t* line 4: no image provided for step test\n\n"
+
+	if err.Error() != want {
+		t.Errorf("Error is %q, want %q", err.Error(), want)
+	}
+}
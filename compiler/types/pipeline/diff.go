@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+const (
+	// DiffAdded defines the change for a resource only in the new pipeline.
+	DiffAdded = "added"
+
+	// DiffRemoved defines the change for a resource only in the old pipeline.
+	DiffRemoved = "removed"
+
+	// DiffChanged defines the change for a resource that differs between pipelines.
+	DiffChanged = "changed"
+)
+
+type (
+	// Diff is the semantic difference between two compiled pipelines.
+	//
+	// swagger:model PipelineDiff
+	Diff struct {
+		Steps       []*ContainerDiff `json:"steps,omitempty"                 yaml:"steps,omitempty"`
+		Services    []*ContainerDiff `json:"services,omitempty"              yaml:"services,omitempty"`
+		Secrets     []*SecretDiff    `json:"secrets,omitempty"               yaml:"secrets,omitempty"`
+		Environment []*KeyDiff       `json:"environment,omitempty"           yaml:"environment,omitempty"`
+		Escalations []string         `json:"privilege_escalations,omitempty" yaml:"privilege_escalations,omitempty"`
+	}
+
+	// ContainerDiff is the difference for a step or service between two pipelines.
+	ContainerDiff struct {
+		Name        string     `json:"name"                  yaml:"name"`
+		Stage       string     `json:"stage,omitempty"       yaml:"stage,omitempty"`
+		Change      string     `json:"change"                yaml:"change"`
+		Image       *ValueDiff `json:"image,omitempty"       yaml:"image,omitempty"`
+		Privileged  *ValueDiff `json:"privileged,omitempty"  yaml:"privileged,omitempty"`
+		Environment []*KeyDiff `json:"environment,omitempty" yaml:"environment,omitempty"`
+		Secrets     []*KeyDiff `json:"secrets,omitempty"     yaml:"secrets,omitempty"`
+		Fields      []string   `json:"fields,omitempty"      yaml:"fields,omitempty"`
+	}
+
+	// SecretDiff is the difference for a secret between two pipelines.
+	SecretDiff struct {
+		Name   string     `json:"name"             yaml:"name"`
+		Change string     `json:"change"           yaml:"change"`
+		Key    *ValueDiff `json:"key,omitempty"    yaml:"key,omitempty"`
+		Fields []string   `json:"fields,omitempty" yaml:"fields,omitempty"`
+	}
+
+	// KeyDiff is the difference for a key of a map between two pipelines.
+	KeyDiff struct {
+		Key    string `json:"key"            yaml:"key"`
+		Change string `json:"change"         yaml:"change"`
+		From   string `json:"from,omitempty" yaml:"from,omitempty"`
+		To     string `json:"to,omitempty"   yaml:"to,omitempty"`
+	}
+
+	// ValueDiff is the difference for a value between two pipelines.
+	ValueDiff struct {
+		From string `json:"from" yaml:"from"`
+		To   string `json:"to"   yaml:"to"`
+	}
+
+	// stagedContainer is a container along with the name of its stage.
+	stagedContainer struct {
+		stage     string
+		container *Container
+	}
+)
+
+// Empty returns true if there are no differences between the pipelines.
+func (d *Diff) Empty() bool {
+	return d == nil ||
+		(len(d.Steps) == 0 &&
+			len(d.Services) == 0 &&
+			len(d.Secrets) == 0 &&
+			len(d.Environment) == 0 &&
+			len(d.Escalations) == 0)
+}
+
+// Compare returns the semantic difference from one compiled pipeline to another.
+// Steps are matched by stage and name, while services and secrets are matched by name.
+func Compare(from, to *Build) *Diff {
+	if from == nil {
+		from = new(Build)
+	}
+
+	if to == nil {
+		to = new(Build)
+	}
+
+	d := new(Diff)
+
+	d.Steps = d.compareContainers("step", stagedContainers(from.Stages, from.Steps), stagedContainers(to.Stages, to.Steps))
+	d.Services = d.compareContainers("service", stagedContainers(nil, from.Services), stagedContainers(nil, to.Services))
+	d.Secrets = compareSecrets(from.Secrets, to.Secrets)
+	d.Environment = compareMaps(from.Environment, to.Environment)
+
+	return d
+}
+
+// stagedContainers is a helper function to flatten the steps of the stages and the containers.
+func stagedContainers(stages StageSlice, containers ContainerSlice) []stagedContainer {
+	flat := []stagedContainer{}
+
+	for _, stage := range stages {
+		for _, step := range stage.Steps {
+			flat = append(flat, stagedContainer{stage: stage.Name, container: step})
+		}
+	}
+
+	for _, container := range containers {
+		flat = append(flat, stagedContainer{container: container})
+	}
+
+	return flat
+}
+
+// compareContainers is a helper function to compare the containers of two pipelines
+// and record the privilege escalations introduced by the new containers.
+func (d *Diff) compareContainers(kind string, from, to []stagedContainer) []*ContainerDiff {
+	diffs := []*ContainerDiff{}
+
+	key := func(s stagedContainer) string {
+		return s.stage + "/" + s.container.Name
+	}
+
+	existing := make(map[string]*Container)
+
+	for _, s := range from {
+		existing[key(s)] = s.container
+	}
+
+	seen := make(map[string]bool)
+
+	for _, s := range to {
+		seen[key(s)] = true
+
+		old, ok := existing[key(s)]
+		if !ok {
+			diffs = append(diffs, &ContainerDiff{
+				Name:   s.container.Name,
+				Stage:  s.stage,
+				Change: DiffAdded,
+				Image:  &ValueDiff{To: s.container.Image},
+			})
+
+			d.escalations(kind, s, new(Container))
+
+			continue
+		}
+
+		if diff := compareContainer(old, s.container); diff != nil {
+			diff.Name = s.container.Name
+			diff.Stage = s.stage
+
+			diffs = append(diffs, diff)
+
+			d.escalations(kind, s, old)
+		}
+	}
+
+	for _, s := range from {
+		if !seen[key(s)] {
+			diffs = append(diffs, &ContainerDiff{
+				Name:   s.container.Name,
+				Stage:  s.stage,
+				Change: DiffRemoved,
+				Image:  &ValueDiff{From: s.container.Image},
+			})
+		}
+	}
+
+	return diffs
+}
+
+// compareContainer is a helper function to compare two versions of a container.
+// It returns nil when the containers do not differ.
+func compareContainer(from, to *Container) *ContainerDiff {
+	diff := &ContainerDiff{Change: DiffChanged}
+
+	if from.Image != to.Image {
+		diff.Image = &ValueDiff{From: from.Image, To: to.Image}
+	}
+
+	if from.Privileged != to.Privileged {
+		diff.Privileged = &ValueDiff{From: strconv.FormatBool(from.Privileged), To: strconv.FormatBool(to.Privileged)}
+	}
+
+	diff.Environment = compareMaps(from.Environment, to.Environment)
+	diff.Secrets = compareMaps(stepSecrets(from.Secrets), stepSecrets(to.Secrets))
+
+	// remaining fields are only reported by name
+	fields := []struct {
+		name     string
+		from, to any
+	}{
+		{"commands", from.Commands, to.Commands},
+		{"detach", from.Detach, to.Detach},
+		{"directory", from.Directory, to.Directory},
+		{"entrypoint", from.Entrypoint, to.Entrypoint},
+		{"needs", from.Needs, to.Needs},
+		{"networks", from.Networks, to.Networks},
+		{"ports", from.Ports, to.Ports},
+		{"pull", from.Pull, to.Pull},
+		{"ruleset", from.Ruleset, to.Ruleset},
+		{"artifacts", from.Artifacts, to.Artifacts},
+		{"ulimits", from.Ulimits, to.Ulimits},
+		{"volumes", from.Volumes, to.Volumes},
+		{"user", from.User, to.User},
+		{"report_as", from.ReportAs, to.ReportAs},
+		{"id_request", from.IDRequest, to.IDRequest},
+		{"generate", from.Generate, to.Generate},
+	}
+
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.from, field.to) {
+			diff.Fields = append(diff.Fields, field.name)
+		}
+	}
+
+	if diff.Image == nil &&
+		diff.Privileged == nil &&
+		len(diff.Environment) == 0 &&
+		len(diff.Secrets) == 0 &&
+		len(diff.Fields) == 0 {
+		return nil
+	}
+
+	return diff
+}
+
+// escalations is a helper function to record the privileges a
+// container gains compared to the previous version of the container.
+func (d *Diff) escalations(kind string, s stagedContainer, from *Container) {
+	name := s.container.Name
+	if len(s.stage) > 0 {
+		name = fmt.Sprintf("%s:%s", s.stage, name)
+	}
+
+	if s.container.Privileged && !from.Privileged {
+		d.Escalations = append(d.Escalations, fmt.Sprintf("%s %s runs privileged", kind, name))
+	}
+
+	if isRoot(s.container.User) && !isRoot(from.User) {
+		d.Escalations = append(d.Escalations, fmt.Sprintf("%s %s runs as root", kind, name))
+	}
+
+	for _, v := range s.container.Volumes {
+		mounted := slices.ContainsFunc(from.Volumes, func(o *Volume) bool {
+			return o.Source == v.Source
+		})
+
+		if !mounted {
+			d.Escalations = append(d.Escalations, fmt.Sprintf("%s %s mounts host path %s", kind, name, v.Source))
+		}
+	}
+}
+
+// isRoot is a helper function to check if a container user is root.
+func isRoot(user string) bool {
+	return user == "root" || user == "0" || user == "0:0"
+}
+
+// compareSecrets is a helper function to compare the secrets of two pipelines by name.
+func compareSecrets(from, to SecretSlice) []*SecretDiff {
+	diffs := []*SecretDiff{}
+
+	existing := make(map[string]*Secret)
+
+	for _, s := range from {
+		existing[s.Name] = s
+	}
+
+	seen := make(map[string]bool)
+
+	for _, s := range to {
+		seen[s.Name] = true
+
+		old, ok := existing[s.Name]
+		if !ok {
+			diffs = append(diffs, &SecretDiff{Name: s.Name, Change: DiffAdded, Key: &ValueDiff{To: s.Key}})
+
+			continue
+		}
+
+		diff := &SecretDiff{Name: s.Name, Change: DiffChanged}
+
+		if old.Key != s.Key {
+			diff.Key = &ValueDiff{From: old.Key, To: s.Key}
+		}
+
+		fields := []struct {
+			name     string
+			from, to any
+		}{
+			{"engine", old.Engine, s.Engine},
+			{"type", old.Type, s.Type},
+			{"pull", old.Pull, s.Pull},
+			{"origin", old.Origin, s.Origin},
+		}
+
+		for _, field := range fields {
+			if !reflect.DeepEqual(field.from, field.to) {
+				diff.Fields = append(diff.Fields, field.name)
+			}
+		}
+
+		if diff.Key != nil || len(diff.Fields) > 0 {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	for _, s := range from {
+		if !seen[s.Name] {
+			diffs = append(diffs, &SecretDiff{Name: s.Name, Change: DiffRemoved, Key: &ValueDiff{From: s.Key}})
+		}
+	}
+
+	return diffs
+}
+
+// stepSecrets is a helper function to map the target of each secret for a step to its source.
+func stepSecrets(secrets StepSecretSlice) map[string]string {
+	m := make(map[string]string)
+
+	for _, s := range secrets {
+		m[s.Target] = s.Source
+	}
+
+	return m
+}
+
+// compareMaps is a helper function to compare two maps sorted by key.
+func compareMaps(from, to map[string]string) []*KeyDiff {
+	diffs := []*KeyDiff{}
+
+	for k, v := range to {
+		old, ok := from[k]
+
+		switch {
+		case !ok:
+			diffs = append(diffs, &KeyDiff{Key: k, Change: DiffAdded, To: v})
+		case old != v:
+			diffs = append(diffs, &KeyDiff{Key: k, Change: DiffChanged, From: old, To: v})
+		}
+	}
+
+	for k, v := range from {
+		if _, ok := to[k]; !ok {
+			diffs = append(diffs, &KeyDiff{Key: k, Change: DiffRemoved, From: v})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+
+	return diffs
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPipeline_Compare(t *testing.T) {
+	// setup types
+	from := &Build{
+		Environment: map[string]string{"FOO": "bar", "REMOVED": "true"},
+		Stages: StageSlice{
+			{
+				Name: "test",
+				Steps: ContainerSlice{
+					{Name: "unit", Image: "golang:1.22", Commands: []string{"go test ./..."}},
+					{Name: "lint", Image: "golangci/golangci-lint:v1"},
+				},
+			},
+		},
+		Services: ContainerSlice{
+			{Name: "postgres", Image: "postgres:15"},
+		},
+		Secrets: SecretSlice{
+			{Name: "docker_password", Key: "org/repo/docker_password", Engine: "native", Type: "repo"},
+			{Name: "token", Key: "org/token", Engine: "native", Type: "org"},
+		},
+	}
+
+	to := &Build{
+		Environment: map[string]string{"FOO": "baz", "ADDED": "true"},
+		Stages: StageSlice{
+			{
+				Name: "test",
+				Steps: ContainerSlice{
+					{
+						Name:        "unit",
+						Image:       "golang:1.23",
+						Commands:    []string{"go test -race ./..."},
+						Environment: map[string]string{"CGO_ENABLED": "1"},
+						Secrets:     StepSecretSlice{{Source: "token", Target: "GITHUB_TOKEN"}},
+					},
+				},
+			},
+			{
+				Name: "publish",
+				Steps: ContainerSlice{
+					{
+						Name:       "docker",
+						Image:      "docker:dind",
+						Privileged: true,
+						User:       "root",
+						Volumes:    []*Volume{{Source: "/var/run/docker.sock", Destination: "/var/run/docker.sock"}},
+					},
+				},
+			},
+		},
+		Services: ContainerSlice{
+			{Name: "postgres", Image: "postgres:15"},
+		},
+		Secrets: SecretSlice{
+			{Name: "docker_password", Key: "org/repo/docker_password", Engine: "vault", Type: "repo"},
+			{Name: "token", Key: "org/token", Engine: "native", Type: "org"},
+			{Name: "npm_token", Key: "org/npm_token", Engine: "native", Type: "org"},
+		},
+	}
+
+	want := &Diff{
+		Steps: []*ContainerDiff{
+			{
+				Name:        "unit",
+				Stage:       "test",
+				Change:      DiffChanged,
+				Image:       &ValueDiff{From: "golang:1.22", To: "golang:1.23"},
+				Environment: []*KeyDiff{{Key: "CGO_ENABLED", Change: DiffAdded, To: "1"}},
+				Secrets:     []*KeyDiff{{Key: "GITHUB_TOKEN", Change: DiffAdded, To: "token"}},
+				Fields:      []string{"commands"},
+			},
+			{
+				Name:   "docker",
+				Stage:  "publish",
+				Change: DiffAdded,
+				Image:  &ValueDiff{To: "docker:dind"},
+			},
+			{
+				Name:   "lint",
+				Stage:  "test",
+				Change: DiffRemoved,
+				Image:  &ValueDiff{From: "golangci/golangci-lint:v1"},
+			},
+		},
+		Services: []*ContainerDiff{},
+		Secrets: []*SecretDiff{
+			{Name: "docker_password", Change: DiffChanged, Fields: []string{"engine"}},
+			{Name: "npm_token", Change: DiffAdded, Key: &ValueDiff{To: "org/npm_token"}},
+		},
+		Environment: []*KeyDiff{
+			{Key: "ADDED", Change: DiffAdded, To: "true"},
+			{Key: "FOO", Change: DiffChanged, From: "bar", To: "baz"},
+			{Key: "REMOVED", Change: DiffRemoved, From: "true"},
+		},
+		Escalations: []string{
+			"step publish:docker runs privileged",
+			"step publish:docker runs as root",
+			"step publish:docker mounts host path /var/run/docker.sock",
+		},
+	}
+
+	// run test
+	got := Compare(from, to)
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Compare() mismatch (-want +got):\n%s", diff)
+	}
+
+	if got.Empty() {
+		t.Errorf("Empty() is true, want false")
+	}
+}
+
+func TestPipeline_Compare_Escalations(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		from *Container
+		to   *Container
+		want []string
+	}{
+		{
+			name: "becomes privileged",
+			from: &Container{Name: "build", Image: "alpine"},
+			to:   &Container{Name: "build", Image: "alpine", Privileged: true},
+			want: []string{"step build runs privileged"},
+		},
+		{
+			name: "already privileged",
+			from: &Container{Name: "build", Image: "alpine", Privileged: true},
+			to:   &Container{Name: "build", Image: "alpine:3", Privileged: true},
+			want: nil,
+		},
+		{
+			name: "becomes root by uid",
+			from: &Container{Name: "build", Image: "alpine", User: "1000"},
+			to:   &Container{Name: "build", Image: "alpine", User: "0"},
+			want: []string{"step build runs as root"},
+		},
+		{
+			name: "mounts additional volume",
+			from: &Container{Name: "build", Image: "alpine", Volumes: []*Volume{{Source: "/tmp"}}},
+			to:   &Container{Name: "build", Image: "alpine", Volumes: []*Volume{{Source: "/tmp"}, {Source: "/etc"}}},
+			want: []string{"step build mounts host path /etc"},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Compare(&Build{Steps: ContainerSlice{test.from}}, &Build{Steps: ContainerSlice{test.to}})
+
+			if diff := cmp.Diff(test.want, got.Escalations); diff != "" {
+				t.Errorf("Compare() escalations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipeline_Compare_Empty(t *testing.T) {
+	// setup types
+	b := &Build{
+		Environment: map[string]string{"FOO": "bar"},
+		Steps: ContainerSlice{
+			{Name: "test", Image: "alpine", Commands: []string{"echo hello"}},
+		},
+		Secrets: SecretSlice{
+			{Name: "token", Key: "org/token", Engine: "native", Type: "org"},
+		},
+	}
+
+	// run test
+	got := Compare(b, b)
+
+	if !got.Empty() {
+		t.Errorf("Compare() is %v, want empty", got)
+	}
+
+	if !Compare(nil, nil).Empty() {
+		t.Errorf("Compare() of nil pipelines is not empty")
+	}
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/expr-lang/expr"
+)
+
+type (
+	// PolicyData is the data to check platform
+	// policy rules against for a compiled pipeline.
+	PolicyData struct {
+		Repo       PolicyRepo        `expr:"repo"`
+		Build      PolicyBuild       `expr:"build"`
+		Containers []PolicyContainer `expr:"containers"`
+	}
+
+	// PolicyRepo is the repo data exposed
+	// to policy rules for a compiled pipeline.
+	PolicyRepo struct {
+		Org          string `expr:"org"`
+		Name         string `expr:"name"`
+		FullName     string `expr:"full_name"`
+		Trusted      bool   `expr:"trusted"`
+		Visibility   string `expr:"visibility"`
+		ApproveBuild string `expr:"approve_build"`
+	}
+
+	// PolicyBuild is the build data exposed
+	// to policy rules for a compiled pipeline.
+	PolicyBuild struct {
+		Event  string `expr:"event"`
+		Branch string `expr:"branch"`
+		Tag    string `expr:"tag"`
+		Target string `expr:"target"`
+		Sender string `expr:"sender"`
+	}
+
+	// PolicyContainer is the container data exposed
+	// to policy rules for a compiled pipeline.
+	PolicyContainer struct {
+		Name       string   `expr:"name"`
+		Image      string   `expr:"image"`
+		Privileged bool     `expr:"privileged"`
+		Detach     bool     `expr:"detach"`
+		Pull       string   `expr:"pull"`
+		Secrets    []string `expr:"secrets"`
+	}
+)
+
+// ValidatePolicy verifies the policy rule compiles to a boolean expression.
+func ValidatePolicy(rule string) error {
+	_, err := expr.Compile(rule, expr.Env(PolicyData{}), expr.AsBool())
+	if err != nil {
+		return fmt.Errorf("failed to compile policy rule %s: %w", rule, err)
+	}
+
+	return nil
+}
+
+// Eval checks if the data for a compiled pipeline satisfies the policy rule.
+func (data *PolicyData) Eval(rule string) (bool, error) {
+	program, err := expr.Compile(rule, expr.Env(PolicyData{}), expr.AsBool())
+	if err != nil {
+		return false, fmt.Errorf("failed to compile policy rule %s: %w", rule, err)
+	}
+
+	result, err := expr.Run(program, *data)
+	if err != nil {
+		return false, fmt.Errorf("failed to run policy rule %s: %w", rule, err)
+	}
+
+	bResult, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("failed to parse policy rule %s: expected bool but got %v", rule, result)
+	}
+
+	return bResult, nil
+}
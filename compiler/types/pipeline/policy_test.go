@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"testing"
+)
+
+func TestPipeline_PolicyData_Eval(t *testing.T) {
+	// setup types
+	data := &PolicyData{
+		Repo: PolicyRepo{
+			Org:          "github",
+			Name:         "octocat",
+			FullName:     "github/octocat",
+			Visibility:   "public",
+			ApproveBuild: "fork-always",
+		},
+		Build: PolicyBuild{
+			Event:  "deployment",
+			Branch: "main",
+			Target: "production",
+			Sender: "octocat",
+		},
+		Containers: []PolicyContainer{
+			{Name: "build", Image: "golang:latest", Pull: "not_present"},
+			{Name: "publish", Image: "target/vela-docker:latest", Privileged: true, Secrets: []string{"docker_password"}},
+		},
+	}
+
+	// setup tests
+	tests := []struct {
+		rule    string
+		want    bool
+		wantErr bool
+	}{
+		{rule: `repo.trusted || none(containers, .privileged)`, want: false},
+		{rule: `all(containers, len(.secrets) == 0 || .image startsWith "target/")`, want: true},
+		{rule: `build.event != "deployment" || build.target != "production" || repo.approve_build == "always"`, want: false},
+		{rule: `repo.full_name == "github/octocat" && build.branch == "main"`, want: true},
+		{rule: `repo.org`, wantErr: true},
+		{rule: `foo == "bar"`, wantErr: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := data.Eval(test.rule)
+
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("Eval for %s should have returned err", test.rule)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Eval for %s returned err: %v", test.rule, err)
+		}
+
+		if got != test.want {
+			t.Errorf("Eval for %s is %v, want %v", test.rule, got, test.want)
+		}
+	}
+}
+
+func TestPipeline_ValidatePolicy(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rule    string
+		wantErr bool
+	}{
+		{rule: `repo.trusted || none(containers, .privileged)`},
+		{rule: `build.event == "push"`},
+		{rule: `build.event`, wantErr: true},
+		{rule: `containers[`, wantErr: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := ValidatePolicy(test.rule)
+
+		if test.wantErr && err == nil {
+			t.Errorf("ValidatePolicy for %s should have returned err", test.rule)
+		}
+
+		if !test.wantErr && err != nil {
+			t.Errorf("ValidatePolicy for %s returned err: %v", test.rule, err)
+		}
+	}
+}
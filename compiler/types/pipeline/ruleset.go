@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/expr-lang/expr"
@@ -32,20 +33,23 @@ type (
 	//
 	// swagger:model PipelineRules
 	Rules struct {
-		Branch   Ruletype `json:"branch,omitempty"   yaml:"branch,omitempty"`
-		Comment  Ruletype `json:"comment,omitempty"  yaml:"comment,omitempty"`
-		Event    Ruletype `json:"event,omitempty"    yaml:"event,omitempty"`
-		Path     Ruletype `json:"path,omitempty"     yaml:"path,omitempty"`
-		Repo     Ruletype `json:"repo,omitempty"     yaml:"repo,omitempty"`
-		Sender   Ruletype `json:"sender,omitempty"   yaml:"sender,omitempty"`
-		Status   Ruletype `json:"status,omitempty"   yaml:"status,omitempty"`
-		Tag      Ruletype `json:"tag,omitempty"      yaml:"tag,omitempty"`
-		Target   Ruletype `json:"target,omitempty"   yaml:"target,omitempty"`
-		Label    Ruletype `json:"label,omitempty"    yaml:"label,omitempty"`
-		Instance Ruletype `json:"instance,omitempty" yaml:"instance,omitempty"`
-		Eval     string   `json:"eval,omitempty"     yaml:"eval,omitempty"`
-		Operator string   `json:"operator,omitempty" yaml:"operator,omitempty"`
-		Matcher  string   `json:"matcher,omitempty"  yaml:"matcher,omitempty"`
+		Branch            Ruletype `json:"branch,omitempty"              yaml:"branch,omitempty"`
+		Comment           Ruletype `json:"comment,omitempty"             yaml:"comment,omitempty"`
+		Event             Ruletype `json:"event,omitempty"               yaml:"event,omitempty"`
+		Path              Ruletype `json:"path,omitempty"                yaml:"path,omitempty"`
+		Repo              Ruletype `json:"repo,omitempty"                yaml:"repo,omitempty"`
+		Sender            Ruletype `json:"sender,omitempty"              yaml:"sender,omitempty"`
+		Status            Ruletype `json:"status,omitempty"              yaml:"status,omitempty"`
+		Tag               Ruletype `json:"tag,omitempty"                 yaml:"tag,omitempty"`
+		Target            Ruletype `json:"target,omitempty"              yaml:"target,omitempty"`
+		Label             Ruletype `json:"label,omitempty"               yaml:"label,omitempty"`
+		Instance          Ruletype `json:"instance,omitempty"            yaml:"instance,omitempty"`
+		Message           Ruletype `json:"message,omitempty"             yaml:"message,omitempty"`
+		AuthorDomain      Ruletype `json:"author_domain,omitempty"       yaml:"author_domain,omitempty"`
+		ChangedFilesCount string   `json:"changed_files_count,omitempty" yaml:"changed_files_count,omitempty"`
+		Eval              string   `json:"eval,omitempty"                yaml:"eval,omitempty"`
+		Operator          string   `json:"operator,omitempty"            yaml:"operator,omitempty"`
+		Matcher           string   `json:"matcher,omitempty"             yaml:"matcher,omitempty"`
 	}
 
 	// Ruletype is the pipeline representation of an element
@@ -68,6 +72,8 @@ type (
 		Target   string
 		Label    []string
 		Instance string
+		Message  string
+		Email    string
 		Env      raw.StringSliceMap
 	}
 )
@@ -146,6 +152,56 @@ func (data *RuleData) MatchRules(rules Rules) (bool, error) {
 		}
 	}
 
+	// the commit message, author and changed files are only known when the
+	// build is compiled, so their rules are skipped without data at runtime
+
+	// match the commit message patterns
+	if len(rules.Message) > 0 && (isCompileTime || len(data.Message) > 0) {
+		match, err = MatchMessage(data.Message, rules.Message, rules.Matcher)
+		if err != nil {
+			return false, err
+		}
+
+		// early exit if OR + truthy
+		if isOr && match {
+			return true, nil
+		}
+
+		// early exit if AND + falsy
+		if !isOr && !match {
+			return false, nil
+		}
+	}
+
+	// match the changed files count threshold
+	if len(rules.ChangedFilesCount) > 0 && (isCompileTime || len(data.Path) > 0) {
+		match, err = MatchCount(len(data.Path), rules.ChangedFilesCount)
+		if err != nil {
+			return false, err
+		}
+
+		// early exit if OR + truthy
+		if isOr && match {
+			return true, nil
+		}
+
+		// early exit if AND + falsy
+		if !isOr && !match {
+			return false, nil
+		}
+	}
+
+	// capture the domain of the commit author email
+	authorDomain := ""
+	if _, domain, ok := strings.Cut(data.Email, "@"); ok {
+		authorDomain = strings.ToLower(domain)
+	}
+
+	authorDomainRule := rules.AuthorDomain
+	if !isCompileTime && len(data.Email) == 0 {
+		authorDomainRule = nil
+	}
+
 	// define rule data type
 	type RuleSetPair struct {
 		data []string
@@ -163,6 +219,7 @@ func (data *RuleData) MatchRules(rules Rules) (bool, error) {
 		{[]string{data.Target}, rules.Target},
 		{data.Label, rules.Label},
 		{[]string{data.Instance}, rules.Instance},
+		{[]string{authorDomain}, authorDomainRule},
 	}
 
 	// add status if it is not compile time
@@ -241,6 +298,70 @@ func MatchRule(data []string, comparator []string, matcher string) (bool, error)
 	return false, nil
 }
 
+// MatchMessage determines the truthy value of a commit message rule given the matcher type.
+//
+// Commit messages span multiple lines and commonly contain characters that are special
+// to the filepath matcher (i.e. "[skip deploy]"), so the filepath matcher checks whether
+// the message contains the pattern while the regexp matcher searches the message.
+func MatchMessage(message string, comparator []string, matcher string) (bool, error) {
+	for _, c := range comparator {
+		switch matcher {
+		case constants.MatcherRegex, "regex":
+			pattern, err := regexp.Compile(c)
+			if err != nil {
+				return false, fmt.Errorf("error in regex pattern %s: %w", c, err)
+			}
+
+			if pattern.MatchString(message) {
+				return true, nil
+			}
+		default:
+			if strings.Contains(message, c) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// MatchCount determines the truthy value of a numeric comparison, i.e. ">= 10",
+// against the provided count. A bare number is treated as a minimum threshold.
+func MatchCount(count int, comparison string) (bool, error) {
+	comparison = strings.TrimSpace(comparison)
+
+	operator := ">="
+
+	for _, op := range []string{">=", "<=", "==", "!=", ">", "<"} {
+		if strings.HasPrefix(comparison, op) {
+			operator = op
+			comparison = strings.TrimSpace(strings.TrimPrefix(comparison, op))
+
+			break
+		}
+	}
+
+	threshold, err := strconv.Atoi(comparison)
+	if err != nil {
+		return false, fmt.Errorf("invalid count comparison %s: %w", comparison, err)
+	}
+
+	switch operator {
+	case ">":
+		return count > threshold, nil
+	case "<":
+		return count < threshold, nil
+	case "<=":
+		return count <= threshold, nil
+	case "==":
+		return count == threshold, nil
+	case "!=":
+		return count != threshold, nil
+	default:
+		return count >= threshold, nil
+	}
+}
+
 // Empty returns true if the provided ruletypes are empty.
 func (r *Rules) Empty() bool {
 	// return true if every ruletype is empty
@@ -255,6 +376,9 @@ func (r *Rules) Empty() bool {
 		len(r.Target) == 0 &&
 		len(r.Label) == 0 &&
 		len(r.Instance) == 0 &&
+		len(r.Message) == 0 &&
+		len(r.AuthorDomain) == 0 &&
+		len(r.ChangedFilesCount) == 0 &&
 		len(r.Eval) == 0 {
 		return true
 	}
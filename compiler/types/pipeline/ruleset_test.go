@@ -655,6 +655,46 @@ func TestPipeline_Rules_Match(t *testing.T) {
 			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Instance: "http://localhost:8080"},
 			want:  true,
 		},
+		{
+			rules: &Rules{Event: []string{"push"}, Message: []string{"[skip deploy]"}},
+			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Message: "fix typo in docs\n\n[skip deploy]"},
+			want:  true,
+		},
+		{
+			rules: &Rules{Event: []string{"push"}, Message: []string{"[skip deploy]"}},
+			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Message: "fix typo in docs"},
+			want:  false,
+		},
+		{
+			rules: &Rules{Message: []string{"^release: v\\d+"}, Matcher: "regexp"},
+			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Message: "release: v1.2.0"},
+			want:  true,
+		},
+		{
+			rules: &Rules{Event: []string{"push"}, AuthorDomain: []string{"github.com"}},
+			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Email: "octocat@GitHub.com"},
+			want:  true,
+		},
+		{
+			rules: &Rules{Event: []string{"push"}, AuthorDomain: []string{"*.github.com"}},
+			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Email: "octocat@github.com"},
+			want:  false,
+		},
+		{
+			rules: &Rules{Event: []string{"push"}, ChangedFilesCount: "> 2"},
+			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Path: []string{"a.go", "b.go", "c.go"}},
+			want:  true,
+		},
+		{
+			rules: &Rules{Event: []string{"push"}, ChangedFilesCount: "5"},
+			data:  &RuleData{Branch: "main", Event: "push", Repo: "octocat/hello-world", Status: "pending", Path: []string{"a.go", "b.go", "c.go"}},
+			want:  false,
+		},
+		{
+			rules: &Rules{Event: []string{"tag"}, ChangedFilesCount: "5", Operator: "or"},
+			data:  &RuleData{Branch: "main", Event: "tag", Repo: "octocat/hello-world", Status: "pending"},
+			want:  true,
+		},
 	}
 
 	// run test
@@ -666,3 +706,46 @@ func TestPipeline_Rules_Match(t *testing.T) {
 		}
 	}
 }
+
+func TestPipeline_MatchCount(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		count      int
+		comparison string
+		want       bool
+		wantErr    bool
+	}{
+		{count: 10, comparison: "10", want: true},
+		{count: 9, comparison: "10", want: false},
+		{count: 11, comparison: "> 10", want: true},
+		{count: 10, comparison: ">10", want: false},
+		{count: 10, comparison: ">= 10", want: true},
+		{count: 3, comparison: "< 10", want: true},
+		{count: 10, comparison: "<= 10", want: true},
+		{count: 10, comparison: "== 10", want: true},
+		{count: 10, comparison: "!= 10", want: false},
+		{count: 10, comparison: "lots", wantErr: true},
+		{count: 10, comparison: "=> 10", wantErr: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := MatchCount(test.count, test.comparison)
+
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("MatchCount for %s should have returned err", test.comparison)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("MatchCount for %s returned err: %v", test.comparison, err)
+		}
+
+		if got != test.want {
+			t.Errorf("MatchCount for %d %s is %v, want %v", test.count, test.comparison, got, test.want)
+		}
+	}
+}
@@ -2,22 +2,55 @@
 
 package pipeline
 
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
 // Worker is the yaml representation of the worker block for a pipeline.
 //
 // swagger:model PipelineWorker
 type Worker struct {
-	Flavor   string `json:"flavor,omitempty"   yaml:"flavor,omitempty"`
-	Platform string `json:"platform,omitempty" yaml:"platform,omitempty"`
+	Flavor   string   `json:"flavor,omitempty"   yaml:"flavor,omitempty"`
+	Platform string   `json:"platform,omitempty" yaml:"platform,omitempty"`
+	Labels   []string `json:"labels,omitempty"   yaml:"labels,omitempty"`
 }
 
 // Empty returns true if the provided worker is empty.
 func (w *Worker) Empty() bool {
 	// return true if every worker field is empty
 	if len(w.Flavor) == 0 &&
-		len(w.Platform) == 0 {
+		len(w.Platform) == 0 &&
+		len(w.Labels) == 0 {
 		return true
 	}
 
 	// return false if any of the worker fields are provided
 	return false
 }
+
+// Selector returns the selector for the worker labels requested
+// by the pipeline, which supports equality-based requirements like
+// gpu=true or arch!=arm64 and set-based requirements like
+// region in (us-east, us-west), docker or !gpu.
+func (w *Worker) Selector() (labels.Selector, error) {
+	selector, err := labels.Parse(strings.Join(w.Labels, ","))
+	if err != nil {
+		return nil, fmt.Errorf("invalid worker labels %s: %w", strings.Join(w.Labels, ", "), err)
+	}
+
+	return selector, nil
+}
+
+// Matches returns true if the provided worker labels
+// satisfy the worker labels requested by the pipeline.
+func (w *Worker) Matches(workerLabels map[string]string) bool {
+	selector, err := w.Selector()
+	if err != nil {
+		return false
+	}
+
+	return selector.Matches(labels.Set(workerLabels))
+}
@@ -14,6 +14,10 @@ func TestPipeline_Worker_Empty(t *testing.T) {
 			worker: &Worker{Flavor: "foo"},
 			want:   false,
 		},
+		{
+			worker: &Worker{Labels: []string{"gpu=true"}},
+			want:   false,
+		},
 		{
 			worker: new(Worker),
 			want:   true,
@@ -29,3 +33,80 @@ func TestPipeline_Worker_Empty(t *testing.T) {
 		}
 	}
 }
+
+func TestPipeline_Worker_Selector(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		labels  []string
+	}{
+		{name: "equality", failure: false, labels: []string{"gpu=true", "arch!=arm64"}},
+		{name: "set", failure: false, labels: []string{"region in (us-east, us-west)", "docker", "!gpu"}},
+		{name: "single string", failure: false, labels: []string{"gpu=true,region notin (eu-west)"}},
+		{name: "empty", failure: false, labels: nil},
+		{name: "invalid operator", failure: true, labels: []string{"gpu~true"}},
+		{name: "invalid set", failure: true, labels: []string{"region in us-east"}},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := &Worker{Labels: test.labels}
+
+			_, err := w.Selector()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Selector should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Selector returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestPipeline_Worker_Matches(t *testing.T) {
+	// setup types
+	labels := map[string]string{
+		"arch":   "arm64",
+		"docker": "true",
+		"gpu":    "false",
+		"region": "us-east",
+	}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		labels []string
+		want   bool
+	}{
+		{name: "no labels", labels: nil, want: true},
+		{name: "equal", labels: []string{"arch=arm64"}, want: true},
+		{name: "not equal", labels: []string{"gpu!=true"}, want: true},
+		{name: "in", labels: []string{"region in (us-east, us-west)"}, want: true},
+		{name: "notin", labels: []string{"region notin (us-east)"}, want: false},
+		{name: "exists", labels: []string{"docker"}, want: true},
+		{name: "does not exist", labels: []string{"!docker"}, want: false},
+		{name: "every requirement", labels: []string{"arch=arm64", "gpu=true"}, want: false},
+		{name: "invalid", labels: []string{"gpu~true"}, want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := &Worker{Labels: test.labels}
+
+			got := w.Matches(labels)
+
+			if got != test.want {
+				t.Errorf("Matches is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
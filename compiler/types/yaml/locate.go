@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package yaml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/go-vela/server/compiler/types/pipeline"
+)
+
+// Locate maps the path of each diagnostic to the position of the
+// offending key within the raw yaml configuration. Paths select
+// list items by index, i.e. steps[0], or by name, i.e. steps[name=test],
+// and are rewritten to select by index once found.
+//
+// Steps created by expanding a template are located at the step
+// referencing the template, since they are not in the configuration.
+func Locate(data []byte, diags pipeline.Diagnostics) {
+	root := new(yaml.Node)
+
+	err := yaml.Unmarshal(data, root)
+	if err != nil || len(root.Content) == 0 {
+		return
+	}
+
+	for _, diag := range diags {
+		if len(diag.Path) == 0 {
+			continue
+		}
+
+		diag.Path, diag.Line, diag.Column = locate(root.Content[0], diag.Path)
+	}
+}
+
+// locate is a helper function to walk the provided node along the path
+// and capture the resolved path with the position of the deepest match.
+func locate(node *yaml.Node, path string) (string, int, int) {
+	segments := splitPath(path)
+	resolved := []string{}
+	line, column := node.Line, node.Column
+
+	for i, segment := range segments {
+		node = deref(node)
+
+		var (
+			next     *yaml.Node
+			expanded bool
+		)
+
+		switch {
+		case strings.HasPrefix(segment, "[") && node.Kind == yaml.SequenceNode:
+			var index int
+
+			index, expanded = findItem(node, strings.Trim(segment, "[]"))
+			if index < 0 {
+				break
+			}
+
+			next = deref(node.Content[index])
+			segment = fmt.Sprintf("[%d]", index)
+
+			// point to the name of the item when it has one
+			line, column = next.Line, next.Column
+			if key, _ := findKey(next, "name"); key != nil {
+				line, column = key.Line, key.Column
+			}
+		case node.Kind == yaml.MappingNode:
+			var key *yaml.Node
+
+			key, next = findKey(node, segment)
+			if key != nil {
+				line, column = key.Line, key.Column
+			}
+		}
+
+		if next == nil {
+			// keep the remainder of the path for keys that are missing
+			resolved = append(resolved, segments[i:]...)
+
+			break
+		}
+
+		resolved = append(resolved, segment)
+		node = next
+
+		// the remainder of the path only exists within the template
+		if expanded {
+			break
+		}
+	}
+
+	return joinPath(resolved), line, column
+}
+
+// findItem is a helper function to capture the index of the item within
+// the sequence matching the selector. Selectors by name fall back to the
+// step referencing a template which the named step was expanded from.
+func findItem(node *yaml.Node, selector string) (int, bool) {
+	name, ok := strings.CutPrefix(selector, "name=")
+	if !ok {
+		index, err := strconv.Atoi(selector)
+		if err != nil || index < 0 || index >= len(node.Content) {
+			return -1, false
+		}
+
+		return index, false
+	}
+
+	for i, item := range node.Content {
+		if _, value := findKey(deref(item), "name"); value != nil && value.Value == name {
+			return i, false
+		}
+	}
+
+	// steps expanded from a template are named <step>_<template step>
+	for i, item := range node.Content {
+		_, value := findKey(deref(item), "name")
+		_, tmpl := findKey(deref(item), "template")
+
+		if value != nil && tmpl != nil && strings.HasPrefix(name, value.Value+"_") {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// findKey is a helper function to capture the key and value nodes
+// for the key within the mapping, including merged anchors.
+func findKey(node *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+
+	// search the mappings merged into the node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != "<<" {
+			continue
+		}
+
+		merged := deref(node.Content[i+1])
+
+		sources := []*yaml.Node{merged}
+		if merged.Kind == yaml.SequenceNode {
+			sources = merged.Content
+		}
+
+		for _, source := range sources {
+			if k, v := findKey(deref(source), key); k != nil {
+				return k, v
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// deref is a helper function to capture the node an alias refers to.
+func deref(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+
+	return node
+}
+
+// splitPath is a helper function to split the path into its keys and selectors,
+// i.e. stages.test.steps[name=unit].image into stages, test, steps, [name=unit] and image.
+func splitPath(path string) []string {
+	segments := []string{}
+
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, current.String())
+			current.Reset()
+		}
+	}
+
+	depth := 0
+
+	for _, r := range path {
+		switch {
+		case r == '[' && depth == 0:
+			flush()
+			depth++
+			current.WriteRune(r)
+		case r == ']' && depth > 0:
+			depth--
+			current.WriteRune(r)
+
+			if depth == 0 {
+				flush()
+			}
+		case r == '.' && depth == 0:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	flush()
+
+	return segments
+}
+
+// joinPath is a helper function to join the keys and selectors into a path.
+func joinPath(segments []string) string {
+	var path strings.Builder
+
+	for _, segment := range segments {
+		if path.Len() > 0 && !strings.HasPrefix(segment, "[") {
+			path.WriteString(".")
+		}
+
+		path.WriteString(segment)
+	}
+
+	return path.String()
+}
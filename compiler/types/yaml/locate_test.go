@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package yaml
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-vela/server/compiler/types/pipeline"
+)
+
+func TestYaml_Locate(t *testing.T) {
+	// setup types
+	data, err := os.ReadFile("testdata/locate.yml")
+	if err != nil {
+		t.Fatalf("unable to read file: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		path       string
+		wantPath   string
+		wantLine   int
+		wantColumn int
+	}{
+		{
+			path:       "version",
+			wantPath:   "version",
+			wantLine:   1,
+			wantColumn: 1,
+		},
+		{
+			path:       "stages.test.steps[name=unit].image",
+			wantPath:   "stages.test.steps[0].image",
+			wantLine:   9,
+			wantColumn: 9,
+		},
+		{
+			path:       "stages.test.steps[name=unit].pull",
+			wantPath:   "stages.test.steps[0].pull",
+			wantLine:   4,
+			wantColumn: 3,
+		},
+		{
+			path:       "stages.test.steps[name=golang_build].image",
+			wantPath:   "stages.test.steps[1]",
+			wantLine:   14,
+			wantColumn: 9,
+		},
+		{
+			path:       "services[0].image",
+			wantPath:   "services[0].image",
+			wantLine:   20,
+			wantColumn: 5,
+		},
+		{
+			path:       "secrets[name=docker_password].origin.image",
+			wantPath:   "secrets[0].origin.image",
+			wantLine:   26,
+			wantColumn: 7,
+		},
+		{
+			path:       "steps[name=missing]",
+			wantPath:   "steps[name=missing]",
+			wantLine:   1,
+			wantColumn: 1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			diags := pipeline.Diagnostics{{Path: test.path}}
+
+			Locate(data, diags)
+
+			want := &pipeline.Diagnostic{Path: test.wantPath, Line: test.wantLine, Column: test.wantColumn}
+
+			if diff := cmp.Diff(want, diags[0]); diff != "" {
+				t.Errorf("Locate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package yaml
+
+import (
+	"strings"
+)
+
+type (
+	// Lock is the yaml representation of the lock
+	// file pinning the templates for a pipeline.
+	Lock struct {
+		Version   string            `yaml:"version,omitempty"   json:"version,omitempty"`
+		Templates LockTemplateSlice `yaml:"templates,omitempty" json:"templates,omitempty"`
+	}
+
+	// LockTemplateSlice is the yaml representation
+	// of the templates block for a lock file.
+	LockTemplateSlice []*LockTemplate
+
+	// LockTemplate is the yaml representation of a
+	// template pinned to an immutable reference.
+	LockTemplate struct {
+		Name   string `yaml:"name,omitempty"   json:"name,omitempty"`
+		Source string `yaml:"source,omitempty" json:"source,omitempty"`
+		Type   string `yaml:"type,omitempty"   json:"type,omitempty"`
+		Ref    string `yaml:"ref,omitempty"    json:"ref,omitempty"`
+	}
+)
+
+// Find returns the pinned template matching the
+// provided type and source from the lock file.
+func (l *Lock) Find(typ, source string) *LockTemplate {
+	if l == nil {
+		return nil
+	}
+
+	for _, t := range l.Templates {
+		if strings.EqualFold(t.Type, typ) && t.Source == source {
+			return t
+		}
+	}
+
+	return nil
+}
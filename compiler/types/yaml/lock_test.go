@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package yaml
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"go.yaml.in/yaml/v3"
+)
+
+func TestYaml_Lock_Find(t *testing.T) {
+	// setup types
+	l := new(Lock)
+
+	b, err := os.ReadFile("testdata/lock.yml")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	err = yaml.Unmarshal(b, l)
+	if err != nil {
+		t.Errorf("unable to unmarshal lock: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		lock   *Lock
+		typ    string
+		source string
+		want   *LockTemplate
+	}{
+		{
+			lock:   l,
+			typ:    "github",
+			source: "github.com/go-vela/atlas/stable/docker_build",
+			want: &LockTemplate{
+				Name:   "docker_build",
+				Source: "github.com/go-vela/atlas/stable/docker_build",
+				Type:   "github",
+				Ref:    "48afb5bdc41ad69bf22588491333f7cf71135163",
+			},
+		},
+		{
+			lock:   l,
+			typ:    "HTTPS",
+			source: "https://templates.example.com/lint.yml",
+			want: &LockTemplate{
+				Name:   "lint",
+				Source: "https://templates.example.com/lint.yml",
+				Type:   "https",
+				Ref:    "sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+			},
+		},
+		{
+			lock:   l,
+			typ:    "file",
+			source: "github.com/go-vela/atlas/stable/docker_build",
+			want:   nil,
+		},
+		{
+			lock:   nil,
+			typ:    "github",
+			source: "github.com/go-vela/atlas/stable/docker_build",
+			want:   nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.lock.Find(test.typ, test.source)
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Find is %v, want %v", got, test.want)
+		}
+	}
+}
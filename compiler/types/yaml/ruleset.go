@@ -32,14 +32,17 @@ type (
 		Repo   []string `yaml:"repo,omitempty,flow"   json:"repo,omitempty"   jsonschema:"description=Limits the execution of a step to matching repos.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
 		Sender []string `yaml:"sender,omitempty,flow" json:"sender,omitempty" jsonschema:"description=Limits the execution of a step to matching build senders.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
 		// enums for 'status' jsonschema are set in JSONSchemaExtend() method below
-		Status   []string `yaml:"status,omitempty,flow"   json:"status,omitempty"   jsonschema:"description=Limits the execution of a step to matching build statuses.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
-		Tag      []string `yaml:"tag,omitempty,flow"      json:"tag,omitempty"      jsonschema:"description=Limits the execution of a step to matching build tag references.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
-		Target   []string `yaml:"target,omitempty,flow"   json:"target,omitempty"   jsonschema:"description=Limits the execution of a step to matching build deployment targets.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
-		Label    []string `yaml:"label,omitempty,flow"    json:"label,omitempty"    jsonschema:"description=Limits step execution to match on pull requests labels.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
-		Instance []string `yaml:"instance,omitempty,flow" json:"instance,omitempty" jsonschema:"description=Limits step execution to match on certain instances.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
-		Eval     string   `yaml:"eval,omitempty"          json:"eval,omitempty"     jsonschema:"description=The expression to evaluate the ruleset against.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
-		Operator string   `yaml:"operator,omitempty"      json:"operator,omitempty" jsonschema:"description=Whether all rule conditions must be met or just any one of them.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
-		Matcher  string   `yaml:"matcher,omitempty"       json:"matcher,omitempty"  jsonschema:"description=Use the defined matching method.\nReference: coming soon"`
+		Status            []string `yaml:"status,omitempty,flow"         json:"status,omitempty"              jsonschema:"description=Limits the execution of a step to matching build statuses.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Tag               []string `yaml:"tag,omitempty,flow"            json:"tag,omitempty"                 jsonschema:"description=Limits the execution of a step to matching build tag references.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Target            []string `yaml:"target,omitempty,flow"         json:"target,omitempty"              jsonschema:"description=Limits the execution of a step to matching build deployment targets.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Label             []string `yaml:"label,omitempty,flow"          json:"label,omitempty"               jsonschema:"description=Limits step execution to match on pull requests labels.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Instance          []string `yaml:"instance,omitempty,flow"       json:"instance,omitempty"            jsonschema:"description=Limits step execution to match on certain instances.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Message           []string `yaml:"message,omitempty,flow"        json:"message,omitempty"             jsonschema:"description=Limits step execution to commit messages containing (filepath) or matching (regexp) a pattern.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		AuthorDomain      []string `yaml:"author_domain,omitempty,flow"  json:"author_domain,omitempty"       jsonschema:"description=Limits step execution to matching commit author email domains.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		ChangedFilesCount string   `yaml:"changed_files_count,omitempty" json:"changed_files_count,omitempty" jsonschema:"description=Limits step execution to a comparison against the number of changed files.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Eval              string   `yaml:"eval,omitempty"                json:"eval,omitempty"                jsonschema:"description=The expression to evaluate the ruleset against.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Operator          string   `yaml:"operator,omitempty"            json:"operator,omitempty"            jsonschema:"description=Whether all rule conditions must be met or just any one of them.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-ruleset-key"`
+		Matcher           string   `yaml:"matcher,omitempty"             json:"matcher,omitempty"             jsonschema:"description=Use the defined matching method.\nReference: coming soon"`
 	}
 )
 
@@ -107,6 +110,12 @@ func (r *Ruleset) UnmarshalYAML(unmarshal func(any) error) error {
 	advanced.If.Target = append(advanced.If.Target, simple.Target...)
 	advanced.If.Label = append(advanced.If.Label, simple.Label...)
 	advanced.If.Instance = append(advanced.If.Instance, simple.Instance...)
+	advanced.If.Message = append(advanced.If.Message, simple.Message...)
+	advanced.If.AuthorDomain = append(advanced.If.AuthorDomain, simple.AuthorDomain...)
+
+	if len(simple.ChangedFilesCount) > 0 {
+		advanced.If.ChangedFilesCount = simple.ChangedFilesCount
+	}
 
 	if len(simple.Eval) > 0 {
 		advanced.If.Eval = simple.Eval
@@ -145,20 +154,23 @@ func (r *Ruleset) UnmarshalYAML(unmarshal func(any) error) error {
 // type to a pipeline Rules type.
 func (r *Rules) ToPipeline() *pipeline.Rules {
 	return &pipeline.Rules{
-		Branch:   r.Branch,
-		Comment:  r.Comment,
-		Event:    r.Event,
-		Path:     r.Path,
-		Repo:     r.Repo,
-		Sender:   r.Sender,
-		Status:   r.Status,
-		Tag:      r.Tag,
-		Target:   r.Target,
-		Label:    r.Label,
-		Instance: r.Instance,
-		Eval:     r.Eval,
-		Matcher:  r.Matcher,
-		Operator: r.Operator,
+		Branch:            r.Branch,
+		Comment:           r.Comment,
+		Event:             r.Event,
+		Path:              r.Path,
+		Repo:              r.Repo,
+		Sender:            r.Sender,
+		Status:            r.Status,
+		Tag:               r.Tag,
+		Target:            r.Target,
+		Label:             r.Label,
+		Instance:          r.Instance,
+		Message:           r.Message,
+		AuthorDomain:      r.AuthorDomain,
+		ChangedFilesCount: r.ChangedFilesCount,
+		Eval:              r.Eval,
+		Matcher:           r.Matcher,
+		Operator:          r.Operator,
 	}
 }
 
@@ -166,20 +178,23 @@ func (r *Rules) ToPipeline() *pipeline.Rules {
 func (r *Rules) UnmarshalYAML(unmarshal func(any) error) error {
 	// rules struct we try unmarshalling to
 	rules := new(struct {
-		Branch   raw.StringSlice
-		Comment  raw.StringSlice
-		Event    raw.StringSlice
-		Path     raw.StringSlice
-		Repo     raw.StringSlice
-		Sender   raw.StringSlice
-		Status   raw.StringSlice
-		Tag      raw.StringSlice
-		Target   raw.StringSlice
-		Label    raw.StringSlice
-		Instance raw.StringSlice
-		Eval     string
-		Matcher  string
-		Operator string
+		Branch            raw.StringSlice
+		Comment           raw.StringSlice
+		Event             raw.StringSlice
+		Path              raw.StringSlice
+		Repo              raw.StringSlice
+		Sender            raw.StringSlice
+		Status            raw.StringSlice
+		Tag               raw.StringSlice
+		Target            raw.StringSlice
+		Label             raw.StringSlice
+		Instance          raw.StringSlice
+		Message           raw.StringSlice
+		AuthorDomain      raw.StringSlice `yaml:"author_domain"`
+		ChangedFilesCount string          `yaml:"changed_files_count"`
+		Eval              string
+		Matcher           string
+		Operator          string
 	})
 
 	// attempt to unmarshal rules
@@ -195,6 +210,9 @@ func (r *Rules) UnmarshalYAML(unmarshal func(any) error) error {
 		r.Target = rules.Target
 		r.Label = rules.Label
 		r.Instance = rules.Instance
+		r.Message = rules.Message
+		r.AuthorDomain = rules.AuthorDomain
+		r.ChangedFilesCount = rules.ChangedFilesCount
 		r.Eval = rules.Eval
 		r.Matcher = rules.Matcher
 		r.Operator = rules.Operator
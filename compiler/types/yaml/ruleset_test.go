@@ -112,20 +112,23 @@ func TestYaml_Ruleset_UnmarshalYAML(t *testing.T) {
 			file: "testdata/ruleset_simple.yml",
 			want: &Ruleset{
 				If: Rules{
-					Branch:   []string{"main"},
-					Comment:  []string{"test comment"},
-					Eval:     `foo == "bar"`,
-					Event:    []string{"push"},
-					Instance: []string{"vela-server"},
-					Label:    []string{"bug"},
-					Path:     []string{"foo.txt"},
-					Repo:     []string{"github/octocat"},
-					Sender:   []string{"octocat"},
-					Status:   []string{"success"},
-					Tag:      []string{"v0.1.0"},
-					Target:   []string{"production"},
-					Matcher:  "filepath",
-					Operator: "and",
+					Branch:            []string{"main"},
+					Comment:           []string{"test comment"},
+					Eval:              `foo == "bar"`,
+					Event:             []string{"push"},
+					Instance:          []string{"vela-server"},
+					Label:             []string{"bug"},
+					Path:              []string{"foo.txt"},
+					Repo:              []string{"github/octocat"},
+					Sender:            []string{"octocat"},
+					Status:            []string{"success"},
+					Tag:               []string{"v0.1.0"},
+					Target:            []string{"production"},
+					Message:           []string{"[skip deploy]"},
+					AuthorDomain:      []string{"github.com"},
+					ChangedFilesCount: "10",
+					Matcher:           "filepath",
+					Operator:          "and",
 				},
 				Continue: true,
 			},
@@ -298,18 +301,21 @@ func TestYaml_Rules_UnmarshalYAML(t *testing.T) {
 			failure: false,
 			file:    "testdata/ruleset_simple.yml",
 			want: &Rules{
-				Branch:   []string{"main"},
-				Comment:  []string{"test comment"},
-				Eval:     `foo == "bar"`,
-				Event:    []string{"push"},
-				Instance: []string{"vela-server"},
-				Label:    []string{"bug"},
-				Path:     []string{"foo.txt"},
-				Repo:     []string{"github/octocat"},
-				Sender:   []string{"octocat"},
-				Status:   []string{"success"},
-				Tag:      []string{"v0.1.0"},
-				Target:   []string{"production"},
+				Branch:            []string{"main"},
+				Comment:           []string{"test comment"},
+				Eval:              `foo == "bar"`,
+				Event:             []string{"push"},
+				Instance:          []string{"vela-server"},
+				Label:             []string{"bug"},
+				Path:              []string{"foo.txt"},
+				Repo:              []string{"github/octocat"},
+				Sender:            []string{"octocat"},
+				Status:            []string{"success"},
+				Tag:               []string{"v0.1.0"},
+				Target:            []string{"production"},
+				Message:           []string{"[skip deploy]"},
+				AuthorDomain:      []string{"github.com"},
+				ChangedFilesCount: "10",
 			},
 		},
 		{
@@ -38,6 +38,7 @@ type (
 		User        string             `yaml:"user,omitempty"        json:"user,omitempty"        jsonschema:"description=Set the user for the container.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-user-key"`
 		ReportAs    string             `yaml:"report_as,omitempty"   json:"report_as,omitempty"   jsonschema:"description=Set the name of the step to report as.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-report_as-key"`
 		IDRequest   string             `yaml:"id_request,omitempty"  json:"id_request,omitempty"  jsonschema:"description=Request ID Request Token for the step.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-id_request-key"`
+		Generate    string             `yaml:"generate,omitempty"    json:"generate,omitempty"    jsonschema:"description=Path to a pipeline file written by the step to run as a child build.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-generate-key"`
 		Git         Git                `yaml:"git,omitempty"         json:"git"                   jsonschema:"description=Git configuration for the step.\nReference: https://go-vela.github.io/docs/reference/yaml/steps/#the-git-key"`
 	}
 )
@@ -68,6 +69,7 @@ func (s *StepSlice) ToPipeline() *pipeline.ContainerSlice {
 			User:        step.User,
 			ReportAs:    step.ReportAs,
 			IDRequest:   step.IDRequest,
+			Generate:    step.Generate,
 			Git:         step.Git.ToPipeline(),
 		})
 	}
@@ -16,8 +16,8 @@ type (
 	Template struct {
 		Name      string         `yaml:"name,omitempty"   json:"name,omitempty"   jsonschema:"required,minLength=1,description=Unique identifier for the template.\nReference: https://go-vela.github.io/docs/reference/yaml/templates/#the-name-key"`
 		Source    string         `yaml:"source,omitempty" json:"source,omitempty" jsonschema:"required,minLength=1,description=Path to template in remote system.\nReference: https://go-vela.github.io/docs/reference/yaml/templates/#the-source-key"`
-		Format    string         `yaml:"format,omitempty" json:"format,omitempty" jsonschema:"enum=starlark,enum=golang,enum=go,default=go,minLength=1,description=language used within the template file \nReference: https://go-vela.github.io/docs/reference/yaml/templates/#the-format-key"`
-		Type      string         `yaml:"type,omitempty"   json:"type,omitempty"   jsonschema:"minLength=1,enum=github,enum=file,example=github,description=Type of template provided from the remote system.\nReference: https://go-vela.github.io/docs/reference/yaml/templates/#the-type-key"`
+		Format    string         `yaml:"format,omitempty" json:"format,omitempty" jsonschema:"enum=starlark,enum=jsonnet,enum=golang,enum=go,default=go,minLength=1,description=language used within the template file \nReference: https://go-vela.github.io/docs/reference/yaml/templates/#the-format-key"`
+		Type      string         `yaml:"type,omitempty"   json:"type,omitempty"   jsonschema:"minLength=1,enum=github,enum=file,enum=https,enum=oci,example=github,description=Type of template provided from the remote system.\nReference: https://go-vela.github.io/docs/reference/yaml/templates/#the-type-key"`
 		Variables map[string]any `yaml:"vars,omitempty"   json:"vars,omitempty"   jsonschema:"description=Variables injected into the template.\nReference: https://go-vela.github.io/docs/reference/yaml/templates/#the-variables-key"`
 	}
 
@@ -2,13 +2,17 @@
 
 package yaml
 
-import "github.com/go-vela/server/compiler/types/pipeline"
+import (
+	"github.com/go-vela/server/compiler/types/pipeline"
+	"github.com/go-vela/server/compiler/types/raw"
+)
 
 // Worker is the yaml representation of a worker
 // from a worker block in a pipeline.
 type Worker struct {
-	Flavor   string `yaml:"flavor,omitempty"   json:"flavor,omitempty"   jsonschema:"minLength=1,description=Flavor identifier for worker.\nReference: https://go-vela.github.io/docs/reference/yaml/worker/#the-flavor-key,example=large"`
-	Platform string `yaml:"platform,omitempty" json:"platform,omitempty" jsonschema:"minLength=1,description=Platform identifier for the worker.\nReference: https://go-vela.github.io/docs/reference/yaml/worker/#the-platform-key,example=kubernetes"`
+	Flavor   string          `yaml:"flavor,omitempty"   json:"flavor,omitempty"   jsonschema:"minLength=1,description=Flavor identifier for worker.\nReference: https://go-vela.github.io/docs/reference/yaml/worker/#the-flavor-key,example=large"`
+	Platform string          `yaml:"platform,omitempty" json:"platform,omitempty" jsonschema:"minLength=1,description=Platform identifier for the worker.\nReference: https://go-vela.github.io/docs/reference/yaml/worker/#the-platform-key,example=kubernetes"`
+	Labels   raw.StringSlice `yaml:"labels,omitempty"   json:"labels,omitempty"   jsonschema:"description=Label selector the worker must satisfy using equality and set-based requirements.\nReference: https://go-vela.github.io/docs/reference/yaml/worker/#the-labels-key,example=gpu=true"`
 }
 
 // ToPipeline converts the Worker type
@@ -17,5 +21,6 @@ func (w *Worker) ToPipeline() *pipeline.Worker {
 	return &pipeline.Worker{
 		Flavor:   w.Flavor,
 		Platform: w.Platform,
+		Labels:   w.Labels,
 	}
 }
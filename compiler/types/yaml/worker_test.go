@@ -25,6 +25,14 @@ func TestYaml_Worker_ToPipeline(t *testing.T) {
 				Platform: "gcp",
 			},
 		},
+		{
+			worker: &Worker{
+				Labels: []string{"gpu=true", "arch in (amd64, arm64)"},
+			},
+			want: &pipeline.Worker{
+				Labels: []string{"gpu=true", "arch in (amd64, arm64)"},
+			},
+		},
 	}
 
 	// run tests
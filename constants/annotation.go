@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Annotation severities.
+const (
+	// AnnotationError defines the severity for an annotation reporting an error.
+	AnnotationError = "error"
+
+	// AnnotationWarning defines the severity for an annotation reporting a warning.
+	AnnotationWarning = "warning"
+
+	// AnnotationNotice defines the severity for an annotation reporting a notice.
+	AnnotationNotice = "notice"
+)
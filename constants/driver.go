@@ -67,4 +67,10 @@ const (
 const (
 	// DriverMinio defines the driver type when integrating with a local storage system.
 	DriverMinio = "minio"
+
+	// DriverS3 defines the driver type when integrating with a generic S3-compatible storage system.
+	DriverS3 = "s3"
+
+	// DriverFilesystem defines the driver type when integrating with a local filesystem storage.
+	DriverFilesystem = "filesystem"
 )
@@ -37,6 +37,9 @@ const (
 	// RunningBuildIDsMaxSize defines the maximum size in characters for worker RunningBuildIDs.
 	RunningBuildIDsMaxSize = 500
 
+	// WorkerLabelsMaxSize defines the maximum size in characters for worker Labels.
+	WorkerLabelsMaxSize = 1000
+
 	// TopicsMaxSize defines the maximum size in characters for repo topics. Ex: GitHub has a 20-topic, 50-char limit.
 	TopicsMaxSize = 1020
 
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Policy rule actions.
+const (
+	// PolicyActionBlock defines the action for a policy rule
+	// that fails compilation of the pipeline when violated.
+	PolicyActionBlock = "block"
+
+	// PolicyActionWarn defines the action for a policy rule
+	// that adds a warning to the pipeline when violated.
+	PolicyActionWarn = "warn"
+)
@@ -18,6 +18,10 @@ const (
 	// PipelineTypeStarlark defines the pipeline type for allowing users
 	// in Vela to control their pipeline being compiled as Starlark templates.
 	PipelineTypeStarlark = "starlark"
+
+	// PipelineTypeJsonnet defines the pipeline type for allowing users
+	// in Vela to control their pipeline being compiled as Jsonnet templates.
+	PipelineTypeJsonnet = "jsonnet"
 )
 
 // Repo ApproveBuild types.
@@ -37,3 +41,14 @@ const (
 	// ApproveNever defines the CI strategy of never having to approve CI builds from outside contributors.
 	ApproveNever = "never"
 )
+
+// Repo OrphanPolicy types.
+const (
+	// OrphanPolicyFail defines the policy of failing the builds
+	// left running on a worker that stopped checking in.
+	OrphanPolicyFail = "fail"
+
+	// OrphanPolicyRequeue defines the policy of restarting the builds
+	// left running on a worker that stopped checking in.
+	OrphanPolicyRequeue = "requeue"
+)
@@ -4,6 +4,9 @@ package constants
 
 // Database tables.
 const (
+	// TableAnnotation defines the table type for the database annotations table.
+	TableAnnotation = "annotations"
+
 	// TableBuild defines the table type for the database builds table.
 	TableBuild = "builds"
 
@@ -25,6 +28,15 @@ const (
 	// TableLog defines the table type for the database logs table.
 	TableLog = "logs"
 
+	// TableLogChunk defines the table type for the database log_chunks table.
+	TableLogChunk = "log_chunks"
+
+	// TableLogObject defines the table type for the database log_objects table.
+	TableLogObject = "log_objects"
+
+	// TableLogSearch defines the table type for the database log_search table.
+	TableLogSearch = "log_search"
+
 	// TablePipeline defines the table type for the database pipelines table.
 	TablePipeline = "pipelines"
 
@@ -49,6 +61,9 @@ const (
 	// TableStep defines the table type for the database steps table.
 	TableStep = "steps"
 
+	// TableTestResult defines the table type for the database test_results table.
+	TableTestResult = "test_results"
+
 	// TableUser defines the table type for the database users table.
 	TableUser = "users"
 
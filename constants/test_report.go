@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+// Test report formats.
+const (
+	// TestReportJUnit defines the format for JUnit XML test reports.
+	TestReportJUnit = "junit"
+
+	// TestReportGo defines the format for Go test2json test reports.
+	TestReportGo = "go"
+)
+
+// Test result statuses.
+const (
+	// TestStatusPassed defines the status for a test that passed.
+	TestStatusPassed = "passed"
+
+	// TestStatusFailed defines the status for a test that failed or errored.
+	TestStatusFailed = "failed"
+
+	// TestStatusSkipped defines the status for a test that was skipped.
+	TestStatusSkipped = "skipped"
+)
@@ -18,4 +18,8 @@ const (
 
 	// WorkerStatusError defines the status for a worker in an error state.
 	WorkerStatusError = "error"
+
+	// WorkerStatusMaintenance defines the status for a draining worker
+	// where worker RunningBuildIDs.length = 0.
+	WorkerStatusMaintenance = "maintenance"
 )
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// createBatchSize is the number of annotations inserted at a time.
+const createBatchSize = 100
+
+// CreateAnnotations creates a list of new annotations in the database.
+func (e *Engine) CreateAnnotations(ctx context.Context, a []*api.Annotation) error {
+	e.logger.WithFields(logrus.Fields{
+		"annotations": len(a),
+	}).Tracef("creating %d annotations", len(a))
+
+	if len(a) == 0 {
+		return nil
+	}
+
+	annotations := make([]*types.Annotation, 0, len(a))
+
+	for _, v := range a {
+		// cast the API type to database type
+		annotation := types.AnnotationFromAPI(v)
+
+		// validate the necessary fields are populated
+		err := annotation.Validate()
+		if err != nil {
+			return err
+		}
+
+		annotations = append(annotations, annotation)
+	}
+
+	// send query to the database
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableAnnotation).
+		CreateInBatches(annotations, createBatchSize).Error
+	if err != nil {
+		return err
+	}
+
+	// capture the IDs assigned by the database
+	for i, annotation := range annotations {
+		a[i].SetID(annotation.ID.Int64)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestAnnotation_Engine_CreateAnnotations(t *testing.T) {
+	// setup types
+	_annotationOne := testAnnotation(0, 1, 12, "undefined: foo")
+	_annotationTwo := testAnnotation(0, 1, 20, "undefined: bar")
+	_annotationTwo.SetColumn(0)
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "annotations"
+("repo_id","build_id","step_id","file","line","column","severity","message","created_at")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9),($10,$11,$12,$13,$14,$15,$16,$17,$18) RETURNING "id"`).
+		WithArgs(1, 1, 1, "main.go", 12, 3, "error", "undefined: foo", 1,
+			1, 1, 1, "main.go", 20, nil, "error", "undefined: bar", 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure     bool
+		name        string
+		database    *Engine
+		annotations []*api.Annotation
+	}{
+		{
+			failure:     false,
+			name:        "postgres",
+			database:    _postgres,
+			annotations: []*api.Annotation{_annotationOne, _annotationTwo},
+		},
+		{
+			failure:     false,
+			name:        "sqlite3",
+			database:    _sqlite,
+			annotations: []*api.Annotation{_annotationOne, _annotationTwo},
+		},
+		{
+			failure:     true,
+			name:        "sqlite3 invalid",
+			database:    _sqlite,
+			annotations: []*api.Annotation{testAnnotation(0, 1, 12, "")},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// reset the IDs assigned by a previous test
+			for _, annotation := range test.annotations {
+				annotation.SetID(0)
+			}
+
+			err := test.database.CreateAnnotations(context.TODO(), test.annotations)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateAnnotations for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateAnnotations for %s returned err: %v", test.name, err)
+			}
+
+			got := []int64{test.annotations[0].GetID(), test.annotations[1].GetID()}
+			if !reflect.DeepEqual(got, []int64{1, 2}) {
+				t.Errorf("CreateAnnotations for %s assigned IDs %v, want %v", test.name, got, []int64{1, 2})
+			}
+		})
+	}
+}
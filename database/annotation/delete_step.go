@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// DeleteAnnotationsForStep deletes the annotations detected in the log for a step from the database.
+func (e *Engine) DeleteAnnotationsForStep(ctx context.Context, s *api.Step) error {
+	e.logger.WithFields(logrus.Fields{
+		"step":     s.GetNumber(),
+		"build_id": s.GetBuildID(),
+	}).Tracef("deleting annotations for step %s", s.GetName())
+
+	// send query to the database
+	return e.client.
+		WithContext(ctx).
+		Table(constants.TableAnnotation).
+		Where("step_id = ?", s.GetID()).
+		Delete(&types.Annotation{}).
+		Error
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+)
+
+func TestAnnotation_Engine_DeleteAnnotationsForStep(t *testing.T) {
+	// setup types
+	_step := testutils.APIStep()
+	_step.SetID(1)
+	_step.SetBuildID(1)
+	_step.SetNumber(1)
+	_step.SetName("test")
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "annotations" WHERE step_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateAnnotations(ctx, []*api.Annotation{
+		testAnnotation(0, 1, 12, "undefined: foo"),
+		testAnnotation(0, 2, 20, "undefined: bar"),
+	})
+	if err != nil {
+		t.Errorf("unable to create annotations for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteAnnotationsForStep(ctx, _step)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteAnnotationsForStep for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteAnnotationsForStep for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// ensure only the annotations for the step were removed
+	got, err := _sqlite.ListAnnotationsForBuild(ctx, &api.Build{ID: new(int64(1))})
+	if err != nil {
+		t.Errorf("unable to list annotations for sqlite: %v", err)
+	}
+
+	if len(got) != 1 || got[0].GetStepID() != 2 {
+		t.Errorf("DeleteAnnotationsForStep left %v, want only step 2", got)
+	}
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import "context"
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the annotations table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+annotations_build_id
+ON annotations (build_id);
+`
+
+	// CreateStepIDIndex represents a query to create an
+	// index on the annotations table for the step_id column.
+	CreateStepIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+annotations_step_id
+ON annotations (step_id);
+`
+)
+
+// CreateAnnotationIndexes creates the indexes for the annotations table in the database.
+func (e *Engine) CreateAnnotationIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for annotations table")
+
+	// create the build_id column index for the annotations table
+	err := e.client.
+		WithContext(ctx).
+		Exec(CreateBuildIDIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the step_id column index for the annotations table
+	return e.client.
+		WithContext(ctx).
+		Exec(CreateStepIDIndex).Error
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// AnnotationInterface represents the Vela interface for annotation
+// functions with the supported Database backends.
+type AnnotationInterface interface {
+	// Annotation Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateAnnotationIndexes defines a function that creates the indexes for the annotations table.
+	CreateAnnotationIndexes(context.Context) error
+	// CreateAnnotationTable defines a function that creates the annotations table.
+	CreateAnnotationTable(context.Context, string) error
+
+	// Annotation Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateAnnotations defines a function that creates a list of new annotations.
+	CreateAnnotations(context.Context, []*api.Annotation) error
+	// DeleteAnnotationsForStep defines a function that deletes the annotations detected in the log for a step.
+	DeleteAnnotationsForStep(context.Context, *api.Step) error
+	// ListAnnotationsForBuild defines a function that gets a list of annotations by build ID.
+	ListAnnotationsForBuild(context.Context, *api.Build) ([]*api.Annotation, error)
+	// ListAnnotationsForStep defines a function that gets a list of annotations by step ID.
+	ListAnnotationsForStep(context.Context, *api.Step) ([]*api.Annotation, error)
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListAnnotationsForBuild gets a list of annotations by build ID from the database.
+func (e *Engine) ListAnnotationsForBuild(ctx context.Context, b *api.Build) ([]*api.Annotation, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+	}).Tracef("listing annotations for build %d", b.GetNumber())
+
+	// variables to store query results and return value
+	a := new([]types.Annotation)
+	annotations := []*api.Annotation{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableAnnotation).
+		Where("build_id = ?", b.GetID()).
+		Order("step_id ASC").
+		Order("id ASC").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, annotation := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := annotation
+
+		// convert query result to API type
+		annotations = append(annotations, tmp.ToAPI())
+	}
+
+	return annotations, nil
+}
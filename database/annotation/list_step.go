@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListAnnotationsForStep gets a list of annotations by step ID from the database.
+func (e *Engine) ListAnnotationsForStep(ctx context.Context, s *api.Step) ([]*api.Annotation, error) {
+	e.logger.WithFields(logrus.Fields{
+		"step":     s.GetNumber(),
+		"build_id": s.GetBuildID(),
+	}).Tracef("listing annotations for step %s", s.GetName())
+
+	// variables to store query results and return value
+	a := new([]types.Annotation)
+	annotations := []*api.Annotation{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableAnnotation).
+		Where("step_id = ?", s.GetID()).
+		Order("id ASC").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, annotation := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := annotation
+
+		// convert query result to API type
+		annotations = append(annotations, tmp.ToAPI())
+	}
+
+	return annotations, nil
+}
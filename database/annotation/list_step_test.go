@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestAnnotation_Engine_ListAnnotationsForStep(t *testing.T) {
+	// setup types
+	_step := testutils.APIStep()
+	_step.SetID(1)
+	_step.SetBuildID(1)
+	_step.SetNumber(1)
+	_step.SetName("test")
+
+	_annotationOne := testAnnotation(1, 1, 12, "undefined: foo")
+	_annotationTwo := testAnnotation(2, 1, 20, "undefined: bar")
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*types.AnnotationFromAPI(_annotationOne), *types.AnnotationFromAPI(_annotationTwo)})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "annotations" WHERE step_id = $1 ORDER BY id ASC`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateAnnotations(ctx, []*api.Annotation{_annotationOne, _annotationTwo})
+	if err != nil {
+		t.Errorf("unable to create annotations for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []*api.Annotation
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Annotation{_annotationOne, _annotationTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Annotation{_annotationOne, _annotationTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListAnnotationsForStep(ctx, _step)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListAnnotationsForStep for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListAnnotationsForStep for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListAnnotationsForStep for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
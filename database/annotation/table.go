@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package annotation
+
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
+
+const (
+	// CreatePostgresTable represents a query to create the Postgres annotations table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+annotations (
+	id            BIGSERIAL PRIMARY KEY,
+	repo_id       BIGINT,
+	build_id      BIGINT,
+	step_id       BIGINT,
+	file          VARCHAR(1000),
+	line          BIGINT,
+	"column"      BIGINT,
+	severity      VARCHAR(50),
+	message       TEXT,
+	created_at    BIGINT
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite annotations table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+annotations (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	step_id       INTEGER,
+	file          TEXT,
+	line          INTEGER,
+	"column"      INTEGER,
+	severity      TEXT,
+	message       TEXT,
+	created_at    INTEGER
+);
+`
+)
+
+// CreateAnnotationTable creates the annotations table in the database.
+func (e *Engine) CreateAnnotationTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating annotations table")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the annotations table for Postgres
+		return e.client.
+			WithContext(ctx).
+			Exec(CreatePostgresTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the annotations table for Sqlite
+		return e.client.
+			WithContext(ctx).
+			Exec(CreateSqliteTable).Error
+	}
+}
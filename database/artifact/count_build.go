@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+// CountArtifactsForBuild gets the count of artifacts by build ID from the database.
+func (e *Engine) CountArtifactsForBuild(ctx context.Context, b *api.Build) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+	}).Tracef("getting count of artifacts for build %d", b.GetNumber())
+
+	// variable to store query results
+	var a int64
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Where("build_id = ?", b.GetID()).
+		Count(&a).
+		Error
+
+	return a, err
+}
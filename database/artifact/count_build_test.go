@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/go-vela/server/database/testutils"
+)
+
+func TestArtifact_Engine_CountArtifactsForBuild(t *testing.T) {
+	// setup types
+	_build := testutils.APIBuild()
+	_build.SetID(1)
+	_build.SetRepo(testutils.APIRepo())
+	_build.SetNumber(1)
+
+	_artifactOne := testArtifact(1, 1, "coverage.out", 2)
+	_artifactTwo := testArtifact(2, 2, "coverage.out", 2)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT count(*) FROM "artifacts" WHERE build_id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateArtifact(ctx, _artifactOne)
+	if err != nil {
+		t.Errorf("unable to create test artifact for sqlite: %v", err)
+	}
+
+	_, err = _sqlite.CreateArtifact(ctx, _artifactTwo)
+	if err != nil {
+		t.Errorf("unable to create test artifact for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CountArtifactsForBuild(ctx, _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CountArtifactsForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CountArtifactsForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CountArtifactsForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
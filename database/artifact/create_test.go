@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestArtifact_Engine_CreateArtifact(t *testing.T) {
+	// setup types
+	_artifact := testArtifact(1, 1, "coverage.out", 2)
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "artifacts"
+("build_id","repo_id","name","step","object_path","size","checksum","content_type","created_at","expires_at","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`).
+		WithArgs(1, 1, "coverage.out", "test", "github/octocat/1/coverage.out", 1024, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", "text/plain", 1, 2, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CreateArtifact(context.TODO(), _artifact)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateArtifact for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateArtifact for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, _artifact) {
+				t.Errorf("CreateArtifact for %s returned %s, want %s", test.name, got, _artifact)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// DeleteArtifact deletes an existing artifact from the database.
+func (e *Engine) DeleteArtifact(ctx context.Context, a *api.Artifact) error {
+	e.logger.WithFields(logrus.Fields{
+		"artifact": a.GetName(),
+		"build_id": a.GetBuildID(),
+	}).Tracef("deleting artifact %s", a.GetName())
+
+	// cast the API type to database type
+	artifact := types.ArtifactFromAPI(a)
+
+	// send query to the database
+	return e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Delete(artifact).
+		Error
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestArtifact_Engine_DeleteArtifact(t *testing.T) {
+	// setup types
+	_artifact := testArtifact(1, 1, "coverage.out", 2)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "artifacts" WHERE "artifacts"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateArtifact(ctx, _artifact)
+	if err != nil {
+		t.Errorf("unable to create test artifact for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteArtifact(ctx, _artifact)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteArtifact for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteArtifact for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
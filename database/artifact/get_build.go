@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetArtifactForBuild gets an artifact by name and build ID from the database.
+func (e *Engine) GetArtifactForBuild(ctx context.Context, b *api.Build, name string) (*api.Artifact, error) {
+	e.logger.WithFields(logrus.Fields{
+		"artifact": name,
+		"build":    b.GetNumber(),
+	}).Tracef("getting artifact %s", name)
+
+	// variable to store query results
+	a := new(types.Artifact)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Where("build_id = ?", b.GetID()).
+		Where("name = ?", name).
+		Take(a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return a.ToAPI(), nil
+}
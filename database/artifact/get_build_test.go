@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestArtifact_Engine_GetArtifactForBuild(t *testing.T) {
+	// setup types
+	_build := testutils.APIBuild()
+	_build.SetID(1)
+	_build.SetRepo(testutils.APIRepo())
+	_build.SetNumber(1)
+
+	_artifact := testArtifact(1, 1, "coverage.out", 2)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*types.ArtifactFromAPI(_artifact)})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "artifacts" WHERE build_id = $1 AND name = $2 LIMIT $3`).WithArgs(1, "coverage.out", 1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateArtifact(ctx, _artifact)
+	if err != nil {
+		t.Errorf("unable to create test artifact for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     *api.Artifact
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _artifact,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _artifact,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetArtifactForBuild(ctx, _build, "coverage.out")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetArtifactForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetArtifactForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetArtifactForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
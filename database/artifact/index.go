@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import "context"
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the artifacts table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+artifacts_build_id
+ON artifacts (build_id);
+`
+
+	// CreateRepoIDIndex represents a query to create an
+	// index on the artifacts table for the repo_id column.
+	CreateRepoIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+artifacts_repo_id
+ON artifacts (repo_id);
+`
+
+	// CreateExpiresAtIndex represents a query to create an
+	// index on the artifacts table for the expires_at column.
+	CreateExpiresAtIndex = `
+CREATE INDEX
+IF NOT EXISTS
+artifacts_expires_at
+ON artifacts (expires_at);
+`
+)
+
+// CreateArtifactIndexes creates the indexes for the artifacts table in the database.
+func (e *Engine) CreateArtifactIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for artifacts table")
+
+	// create the build_id column index for the artifacts table
+	err := e.client.
+		WithContext(ctx).
+		Exec(CreateBuildIDIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the repo_id column index for the artifacts table
+	err = e.client.
+		WithContext(ctx).
+		Exec(CreateRepoIDIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the expires_at column index for the artifacts table
+	return e.client.
+		WithContext(ctx).
+		Exec(CreateExpiresAtIndex).Error
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ArtifactInterface represents the Vela interface for artifact
+// functions with the supported Database backends.
+type ArtifactInterface interface {
+	// Artifact Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateArtifactIndexes defines a function that creates the indexes for the artifacts table.
+	CreateArtifactIndexes(context.Context) error
+	// CreateArtifactTable defines a function that creates the artifacts table.
+	CreateArtifactTable(context.Context, string) error
+
+	// Artifact Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CountArtifactsForBuild defines a function that gets the count of artifacts by build ID.
+	CountArtifactsForBuild(context.Context, *api.Build) (int64, error)
+	// CreateArtifact defines a function that creates a new artifact.
+	CreateArtifact(context.Context, *api.Artifact) (*api.Artifact, error)
+	// DeleteArtifact defines a function that deletes an existing artifact.
+	DeleteArtifact(context.Context, *api.Artifact) error
+	// GetArtifactForBuild defines a function that gets an artifact by build ID and name.
+	GetArtifactForBuild(context.Context, *api.Build, string) (*api.Artifact, error)
+	// ListArtifactsForBuild defines a function that gets a list of artifacts by build ID.
+	ListArtifactsForBuild(context.Context, *api.Build, int, int) ([]*api.Artifact, error)
+	// ListArtifactsForRepo defines a function that gets a list of artifacts by repo ID.
+	ListArtifactsForRepo(context.Context, *api.Repo) ([]*api.Artifact, error)
+	// ListArtifactRepoIDs defines a function that gets a list of IDs for repos with artifacts.
+	ListArtifactRepoIDs(context.Context) ([]int64, error)
+	// ListExpiredArtifacts defines a function that gets a list of artifacts expired before a timestamp.
+	ListExpiredArtifacts(context.Context, int64, int) ([]*api.Artifact, error)
+	// UpdateArtifact defines a function that updates an existing artifact.
+	UpdateArtifact(context.Context, *api.Artifact) (*api.Artifact, error)
+}
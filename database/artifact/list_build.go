@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListArtifactsForBuild gets a list of artifacts by build ID from the database.
+func (e *Engine) ListArtifactsForBuild(ctx context.Context, b *api.Build, page, perPage int) ([]*api.Artifact, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+	}).Tracef("listing artifacts for build %d", b.GetNumber())
+
+	// variables to store query results and return value
+	a := new([]types.Artifact)
+	artifacts := []*api.Artifact{}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Where("build_id = ?", b.GetID()).
+		Order("name ASC").
+		Limit(perPage).
+		Offset(offset).
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, artifact := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := artifact
+
+		// convert query result to API type
+		artifacts = append(artifacts, tmp.ToAPI())
+	}
+
+	return artifacts, nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListExpiredArtifacts gets a list of artifacts that expired before
+// the provided timestamp from the database, up to the provided limit.
+func (e *Engine) ListExpiredArtifacts(ctx context.Context, before int64, limit int) ([]*api.Artifact, error) {
+	e.logger.Tracef("listing artifacts expired before %d", before)
+
+	// variables to store query results and return value
+	a := new([]types.Artifact)
+	artifacts := []*api.Artifact{}
+
+	// send query to the database and store result in variable
+	//
+	// artifacts without an expiration are kept indefinitely
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Where("expires_at > 0").
+		Where("expires_at < ?", before).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, artifact := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := artifact
+
+		// convert query result to API type
+		artifacts = append(artifacts, tmp.ToAPI())
+	}
+
+	return artifacts, nil
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestArtifact_Engine_ListExpiredArtifacts(t *testing.T) {
+	// setup types
+	_artifactOne := testArtifact(1, 1, "coverage.out", 2)
+	_artifactTwo := testArtifact(2, 1, "app.tar.gz", 5)
+	_artifactThree := testArtifact(3, 1, "report.xml", 0)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*types.ArtifactFromAPI(_artifactOne)})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "artifacts" WHERE expires_at > 0 AND expires_at < $1 ORDER BY expires_at ASC LIMIT $2`).WithArgs(3, 100).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, artifact := range []*api.Artifact{_artifactOne, _artifactTwo, _artifactThree} {
+		_, err := _sqlite.CreateArtifact(ctx, artifact)
+		if err != nil {
+			t.Errorf("unable to create test artifact for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []*api.Artifact
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Artifact{_artifactOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Artifact{_artifactOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListExpiredArtifacts(ctx, 3, 100)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListExpiredArtifacts for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListExpiredArtifacts for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListExpiredArtifacts for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListArtifactsForRepo gets a list of artifacts by repo ID from the database,
+// ordered from the most recent build to the oldest.
+func (e *Engine) ListArtifactsForRepo(ctx context.Context, r *api.Repo) ([]*api.Artifact, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("listing artifacts for repo %s", r.GetFullName())
+
+	// variables to store query results and return value
+	a := new([]types.Artifact)
+	artifacts := []*api.Artifact{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Where("repo_id = ?", r.GetID()).
+		Order("build_id DESC").
+		Order("name ASC").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, artifact := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := artifact
+
+		// convert query result to API type
+		artifacts = append(artifacts, tmp.ToAPI())
+	}
+
+	return artifacts, nil
+}
+
+// ListArtifactRepoIDs gets a list of IDs for the repos with artifacts from the database.
+func (e *Engine) ListArtifactRepoIDs(ctx context.Context) ([]int64, error) {
+	e.logger.Tracef("listing repo IDs for artifacts")
+
+	// variable to store query results
+	ids := []int64{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Distinct("repo_id").
+		Order("repo_id ASC").
+		Pluck("repo_id", &ids).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
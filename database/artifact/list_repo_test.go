@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestArtifact_Engine_ListArtifactsForRepo(t *testing.T) {
+	// setup types
+	_repo := testutils.APIRepo()
+	_repo.SetID(1)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+
+	_artifactOne := testArtifact(1, 1, "coverage.out", 2)
+	_artifactTwo := testArtifact(2, 2, "app.tar.gz", 2)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*types.ArtifactFromAPI(_artifactTwo), *types.ArtifactFromAPI(_artifactOne)})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "artifacts" WHERE repo_id = $1 ORDER BY build_id DESC,name ASC`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateArtifact(ctx, _artifactOne)
+	if err != nil {
+		t.Errorf("unable to create test artifact for sqlite: %v", err)
+	}
+
+	_, err = _sqlite.CreateArtifact(ctx, _artifactTwo)
+	if err != nil {
+		t.Errorf("unable to create test artifact for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []*api.Artifact
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Artifact{_artifactTwo, _artifactOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Artifact{_artifactTwo, _artifactOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListArtifactsForRepo(ctx, _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListArtifactsForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListArtifactsForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListArtifactsForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestArtifact_Engine_ListArtifactRepoIDs(t *testing.T) {
+	// setup types
+	_artifactOne := testArtifact(1, 1, "coverage.out", 2)
+	_artifactTwo := testArtifact(2, 2, "app.tar.gz", 2)
+
+	_artifactThree := testArtifact(3, 3, "app.tar.gz", 2)
+	_artifactThree.SetRepoID(2)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"repo_id"}).AddRow(1).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT DISTINCT repo_id FROM "artifacts" ORDER BY repo_id ASC`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, artifact := range []*api.Artifact{_artifactOne, _artifactTwo, _artifactThree} {
+		_, err := _sqlite.CreateArtifact(ctx, artifact)
+		if err != nil {
+			t.Errorf("unable to create test artifact for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []int64{1, 2},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []int64{1, 2},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListArtifactRepoIDs(ctx)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListArtifactRepoIDs for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListArtifactRepoIDs for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListArtifactRepoIDs for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
+
+const (
+	// CreatePostgresTable represents a query to create the Postgres artifacts table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+artifacts (
+	id            BIGSERIAL PRIMARY KEY,
+	build_id      BIGINT,
+	repo_id       BIGINT,
+	name          VARCHAR(1000),
+	step          VARCHAR(250),
+	object_path   VARCHAR(2000),
+	size          BIGINT,
+	checksum      VARCHAR(250),
+	content_type  VARCHAR(250),
+	created_at    BIGINT,
+	expires_at    BIGINT,
+	UNIQUE(build_id, name)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite artifacts table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+artifacts (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	name          TEXT,
+	step          TEXT,
+	object_path   TEXT,
+	size          INTEGER,
+	checksum      TEXT,
+	content_type  TEXT,
+	created_at    INTEGER,
+	expires_at    INTEGER,
+	UNIQUE(build_id, name)
+);
+`
+)
+
+// CreateArtifactTable creates the artifacts table in the database.
+func (e *Engine) CreateArtifactTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating artifacts table")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the artifacts table for Postgres
+		return e.client.
+			WithContext(ctx).
+			Exec(CreatePostgresTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the artifacts table for Sqlite
+		return e.client.
+			WithContext(ctx).
+			Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// UpdateArtifact updates an existing artifact in the database.
+func (e *Engine) UpdateArtifact(ctx context.Context, a *api.Artifact) (*api.Artifact, error) {
+	e.logger.WithFields(logrus.Fields{
+		"artifact": a.GetName(),
+		"build_id": a.GetBuildID(),
+	}).Tracef("updating artifact %s", a.GetName())
+
+	// cast the API type to database type
+	artifact := types.ArtifactFromAPI(a)
+
+	// validate the necessary fields are populated
+	err := artifact.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	// send query to the database
+	err = e.client.
+		WithContext(ctx).
+		Table(constants.TableArtifact).
+		Save(artifact).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return artifact.ToAPI(), nil
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestArtifact_Engine_UpdateArtifact(t *testing.T) {
+	// setup types
+	_artifact := testArtifact(1, 1, "coverage.out", 2)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "artifacts"
+SET "build_id"=$1,"repo_id"=$2,"name"=$3,"step"=$4,"object_path"=$5,"size"=$6,"checksum"=$7,"content_type"=$8,"created_at"=$9,"expires_at"=$10
+WHERE "id" = $11`).
+		WithArgs(1, 1, "coverage.out", "test", "github/octocat/1/coverage.out", 1024, "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", "text/plain", 1, 2, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateArtifact(ctx, _artifact)
+	if err != nil {
+		t.Errorf("unable to create test artifact for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.UpdateArtifact(ctx, _artifact)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateArtifact for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateArtifact for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, _artifact) {
+				t.Errorf("UpdateArtifact for %s returned %s, want %s", test.name, got, _artifact)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/constants"
+)
+
+// CountActiveBuildsForOrg gets the count of builds by org name placed in the
+// provided queue routes that are running or already published to the queue.
+func (e *Engine) CountActiveBuildsForOrg(ctx context.Context, org string, routes []string) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("getting count of active builds for org %s in routes %v", org, routes)
+
+	// variable to store query results
+	var b int64
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableBuild).
+		Joins("JOIN repos ON builds.repo_id = repos.id").
+		Where("repos.org = ?", org).
+		Where("builds.route IN ?", routes).
+		Where("builds.status = ? OR (builds.status = ? AND builds.enqueued > 0)", constants.StatusRunning, constants.StatusPending).
+		Count(&b).
+		Error
+
+	return b, err
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestBuild_Engine_CountActiveBuildsForOrg(t *testing.T) {
+	// setup types
+	_owner := testutils.APIUser()
+	_owner.SetID(1)
+	_owner.SetName("foo")
+	_owner.SetToken("bar")
+
+	_repo := testutils.APIRepo()
+	_repo.SetID(1)
+	_repo.SetOwner(_owner)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+	_repo.SetTopics([]string{})
+
+	_buildOne := testutils.APIBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepo(_repo)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+	_buildOne.SetStatus("running")
+	_buildOne.SetRoute("secure")
+
+	_buildTwo := testutils.APIBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepo(_repo)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+	_buildTwo.SetStatus("pending")
+	_buildTwo.SetEnqueued(1)
+	_buildTwo.SetRoute("secure")
+
+	_buildThree := testutils.APIBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepo(_repo)
+	_buildThree.SetNumber(3)
+	_buildThree.SetDeployPayload(nil)
+	_buildThree.SetStatus("pending")
+	_buildThree.SetRoute("secure")
+
+	_buildFour := testutils.APIBuild()
+	_buildFour.SetID(4)
+	_buildFour.SetRepo(_repo)
+	_buildFour.SetNumber(4)
+	_buildFour.SetDeployPayload(nil)
+	_buildFour.SetStatus("running")
+	_buildFour.SetRoute("vela")
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT count(*) FROM "builds" JOIN repos ON builds.repo_id = repos.id WHERE repos.org = $1 AND builds.route IN ($2) AND (builds.status = $3 OR (builds.status = $4 AND builds.enqueued > 0))`).
+		WithArgs("foo", "secure", "running", "pending").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, _build := range []*api.Build{_buildOne, _buildTwo, _buildThree, _buildFour} {
+		err := createTestBuild(t.Context(), _sqlite, _build)
+		if err != nil {
+			t.Errorf("unable to create test build for sqlite: %v", err)
+		}
+	}
+
+	err := _sqlite.client.AutoMigrate(&types.Repo{})
+	if err != nil {
+		t.Errorf("unable to create repo table for sqlite: %v", err)
+	}
+
+	err = _sqlite.client.Table(constants.TableRepo).Create(types.RepoFromAPI(_repo)).Error
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     2,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CountActiveBuildsForOrg(context.TODO(), "foo", []string{"secure"})
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CountActiveBuildsForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CountActiveBuildsForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CountActiveBuildsForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
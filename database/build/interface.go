@@ -30,6 +30,8 @@ type BuildInterface interface {
 	CleanBuilds(context.Context, string, int64) (int64, error)
 	// CountBuilds defines a function that gets the count of all builds.
 	CountBuilds(context.Context) (int64, error)
+	// CountActiveBuildsForOrg defines a function that gets the count of running or published builds by org name and routes.
+	CountActiveBuildsForOrg(context.Context, string, []string) (int64, error)
 	// CountBuildsForDeployment defines a function that gets the count of builds by deployment url.
 	CountBuildsForDeployment(context.Context, *api.Deployment, map[string]any) (int64, error)
 	// CountBuildsForOrg defines a function that gets the count of builds by org name.
@@ -56,6 +58,8 @@ type BuildInterface interface {
 	ListBuildsForDashboardRepo(context.Context, *api.Repo, []string, []string) ([]*api.Build, error)
 	// ListBuildsForRepo defines a function that gets a list of builds by repo ID.
 	ListBuildsForRepo(context.Context, *api.Repo, map[string]any, int64, int64, int, int) ([]*api.Build, error)
+	// ListChildBuilds defines a function that gets a list of builds compiled from pipelines generated by a build.
+	ListChildBuilds(context.Context, *api.Build) ([]*api.Build, error)
 	// ListPendingAndRunningBuilds defines a function that gets a list of pending and running builds.
 	ListPendingAndRunningBuilds(context.Context, string) ([]*api.QueueBuild, error)
 	// ListPendingAndRunningBuildsForRepo defines a function that gets a list of pending and running builds for a repo.
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListChildBuilds gets a list of builds compiled from pipelines generated by the provided build from the database.
+func (e *Engine) ListChildBuilds(ctx context.Context, b *api.Build) ([]*api.Build, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   b.GetRepo().GetOrg(),
+		"repo":  b.GetRepo().GetName(),
+	}).Tracef("listing child builds for build %s/%d", b.GetRepo().GetFullName(), b.GetNumber())
+
+	// variables to store query results and return values
+	c := new([]types.Build)
+	builds := []*api.Build{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableBuild).
+		Select("builds.*").
+		Joins("JOIN pipelines ON builds.pipeline_id = pipelines.id").
+		Where("builds.repo_id = ?", b.GetRepo().GetID()).
+		Where("builds.parent = ?", b.GetNumber()).
+		Where("pipelines.generated_digest <> ?", "").
+		Order("builds.number DESC").
+		Find(&c).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, build := range *c {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := build
+
+		result := tmp.ToAPI()
+		result.SetRepo(b.GetRepo())
+
+		builds = append(builds, result)
+	}
+
+	return builds, nil
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestBuild_Engine_ListChildBuilds(t *testing.T) {
+	// setup types
+	_owner := testutils.APIUser().Crop()
+	_owner.SetID(1)
+	_owner.SetName("foo")
+	_owner.SetToken("bar")
+
+	_repo := testutils.APIRepo()
+	_repo.SetID(1)
+	_repo.SetOwner(_owner)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetAllowEvents(api.NewEventsFromMask(1))
+	_repo.SetPipelineType(constants.PipelineTypeYAML)
+	_repo.SetTopics([]string{})
+
+	_pipeline := testutils.APIPipeline()
+	_pipeline.SetID(1)
+	_pipeline.SetRepo(_repo)
+	_pipeline.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+	_generated := testutils.APIPipeline()
+	_generated.SetID(2)
+	_generated.SetRepo(_repo)
+	_generated.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	_generated.SetGeneratedDigest("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+
+	_parent := testutils.APIBuild()
+	_parent.SetID(1)
+	_parent.SetRepo(_repo)
+	_parent.SetNumber(1)
+	_parent.SetPipelineID(1)
+	_parent.SetDeployPayload(nil)
+
+	_restart := testutils.APIBuild()
+	_restart.SetID(2)
+	_restart.SetRepo(_repo)
+	_restart.SetNumber(2)
+	_restart.SetParent(1)
+	_restart.SetPipelineID(1)
+	_restart.SetDeployPayload(nil)
+
+	_child := testutils.APIBuild()
+	_child.SetID(3)
+	_child.SetRepo(_repo)
+	_child.SetNumber(3)
+	_child.SetParent(1)
+	_child.SetPipelineID(2)
+	_child.SetDeployPayload(nil)
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected query result in mock
+	_rows := testutils.CreateMockRows([]any{*types.BuildFromAPI(_child)})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT builds.* FROM "builds" JOIN pipelines ON builds.pipeline_id = pipelines.id WHERE builds.repo_id = $1 AND builds.parent = $2 AND pipelines.generated_digest <> $3 ORDER BY builds.number DESC`).
+		WithArgs(1, 1, "").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, _build := range []*api.Build{_parent, _restart, _child} {
+		err := createTestBuild(t.Context(), _sqlite, _build)
+		if err != nil {
+			t.Errorf("unable to create test build for sqlite: %v", err)
+		}
+	}
+
+	err := _sqlite.client.AutoMigrate(&types.Pipeline{})
+	if err != nil {
+		t.Errorf("unable to create pipeline table for sqlite: %v", err)
+	}
+
+	for _, _p := range []*api.Pipeline{_pipeline, _generated} {
+		err = _sqlite.client.Table(constants.TablePipeline).Omit("Repo").Create(types.PipelineFromAPI(_p)).Error
+		if err != nil {
+			t.Errorf("unable to create test pipeline for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []*api.Build
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Build{_child},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Build{_child},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListChildBuilds(context.TODO(), _parent)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListChildBuilds for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListChildBuilds for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListChildBuilds for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/annotation"
+	"github.com/go-vela/server/database/artifact"
 	"github.com/go-vela/server/database/build"
 	"github.com/go-vela/server/database/dashboard"
 	"github.com/go-vela/server/database/deployment"
@@ -28,6 +30,7 @@ import (
 	"github.com/go-vela/server/database/service"
 	"github.com/go-vela/server/database/settings"
 	"github.com/go-vela/server/database/step"
+	"github.com/go-vela/server/database/testresult"
 	"github.com/go-vela/server/database/user"
 	"github.com/go-vela/server/database/worker"
 	"github.com/go-vela/server/tracing"
@@ -82,6 +85,8 @@ type (
 		tracing *tracing.Client
 
 		settings.SettingsInterface
+		annotation.AnnotationInterface
+		artifact.ArtifactInterface
 		build.BuildInterface
 		dashboard.DashboardInterface
 		executable.BuildExecutableInterface
@@ -95,6 +100,7 @@ type (
 		secret.SecretInterface
 		service.ServiceInterface
 		step.StepInterface
+		testresult.TestResultInterface
 		user.UserInterface
 		worker.WorkerInterface
 	}
@@ -4,6 +4,7 @@ package database
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"reflect"
 	"sort"
@@ -20,6 +21,8 @@ import (
 	"github.com/go-vela/server/api/types/settings"
 	"github.com/go-vela/server/compiler/types/raw"
 	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/annotation"
+	"github.com/go-vela/server/database/artifact"
 	"github.com/go-vela/server/database/build"
 	"github.com/go-vela/server/database/dashboard"
 	"github.com/go-vela/server/database/deployment"
@@ -34,6 +37,7 @@ import (
 	"github.com/go-vela/server/database/service"
 	dbSettings "github.com/go-vela/server/database/settings"
 	"github.com/go-vela/server/database/step"
+	"github.com/go-vela/server/database/testresult"
 	"github.com/go-vela/server/database/testutils"
 	"github.com/go-vela/server/database/user"
 	"github.com/go-vela/server/database/worker"
@@ -42,6 +46,8 @@ import (
 
 // Resources represents the object containing test resources.
 type Resources struct {
+	Annotations []*api.Annotation
+	Artifacts   []*api.Artifact
 	Builds      []*api.Build
 	Dashboards  []*api.Dashboard
 	Deployments []*api.Deployment
@@ -55,6 +61,7 @@ type Resources struct {
 	Secrets     []*api.Secret
 	Services    []*api.Service
 	Steps       []*api.Step
+	TestResults []*api.TestResult
 	Users       []*api.User
 	Workers     []*api.Worker
 	Platform    []*settings.Platform
@@ -132,6 +139,10 @@ func TestDatabase_Integration(t *testing.T) {
 				t.Errorf("unable to ping database engine for %s: %v", test.name, err)
 			}
 
+			t.Run("test_annotations", func(t *testing.T) { testAnnotations(t, db, resources) })
+
+			t.Run("test_artifacts", func(t *testing.T) { testArtifacts(t, db, resources) })
+
 			t.Run("test_builds", func(t *testing.T) { testBuilds(t, db, resources) })
 
 			t.Run("test_dashboards", func(t *testing.T) { testDashboards(t, db, resources) })
@@ -158,6 +169,8 @@ func TestDatabase_Integration(t *testing.T) {
 
 			t.Run("test_steps", func(t *testing.T) { testSteps(t, db, resources) })
 
+			t.Run("test_test_results", func(t *testing.T) { testTestResults(t, db, resources) })
+
 			t.Run("test_users", func(t *testing.T) { testUsers(t, db, resources) })
 
 			t.Run("test_workers", func(t *testing.T) { testWorkers(t, db, resources) })
@@ -172,6 +185,146 @@ func TestDatabase_Integration(t *testing.T) {
 	}
 }
 
+func testArtifacts(t *testing.T, db Interface, resources *Resources) {
+	// create a variable to track the number of methods called for artifacts
+	methods := make(map[string]bool)
+	// capture the element type of the artifact interface
+	element := reflect.TypeFor[artifact.ArtifactInterface]()
+	// iterate through all methods found in the artifact interface
+	for method := range element.Methods() {
+		// skip tracking the methods to create indexes and tables for artifacts
+		// since those are already called when the database engine starts
+		if strings.Contains(method.Name, "Index") ||
+			strings.Contains(method.Name, "Table") {
+			continue
+		}
+
+		// add the method name to the list of functions
+		methods[method.Name] = false
+	}
+
+	ctx := context.TODO()
+
+	// create the artifacts
+	for _, artifact := range resources.Artifacts {
+		_, err := db.CreateArtifact(ctx, artifact)
+		if err != nil {
+			t.Errorf("unable to create artifact %d: %v", artifact.GetID(), err)
+		}
+	}
+
+	methods["CreateArtifact"] = true
+
+	// count the artifacts for a build
+	count, err := db.CountArtifactsForBuild(ctx, resources.Builds[0])
+	if err != nil {
+		t.Errorf("unable to count artifacts for build %d: %v", resources.Builds[0].GetID(), err)
+	}
+
+	if int(count) != len(resources.Artifacts) {
+		t.Errorf("CountArtifactsForBuild() is %v, want %v", count, len(resources.Artifacts))
+	}
+
+	methods["CountArtifactsForBuild"] = true
+
+	// list the artifacts for a build
+	list, err := db.ListArtifactsForBuild(ctx, resources.Builds[0], 1, 10)
+	if err != nil {
+		t.Errorf("unable to list artifacts for build %d: %v", resources.Builds[0].GetID(), err)
+	}
+
+	if !cmp.Equal(list, []*api.Artifact{resources.Artifacts[1], resources.Artifacts[0]}) {
+		t.Errorf("ListArtifactsForBuild() is %v, want %v", list, []*api.Artifact{resources.Artifacts[1], resources.Artifacts[0]})
+	}
+
+	methods["ListArtifactsForBuild"] = true
+
+	// list the artifacts for a repo
+	list, err = db.ListArtifactsForRepo(ctx, resources.Repos[0])
+	if err != nil {
+		t.Errorf("unable to list artifacts for repo: %v", err)
+	}
+
+	if !cmp.Equal(list, []*api.Artifact{resources.Artifacts[1], resources.Artifacts[0]}) {
+		t.Errorf("ListArtifactsForRepo() is %v, want %v", list, []*api.Artifact{resources.Artifacts[1], resources.Artifacts[0]})
+	}
+
+	methods["ListArtifactsForRepo"] = true
+
+	// list the repo IDs with artifacts
+	ids, err := db.ListArtifactRepoIDs(ctx)
+	if err != nil {
+		t.Errorf("unable to list artifact repo IDs: %v", err)
+	}
+
+	if !cmp.Equal(ids, []int64{1}) {
+		t.Errorf("ListArtifactRepoIDs() is %v, want %v", ids, []int64{1})
+	}
+
+	methods["ListArtifactRepoIDs"] = true
+
+	// list the expired artifacts
+	list, err = db.ListExpiredArtifacts(ctx, 1563474091, 10)
+	if err != nil {
+		t.Errorf("unable to list expired artifacts: %v", err)
+	}
+
+	if !cmp.Equal(list, []*api.Artifact{resources.Artifacts[0]}) {
+		t.Errorf("ListExpiredArtifacts() is %v, want %v", list, []*api.Artifact{resources.Artifacts[0]})
+	}
+
+	methods["ListExpiredArtifacts"] = true
+
+	// lookup the artifacts by name
+	for _, artifact := range resources.Artifacts {
+		build := resources.Builds[artifact.GetBuildID()-1]
+
+		got, err := db.GetArtifactForBuild(ctx, build, artifact.GetName())
+		if err != nil {
+			t.Errorf("unable to get artifact %d for build %d: %v", artifact.GetID(), build.GetID(), err)
+		}
+
+		if !cmp.Equal(got, artifact) {
+			t.Errorf("GetArtifactForBuild() is %v, want %v", got, artifact)
+		}
+	}
+
+	methods["GetArtifactForBuild"] = true
+
+	// update the artifacts
+	for _, artifact := range resources.Artifacts {
+		artifact.SetSize(4096)
+
+		got, err := db.UpdateArtifact(ctx, artifact)
+		if err != nil {
+			t.Errorf("unable to update artifact %d: %v", artifact.GetID(), err)
+		}
+
+		if !cmp.Equal(got, artifact) {
+			t.Errorf("UpdateArtifact() is %v, want %v", got, artifact)
+		}
+	}
+
+	methods["UpdateArtifact"] = true
+
+	// delete the artifacts
+	for _, artifact := range resources.Artifacts {
+		err = db.DeleteArtifact(ctx, artifact)
+		if err != nil {
+			t.Errorf("unable to delete artifact %d: %v", artifact.GetID(), err)
+		}
+	}
+
+	methods["DeleteArtifact"] = true
+
+	// ensure we called all the methods we expected to
+	for method, called := range methods {
+		if !called {
+			t.Errorf("method %s was not called for artifacts", method)
+		}
+	}
+}
+
 func testBuilds(t *testing.T, db Interface, resources *Resources) {
 	// create a variable to track the number of methods called for builds
 	methods := make(map[string]bool)
@@ -263,6 +416,18 @@ func testBuilds(t *testing.T, db Interface, resources *Resources) {
 
 	methods["CountBuildsForOrg"] = true
 
+	// count the active builds for an org
+	count, err = db.CountActiveBuildsForOrg(context.TODO(), resources.Repos[0].GetOrg(), []string{"vela"})
+	if err != nil {
+		t.Errorf("unable to count active builds for org %s: %v", resources.Repos[0].GetOrg(), err)
+	}
+
+	if int(count) != len(resources.Builds) {
+		t.Errorf("CountActiveBuildsForOrg() is %v, want %v", count, len(resources.Builds))
+	}
+
+	methods["CountActiveBuildsForOrg"] = true
+
 	// count the builds for a repo
 	count, err = db.CountBuildsForRepo(context.TODO(), resources.Repos[0], nil, time.Now().Unix(), 0)
 	if err != nil {
@@ -394,6 +559,21 @@ func testBuilds(t *testing.T, db Interface, resources *Resources) {
 
 	methods["GetBuildForRepo"] = true
 
+	// list the child builds of the builds
+	for _, build := range resources.Builds {
+		children, err := db.ListChildBuilds(context.TODO(), build)
+		if err != nil {
+			t.Errorf("unable to list child builds for build %d: %v", build.GetID(), err)
+		}
+
+		// the builds are compiled from the pipeline at their commit
+		if len(children) != 0 {
+			t.Errorf("ListChildBuilds() is %v, want %v", len(children), 0)
+		}
+	}
+
+	methods["ListChildBuilds"] = true
+
 	// clean the builds
 	count, err = db.CleanBuilds(context.TODO(), "integration testing", 1563474090)
 	if err != nil {
@@ -1258,6 +1438,109 @@ func testLogs(t *testing.T, db Interface, resources *Resources) {
 	methods["UpdateLog"] = true
 	methods["GetLog"] = true
 
+	// append to the logs
+	for _, log := range resources.Logs {
+		chunk, err := db.CreateLogChunk(context.TODO(), log, []byte("baz"))
+		if err != nil {
+			t.Errorf("unable to append to log %d: %v", log.GetID(), err)
+		}
+
+		if chunk.Offset != int64(len(log.GetData())) {
+			t.Errorf("CreateLogChunk() offset is %d, want %d", chunk.Offset, len(log.GetData()))
+		}
+
+		log.AppendData([]byte("baz"))
+
+		// list the chunks appended to the log
+		chunks, err := db.ListLogChunks(context.TODO(), log, chunk.Offset)
+		if err != nil {
+			t.Errorf("unable to list chunks for log %d: %v", log.GetID(), err)
+		}
+
+		if diff := cmp.Diff([]*api.LogChunk{chunk}, chunks); diff != "" {
+			t.Errorf("ListLogChunks() mismatch (-want +got):\n%s", diff)
+		}
+
+		// compact the chunks into the log
+		err = db.CompactLog(context.TODO(), log)
+		if err != nil {
+			t.Errorf("unable to compact log %d: %v", log.GetID(), err)
+		}
+
+		got, err := db.GetLog(context.TODO(), log.GetID())
+		if err != nil {
+			t.Errorf("unable to get log %d by ID: %v", log.GetID(), err)
+		}
+
+		if !cmp.Equal(got, log) {
+			t.Errorf("GetLog() is %v, want %v", got, log)
+		}
+	}
+
+	methods["CreateLogChunk"] = true
+	methods["ListLogChunks"] = true
+	methods["CompactLog"] = true
+
+	// create the blocks of lines to search the logs
+	for _, log := range resources.Logs {
+		err = db.CreateLogSearch(context.TODO(), log)
+		if err != nil {
+			t.Errorf("unable to create search for log %d: %v", log.GetID(), err)
+		}
+	}
+
+	methods["CreateLogSearch"] = true
+
+	// search the logs for the appended data
+	results, err := db.SearchLogs(context.TODO(), []int64{resources.Repos[0].GetID()}, "baz", 0, time.Now().Add(time.Minute).Unix(), 0, 10)
+	if err != nil {
+		t.Errorf("unable to search logs: %v", err)
+	}
+
+	if len(results) != len(resources.Logs) {
+		t.Errorf("SearchLogs() is %d results, want %d", len(results), len(resources.Logs))
+	}
+
+	methods["SearchLogs"] = true
+
+	// list the logs to offload
+	list, err = db.ListLogsForOffload(context.TODO(), time.Now().Add(time.Minute).Unix(), 10)
+	if err != nil {
+		t.Errorf("unable to list logs to offload: %v", err)
+	}
+
+	if diff := cmp.Diff(resources.Logs, list); diff != "" {
+		t.Errorf("ListLogsForOffload() mismatch (-want +got):\n%s", diff)
+	}
+
+	methods["ListLogsForOffload"] = true
+
+	// offload the logs
+	for _, log := range resources.Logs {
+		object := &api.Object{
+			ObjectName:  fmt.Sprintf("logs/%d/%d/log_%d.log", log.GetRepoID(), log.GetBuildID(), log.GetID()),
+			Size:        int64(len(log.GetData())),
+			ContentType: "text/plain",
+		}
+
+		err = db.OffloadLog(context.TODO(), log, object)
+		if err != nil {
+			t.Errorf("unable to offload log %d: %v", log.GetID(), err)
+		}
+
+		got, err := db.GetLogObject(context.TODO(), log)
+		if err != nil {
+			t.Errorf("unable to get object for log %d: %v", log.GetID(), err)
+		}
+
+		if !reflect.DeepEqual(got, object) {
+			t.Errorf("GetLogObject() is %v, want %v", got, object)
+		}
+	}
+
+	methods["OffloadLog"] = true
+	methods["GetLogObject"] = true
+
 	// delete the logs
 	for _, log := range resources.Logs {
 		err = db.DeleteLog(context.TODO(), log)
@@ -1269,13 +1552,21 @@ func testLogs(t *testing.T, db Interface, resources *Resources) {
 	methods["DeleteLog"] = true
 
 	// clean the logs
-	_, err = db.CleanLogs(context.TODO(), time.Now().Unix(), 1000, false, db.Driver())
+	_, err = db.CleanLogs(context.TODO(), time.Now().Unix(), 1000, false, db.Driver(), nil)
 	if err != nil {
 		t.Errorf("unable to clean logs: %v", err)
 	}
 
 	methods["CleanLogs"] = true
 
+	// apply the log retention policies
+	_, err = db.ApplyLogRetention(context.TODO(), []*log.RetentionPolicy{{RepoID: 1, Before: time.Now().Unix()}}, 1000, false, db.Driver(), nil)
+	if err != nil {
+		t.Errorf("unable to apply log retention: %v", err)
+	}
+
+	methods["ApplyLogRetention"] = true
+
 	// ensure we called all the methods we expected to
 	for method, called := range methods {
 		if !called {
@@ -1404,6 +1695,22 @@ func testPipelines(t *testing.T, db Interface, resources *Resources) {
 
 	methods["GetPipelineForRepo"] = true
 
+	// lookup the pipelines by digest of the generated configuration
+	for _, pipeline := range resources.Pipelines {
+		repo := resources.Repos[pipeline.GetRepo().GetID()-1]
+
+		got, err := db.GetGeneratedPipelineForRepo(context.TODO(), pipeline.GetCommit(), pipeline.GetGeneratedDigest(), repo)
+		if err != nil {
+			t.Errorf("unable to get generated pipeline %d for repo %d: %v", pipeline.GetID(), repo.GetID(), err)
+		}
+
+		if diff := cmp.Diff(pipeline, got); diff != "" {
+			t.Errorf("GetGeneratedPipelineForRepo() mismatch (-want +got):\n%s", diff)
+		}
+	}
+
+	methods["GetGeneratedPipelineForRepo"] = true
+
 	// update the pipelines
 	for _, pipeline := range resources.Pipelines {
 		pipeline.SetVersion("2")
@@ -2348,6 +2655,164 @@ func testServices(t *testing.T, db Interface, resources *Resources) {
 	}
 }
 
+func testAnnotations(t *testing.T, db Interface, resources *Resources) {
+	// create a variable to track the number of methods called for annotations
+	methods := make(map[string]bool)
+	// capture the element type of the annotation interface
+	element := reflect.TypeFor[annotation.AnnotationInterface]()
+	// iterate through all methods found in the annotation interface
+	for method := range element.Methods() {
+		// skip tracking the methods to create indexes and tables for annotations
+		// since those are already called when the database engine starts
+		if strings.Contains(method.Name, "Index") ||
+			strings.Contains(method.Name, "Table") {
+			continue
+		}
+
+		// add the method name to the list of functions
+		methods[method.Name] = false
+	}
+
+	ctx := context.TODO()
+
+	// create the annotations
+	err := db.CreateAnnotations(ctx, resources.Annotations)
+	if err != nil {
+		t.Errorf("unable to create annotations: %v", err)
+	}
+
+	methods["CreateAnnotations"] = true
+
+	// list the annotations for a build
+	list, err := db.ListAnnotationsForBuild(ctx, resources.Builds[0])
+	if err != nil {
+		t.Errorf("unable to list annotations for build %d: %v", resources.Builds[0].GetID(), err)
+	}
+
+	if !cmp.Equal(list, resources.Annotations) {
+		t.Errorf("ListAnnotationsForBuild() is %v, want %v", list, resources.Annotations)
+	}
+
+	methods["ListAnnotationsForBuild"] = true
+
+	// list the annotations for a step
+	list, err = db.ListAnnotationsForStep(ctx, resources.Steps[1])
+	if err != nil {
+		t.Errorf("unable to list annotations for step %d: %v", resources.Steps[1].GetID(), err)
+	}
+
+	if !cmp.Equal(list, []*api.Annotation{resources.Annotations[1]}) {
+		t.Errorf("ListAnnotationsForStep() is %v, want %v", list, []*api.Annotation{resources.Annotations[1]})
+	}
+
+	methods["ListAnnotationsForStep"] = true
+
+	// delete the annotations
+	for _, step := range resources.Steps {
+		err = db.DeleteAnnotationsForStep(ctx, step)
+		if err != nil {
+			t.Errorf("unable to delete annotations for step %d: %v", step.GetID(), err)
+		}
+	}
+
+	list, err = db.ListAnnotationsForBuild(ctx, resources.Builds[0])
+	if err != nil {
+		t.Errorf("unable to list annotations for build %d: %v", resources.Builds[0].GetID(), err)
+	}
+
+	if len(list) != 0 {
+		t.Errorf("DeleteAnnotationsForStep() left %v, want none", list)
+	}
+
+	methods["DeleteAnnotationsForStep"] = true
+
+	// ensure we called all the methods we expected to
+	for method, called := range methods {
+		if !called {
+			t.Errorf("method %s was not called for annotations", method)
+		}
+	}
+}
+
+func testTestResults(t *testing.T, db Interface, resources *Resources) {
+	// create a variable to track the number of methods called for test results
+	methods := make(map[string]bool)
+	// capture the element type of the test result interface
+	element := reflect.TypeFor[testresult.TestResultInterface]()
+	// iterate through all methods found in the test result interface
+	for method := range element.Methods() {
+		// skip tracking the methods to create indexes and tables for test results
+		// since those are already called when the database engine starts
+		if strings.Contains(method.Name, "Index") ||
+			strings.Contains(method.Name, "Table") {
+			continue
+		}
+
+		// add the method name to the list of functions
+		methods[method.Name] = false
+	}
+
+	ctx := context.TODO()
+
+	// create the test results
+	err := db.CreateTestResults(ctx, resources.TestResults)
+	if err != nil {
+		t.Errorf("unable to create test results: %v", err)
+	}
+
+	methods["CreateTestResults"] = true
+
+	// list the test results for a build
+	list, err := db.ListTestResultsForBuild(ctx, resources.Builds[0])
+	if err != nil {
+		t.Errorf("unable to list test results for build %d: %v", resources.Builds[0].GetID(), err)
+	}
+
+	if !cmp.Equal(list, []*api.TestResult{resources.TestResults[0]}) {
+		t.Errorf("ListTestResultsForBuild() is %v, want %v", list, []*api.TestResult{resources.TestResults[0]})
+	}
+
+	methods["ListTestResultsForBuild"] = true
+
+	// list the test result history for a branch
+	list, err = db.ListTestResultHistory(ctx, resources.Repos[0], "main", 3, 10)
+	if err != nil {
+		t.Errorf("unable to list test result history for repo %d: %v", resources.Repos[0].GetID(), err)
+	}
+
+	if !cmp.Equal(list, resources.TestResults) {
+		t.Errorf("ListTestResultHistory() is %v, want %v", list, resources.TestResults)
+	}
+
+	methods["ListTestResultHistory"] = true
+
+	// delete the test results
+	for _, artifact := range resources.Artifacts {
+		err = db.DeleteTestResultsForArtifact(ctx, artifact)
+		if err != nil {
+			t.Errorf("unable to delete test results for artifact %d: %v", artifact.GetID(), err)
+		}
+	}
+
+	list, err = db.ListTestResultsForBuild(ctx, resources.Builds[1])
+	if err != nil {
+		t.Errorf("unable to list test results for build %d: %v", resources.Builds[1].GetID(), err)
+	}
+
+	if len(list) != 0 {
+		t.Errorf("DeleteTestResultsForArtifact() left %v, want none", list)
+	}
+
+	methods["DeleteTestResultsForArtifact"] = true
+
+	// ensure we called all the methods we expected to
+	for method, called := range methods {
+		if !called {
+			t.Errorf("method %s was not called for test results", method)
+		}
+	}
+}
+
 func testSteps(t *testing.T, db Interface, resources *Resources) {
 	// create a variable to track the number of methods called for steps
 	methods := make(map[string]bool)
@@ -2742,6 +3207,30 @@ func testWorkers(t *testing.T, db Interface, resources *Resources) {
 	methods["UpdateWorker"] = true
 	methods["GetWorker"] = true
 
+	// reap the workers
+	for _, worker := range resources.Workers {
+		reaped, err := db.ReapWorker(context.TODO(), worker)
+		if err != nil {
+			t.Errorf("unable to reap worker %d: %v", worker.GetID(), err)
+		}
+
+		if !reaped {
+			t.Errorf("ReapWorker() for worker %d is %v, want %v", worker.GetID(), reaped, true)
+		}
+
+		// the worker changed since it was retrieved
+		reaped, err = db.ReapWorker(context.TODO(), worker)
+		if err != nil {
+			t.Errorf("unable to reap worker %d: %v", worker.GetID(), err)
+		}
+
+		if reaped {
+			t.Errorf("ReapWorker() for reaped worker %d is %v, want %v", worker.GetID(), reaped, false)
+		}
+	}
+
+	methods["ReapWorker"] = true
+
 	// delete the workers
 	for _, worker := range resources.Workers {
 		err = db.DeleteWorker(context.TODO(), worker)
@@ -2860,6 +3349,7 @@ func newResources() *Resources {
 	repoOne.SetAllowEvents(api.NewEventsFromMask(1))
 	repoOne.SetMergeQueueEvents([]string{})
 	repoOne.SetApprovalTimeout(7)
+	repoOne.SetOrphanPolicy(constants.OrphanPolicyFail)
 	repoOne.SetInstallID(0)
 	repoOne.SetCustomProps(map[string]any{"foo": "bar"})
 
@@ -2888,6 +3378,7 @@ func newResources() *Resources {
 	repoTwo.SetAllowEvents(api.NewEventsFromMask(1))
 	repoTwo.SetMergeQueueEvents([]string{})
 	repoTwo.SetApprovalTimeout(7)
+	repoTwo.SetOrphanPolicy(constants.OrphanPolicyRequeue)
 	repoTwo.SetInstallID(0)
 	repoTwo.SetCustomProps(map[string]any{"foo": "bar"})
 
@@ -3143,6 +3634,7 @@ func newResources() *Resources {
 	pipelineOne.SetID(1)
 	pipelineOne.SetRepo(repoOne)
 	pipelineOne.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	pipelineOne.SetGeneratedDigest("")
 	pipelineOne.SetFlavor("large")
 	pipelineOne.SetPlatform("docker")
 	pipelineOne.SetRef("refs/heads/main")
@@ -3156,12 +3648,14 @@ func newResources() *Resources {
 	pipelineOne.SetTemplates(false)
 	pipelineOne.SetArtifact(false)
 	pipelineOne.SetWarnings([]string{})
+	pipelineOne.SetResolvedTemplates(nil)
 	pipelineOne.SetData([]byte("version: 1"))
 
 	pipelineTwo := new(api.Pipeline)
 	pipelineTwo.SetID(2)
 	pipelineTwo.SetRepo(repoOne)
 	pipelineTwo.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135164")
+	pipelineTwo.SetGeneratedDigest("")
 	pipelineTwo.SetFlavor("large")
 	pipelineTwo.SetPlatform("docker")
 	pipelineTwo.SetRef("refs/heads/main")
@@ -3175,6 +3669,14 @@ func newResources() *Resources {
 	pipelineTwo.SetTemplates(false)
 	pipelineTwo.SetArtifact(false)
 	pipelineTwo.SetWarnings([]string{"42:this is a warning"})
+	pipelineTwo.SetResolvedTemplates([]api.ResolvedTemplate{
+		{
+			Name:   new("sample"),
+			Source: new("github.com/github/octocat/template.yml@main"),
+			Type:   new("github"),
+			Ref:    new("48afb5bdc41ad69bf22588491333f7cf71135163"),
+		},
+	})
 	pipelineTwo.SetData([]byte("version: 1"))
 
 	currTime := time.Now().UTC()
@@ -3301,6 +3803,86 @@ func newResources() *Resources {
 	serviceTwo.SetRuntime("docker")
 	serviceTwo.SetDistribution("linux")
 
+	artifactOne := new(api.Artifact)
+	artifactOne.SetID(1)
+	artifactOne.SetBuildID(1)
+	artifactOne.SetRepoID(1)
+	artifactOne.SetName("coverage.out")
+	artifactOne.SetStep("test")
+	artifactOne.SetObjectPath("github/octocat/1/coverage.out")
+	artifactOne.SetSize(1024)
+	artifactOne.SetChecksum("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+	artifactOne.SetContentType("text/plain")
+	artifactOne.SetCreatedAt(1563474076)
+	artifactOne.SetExpiresAt(1563474090)
+
+	artifactTwo := new(api.Artifact)
+	artifactTwo.SetID(2)
+	artifactTwo.SetBuildID(1)
+	artifactTwo.SetRepoID(1)
+	artifactTwo.SetName("app.tar.gz")
+	artifactTwo.SetStep("build")
+	artifactTwo.SetObjectPath("github/octocat/1/app.tar.gz")
+	artifactTwo.SetSize(2048)
+	artifactTwo.SetChecksum("sha256:4355a46b19d348dc2f57c046f8ef63d4538ebb936000f3c9ee954a27460dd865")
+	artifactTwo.SetContentType("application/gzip")
+	artifactTwo.SetCreatedAt(1563474086)
+	artifactTwo.SetExpiresAt(0)
+
+	testResultOne := new(api.TestResult)
+	testResultOne.SetID(1)
+	testResultOne.SetBuildID(1)
+	testResultOne.SetRepoID(1)
+	testResultOne.SetArtifactID(1)
+	testResultOne.SetStep("test")
+	testResultOne.SetBranch("main")
+	testResultOne.SetSuite("github.com/go-vela/server/api")
+	testResultOne.SetName("TestAPI_Foo")
+	testResultOne.SetStatus("passed")
+	testResultOne.SetMessage("")
+	testResultOne.SetDuration(1500)
+	testResultOne.SetFlaky(false)
+	testResultOne.SetCreatedAt(1563474076)
+
+	testResultTwo := new(api.TestResult)
+	testResultTwo.SetID(2)
+	testResultTwo.SetBuildID(2)
+	testResultTwo.SetRepoID(1)
+	testResultTwo.SetArtifactID(2)
+	testResultTwo.SetStep("test")
+	testResultTwo.SetBranch("main")
+	testResultTwo.SetSuite("github.com/go-vela/server/api")
+	testResultTwo.SetName("TestAPI_Foo")
+	testResultTwo.SetStatus("failed")
+	testResultTwo.SetMessage("expected 1, got 2")
+	testResultTwo.SetDuration(2500)
+	testResultTwo.SetFlaky(true)
+	testResultTwo.SetCreatedAt(1563474086)
+
+	annotationOne := new(api.Annotation)
+	annotationOne.SetID(1)
+	annotationOne.SetRepoID(1)
+	annotationOne.SetBuildID(1)
+	annotationOne.SetStepID(1)
+	annotationOne.SetFile("main.go")
+	annotationOne.SetLine(12)
+	annotationOne.SetColumn(3)
+	annotationOne.SetSeverity("error")
+	annotationOne.SetMessage("undefined: foo")
+	annotationOne.SetCreatedAt(1563474076)
+
+	annotationTwo := new(api.Annotation)
+	annotationTwo.SetID(2)
+	annotationTwo.SetRepoID(1)
+	annotationTwo.SetBuildID(1)
+	annotationTwo.SetStepID(2)
+	annotationTwo.SetFile("app.js")
+	annotationTwo.SetLine(1)
+	annotationTwo.SetColumn(5)
+	annotationTwo.SetSeverity("warning")
+	annotationTwo.SetMessage("Unexpected console statement")
+	annotationTwo.SetCreatedAt(1563474086)
+
 	stepOne := new(api.Step)
 	stepOne.SetID(1)
 	stepOne.SetBuildID(1)
@@ -3350,7 +3932,10 @@ func newResources() *Resources {
 	workerOne.SetHostname("worker-1.example.com")
 	workerOne.SetAddress("https://worker-1.example.com")
 	workerOne.SetRoutes([]string{"vela"})
+	workerOne.SetLabels(map[string]string{"arch": "amd64", "gpu": "false"})
 	workerOne.SetActive(true)
+	workerOne.SetDraining(false)
+	workerOne.SetDrainStartedAt(0)
 	workerOne.SetStatus("available")
 	workerOne.SetLastStatusUpdateAt(time.Now().UTC().Unix())
 	workerOne.SetRunningBuilds([]*api.Build{_bPartialOne})
@@ -3364,7 +3949,10 @@ func newResources() *Resources {
 	workerTwo.SetHostname("worker-2.example.com")
 	workerTwo.SetAddress("https://worker-2.example.com")
 	workerTwo.SetRoutes([]string{"vela"})
+	workerTwo.SetLabels(map[string]string{"arch": "arm64", "gpu": "true"})
 	workerTwo.SetActive(true)
+	workerTwo.SetDraining(true)
+	workerTwo.SetDrainStartedAt(time.Now().UTC().Unix())
 	workerTwo.SetStatus("available")
 	workerTwo.SetLastStatusUpdateAt(time.Now().UTC().Unix())
 	workerTwo.SetRunningBuilds([]*api.Build{_bPartialTwo})
@@ -3374,6 +3962,8 @@ func newResources() *Resources {
 	workerTwo.SetBuildLimit(1)
 
 	return &Resources{
+		Annotations: []*api.Annotation{annotationOne, annotationTwo},
+		Artifacts:   []*api.Artifact{artifactOne, artifactTwo},
 		Builds:      []*api.Build{buildOne, buildTwo},
 		Dashboards:  []*api.Dashboard{dashboardOne, dashboardTwo},
 		Deployments: []*api.Deployment{deploymentOne, deploymentTwo},
@@ -3387,6 +3977,7 @@ func newResources() *Resources {
 		Secrets:     []*api.Secret{secretOrg, secretRepo, secretShared},
 		Services:    []*api.Service{serviceOne, serviceTwo},
 		Steps:       []*api.Step{stepOne, stepTwo},
+		TestResults: []*api.TestResult{testResultOne, testResultTwo},
 		Users:       []*api.User{userOne, userTwo},
 		Workers:     []*api.Worker{workerOne, workerTwo},
 	}
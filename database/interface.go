@@ -3,6 +3,8 @@
 package database
 
 import (
+	"github.com/go-vela/server/database/annotation"
+	"github.com/go-vela/server/database/artifact"
 	"github.com/go-vela/server/database/build"
 	"github.com/go-vela/server/database/dashboard"
 	"github.com/go-vela/server/database/deployment"
@@ -17,6 +19,7 @@ import (
 	"github.com/go-vela/server/database/service"
 	"github.com/go-vela/server/database/settings"
 	"github.com/go-vela/server/database/step"
+	"github.com/go-vela/server/database/testresult"
 	"github.com/go-vela/server/database/user"
 	"github.com/go-vela/server/database/worker"
 )
@@ -42,6 +45,12 @@ type Interface interface {
 	// SettingsInterface defines the interface for platform settings stored in the database.
 	settings.SettingsInterface
 
+	// AnnotationInterface defines the interface for annotations stored in the database.
+	annotation.AnnotationInterface
+
+	// ArtifactInterface defines the interface for artifacts stored in the database.
+	artifact.ArtifactInterface
+
 	// BuildInterface defines the interface for builds stored in the database.
 	build.BuildInterface
 
@@ -81,6 +90,9 @@ type Interface interface {
 	// StepInterface defines the interface for steps stored in the database.
 	step.StepInterface
 
+	// TestResultInterface defines the interface for test results stored in the database.
+	testresult.TestResultInterface
+
 	// UserInterface defines the interface for users stored in the database.
 	user.UserInterface
 
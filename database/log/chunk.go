@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// logColumn returns the column and ID identifying
+// the step or service the log entry is for.
+func logColumn(l *api.Log) (string, int64) {
+	if l.GetServiceID() > 0 {
+		return "service_id", l.GetServiceID()
+	}
+
+	return "step_id", l.GetStepID()
+}
+
+// appendChunks appends the data of the chunks not
+// yet compacted into the logs to the decompressed logs.
+func (e *Engine) appendChunks(ctx context.Context, logs ...*types.Log) error {
+	// variables to store the logs by ID
+	ids := []int64{}
+	index := make(map[int64]*types.Log, len(logs))
+
+	for _, l := range logs {
+		ids = append(ids, l.ID.Int64)
+		index[l.ID.Int64] = l
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// variable to store query results
+	chunks := []types.LogChunk{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableLogChunk).
+		Where("log_id IN ?", ids).
+		Order("log_id ASC").
+		Order("sequence ASC").
+		Find(&chunks).
+		Error
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		l, ok := index[chunk.LogID.Int64]
+		if !ok {
+			continue
+		}
+
+		l.Data = append(l.Data, chunk.Data...)
+	}
+
+	return nil
+}
@@ -4,18 +4,31 @@ package log
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
 )
 
+// ErrCleanupInProgress defines the error returned when the lock
+// for cleanup operations is held by another cleanup operation.
+var ErrCleanupInProgress = errors.New("another cleanup operation is already in progress")
+
+// RemoveObjectFunc removes the object an offloaded
+// log was moved to from the object storage.
+type RemoveObjectFunc func(ctx context.Context, path string) error
+
 // CleanupResult represents the result of a log cleanup operation.
 type CleanupResult struct {
 	DeletedCount       int64
 	AffectedPartitions []string
+	// DeletedByRepo is the count of logs deleted for each repo ID,
+	// only populated when applying log retention policies.
+	DeletedByRepo map[int64]int64
 }
 
 // CleanLogs deletes log records created before the specified timestamp in batches.
@@ -24,31 +37,19 @@ type CleanupResult struct {
 // It uses a database-based lock to prevent multiple concurrent cleanup operations.
 // If partitioned mode is enabled and the database is PostgreSQL, it will use
 // partition-aware cleanup for better performance.
-func (e *Engine) CleanLogs(ctx context.Context, before int64, batchSize int, withVacuum bool, driver string) (*CleanupResult, error) {
+//
+// The objects offloaded logs were moved to are removed with the provided
+// function before the pointers to them, which may be nil without storage.
+func (e *Engine) CleanLogs(ctx context.Context, before int64, batchSize int, withVacuum bool, driver string, remove RemoveObjectFunc) (*CleanupResult, error) {
 	logrus.Tracef("cleaning logs created before %d in batches of %d", before, batchSize)
 
 	// try to acquire a distributed lock to prevent concurrent cleanup operations
-	acquired, err := e.acquireCleanupLock(ctx, driver)
+	release, err := e.lockCleanup(ctx, driver)
 	if err != nil {
-		return nil, fmt.Errorf("failed to acquire cleanup lock: %w", err)
-	}
-
-	if !acquired {
-		logrus.Debug("cleanup lock is already held by another operation")
-		return nil, fmt.Errorf("another cleanup operation is already in progress")
+		return nil, err
 	}
 
-	logrus.Debug("successfully acquired cleanup lock")
-
-	defer func() {
-		if acquired {
-			if releaseErr := e.releaseCleanupLock(ctx, driver); releaseErr != nil {
-				logrus.Warnf("failed to release cleanup lock: %v", releaseErr)
-			} else {
-				logrus.Debug("successfully released cleanup lock")
-			}
-		}
-	}()
+	defer release()
 
 	if e.isPartitionedModeEnabled() && driver == constants.DriverPostgres {
 		logrus.Debug("attempting partition-aware log cleanup")
@@ -56,13 +57,134 @@ func (e *Engine) CleanLogs(ctx context.Context, before int64, batchSize int, wit
 		result, err := e.cleanLogsPartitioned(ctx, before, batchSize, withVacuum)
 		if err == nil {
 			logrus.Infof("partition-aware cleanup completed successfully, deleted %d logs", result.DeletedCount)
+
+			e.cleanLogChunks(ctx, before, batchSize, remove)
+
 			return result, nil
 		}
 		// fall back to traditional cleanup
 		logrus.Warnf("partition-aware cleanup failed (%v), falling back to traditional cleanup", err)
 	}
 
-	return e.cleanLogs(ctx, before, batchSize, withVacuum, driver)
+	result, err := e.cleanLogs(ctx, before, batchSize, withVacuum, driver)
+	if err != nil {
+		return result, err
+	}
+
+	e.cleanLogChunks(ctx, before, batchSize, remove)
+
+	return result, nil
+}
+
+// cleanLogChunks deletes log chunks, pointers to offloaded logs and blocks of lines for
+// search created before the specified timestamp in batches. These belong to logs removed by the cleanup,
+// so failing to delete them is logged instead of failing the cleanup.
+func (e *Engine) cleanLogChunks(ctx context.Context, before int64, batchSize int, remove RemoveObjectFunc) {
+	for _, table := range []string{constants.TableLogChunk, constants.TableLogSearch} {
+		e.cleanLogTable(ctx, table, before, batchSize)
+	}
+
+	query := fmt.Sprintf("SELECT l.id, l.object_path FROM %s l WHERE l.created_at < ? ORDER BY l.created_at ASC LIMIT ?", constants.TableLogObject)
+
+	deleted, err := e.cleanLogObjects(ctx, query, []any{before, batchSize}, batchSize, remove)
+	if err != nil {
+		logrus.Warnf("failed to delete batch of %s: %v", constants.TableLogObject, err)
+	}
+
+	if deleted > 0 {
+		logrus.Infof("cleaned %d %s created before %d", deleted, constants.TableLogObject, before)
+	}
+}
+
+// cleanLogObjects removes the objects offloaded logs were moved to from the object storage
+// before deleting the pointers to them in batches. The query selects the id and object path
+// of a batch of pointers. A pointer is kept when its object fails to be removed, so the
+// removal is retried by the next cleanup.
+func (e *Engine) cleanLogObjects(ctx context.Context, query string, args []any, batchSize int, remove RemoveObjectFunc) (int64, error) {
+	var totalDeleted int64
+
+	for ctx.Err() == nil {
+		objects := []types.LogObject{}
+
+		err := e.client.
+			WithContext(ctx).
+			Raw(query, args...).
+			Scan(&objects).
+			Error
+		if err != nil {
+			return totalDeleted, err
+		}
+
+		ids := []int64{}
+		failed := false
+
+		for _, object := range objects {
+			if remove != nil {
+				err = remove(ctx, object.ObjectPath.String)
+				if err != nil {
+					logrus.Warnf("failed to remove object %s of offloaded log: %v", object.ObjectPath.String, err)
+
+					failed = true
+
+					continue
+				}
+			}
+
+			ids = append(ids, object.ID.Int64)
+		}
+
+		if len(ids) > 0 {
+			result := e.client.
+				WithContext(ctx).
+				Exec(fmt.Sprintf("DELETE FROM %s WHERE id IN ?", constants.TableLogObject), ids)
+			if result.Error != nil {
+				return totalDeleted, result.Error
+			}
+
+			totalDeleted += result.RowsAffected
+		}
+
+		// stop at the first failure since the next batch would select the same pointers
+		if failed || len(objects) < batchSize {
+			break
+		}
+
+		// sleep between batches to reduce database load
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return totalDeleted, nil
+}
+
+// cleanLogTable deletes records created before the specified timestamp from the table in batches.
+func (e *Engine) cleanLogTable(ctx context.Context, table string, before int64, batchSize int) {
+	var totalDeleted int64
+
+	for ctx.Err() == nil {
+		deleteResult := e.client.
+			WithContext(ctx).
+			Exec(fmt.Sprintf("DELETE FROM %s WHERE id IN (SELECT id FROM %s WHERE created_at < ? ORDER BY created_at ASC LIMIT ?)", table, table), before, batchSize)
+
+		if deleteResult.Error != nil {
+			logrus.Warnf("failed to delete batch of %s: %v", table, deleteResult.Error)
+
+			return
+		}
+
+		totalDeleted += deleteResult.RowsAffected
+
+		// if we deleted fewer records than the batch size, we're done
+		if deleteResult.RowsAffected < int64(batchSize) {
+			break
+		}
+
+		// sleep between batches to reduce database load
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if totalDeleted > 0 {
+		logrus.Infof("cleaned %d %s created before %d", totalDeleted, table, before)
+	}
 }
 
 // cleanLogs implements the original non-partitioned cleanup logic.
@@ -152,6 +274,31 @@ func (e *Engine) vacuumLogs(ctx context.Context, driver string) error {
 	}
 }
 
+// lockCleanup acquires the distributed lock for cleanup operations and
+// returns a function to release it, or an error when the lock is held
+// by another cleanup operation.
+func (e *Engine) lockCleanup(ctx context.Context, driver string) (func(), error) {
+	acquired, err := e.acquireCleanupLock(ctx, driver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire cleanup lock: %w", err)
+	}
+
+	if !acquired {
+		logrus.Debug("cleanup lock is already held by another operation")
+		return nil, ErrCleanupInProgress
+	}
+
+	logrus.Debug("successfully acquired cleanup lock")
+
+	return func() {
+		if releaseErr := e.releaseCleanupLock(ctx, driver); releaseErr != nil {
+			logrus.Warnf("failed to release cleanup lock: %v", releaseErr)
+		} else {
+			logrus.Debug("successfully released cleanup lock")
+		}
+	}, nil
+}
+
 // acquireCleanupLock attempts to acquire a distributed advisory lock for cleanup operations.
 // returns true if the lock was acquired, false if another operation is already running.
 func (e *Engine) acquireCleanupLock(ctx context.Context, driver string) (bool, error) {
@@ -4,14 +4,18 @@ package log
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
 )
 
 func TestLog_Engine_CleanLogs(t *testing.T) {
@@ -43,6 +47,24 @@ func TestLog_Engine_CleanLogs(t *testing.T) {
 	_mock.ExpectExec(`VACUUM ANALYZE logs`).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
+	// mock deletion of the log chunks that were never compacted
+	_mock.ExpectExec("DELETE FROM log_chunks WHERE id IN (SELECT id FROM log_chunks WHERE created_at < $1 ORDER BY created_at ASC LIMIT $2)").
+		WithArgs(testutils.AnyArgument{}, testutils.AnyArgument{}).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	// mock deletion of the blocks of lines for search
+	_mock.ExpectExec("DELETE FROM log_search WHERE id IN (SELECT id FROM log_search WHERE created_at < $1 ORDER BY created_at ASC LIMIT $2)").
+		WithArgs(testutils.AnyArgument{}, testutils.AnyArgument{}).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	// mock removal of the objects offloaded logs were moved to before the pointers to them
+	_mock.ExpectQuery("SELECT l.id, l.object_path FROM log_objects l WHERE l.created_at < $1 ORDER BY l.created_at ASC LIMIT $2").
+		WithArgs(testutils.AnyArgument{}, testutils.AnyArgument{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "object_path"}).AddRow(1, "logs/1/1/step_1.log"))
+	_mock.ExpectExec("DELETE FROM log_objects WHERE id IN ($1)").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
 	// mock PostgreSQL advisory lock release
 	_mock.ExpectQuery("SELECT pg_advisory_unlock($1)").
 		WithArgs(int64(123456789)).
@@ -66,6 +88,31 @@ func TestLog_Engine_CleanLogs(t *testing.T) {
 		if err != nil {
 			t.Errorf("unable to create test log for sqlite: %v", err)
 		}
+
+		// the first logs were moved to the object storage
+		if i > 2 {
+			continue
+		}
+
+		object := types.LogObjectFromLog(_log, &api.Object{ObjectName: fmt.Sprintf("logs/1/1/step_%d.log", i), Size: 13}, _log.GetCreatedAt())
+
+		err = _sqlite.client.Table(constants.TableLogObject).Create(object).Error
+		if err != nil {
+			t.Errorf("unable to create test log object for sqlite: %v", err)
+		}
+	}
+
+	// the object of the second log fails to be removed
+	removed := []string{}
+
+	remove := func(_ context.Context, path string) error {
+		if path == "logs/1/1/step_2.log" {
+			return errors.New("unable to remove object")
+		}
+
+		removed = append(removed, path)
+
+		return nil
 	}
 
 	// setup tests
@@ -104,7 +151,7 @@ func TestLog_Engine_CleanLogs(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			result, err := test.database.CleanLogs(context.TODO(), test.before, test.batchSize, test.withVacuum, test.driver)
+			result, err := test.database.CleanLogs(context.TODO(), test.before, test.batchSize, test.withVacuum, test.driver, remove)
 
 			if test.failure {
 				if err == nil {
@@ -133,6 +180,23 @@ func TestLog_Engine_CleanLogs(t *testing.T) {
 			}
 		})
 	}
+
+	// the objects are removed from the storage before the pointers to them
+	if !reflect.DeepEqual(removed, []string{"logs/1/1/step_1.log", "logs/1/1/step_1.log"}) {
+		t.Errorf("CleanLogs removed objects %v, want %v", removed, []string{"logs/1/1/step_1.log", "logs/1/1/step_1.log"})
+	}
+
+	// the pointer to the object failing to be removed is kept for the next cleanup
+	var count int64
+
+	err := _sqlite.client.Table(constants.TableLogObject).Count(&count).Error
+	if err != nil {
+		t.Errorf("unable to count log objects for sqlite: %v", err)
+	}
+
+	if count != 1 {
+		t.Errorf("CleanLogs left %d log objects, want 1", count)
+	}
 }
 
 func TestLog_Engine_CleanLogs_EmptyDatabase(t *testing.T) {
@@ -153,12 +217,27 @@ func TestLog_Engine_CleanLogs_EmptyDatabase(t *testing.T) {
 		WithArgs(testutils.AnyArgument{}, testutils.AnyArgument{}).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
+	// mock deletion of the log chunks that were never compacted
+	_mock.ExpectExec("DELETE FROM log_chunks WHERE id IN (SELECT id FROM log_chunks WHERE created_at < $1 ORDER BY created_at ASC LIMIT $2)").
+		WithArgs(testutils.AnyArgument{}, testutils.AnyArgument{}).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	// mock deletion of the blocks of lines for search
+	_mock.ExpectExec("DELETE FROM log_search WHERE id IN (SELECT id FROM log_search WHERE created_at < $1 ORDER BY created_at ASC LIMIT $2)").
+		WithArgs(testutils.AnyArgument{}, testutils.AnyArgument{}).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	// mock removal of the objects offloaded logs were moved to
+	_mock.ExpectQuery("SELECT l.id, l.object_path FROM log_objects l WHERE l.created_at < $1 ORDER BY l.created_at ASC LIMIT $2").
+		WithArgs(testutils.AnyArgument{}, testutils.AnyArgument{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "object_path"}))
+
 	// mock PostgreSQL advisory lock release
 	_mock.ExpectQuery("SELECT pg_advisory_unlock($1)").
 		WithArgs(int64(123456789)).
 		WillReturnRows(sqlmock.NewRows([]string{"pg_advisory_unlock"}).AddRow(true))
 
-	deleted, err := _postgres.CleanLogs(context.TODO(), cutoffTime, 1000, false, constants.DriverPostgres)
+	deleted, err := _postgres.CleanLogs(context.TODO(), cutoffTime, 1000, false, constants.DriverPostgres, nil)
 	if err != nil {
 		t.Errorf("CleanLogs should not have returned err: %v", err)
 	}
@@ -190,7 +269,7 @@ func TestLog_Engine_CleanLogs_ContextCancellation(t *testing.T) {
 	cancel() // immediately cancel
 
 	// the function should return early due to context cancellation
-	result, err := _postgres.CleanLogs(ctx, cutoffTime, 1000, false, constants.DriverPostgres)
+	result, err := _postgres.CleanLogs(ctx, cutoffTime, 1000, false, constants.DriverPostgres, nil)
 	if err == nil {
 		t.Errorf("CleanLogs should have returned context cancellation error")
 	}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// CompactLog compacts the chunks appended to an existing log into the log
+// in the database. The log entry is identified by the service or step ID
+// of the provided log.
+//
+// Chunks appended while the log is compacted are kept for the next compaction.
+func (e *Engine) CompactLog(ctx context.Context, l *api.Log) error {
+	column, id := logColumn(l)
+
+	e.logger.Tracef("compacting log for %s %d", column, id)
+
+	return e.client.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			// capture the chunks appended to the log
+			chunks := []types.LogChunk{}
+
+			err := tx.
+				Table(constants.TableLogChunk).
+				Where(column+" = ?", id).
+				Order("sequence ASC").
+				Find(&chunks).
+				Error
+			if err != nil {
+				return err
+			}
+
+			if len(chunks) == 0 {
+				return nil
+			}
+
+			log := new(types.Log)
+
+			err = tx.
+				Table(constants.TableLog).
+				Where("id = ?", chunks[0].LogID.Int64).
+				Take(log).
+				Error
+			if err != nil {
+				return err
+			}
+
+			err = log.Decompress()
+			if err != nil {
+				// ensures that the change is backwards compatible
+				// by logging the error instead of returning it
+				// which allows us to compact uncompressed logs
+				e.logger.Errorf("unable to decompress log for %s %d: %v", column, id, err)
+			}
+
+			for _, chunk := range chunks {
+				log.Data = append(log.Data, chunk.Data...)
+			}
+
+			// compress log data for the resource
+			err = log.Compress(e.config.CompressionLevel)
+			if err != nil {
+				return fmt.Errorf("unable to compress log for %s %d: %w", column, id, err)
+			}
+
+			err = tx.
+				Table(constants.TableLog).
+				Save(log).
+				Error
+			if err != nil {
+				return err
+			}
+
+			// send query to the database
+			return tx.
+				Table(constants.TableLogChunk).
+				Where("log_id = ?", log.ID.Int64).
+				Where("sequence <= ?", chunks[len(chunks)-1].Sequence.Int64).
+				Delete(new(types.LogChunk)).
+				Error
+		})
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_CompactLog(t *testing.T) {
+	// setup types
+	_log := testutils.APILog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo"))
+	_log.SetCreatedAt(1)
+
+	_chunk := types.LogChunkFromLog(types.LogFromAPI(_log), 1, 3, []byte("bar"), 1)
+	_chunk.ID.Int64, _chunk.ID.Valid = 1, true
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE step_id = $1 ORDER BY sequence ASC`).
+		WithArgs(1).
+		WillReturnRows(testutils.CreateMockRows([]any{*_chunk}))
+	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE id = $1 LIMIT $2`).
+		WithArgs(1, 1).
+		WillReturnRows(testutils.CreateMockRows([]any{*types.LogFromAPI(_log)}))
+	_mock.ExpectExec(`UPDATE "logs"
+SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5,"created_at"=$6
+WHERE "id" = $7`).
+		WithArgs(1, 1, nil, 1, AnyArgument{}, 1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`DELETE FROM "log_chunks" WHERE log_id = $1 AND sequence <= $2`).
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	_, err = _sqlite.CreateLogChunk(context.TODO(), _log, []byte("bar"))
+	if err != nil {
+		t.Errorf("unable to create test log chunk for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CompactLog(context.TODO(), _log)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CompactLog for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CompactLog for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// the compacted log keeps the appended data without chunks
+	chunks, err := _sqlite.ListLogChunks(context.TODO(), _log, 0)
+	if err != nil {
+		t.Errorf("ListLogChunks for sqlite3 returned err: %v", err)
+	}
+
+	if len(chunks) > 0 {
+		t.Errorf("ListLogChunks for sqlite3 is %v, want none", chunks)
+	}
+
+	got, err := _sqlite.GetLogForStep(context.TODO(), &api.Step{ID: new(int64(1))})
+	if err != nil {
+		t.Errorf("GetLogForStep for sqlite3 returned err: %v", err)
+	}
+
+	if string(got.GetData()) != "foobar" {
+		t.Errorf("GetLogForStep for sqlite3 is %s, want %s", got.GetData(), "foobar")
+	}
+
+	// appending after compaction continues from the compacted log
+	chunk, err := _sqlite.CreateLogChunk(context.TODO(), _log, []byte("baz"))
+	if err != nil {
+		t.Errorf("CreateLogChunk for sqlite3 returned err: %v", err)
+	}
+
+	if chunk.Offset != 6 {
+		t.Errorf("CreateLogChunk for sqlite3 offset is %d, want %d", chunk.Offset, 6)
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// CreateLogChunk appends data to an existing log in the database.
+//
+// The data is stored as the next chunk of the log, positioned after the
+// data already in the log, instead of rewriting the log. The log entry is
+// identified by the service or step ID of the provided log.
+func (e *Engine) CreateLogChunk(ctx context.Context, l *api.Log, data []byte) (*api.LogChunk, error) {
+	column, id := logColumn(l)
+
+	e.logger.Tracef("appending to log for %s %d", column, id)
+
+	// variable to store the chunk to create
+	var chunk *types.LogChunk
+
+	err := e.client.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			created := time.Now().UTC().Unix()
+
+			// capture the last chunk appended to the log
+			last := new(types.LogChunk)
+
+			err := tx.
+				Table(constants.TableLogChunk).
+				Where(column+" = ?", id).
+				Order("sequence DESC").
+				Take(last).
+				Error
+
+			switch {
+			case err == nil:
+				log := &types.Log{
+					ID:        last.LogID,
+					BuildID:   last.BuildID,
+					RepoID:    last.RepoID,
+					ServiceID: last.ServiceID,
+					StepID:    last.StepID,
+				}
+
+				chunk = types.LogChunkFromLog(log, last.Sequence.Int64+1, last.Position.Int64+int64(len(last.Data)), data, created)
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				// the log has no chunks so the data follows the log itself
+				log := new(types.Log)
+
+				err = tx.
+					Table(constants.TableLog).
+					Where(column+" = ?", id).
+					Take(log).
+					Error
+				if err != nil {
+					return err
+				}
+
+				err = log.Decompress()
+				if err != nil {
+					// ensures that the change is backwards compatible
+					// by logging the error instead of returning it
+					// which allows us to append to uncompressed logs
+					e.logger.Errorf("unable to decompress log for %s %d: %v", column, id, err)
+				}
+
+				chunk = types.LogChunkFromLog(log, 1, int64(len(log.Data)), data, created)
+			default:
+				return err
+			}
+
+			// validate the necessary fields are populated
+			err = chunk.Validate()
+			if err != nil {
+				return err
+			}
+
+			// send query to the database
+			return tx.
+				Table(constants.TableLogChunk).
+				Create(chunk).
+				Error
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk.ToAPI(), nil
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_CreateLogChunk(t *testing.T) {
+	// setup types
+	_log := testutils.APILog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo"))
+	_log.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*types.LogFromAPI(_log)})
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE step_id = $1 ORDER BY sequence DESC LIMIT $2`).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE step_id = $1 LIMIT $2`).
+		WithArgs(1, 1).
+		WillReturnRows(_rows)
+	_mock.ExpectQuery(`INSERT INTO "log_chunks" ("log_id","build_id","repo_id","service_id","step_id","sequence","position","data","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs(1, 1, 1, nil, 1, 1, 3, []byte("bar"), testutils.AnyArgument{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     *api.LogChunk
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     &api.LogChunk{Offset: 3, Data: []byte("bar")},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     &api.LogChunk{Offset: 3, Data: []byte("bar")},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CreateLogChunk(context.TODO(), _log, []byte("bar"))
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateLogChunk for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateLogChunk for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CreateLogChunk for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+
+	// append another chunk after the first chunk
+	got, err := _sqlite.CreateLogChunk(context.TODO(), _log, []byte("baz"))
+	if err != nil {
+		t.Errorf("CreateLogChunk for sqlite3 returned err: %v", err)
+	}
+
+	want := &api.LogChunk{Offset: 6, Data: []byte("baz")}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CreateLogChunk for sqlite3 is %v, want %v", got, want)
+	}
+
+	// the chunks are included when getting the log
+	l, err := _sqlite.GetLogForStep(context.TODO(), &api.Step{ID: new(int64(1))})
+	if err != nil {
+		t.Errorf("GetLogForStep for sqlite3 returned err: %v", err)
+	}
+
+	if string(l.GetData()) != "foobarbaz" {
+		t.Errorf("GetLogForStep for sqlite3 is %s, want %s", l.GetData(), "foobarbaz")
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// CreateLogSearch creates the blocks of lines used to search
+// an existing log in the database, replacing any previous blocks.
+func (e *Engine) CreateLogSearch(ctx context.Context, l *api.Log) error {
+	e.logger.Tracef("creating search for log %d", l.GetID())
+
+	created := l.GetCreatedAt()
+	if created == 0 {
+		created = time.Now().UTC().Unix()
+	}
+
+	// split the log into the blocks of lines
+	blocks := types.LogSearchFromLog(l, created)
+
+	// validate the necessary fields are populated
+	for _, block := range blocks {
+		err := block.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return e.client.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Table(constants.TableLogSearch).
+				Where("log_id = ?", l.GetID()).
+				Delete(new(types.LogSearch)).
+				Error
+			if err != nil {
+				return err
+			}
+
+			if len(blocks) == 0 {
+				return nil
+			}
+
+			// send query to the database
+			return tx.
+				Table(constants.TableLogSearch).
+				CreateInBatches(blocks, 100).
+				Error
+		})
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+)
+
+func TestLog_Engine_CreateLogSearch(t *testing.T) {
+	// setup types
+	_log := testutils.APILog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo\nbar\n"))
+	_log.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`DELETE FROM "log_search" WHERE log_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	_mock.ExpectQuery(`INSERT INTO "log_search" ("log_id","build_id","repo_id","service_id","step_id","line","content","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs(1, 1, 1, nil, 1, 1, "foo\nbar", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateLogSearch(context.TODO(), _log)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateLogSearch for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateLogSearch for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// creating the search again replaces the previous blocks
+	_log.SetData([]byte("baz"))
+
+	err := _sqlite.CreateLogSearch(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("CreateLogSearch for sqlite3 returned err: %v", err)
+	}
+
+	got, err := _sqlite.SearchLogs(context.TODO(), []int64{1}, "foo", 0, 2, 0, 10)
+	if err != nil {
+		t.Errorf("SearchLogs for sqlite3 returned err: %v", err)
+	}
+
+	if len(got) > 0 {
+		t.Errorf("SearchLogs for sqlite3 is %v, want none", got)
+	}
+
+	// logs without an ID can not be searched
+	err = _sqlite.CreateLogSearch(context.TODO(), &api.Log{StepID: new(int64(1)), Data: new([]byte("foo"))})
+	if err == nil {
+		t.Errorf("CreateLogSearch for sqlite3 should have returned err")
+	}
+}
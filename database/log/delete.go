@@ -5,6 +5,8 @@ package log
 import (
 	"context"
 
+	"gorm.io/gorm"
+
 	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database/types"
@@ -26,7 +28,37 @@ func (e *Engine) DeleteLog(ctx context.Context, l *api.Log) error {
 	// send query to the database
 	return e.client.
 		WithContext(ctx).
-		Table(constants.TableLog).
-		Delete(log).
-		Error
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Table(constants.TableLogChunk).
+				Where("log_id = ?", log.ID.Int64).
+				Delete(new(types.LogChunk)).
+				Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.
+				Table(constants.TableLogObject).
+				Where("log_id = ?", log.ID.Int64).
+				Delete(new(types.LogObject)).
+				Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.
+				Table(constants.TableLogSearch).
+				Where("log_id = ?", log.ID.Int64).
+				Delete(new(types.LogSearch)).
+				Error
+			if err != nil {
+				return err
+			}
+
+			return tx.
+				Table(constants.TableLog).
+				Delete(log).
+				Error
+		})
 }
@@ -24,10 +24,21 @@ func TestLog_Engine_DeleteLog(t *testing.T) {
 
 	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
 
-	// ensure the mock expects the query
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`DELETE FROM "log_chunks" WHERE log_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	_mock.ExpectExec(`DELETE FROM "log_objects" WHERE log_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	_mock.ExpectExec(`DELETE FROM "log_search" WHERE log_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
 	_mock.ExpectExec(`DELETE FROM "logs" WHERE "logs"."id" = $1`).
 		WithArgs(1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectCommit()
 
 	_sqlite := testSqlite(t)
 
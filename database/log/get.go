@@ -35,9 +35,12 @@ func (e *Engine) GetLog(ctx context.Context, id int64) (*api.Log, error) {
 		// by logging the error instead of returning it
 		// which allows us to fetch uncompressed logs
 		e.logger.Errorf("unable to decompress log %d: %v", id, err)
+	}
 
-		// return the uncompressed log
-		return l.ToAPI(), nil
+	// append the data not yet compacted into the log
+	err = e.appendChunks(ctx, l)
+	if err != nil {
+		return nil, err
 	}
 
 	// return the log
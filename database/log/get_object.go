@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetLogObject gets the object an existing log was moved to from the database.
+func (e *Engine) GetLogObject(ctx context.Context, l *api.Log) (*api.Object, error) {
+	e.logger.Tracef("getting object for log %d", l.GetID())
+
+	// variable to store query results
+	o := new(types.LogObject)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableLogObject).
+		Where("log_id = ?", l.GetID()).
+		Take(o).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return o.ToAPI(), nil
+}
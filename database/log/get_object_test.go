@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_GetLogObject(t *testing.T) {
+	// setup types
+	_log := testutils.APILog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte{})
+	_log.SetCreatedAt(1)
+
+	_object := &api.Object{
+		ObjectName:  "logs/1/1/step_1.log",
+		Size:        3,
+		ContentType: "text/plain",
+	}
+
+	_row := types.LogObjectFromLog(_log, _object, 1)
+	_row.ID.Int64, _row.ID.Valid = 1, true
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "log_objects" WHERE log_id = $1 LIMIT $2`).
+		WithArgs(1, 1).
+		WillReturnRows(testutils.CreateMockRows([]any{*_row}))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	// the log has not been offloaded yet
+	_, err = _sqlite.GetLogObject(context.TODO(), _log)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetLogObject for sqlite3 returned err %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+
+	err = _sqlite.OffloadLog(context.TODO(), _log, _object)
+	if err != nil {
+		t.Errorf("unable to offload test log for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     *api.Object
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _object,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _object,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetLogObject(context.TODO(), _log)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetLogObject for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetLogObject for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetLogObject for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
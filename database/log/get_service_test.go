@@ -7,6 +7,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
+
 	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database/testutils"
 	"github.com/go-vela/server/database/types"
@@ -38,6 +40,7 @@ func TestLog_Engine_GetLogForService(t *testing.T) {
 
 	// ensure the mock expects the query
 	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE service_id = $1 LIMIT $2`).WithArgs(1, 1).WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE log_id IN ($1) ORDER BY log_id ASC,sequence ASC`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}))
 
 	_sqlite := testSqlite(t)
 
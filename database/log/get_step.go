@@ -35,9 +35,12 @@ func (e *Engine) GetLogForStep(ctx context.Context, s *api.Step) (*api.Log, erro
 		// by logging the error instead of returning it
 		// which allows us to fetch uncompressed logs
 		e.logger.Errorf("unable to decompress log for step %d for build %d: %v", s.GetID(), s.GetBuildID(), err)
+	}
 
-		// return the uncompressed log
-		return l.ToAPI(), nil
+	// append the data not yet compacted into the log
+	err = e.appendChunks(ctx, l)
+	if err != nil {
+		return nil, err
 	}
 
 	// return the log
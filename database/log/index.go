@@ -2,7 +2,11 @@
 
 package log
 
-import "context"
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
 
 const (
 	// CreateBuildIDIndex represents a query to create an
@@ -21,6 +25,70 @@ CREATE INDEX
 IF NOT EXISTS
 logs_created_at
 ON logs (created_at);
+`
+
+	// CreateChunkStepIDIndex represents a query to create an
+	// index on the log_chunks table for the step_id column.
+	CreateChunkStepIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_chunks_step_id
+ON log_chunks (step_id);
+`
+
+	// CreateChunkServiceIDIndex represents a query to create an
+	// index on the log_chunks table for the service_id column.
+	CreateChunkServiceIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_chunks_service_id
+ON log_chunks (service_id);
+`
+
+	// CreateChunkCreatedAtIndex represents a query to create an
+	// index on the log_chunks table for the created_at column.
+	CreateChunkCreatedAtIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_chunks_created_at
+ON log_chunks (created_at);
+`
+
+	// CreateObjectCreatedAtIndex represents a query to create an
+	// index on the log_objects table for the created_at column.
+	CreateObjectCreatedAtIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_objects_created_at
+ON log_objects (created_at);
+`
+
+	// CreateSearchRepoIDIndex represents a query to create an index
+	// on the log_search table for the repo_id and created_at columns.
+	CreateSearchRepoIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_search_repo_id
+ON log_search (repo_id, created_at);
+`
+
+	// CreateSearchCreatedAtIndex represents a query to create an
+	// index on the log_search table for the created_at column.
+	CreateSearchCreatedAtIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_search_created_at
+ON log_search (created_at);
+`
+
+	// CreatePostgresSearchContentIndex represents a query to create a full-text
+	// index on the log_search table for the content column with Postgres.
+	CreatePostgresSearchContentIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_search_content
+ON log_search
+USING GIN (to_tsvector('simple', content));
 `
 )
 
@@ -31,6 +99,17 @@ func (e *Engine) CreateLogIndexes(ctx context.Context) error {
 	indices := []string{
 		CreateBuildIDIndex,
 		CreateCreatedAtIndex,
+		CreateChunkStepIDIndex,
+		CreateChunkServiceIDIndex,
+		CreateChunkCreatedAtIndex,
+		CreateObjectCreatedAtIndex,
+		CreateSearchRepoIDIndex,
+		CreateSearchCreatedAtIndex,
+	}
+
+	// full-text search indexes are only supported by Postgres
+	if e.client.Config.Dialector.Name() == constants.DriverPostgres {
+		indices = append(indices, CreatePostgresSearchContentIndex)
 	}
 
 	for _, index := range indices {
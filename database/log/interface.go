@@ -30,8 +30,14 @@ type LogInterface interface {
 	CountLogs(context.Context) (int64, error)
 	// CountLogsForBuild defines a function that gets the count of logs by build ID.
 	CountLogsForBuild(context.Context, *api.Build) (int64, error)
+	// CompactLog defines a function that compacts the chunks appended to a log into the log.
+	CompactLog(context.Context, *api.Log) error
 	// CreateLog defines a function that creates a new log.
 	CreateLog(context.Context, *api.Log) error
+	// CreateLogChunk defines a function that appends a chunk to an existing log.
+	CreateLogChunk(context.Context, *api.Log, []byte) (*api.LogChunk, error)
+	// CreateLogSearch defines a function that creates the blocks of lines to search a log.
+	CreateLogSearch(context.Context, *api.Log) error
 	// DeleteLog defines a function that deletes an existing log.
 	DeleteLog(context.Context, *api.Log) error
 	// GetLog defines a function that gets a log by ID.
@@ -40,12 +46,24 @@ type LogInterface interface {
 	GetLogForService(context.Context, *api.Service) (*api.Log, error)
 	// GetLogForStep defines a function that gets a log by step ID.
 	GetLogForStep(context.Context, *api.Step) (*api.Log, error)
+	// GetLogObject defines a function that gets the object a log was moved to.
+	GetLogObject(context.Context, *api.Log) (*api.Object, error)
 	// ListLogs defines a function that gets a list of all logs.
 	ListLogs(context.Context) ([]*api.Log, error)
+	// ListLogChunks defines a function that gets a list of the chunks appended to a log since an offset.
+	ListLogChunks(context.Context, *api.Log, int64) ([]*api.LogChunk, error)
 	// ListLogsForBuild defines a function that gets a list of logs by build ID.
 	ListLogsForBuild(context.Context, *api.Build, int, int) ([]*api.Log, error)
+	// ListLogsForOffload defines a function that gets a list of logs created before a timestamp not yet moved to the object storage.
+	ListLogsForOffload(context.Context, int64, int) ([]*api.Log, error)
+	// OffloadLog defines a function that records a log as moved to an object.
+	OffloadLog(context.Context, *api.Log, *api.Object) error
+	// SearchLogs defines a function that gets a list of lines from logs matching a query.
+	SearchLogs(context.Context, []int64, string, int64, int64, int, int) ([]*api.LogSearchResult, error)
 	// UpdateLog defines a function that updates an existing log.
 	UpdateLog(context.Context, *api.Log) error
 	// CleanLogs defines a function that deletes logs older than a specified timestamp in batches.
-	CleanLogs(context.Context, int64, int, bool, string) (*CleanupResult, error)
+	CleanLogs(context.Context, int64, int, bool, string, RemoveObjectFunc) (*CleanupResult, error)
+	// ApplyLogRetention defines a function that deletes logs outside of retention policies in batches.
+	ApplyLogRetention(context.Context, []*RetentionPolicy, int, bool, string, RemoveObjectFunc) (*CleanupResult, error)
 }
@@ -28,13 +28,15 @@ func (e *Engine) ListLogs(ctx context.Context) ([]*api.Log, error) {
 		return nil, err
 	}
 
+	// variable to store the decompressed logs
+	decompressed := []*types.Log{}
+
 	// iterate through all query results
-	for _, log := range *l {
-		// https://golang.org/doc/faq#closures_and_goroutines
-		tmp := log
+	for i := range *l {
+		log := &(*l)[i]
 
 		// decompress log data
-		err = tmp.Decompress()
+		err = log.Decompress()
 		if err != nil {
 			// ensures that the change is backwards compatible
 			// by logging the error instead of returning it
@@ -42,8 +44,18 @@ func (e *Engine) ListLogs(ctx context.Context) ([]*api.Log, error) {
 			e.logger.Errorf("unable to decompress logs: %v", err)
 		}
 
+		decompressed = append(decompressed, log)
+	}
+
+	// append the data not yet compacted into the logs
+	err = e.appendChunks(ctx, decompressed...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, log := range decompressed {
 		// convert query result to API type
-		logs = append(logs, tmp.ToAPI())
+		logs = append(logs, log.ToAPI())
 	}
 
 	return logs, nil
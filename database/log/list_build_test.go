@@ -7,6 +7,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
+
 	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database/testutils"
 	"github.com/go-vela/server/database/types"
@@ -48,6 +50,7 @@ func TestLog_Engine_ListLogsForBuild(t *testing.T) {
 
 	// ensure the mock expects the query
 	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE build_id = $1 ORDER BY service_id ASC NULLS LAST,step_id ASC LIMIT $2`).WithArgs(1, 10).WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE log_id IN ($1,$2) ORDER BY log_id ASC,sequence ASC`).WithArgs(1, 2).WillReturnRows(sqlmock.NewRows([]string{"id"}))
 
 	_sqlite := testSqlite(t)
 
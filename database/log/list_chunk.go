@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListLogChunks gets a list of the chunks appended to a log that end at or
+// after the offset from the database. The log entry is identified by the
+// service or step ID of the provided log.
+//
+// Chunks compacted into the log are no longer returned.
+func (e *Engine) ListLogChunks(ctx context.Context, l *api.Log, since int64) ([]*api.LogChunk, error) {
+	column, id := logColumn(l)
+
+	e.logger.Tracef("listing chunks since %d for log for %s %d", since, column, id)
+
+	// variables to store query results and return value
+	c := new([]types.LogChunk)
+	chunks := []*api.LogChunk{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableLogChunk).
+		Where(column+" = ?", id).
+		Where("position + length(data) >= ?", since).
+		Order("sequence ASC").
+		Find(&c).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, chunk := range *c {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := chunk
+
+		// convert query result to API type
+		chunks = append(chunks, tmp.ToAPI())
+	}
+
+	return chunks, nil
+}
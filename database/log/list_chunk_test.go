@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_ListLogChunks(t *testing.T) {
+	// setup types
+	_log := testutils.APILog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo"))
+	_log.SetCreatedAt(1)
+
+	_chunkOne := types.LogChunkFromLog(types.LogFromAPI(_log), 1, 3, []byte("bar"), 1)
+	_chunkOne.ID.Int64, _chunkOne.ID.Valid = 1, true
+
+	_chunkTwo := types.LogChunkFromLog(types.LogFromAPI(_log), 2, 6, []byte("baz"), 1)
+	_chunkTwo.ID.Int64, _chunkTwo.ID.Valid = 2, true
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*_chunkTwo})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE step_id = $1 AND position + length(data) >= $2 ORDER BY sequence ASC`).
+		WithArgs(1, 7).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	for _, data := range []string{"bar", "baz"} {
+		_, err = _sqlite.CreateLogChunk(context.TODO(), _log, []byte(data))
+		if err != nil {
+			t.Errorf("unable to create test log chunk for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		since    int64
+		want     []*api.LogChunk
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			since:    7,
+			want:     []*api.LogChunk{_chunkTwo.ToAPI()},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			since:    7,
+			want:     []*api.LogChunk{_chunkTwo.ToAPI()},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 all chunks",
+			database: _sqlite,
+			since:    0,
+			want:     []*api.LogChunk{_chunkOne.ToAPI(), _chunkTwo.ToAPI()},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 caught up",
+			database: _sqlite,
+			since:    9,
+			want:     []*api.LogChunk{_chunkTwo.ToAPI()},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListLogChunks(context.TODO(), _log, test.since)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListLogChunks for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListLogChunks for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListLogChunks for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListLogsForOffload gets a list of logs created before the timestamp
+// that were not moved to the object storage from the database.
+func (e *Engine) ListLogsForOffload(ctx context.Context, before int64, limit int) ([]*api.Log, error) {
+	e.logger.Tracef("listing logs created before %d to offload", before)
+
+	// variables to store query results and return value
+	l := new([]types.Log)
+	logs := []*api.Log{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableLog).
+		Where("created_at < ?", before).
+		Where("id NOT IN (?)", e.client.Table(constants.TableLogObject).Select("log_id")).
+		Order("id ASC").
+		Limit(limit).
+		Find(&l).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// variable to store the decompressed logs
+	decompressed := []*types.Log{}
+
+	// iterate through all query results
+	for i := range *l {
+		log := &(*l)[i]
+
+		// decompress log data
+		err = log.Decompress()
+		if err != nil {
+			// ensures that the change is backwards compatible
+			// by logging the error instead of returning it
+			// which allows us to fetch uncompressed logs
+			e.logger.Errorf("unable to decompress log %d: %v", log.ID.Int64, err)
+		}
+
+		decompressed = append(decompressed, log)
+	}
+
+	// append the data not yet compacted into the logs
+	err = e.appendChunks(ctx, decompressed...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, log := range decompressed {
+		// convert query result to API type
+		logs = append(logs, log.ToAPI())
+	}
+
+	return logs, nil
+}
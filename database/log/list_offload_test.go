@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_ListLogsForOffload(t *testing.T) {
+	// setup types
+	_service := testutils.APILog()
+	_service.SetID(1)
+	_service.SetRepoID(1)
+	_service.SetBuildID(1)
+	_service.SetServiceID(1)
+	_service.SetData([]byte("foo"))
+	_service.SetCreatedAt(1)
+
+	_step := testutils.APILog()
+	_step.SetID(2)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+	_step.SetStepID(1)
+	_step.SetData([]byte("bar"))
+	_step.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery(`SELECT * FROM "logs" WHERE created_at < $1 AND id NOT IN (SELECT log_id FROM "log_objects") ORDER BY id ASC LIMIT $2`).
+		WithArgs(2, 10).
+		WillReturnRows(testutils.CreateMockRows([]any{*types.LogFromAPI(_service), *types.LogFromAPI(_step)}))
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE log_id IN ($1,$2) ORDER BY log_id ASC,sequence ASC`).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _service)
+	if err != nil {
+		t.Errorf("unable to create test service log for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateLog(context.TODO(), _step)
+	if err != nil {
+		t.Errorf("unable to create test step log for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []*api.Log
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Log{_service, _step},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Log{_service, _step},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListLogsForOffload(context.TODO(), 2, 10)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListLogsForOffload for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListLogsForOffload for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListLogsForOffload for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+
+	// offloaded logs are no longer listed
+	err = _sqlite.OffloadLog(context.TODO(), _service, &api.Object{ObjectName: "logs/1/1/service_1.log", Size: 3})
+	if err != nil {
+		t.Errorf("unable to offload test service log for sqlite: %v", err)
+	}
+
+	got, err := _sqlite.ListLogsForOffload(context.TODO(), 2, 10)
+	if err != nil {
+		t.Errorf("ListLogsForOffload for sqlite3 returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, []*api.Log{_step}) {
+		t.Errorf("ListLogsForOffload for sqlite3 is %v, want %v", got, []*api.Log{_step})
+	}
+}
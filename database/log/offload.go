@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// OffloadLog records an existing log as moved to the object in the database.
+//
+// The data and chunks of the log are removed, leaving the log
+// with a pointer to the object the data was moved to. Chunks
+// appended after the data was moved are kept.
+func (e *Engine) OffloadLog(ctx context.Context, l *api.Log, o *api.Object) error {
+	e.logger.Tracef("offloading log %d to object %s", l.GetID(), o.ObjectName)
+
+	// create the pointer to the object for the log
+	object := types.LogObjectFromLog(l, o, time.Now().UTC().Unix())
+
+	// validate the necessary fields are populated
+	err := object.Validate()
+	if err != nil {
+		return err
+	}
+
+	// compress the empty log data left in the database
+	log := types.LogFromAPI(l)
+	log.Data = []byte{}
+
+	err = log.Compress(e.config.CompressionLevel)
+	if err != nil {
+		return fmt.Errorf("unable to compress log %d: %w", l.GetID(), err)
+	}
+
+	return e.client.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Table(constants.TableLogObject).
+				Create(object).
+				Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.
+				Table(constants.TableLog).
+				Where("id = ?", l.GetID()).
+				Update("data", log.Data).
+				Error
+			if err != nil {
+				return err
+			}
+
+			// capture the chunks appended to the log
+			chunks := []types.LogChunk{}
+
+			err = tx.
+				Table(constants.TableLogChunk).
+				Where("log_id = ?", l.GetID()).
+				Order("sequence ASC").
+				Find(&chunks).
+				Error
+			if err != nil {
+				return err
+			}
+
+			// find the last chunk within the data moved to the object
+			var sequence int64
+
+			for _, chunk := range chunks {
+				if chunk.Position.Int64+int64(len(chunk.Data)) > o.Size {
+					break
+				}
+
+				sequence = chunk.Sequence.Int64
+			}
+
+			if sequence == 0 {
+				return nil
+			}
+
+			// send query to the database
+			return tx.
+				Table(constants.TableLogChunk).
+				Where("log_id = ?", l.GetID()).
+				Where("sequence <= ?", sequence).
+				Delete(new(types.LogChunk)).
+				Error
+		})
+}
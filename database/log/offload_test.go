@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_OffloadLog(t *testing.T) {
+	// setup types
+	_log := testutils.APILog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo"))
+	_log.SetCreatedAt(1)
+
+	// the chunk appended before the log was moved is part of the object
+	_object := &api.Object{
+		ObjectName:  "logs/1/1/step_1.log",
+		Size:        6,
+		ContentType: "text/plain",
+	}
+
+	_chunk := types.LogChunkFromLog(types.LogFromAPI(_log), 1, 3, []byte("bar"), 1)
+	_chunk.ID.Int64, _chunk.ID.Valid = 1, true
+
+	_appended := types.LogChunkFromLog(types.LogFromAPI(_log), 2, 6, []byte("baz"), 1)
+	_appended.ID.Int64, _appended.ID.Valid = 2, true
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectQuery(`INSERT INTO "log_objects" ("log_id","build_id","repo_id","service_id","step_id","object_path","size","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs(1, 1, 1, nil, 1, "logs/1/1/step_1.log", 6, AnyArgument{}).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	_mock.ExpectExec(`UPDATE "logs" SET "data"=$1 WHERE id = $2`).
+		WithArgs(AnyArgument{}, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectQuery(`SELECT * FROM "log_chunks" WHERE log_id = $1 ORDER BY sequence ASC`).
+		WithArgs(1).
+		WillReturnRows(testutils.CreateMockRows([]any{*_chunk, *_appended}))
+	_mock.ExpectExec(`DELETE FROM "log_chunks" WHERE log_id = $1 AND sequence <= $2`).
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	_, err = _sqlite.CreateLogChunk(context.TODO(), _log, []byte("bar"))
+	if err != nil {
+		t.Errorf("unable to create test log chunk for sqlite: %v", err)
+	}
+
+	_, err = _sqlite.CreateLogChunk(context.TODO(), _log, []byte("baz"))
+	if err != nil {
+		t.Errorf("unable to create test log chunk for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.OffloadLog(context.TODO(), _log, _object)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("OffloadLog for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("OffloadLog for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// the offloaded log is left with only the chunk appended after it was moved
+	got, err := _sqlite.GetLogForStep(context.TODO(), &api.Step{ID: new(int64(1))})
+	if err != nil {
+		t.Errorf("GetLogForStep for sqlite3 returned err: %v", err)
+	}
+
+	if string(got.GetData()) != "baz" {
+		t.Errorf("GetLogForStep for sqlite3 is %s, want %s", got.GetData(), "baz")
+	}
+
+	object, err := _sqlite.GetLogObject(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("GetLogObject for sqlite3 returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(object, _object) {
+		t.Errorf("GetLogObject for sqlite3 is %v, want %v", object, _object)
+	}
+
+	// offloading the log again fails on the existing pointer
+	err = _sqlite.OffloadLog(context.TODO(), _log, _object)
+	if err == nil {
+		t.Errorf("OffloadLog for sqlite3 should have returned err for offloaded log")
+	}
+}
@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/constants"
+)
+
+// RetentionPolicy represents the logs of a repo removed by a log retention rule.
+type RetentionPolicy struct {
+	// RepoID is the ID of the repo the policy applies to.
+	RepoID int64
+	// Before removes the logs of builds that did not fail created
+	// before the timestamp, where zero keeps those logs.
+	Before int64
+	// FailedBefore removes the logs of failed builds created
+	// before the timestamp, where zero keeps those logs.
+	FailedBefore int64
+	// KeepDeployments keeps the logs of deployment builds.
+	KeepDeployments bool
+}
+
+// ApplyLogRetention deletes the logs of repos outside of the provided retention policies in batches.
+// It shares the lock of CleanLogs so retention and cleanup operations never run concurrently, and
+// deletes from the log partitions directly if partitioned mode is enabled and the database is PostgreSQL.
+//
+// The log chunks, pointers to offloaded logs and blocks of lines for search of the deleted logs
+// are removed as well. The objects offloaded logs were moved to are removed with the provided
+// function before the pointers to them, which may be nil without storage.
+func (e *Engine) ApplyLogRetention(ctx context.Context, policies []*RetentionPolicy, batchSize int, withVacuum bool, driver string, remove RemoveObjectFunc) (*CleanupResult, error) {
+	logrus.Tracef("applying %d log retention policies in batches of %d", len(policies), batchSize)
+
+	// try to acquire a distributed lock to prevent concurrent cleanup operations
+	release, err := e.lockCleanup(ctx, driver)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	result := &CleanupResult{
+		AffectedPartitions: []string{},
+		DeletedByRepo:      make(map[int64]int64),
+	}
+
+	tables := []string{constants.TableLog}
+	partitioned := false
+
+	if e.isPartitionedModeEnabled() && driver == constants.DriverPostgres {
+		partitions, err := e.discoverLogPartitions(ctx)
+
+		switch {
+		case err != nil:
+			logrus.Warnf("failed to discover log partitions (%v), falling back to traditional retention", err)
+		case len(partitions) == 0:
+			logrus.Warnf("no log partitions found matching pattern %s, falling back to traditional retention", e.config.LogPartitionPattern)
+		default:
+			tables = partitions
+			partitioned = true
+		}
+	}
+
+	affected := make(map[string]bool)
+
+	for _, policy := range policies {
+		for _, table := range tables {
+			deleted, err := e.cleanRetentionTable(ctx, table, policy, batchSize)
+			if err != nil {
+				return result, fmt.Errorf("failed to apply log retention for repo %d: %w", policy.RepoID, err)
+			}
+
+			if deleted == 0 {
+				continue
+			}
+
+			result.DeletedCount += deleted
+			result.DeletedByRepo[policy.RepoID] += deleted
+
+			if partitioned && !affected[table] {
+				affected[table] = true
+				result.AffectedPartitions = append(result.AffectedPartitions, table)
+			}
+		}
+
+		// these belong to logs removed by the policy, so failing
+		// to delete them is logged instead of failing the retention
+		for _, table := range []string{constants.TableLogChunk, constants.TableLogSearch} {
+			_, err := e.cleanRetentionTable(ctx, table, policy, batchSize)
+			if err != nil {
+				logrus.Warnf("failed to apply log retention to %s for repo %d: %v", table, policy.RepoID, err)
+			}
+		}
+
+		_, err := e.cleanRetentionObjects(ctx, policy, batchSize, remove)
+		if err != nil {
+			logrus.Warnf("failed to apply log retention to %s for repo %d: %v", constants.TableLogObject, policy.RepoID, err)
+		}
+	}
+
+	logrus.Infof("log retention deleted %d logs for %d repos", result.DeletedCount, len(result.DeletedByRepo))
+
+	// optionally run VACUUM to reclaim space
+	if withVacuum && result.DeletedCount > 0 {
+		if partitioned {
+			for _, partition := range result.AffectedPartitions {
+				if err := e.vacuumPartition(ctx, partition); err != nil {
+					logrus.Warnf("failed to vacuum partition %s: %v", partition, err)
+				}
+			}
+		} else if err := e.vacuumLogs(ctx, driver); err != nil {
+			// don't fail the entire operation if vacuum fails
+			logrus.Warnf("failed to vacuum logs table after retention: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+// cleanRetentionTable deletes records of a repo outside of the retention policy from the table in batches.
+func (e *Engine) cleanRetentionTable(ctx context.Context, table string, policy *RetentionPolicy, batchSize int) (int64, error) {
+	filter, args := retentionFilter(policy)
+
+	// the policy keeps every log of the repo
+	if len(filter) == 0 {
+		return 0, nil
+	}
+
+	query := fmt.Sprintf(
+		"DELETE FROM %s WHERE id IN (SELECT l.id FROM %s l JOIN builds b ON b.id = l.build_id WHERE l.repo_id = ? AND %s ORDER BY l.created_at ASC LIMIT ?)",
+		table, table, filter,
+	)
+
+	args = append([]any{policy.RepoID}, args...)
+	args = append(args, batchSize)
+
+	var totalDeleted int64
+
+	// process deletions in batches
+	for {
+		// check for context cancellation
+		select {
+		case <-ctx.Done():
+			return totalDeleted, ctx.Err()
+		default:
+		}
+
+		result := e.client.WithContext(ctx).Exec(query, args...)
+		if result.Error != nil {
+			return totalDeleted, fmt.Errorf("failed to delete batch of %s: %w", table, result.Error)
+		}
+
+		totalDeleted += result.RowsAffected
+
+		// if we deleted fewer records than the batch size, we're done
+		if result.RowsAffected < int64(batchSize) {
+			break
+		}
+
+		// sleep between batches to reduce database load
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return totalDeleted, nil
+}
+
+// cleanRetentionObjects removes the objects of offloaded logs of a repo outside of the
+// retention policy from the object storage and deletes the pointers to them in batches.
+func (e *Engine) cleanRetentionObjects(ctx context.Context, policy *RetentionPolicy, batchSize int, remove RemoveObjectFunc) (int64, error) {
+	filter, args := retentionFilter(policy)
+
+	// the policy keeps every log of the repo
+	if len(filter) == 0 {
+		return 0, nil
+	}
+
+	query := fmt.Sprintf(
+		"SELECT l.id, l.object_path FROM %s l JOIN builds b ON b.id = l.build_id WHERE l.repo_id = ? AND %s ORDER BY l.created_at ASC LIMIT ?",
+		constants.TableLogObject, filter,
+	)
+
+	args = append([]any{policy.RepoID}, args...)
+	args = append(args, batchSize)
+
+	return e.cleanLogObjects(ctx, query, args, batchSize, remove)
+}
+
+// retentionFilter is a helper function to build the condition selecting
+// the records outside of the retention policy along with its arguments.
+func retentionFilter(policy *RetentionPolicy) (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	if policy.Before > 0 {
+		conditions = append(conditions, "(b.status NOT IN (?, ?) AND l.created_at < ?)")
+		args = append(args, constants.StatusFailure, constants.StatusError, policy.Before)
+	}
+
+	if policy.FailedBefore > 0 {
+		conditions = append(conditions, "(b.status IN (?, ?) AND l.created_at < ?)")
+		args = append(args, constants.StatusFailure, constants.StatusError, policy.FailedBefore)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	filter := fmt.Sprintf("(%s)", strings.Join(conditions, " OR "))
+
+	if policy.KeepDeployments {
+		filter += " AND b.event <> ?"
+
+		args = append(args, constants.EventDeploy)
+	}
+
+	return filter, args
+}
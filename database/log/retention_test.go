@@ -0,0 +1,262 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_ApplyLogRetention(t *testing.T) {
+	// setup types
+	now := time.Now()
+	before := now.Add(-30 * 24 * time.Hour).Unix()
+	failedBefore := now.Add(-90 * 24 * time.Hour).Unix()
+
+	_policy := &RetentionPolicy{
+		RepoID:          1,
+		Before:          before,
+		FailedBefore:    failedBefore,
+		KeepDeployments: true,
+	}
+
+	_success := testutils.APIBuild()
+	_success.SetID(1)
+	_success.SetRepo(testutils.APIRepo())
+	_success.GetRepo().SetID(1)
+	_success.SetNumber(1)
+	_success.SetStatus(constants.StatusSuccess)
+	_success.SetEvent(constants.EventPush)
+
+	_failure := testutils.APIBuild()
+	_failure.SetID(2)
+	_failure.SetRepo(_success.GetRepo())
+	_failure.SetNumber(2)
+	_failure.SetStatus(constants.StatusFailure)
+	_failure.SetEvent(constants.EventPush)
+
+	_deploy := testutils.APIBuild()
+	_deploy.SetID(3)
+	_deploy.SetRepo(_success.GetRepo())
+	_deploy.SetNumber(3)
+	_deploy.SetStatus(constants.StatusSuccess)
+	_deploy.SetEvent(constants.EventDeploy)
+
+	// setup the test database client
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// mock PostgreSQL advisory lock acquisition
+	_mock.ExpectQuery("SELECT pg_try_advisory_lock($1)").
+		WithArgs(int64(123456789)).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+
+	// mock deletion of the logs outside of the policy in batches
+	_mock.ExpectExec("DELETE FROM logs WHERE id IN (SELECT l.id FROM logs l JOIN builds b ON b.id = l.build_id WHERE l.repo_id = $1 AND ((b.status NOT IN ($2, $3) AND l.created_at < $4) OR (b.status IN ($5, $6) AND l.created_at < $7)) AND b.event <> $8 ORDER BY l.created_at ASC LIMIT $9)").
+		WithArgs(1, "failure", "error", before, "failure", "error", failedBefore, "deployment", 2).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	_mock.ExpectExec("DELETE FROM logs WHERE id IN (SELECT l.id FROM logs l JOIN builds b ON b.id = l.build_id WHERE l.repo_id = $1 AND ((b.status NOT IN ($2, $3) AND l.created_at < $4) OR (b.status IN ($5, $6) AND l.created_at < $7)) AND b.event <> $8 ORDER BY l.created_at ASC LIMIT $9)").
+		WithArgs(1, "failure", "error", before, "failure", "error", failedBefore, "deployment", 2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// mock deletion of the log chunks and blocks of lines for search
+	for _, table := range []string{"log_chunks", "log_search"} {
+		_mock.ExpectExec("DELETE FROM "+table+" WHERE id IN (SELECT l.id FROM "+table+" l JOIN builds b ON b.id = l.build_id WHERE l.repo_id = $1 AND ((b.status NOT IN ($2, $3) AND l.created_at < $4) OR (b.status IN ($5, $6) AND l.created_at < $7)) AND b.event <> $8 ORDER BY l.created_at ASC LIMIT $9)").
+			WithArgs(1, "failure", "error", before, "failure", "error", failedBefore, "deployment", 2).
+			WillReturnResult(sqlmock.NewResult(1, 0))
+	}
+
+	// mock removal of the objects offloaded logs were moved to before the pointers to them
+	_mock.ExpectQuery("SELECT l.id, l.object_path FROM log_objects l JOIN builds b ON b.id = l.build_id WHERE l.repo_id = $1 AND ((b.status NOT IN ($2, $3) AND l.created_at < $4) OR (b.status IN ($5, $6) AND l.created_at < $7)) AND b.event <> $8 ORDER BY l.created_at ASC LIMIT $9").
+		WithArgs(1, "failure", "error", before, "failure", "error", failedBefore, "deployment", 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "object_path"}).AddRow(1, "logs/1/1/step_1.log"))
+	_mock.ExpectExec("DELETE FROM log_objects WHERE id IN ($1)").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// mock VACUUM operation
+	_mock.ExpectExec(`VACUUM ANALYZE logs`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// mock PostgreSQL advisory lock release
+	_mock.ExpectQuery("SELECT pg_advisory_unlock($1)").
+		WithArgs(int64(123456789)).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_advisory_unlock"}).AddRow(true))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.client.AutoMigrate(&types.Build{})
+	if err != nil {
+		t.Errorf("unable to create build table for sqlite: %v", err)
+	}
+
+	for _, b := range []*types.Build{types.BuildFromAPI(_success), types.BuildFromAPI(_failure), types.BuildFromAPI(_deploy)} {
+		err = _sqlite.client.Table(constants.TableBuild).Create(b).Error
+		if err != nil {
+			t.Errorf("unable to create test build for sqlite: %v", err)
+		}
+	}
+
+	// create logs for each build where only the log of the
+	// successful build from 60 days ago is outside of the policy
+	for i, created := range []struct {
+		build int64
+		age   time.Duration
+	}{
+		{build: 1, age: 60 * 24 * time.Hour},
+		{build: 1, age: 24 * time.Hour},
+		{build: 2, age: 60 * 24 * time.Hour},
+		{build: 3, age: 365 * 24 * time.Hour},
+	} {
+		_log := testutils.APILog()
+		_log.SetID(int64(i + 1))
+		_log.SetBuildID(created.build)
+		_log.SetRepoID(1)
+		_log.SetStepID(int64(i + 1))
+		_log.SetData([]byte("test log data"))
+		_log.SetCreatedAt(now.Add(-created.age).Unix())
+
+		err = _sqlite.CreateLog(context.TODO(), _log)
+		if err != nil {
+			t.Errorf("unable to create test log for sqlite: %v", err)
+		}
+
+		object := types.LogObjectFromLog(_log, &api.Object{ObjectName: fmt.Sprintf("logs/1/%d/step_%d.log", created.build, i+1), Size: 13}, _log.GetCreatedAt())
+
+		err = _sqlite.client.Table(constants.TableLogObject).Create(object).Error
+		if err != nil {
+			t.Errorf("unable to create test log object for sqlite: %v", err)
+		}
+	}
+
+	removed := []string{}
+
+	remove := func(_ context.Context, path string) error {
+		removed = append(removed, path)
+
+		return nil
+	}
+
+	// setup tests
+	tests := []struct {
+		failure    bool
+		name       string
+		database   *Engine
+		batchSize  int
+		withVacuum bool
+		driver     string
+		want       map[int64]int64
+	}{
+		{
+			failure:    false,
+			name:       "postgres with vacuum",
+			database:   _postgres,
+			batchSize:  2,
+			withVacuum: true,
+			driver:     constants.DriverPostgres,
+			want:       map[int64]int64{1: 3},
+		},
+		{
+			failure:    false,
+			name:       "sqlite without vacuum",
+			database:   _sqlite,
+			batchSize:  2,
+			withVacuum: false,
+			driver:     constants.DriverSqlite,
+			want:       map[int64]int64{1: 1},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.database.ApplyLogRetention(context.TODO(), []*RetentionPolicy{_policy}, test.batchSize, test.withVacuum, test.driver, remove)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ApplyLogRetention for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ApplyLogRetention for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(result.DeletedByRepo, test.want) {
+				t.Errorf("ApplyLogRetention for %s deleted %v, want %v", test.name, result.DeletedByRepo, test.want)
+			}
+		})
+	}
+
+	// only the objects of logs outside of the policy are removed from the storage
+	if !reflect.DeepEqual(removed, []string{"logs/1/1/step_1.log", "logs/1/1/step_1.log"}) {
+		t.Errorf("ApplyLogRetention removed objects %v, want %v", removed, []string{"logs/1/1/step_1.log", "logs/1/1/step_1.log"})
+	}
+}
+
+func TestLog_Engine_ApplyLogRetention_KeepForever(t *testing.T) {
+	// setup the test database client
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// mock PostgreSQL advisory lock acquisition and release without any deletion
+	_mock.ExpectQuery("SELECT pg_try_advisory_lock($1)").
+		WithArgs(int64(123456789)).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+
+	_mock.ExpectQuery("SELECT pg_advisory_unlock($1)").
+		WithArgs(int64(123456789)).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_advisory_unlock"}).AddRow(true))
+
+	result, err := _postgres.ApplyLogRetention(context.TODO(), []*RetentionPolicy{{RepoID: 1, KeepDeployments: true}}, 1000, true, constants.DriverPostgres, nil)
+	if err != nil {
+		t.Errorf("ApplyLogRetention returned err: %v", err)
+	}
+
+	if result.DeletedCount != 0 {
+		t.Errorf("ApplyLogRetention returned %d deleted, want 0", result.DeletedCount)
+	}
+
+	if err := _mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("ApplyLogRetention did not meet expectations: %v", err)
+	}
+}
+
+func TestLog_Engine_ApplyLogRetention_LockHeld(t *testing.T) {
+	// setup the test database client
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// mock PostgreSQL advisory lock held by another cleanup operation
+	_mock.ExpectQuery("SELECT pg_try_advisory_lock($1)").
+		WithArgs(int64(123456789)).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+	_, err := _postgres.ApplyLogRetention(context.TODO(), []*RetentionPolicy{{RepoID: 1}}, 1000, false, constants.DriverPostgres, nil)
+	if !errors.Is(err, ErrCleanupInProgress) {
+		t.Errorf("ApplyLogRetention returned err %v, want %v", err, ErrCleanupInProgress)
+	}
+
+	if err := _mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("ApplyLogRetention did not meet expectations: %v", err)
+	}
+}
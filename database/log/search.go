@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"strings"
+
+	"gorm.io/gorm"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// likeEscaper escapes the wildcard characters of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchLogs gets a list of lines from the logs for the repos created between
+// the timestamps that contain every term of the query from the database.
+//
+// Postgres matches the blocks of lines with a full-text search of the query
+// while other databases fall back to matching every term within the blocks.
+// The lines are ordered from the most recent build and include up to the
+// provided number of lines before and after each line.
+func (e *Engine) SearchLogs(ctx context.Context, repos []int64, query string, after, before int64, lines, limit int) ([]*api.LogSearchResult, error) {
+	e.logger.Tracef("searching logs for %d repos", len(repos))
+
+	// variable to store the results
+	results := []*api.LogSearchResult{}
+
+	terms := types.SearchTerms(query)
+	if len(terms) == 0 || len(repos) == 0 || limit <= 0 {
+		return results, nil
+	}
+
+	// blocks is a helper function to create the query for the blocks of lines
+	blocks := func() *gorm.DB {
+		tx := e.client.
+			WithContext(ctx).
+			Table(constants.TableLogSearch).
+			Where("repo_id IN ?", repos).
+			Where("created_at > ?", after).
+			Where("created_at < ?", before)
+
+		if e.client.Config.Dialector.Name() == constants.DriverPostgres {
+			return tx.Where("to_tsvector('simple', content) @@ plainto_tsquery('simple', ?)", query)
+		}
+
+		for _, term := range terms {
+			tx = tx.Where(`content LIKE ? ESCAPE '\'`, "%"+likeEscaper.Replace(term)+"%")
+		}
+
+		return tx
+	}
+
+	// blocks matching the query may not contain a line with every term,
+	// so the blocks are read until enough lines are found
+	for offset := 0; len(results) < limit; offset += limit {
+		// variable to store query results
+		s := new([]types.LogSearch)
+
+		// send query to the database and store result in variable
+		err := blocks().
+			Order("build_id DESC").
+			Order("log_id ASC").
+			Order("line ASC").
+			Offset(offset).
+			Limit(limit).
+			Find(&s).
+			Error
+		if err != nil {
+			return nil, err
+		}
+
+		// iterate through all query results
+		for _, block := range *s {
+			results = append(results, block.Match(terms, lines)...)
+		}
+
+		if len(*s) < limit {
+			break
+		}
+	}
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
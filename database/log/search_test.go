@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestLog_Engine_SearchLogs(t *testing.T) {
+	// setup types
+	_step := testutils.APILog()
+	_step.SetID(1)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+	_step.SetStepID(1)
+	_step.SetData([]byte("=== RUN TestFoo\npanic: runtime error\ngoroutine 1 [running]:\n"))
+	_step.SetCreatedAt(1)
+
+	_service := testutils.APILog()
+	_service.SetID(2)
+	_service.SetRepoID(1)
+	_service.SetBuildID(2)
+	_service.SetServiceID(1)
+	_service.SetData([]byte("Panic: 100% disk usage\n"))
+	_service.SetCreatedAt(1)
+
+	_other := testutils.APILog()
+	_other.SetID(3)
+	_other.SetRepoID(2)
+	_other.SetBuildID(3)
+	_other.SetStepID(2)
+	_other.SetData([]byte("panic: runtime error\n"))
+	_other.SetCreatedAt(1)
+
+	_block := types.LogSearchFromLog(_step, 1)[0]
+	_block.ID.Int64, _block.ID.Valid = 1, true
+
+	_want := []*api.LogSearchResult{
+		{
+			RepoID:  1,
+			BuildID: 1,
+			StepID:  1,
+			Line:    2,
+			Text:    "panic: runtime error",
+			Before:  []string{"=== RUN TestFoo"},
+			After:   []string{"goroutine 1 [running]:"},
+		},
+	}
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "log_search" WHERE repo_id IN ($1) AND created_at > $2 AND created_at < $3 AND to_tsvector('simple', content) @@ plainto_tsquery('simple', $4) ORDER BY build_id DESC,log_id ASC,line ASC LIMIT $5`).
+		WithArgs(1, 0, 2, "runtime error", 10).
+		WillReturnRows(testutils.CreateMockRows([]any{*_block}))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, log := range []*api.Log{_step, _service, _other} {
+		err := _sqlite.CreateLogSearch(context.TODO(), log)
+		if err != nil {
+			t.Errorf("unable to create test log search for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		query    string
+		limit    int
+		want     []*api.LogSearchResult
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			query:    "runtime error",
+			limit:    10,
+			want:     _want,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			query:    "runtime error",
+			limit:    10,
+			want:     _want,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with wildcard",
+			database: _sqlite,
+			query:    "100%",
+			limit:    10,
+			want: []*api.LogSearchResult{
+				{
+					RepoID:    1,
+					BuildID:   2,
+					ServiceID: 1,
+					Line:      1,
+					Text:      "Panic: 100% disk usage",
+					Before:    []string{},
+					After:     []string{},
+				},
+			},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with limit",
+			database: _sqlite,
+			query:    "panic",
+			limit:    1,
+			want: []*api.LogSearchResult{
+				{
+					RepoID:    1,
+					BuildID:   2,
+					ServiceID: 1,
+					Line:      1,
+					Text:      "Panic: 100% disk usage",
+					Before:    []string{},
+					After:     []string{},
+				},
+			},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 without terms",
+			database: _sqlite,
+			query:    "",
+			limit:    10,
+			want:     []*api.LogSearchResult{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.SearchLogs(context.TODO(), []int64{1}, test.query, 0, 2, 1, test.limit)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("SearchLogs for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("SearchLogs for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("SearchLogs for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -44,6 +44,27 @@ import (
 // CREATE INDEX IF NOT EXISTS logs_created_at ON logs (created_at);
 //
 // Note: SQLite does not support partitioning, so this is not an option.
+//
+// The log_chunks table holds the data appended to a log until it is compacted
+// into the logs table. When the logs table is partitioned, the log_chunks table
+// can be partitioned by the log it belongs to, which keeps the chunks for a log
+// in a single partition and satisfies the unique sequence per log:
+//
+// CREATE TABLE log_chunks (
+//     id            BIGSERIAL,
+//     log_id        BIGINT,
+//     ...
+//     PRIMARY KEY (id, log_id),
+//     UNIQUE (log_id, sequence)
+// ) PARTITION BY HASH (log_id);
+//
+// The log_objects table holds a pointer for each log moved to the object
+// storage. The logs keep their row with the data removed, so the pointers
+// are kept in a separate table and need no changes to a partitioned logs table.
+//
+// The log_search table holds the decompressed data of finished logs split into
+// blocks of lines. The blocks are kept small enough to index the content for
+// full-text search with Postgres, which limits the size of the indexed text.
 
 const (
 	// CreatePostgresTable represents a query to create the Postgres logs table.
@@ -78,26 +99,138 @@ logs (
 	UNIQUE(step_id),
 	UNIQUE(service_id)
 );
+`
+
+	// CreatePostgresChunkTable represents a query to create the Postgres log_chunks table.
+	CreatePostgresChunkTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_chunks (
+	id            BIGSERIAL PRIMARY KEY,
+	log_id        BIGINT,
+	build_id      BIGINT,
+	repo_id       BIGINT,
+	service_id    BIGINT,
+	step_id       BIGINT,
+	sequence      INTEGER,
+	position      BIGINT,
+	data          BYTEA,
+	created_at    BIGINT,
+	UNIQUE(log_id, sequence)
+);
+`
+
+	// CreateSqliteChunkTable represents a query to create the Sqlite log_chunks table.
+	CreateSqliteChunkTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_chunks (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	log_id        INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	service_id    INTEGER,
+	step_id       INTEGER,
+	sequence      INTEGER,
+	position      INTEGER,
+	data          BLOB,
+	created_at    INTEGER,
+	UNIQUE(log_id, sequence)
+);
+`
+
+	// CreatePostgresObjectTable represents a query to create the Postgres log_objects table.
+	CreatePostgresObjectTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_objects (
+	id            BIGSERIAL PRIMARY KEY,
+	log_id        BIGINT,
+	build_id      BIGINT,
+	repo_id       BIGINT,
+	service_id    BIGINT,
+	step_id       BIGINT,
+	object_path   VARCHAR(1000),
+	size          BIGINT,
+	created_at    BIGINT,
+	UNIQUE(log_id)
+);
+`
+
+	// CreateSqliteObjectTable represents a query to create the Sqlite log_objects table.
+	CreateSqliteObjectTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_objects (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	log_id        INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	service_id    INTEGER,
+	step_id       INTEGER,
+	object_path   TEXT,
+	size          INTEGER,
+	created_at    INTEGER,
+	UNIQUE(log_id)
+);
+`
+
+	// CreatePostgresSearchTable represents a query to create the Postgres log_search table.
+	CreatePostgresSearchTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_search (
+	id            BIGSERIAL PRIMARY KEY,
+	log_id        BIGINT,
+	build_id      BIGINT,
+	repo_id       BIGINT,
+	service_id    BIGINT,
+	step_id       BIGINT,
+	line          BIGINT,
+	content       TEXT,
+	created_at    BIGINT,
+	UNIQUE(log_id, line)
+);
+`
+
+	// CreateSqliteSearchTable represents a query to create the Sqlite log_search table.
+	CreateSqliteSearchTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_search (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	log_id        INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	service_id    INTEGER,
+	step_id       INTEGER,
+	line          INTEGER,
+	content       TEXT,
+	created_at    INTEGER,
+	UNIQUE(log_id, line)
+);
 `
 )
 
-// CreateLogTable creates the logs table in the database.
+// CreateLogTable creates the logs, log_chunks, log_objects and log_search tables in the database.
 func (e *Engine) CreateLogTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating logs table")
 
-	// handle the driver provided to create the table
-	switch driver {
-	case constants.DriverPostgres:
-		// create the logs table for Postgres
-		return e.client.
-			WithContext(ctx).
-			Exec(CreatePostgresTable).Error
-	case constants.DriverSqlite:
-		fallthrough
-	default:
-		// create the logs table for Sqlite
-		return e.client.
+	tables := []string{CreateSqliteTable, CreateSqliteChunkTable, CreateSqliteObjectTable, CreateSqliteSearchTable}
+
+	// handle the driver provided to create the tables
+	if driver == constants.DriverPostgres {
+		tables = []string{CreatePostgresTable, CreatePostgresChunkTable, CreatePostgresObjectTable, CreatePostgresSearchTable}
+	}
+
+	for _, table := range tables {
+		err := e.client.
 			WithContext(ctx).
-			Exec(CreateSqliteTable).Error
+			Exec(table).Error
+		if err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
@@ -6,6 +6,8 @@ import (
 	"context"
 	"fmt"
 
+	"gorm.io/gorm"
+
 	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database/types"
@@ -44,7 +46,20 @@ func (e *Engine) UpdateLog(ctx context.Context, l *api.Log) error {
 	// send query to the database
 	return e.client.
 		WithContext(ctx).
-		Table(constants.TableLog).
-		Save(log).
-		Error
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Table(constants.TableLog).
+				Save(log).
+				Error
+			if err != nil {
+				return err
+			}
+
+			// the log is replaced so drop the chunks appended to it
+			return tx.
+				Table(constants.TableLogChunk).
+				Where("log_id = ?", log.ID.Int64).
+				Delete(new(types.LogChunk)).
+				Error
+		})
 }
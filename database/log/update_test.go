@@ -34,19 +34,29 @@ func TestLog_Engine_UpdateLog(t *testing.T) {
 
 	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
 
-	// ensure the mock expects the service query
+	// ensure the mock expects the service queries
+	_mock.ExpectBegin()
 	_mock.ExpectExec(`UPDATE "logs"
 SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5,"created_at"=$6
 WHERE "id" = $7`).
 		WithArgs(1, 1, 1, nil, AnyArgument{}, 1, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`DELETE FROM "log_chunks" WHERE log_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	_mock.ExpectCommit()
 
-	// ensure the mock expects the step query
+	// ensure the mock expects the step queries
+	_mock.ExpectBegin()
 	_mock.ExpectExec(`UPDATE "logs"
 SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5,"created_at"=$6
 WHERE "id" = $7`).
 		WithArgs(1, 1, nil, 1, AnyArgument{}, 1, 2).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`DELETE FROM "log_chunks" WHERE log_id = $1`).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	_mock.ExpectCommit()
 
 	_sqlite := testSqlite(t)
 
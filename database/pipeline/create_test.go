@@ -35,9 +35,9 @@ func TestPipeline_Engine_CreatePipeline(t *testing.T) {
 
 	// ensure the mock expects the query
 	_mock.ExpectQuery(`INSERT INTO "pipelines"
-("repo_id","commit","flavor","platform","ref","type","version","external_secrets","internal_secrets","services","stages","steps","templates","artifacts","warnings","data","id")
-VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17) RETURNING "id"`).
-		WithArgs(1, "48afb5bdc41ad69bf22588491333f7cf71135163", nil, nil, "refs/heads/main", "yaml", "1", false, false, false, false, false, false, false, nil, AnyArgument{}, 1).
+("repo_id","commit","generated_digest","flavor","platform","ref","type","version","external_secrets","internal_secrets","services","stages","steps","templates","artifacts","warnings","resolved_templates","data","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19) RETURNING "id"`).
+		WithArgs(1, "48afb5bdc41ad69bf22588491333f7cf71135163", "", nil, nil, "refs/heads/main", "yaml", "1", false, false, false, false, false, false, false, nil, nil, AnyArgument{}, 1).
 		WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetGeneratedPipelineForRepo gets a pipeline generated by a build by commit SHA,
+// digest of the generated configuration and repo ID from the database.
+func (e *Engine) GetGeneratedPipelineForRepo(ctx context.Context, commit, digest string, r *api.Repo) (*api.Pipeline, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":      r.GetOrg(),
+		"pipeline": commit,
+		"repo":     r.GetName(),
+	}).Tracef("getting generated pipeline %s/%s/%s", r.GetFullName(), commit, digest)
+
+	// variable to store query results
+	p := new(types.Pipeline)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TablePipeline).
+		Where("repo_id = ?", r.GetID()).
+		Where("\"commit\" = ?", commit).
+		Where("generated_digest = ?", digest).
+		Take(p).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.Decompress()
+	if err != nil {
+		return nil, err
+	}
+
+	result := p.ToAPI()
+	result.SetRepo(r)
+
+	return result, nil
+}
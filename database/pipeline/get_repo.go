@@ -29,6 +29,7 @@ func (e *Engine) GetPipelineForRepo(ctx context.Context, commit string, r *api.R
 		Table(constants.TablePipeline).
 		Where("repo_id = ?", r.GetID()).
 		Where("\"commit\" = ?", commit).
+		Where("generated_digest = ?", "").
 		Take(p).
 		Error
 	if err != nil {
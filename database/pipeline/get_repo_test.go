@@ -56,7 +56,7 @@ func TestPipeline_Engine_GetPipelineForRepo(t *testing.T) {
 	_rows := testutils.CreateMockRows([]any{*dbPipeline})
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery(`SELECT * FROM "pipelines" WHERE repo_id = $1 AND "commit" = $2 LIMIT $3`).WithArgs(1, "48afb5bdc41ad69bf22588491333f7cf71135163", 1).WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT * FROM "pipelines" WHERE repo_id = $1 AND "commit" = $2 AND generated_digest = $3 LIMIT $4`).WithArgs(1, "48afb5bdc41ad69bf22588491333f7cf71135163", "", 1).WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
 
@@ -36,6 +36,8 @@ type PipelineInterface interface {
 	DeletePipeline(context.Context, *api.Pipeline) error
 	// GetPipeline defines a function that gets a pipeline by ID.
 	GetPipeline(context.Context, int64) (*api.Pipeline, error)
+	// GetGeneratedPipelineForRepo defines a function that gets a pipeline generated by a build by commit SHA, digest and repo ID.
+	GetGeneratedPipelineForRepo(context.Context, string, string, *api.Repo) (*api.Pipeline, error)
 	// GetPipelineForRepo defines a function that gets a pipeline by commit SHA and repo ID.
 	GetPipelineForRepo(context.Context, string, *api.Repo) (*api.Pipeline, error)
 	// ListPipelines defines a function that gets a list of all pipelines.
@@ -14,24 +14,26 @@ const (
 CREATE TABLE
 IF NOT EXISTS
 pipelines (
-	id               BIGSERIAL PRIMARY KEY,
-	repo_id          BIGINT,
-	commit           VARCHAR(500),
-	flavor           VARCHAR(100),
-	platform         VARCHAR(100),
-	ref              VARCHAR(500),
-	type             VARCHAR(100),
-	version          VARCHAR(50),
-	external_secrets BOOLEAN,
-	internal_secrets BOOLEAN,
-	services         BOOLEAN,
-	stages           BOOLEAN,
-	steps            BOOLEAN,
-	templates        BOOLEAN,
-	artifacts        BOOLEAN,
-	warnings         VARCHAR(5000),
-	data             BYTEA,
-	UNIQUE(repo_id, commit)
+	id                 BIGSERIAL PRIMARY KEY,
+	repo_id            BIGINT,
+	commit             VARCHAR(500),
+	generated_digest   VARCHAR(64) NOT NULL DEFAULT '',
+	flavor             VARCHAR(100),
+	platform           VARCHAR(100),
+	ref                VARCHAR(500),
+	type               VARCHAR(100),
+	version            VARCHAR(50),
+	external_secrets   BOOLEAN,
+	internal_secrets   BOOLEAN,
+	services           BOOLEAN,
+	stages             BOOLEAN,
+	steps              BOOLEAN,
+	templates          BOOLEAN,
+	artifacts          BOOLEAN,
+	warnings           VARCHAR(5000),
+	resolved_templates TEXT,
+	data               BYTEA,
+	UNIQUE(repo_id, commit, generated_digest)
 );
 `
 
@@ -40,24 +42,26 @@ pipelines (
 CREATE TABLE
 IF NOT EXISTS
 pipelines (
-	id               INTEGER PRIMARY KEY AUTOINCREMENT,
-	repo_id          INTEGER,
-	'commit'         TEXT,
-	flavor           TEXT,
-	platform         TEXT,
-	ref              TEXT,
-	type             TEXT,
-	version          TEXT,
-	external_secrets BOOLEAN,
-	internal_secrets BOOLEAN,
-	services         BOOLEAN,
-	stages           BOOLEAN,
-	steps            BOOLEAN,
-	templates        BOOLEAN,
-	artifacts        BOOLEAN,
-	warnings         TEXT,
-	data             BLOB,
-	UNIQUE(repo_id, 'commit')
+	id                 INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id            INTEGER,
+	'commit'           TEXT,
+	generated_digest   TEXT NOT NULL DEFAULT '',
+	flavor             TEXT,
+	platform           TEXT,
+	ref                TEXT,
+	type               TEXT,
+	version            TEXT,
+	external_secrets   BOOLEAN,
+	internal_secrets   BOOLEAN,
+	services           BOOLEAN,
+	stages             BOOLEAN,
+	steps              BOOLEAN,
+	templates          BOOLEAN,
+	artifacts          BOOLEAN,
+	warnings           TEXT,
+	resolved_templates TEXT,
+	data               BLOB,
+	UNIQUE(repo_id, 'commit', generated_digest)
 );
 `
 )
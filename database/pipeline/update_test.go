@@ -32,9 +32,9 @@ func TestPipeline_Engine_UpdatePipeline(t *testing.T) {
 
 	// ensure the mock expects the query
 	_mock.ExpectExec(`UPDATE "pipelines"
-SET "repo_id"=$1,"commit"=$2,"flavor"=$3,"platform"=$4,"ref"=$5,"type"=$6,"version"=$7,"external_secrets"=$8,"internal_secrets"=$9,"services"=$10,"stages"=$11,"steps"=$12,"templates"=$13,"artifacts"=$14,"warnings"=$15,"data"=$16
-WHERE "id" = $17`).
-		WithArgs(1, "48afb5bdc41ad69bf22588491333f7cf71135163", nil, nil, "refs/heads/main", "yaml", "1", false, false, false, false, false, false, false, nil, AnyArgument{}, 1).
+SET "repo_id"=$1,"commit"=$2,"generated_digest"=$3,"flavor"=$4,"platform"=$5,"ref"=$6,"type"=$7,"version"=$8,"external_secrets"=$9,"internal_secrets"=$10,"services"=$11,"stages"=$12,"steps"=$13,"templates"=$14,"artifacts"=$15,"warnings"=$16,"resolved_templates"=$17,"data"=$18
+WHERE "id" = $19`).
+		WithArgs(1, "48afb5bdc41ad69bf22588491333f7cf71135163", "", nil, nil, "refs/heads/main", "yaml", "1", false, false, false, false, false, false, false, nil, nil, AnyArgument{}, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_sqlite := testSqlite(t)
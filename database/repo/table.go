@@ -38,6 +38,7 @@ repos (
 	previous_name      VARCHAR(100),
 	approve_build      VARCHAR(20),
 	approval_timeout   INTEGER,
+	orphan_policy      VARCHAR(20),
 	install_id         BIGINT,
 	custom_props       JSON DEFAULT NULL,
 	UNIQUE(full_name)
@@ -73,6 +74,7 @@ repos (
 	previous_name      TEXT,
 	approve_build      TEXT,
 	approval_timeout   INTEGER,
+	orphan_policy      TEXT,
 	install_id         INTEGER,
 	custom_props       TEXT,
 	UNIQUE(full_name)
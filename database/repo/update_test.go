@@ -30,6 +30,7 @@ func TestRepo_Engine_UpdateRepo(t *testing.T) {
 	_repo.SetTopics([]string{})
 	_repo.SetAllowEvents(api.NewEventsFromMask(1))
 	_repo.SetApprovalTimeout(5)
+	_repo.SetOrphanPolicy(constants.OrphanPolicyRequeue)
 	_repo.SetCustomProps(map[string]any{"foo": "bar"})
 
 	_postgres, _mock := testPostgres(t)
@@ -38,9 +39,9 @@ func TestRepo_Engine_UpdateRepo(t *testing.T) {
 
 	// ensure the mock expects the query
 	_mock.ExpectExec(`UPDATE "repos"
-SET "user_id"=$1,"hash"=$2,"org"=$3,"name"=$4,"full_name"=$5,"link"=$6,"clone"=$7,"branch"=$8,"topics"=$9,"build_limit"=$10,"timeout"=$11,"counter"=$12,"visibility"=$13,"private"=$14,"trusted"=$15,"active"=$16,"allow_events"=$17,"merge_queue_events"=$18,"pipeline_type"=$19,"previous_name"=$20,"approve_build"=$21,"approval_timeout"=$22,"install_id"=$23,"custom_props"=$24
-WHERE "id" = $25`).
-		WithArgs(1, AnyArgument{}, "foo", "bar", "foo/bar", "", "", "", AnyArgument{}, AnyArgument{}, AnyArgument{}, AnyArgument{}, "public", false, false, false, 1, nil, "yaml", "oldName", constants.ApproveForkAlways, 5, 0, `{"foo":"bar"}`, 1).
+SET "user_id"=$1,"hash"=$2,"org"=$3,"name"=$4,"full_name"=$5,"link"=$6,"clone"=$7,"branch"=$8,"topics"=$9,"build_limit"=$10,"timeout"=$11,"counter"=$12,"visibility"=$13,"private"=$14,"trusted"=$15,"active"=$16,"allow_events"=$17,"merge_queue_events"=$18,"pipeline_type"=$19,"previous_name"=$20,"approve_build"=$21,"approval_timeout"=$22,"orphan_policy"=$23,"install_id"=$24,"custom_props"=$25
+WHERE "id" = $26`).
+		WithArgs(1, AnyArgument{}, "foo", "bar", "foo/bar", "", "", "", AnyArgument{}, AnyArgument{}, AnyArgument{}, AnyArgument{}, "public", false, false, false, 1, nil, "yaml", "oldName", constants.ApproveForkAlways, 5, constants.OrphanPolicyRequeue, 0, `{"foo":"bar"}`, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_sqlite := testSqlite(t)
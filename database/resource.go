@@ -5,6 +5,8 @@ package database
 import (
 	"context"
 
+	"github.com/go-vela/server/database/annotation"
+	"github.com/go-vela/server/database/artifact"
 	"github.com/go-vela/server/database/build"
 	"github.com/go-vela/server/database/dashboard"
 	"github.com/go-vela/server/database/deployment"
@@ -19,6 +21,7 @@ import (
 	"github.com/go-vela/server/database/service"
 	"github.com/go-vela/server/database/settings"
 	"github.com/go-vela/server/database/step"
+	"github.com/go-vela/server/database/testresult"
 	"github.com/go-vela/server/database/user"
 	"github.com/go-vela/server/database/worker"
 )
@@ -40,6 +43,28 @@ func (e *engine) NewResources(ctx context.Context) error {
 		return err
 	}
 
+	// create the database agnostic engine for annotations
+	e.AnnotationInterface, err = annotation.New(
+		annotation.WithContext(ctx),
+		annotation.WithClient(e.client),
+		annotation.WithLogger(e.logger),
+		annotation.WithSkipCreation(e.config.SkipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic engine for artifacts
+	e.ArtifactInterface, err = artifact.New(
+		artifact.WithContext(ctx),
+		artifact.WithClient(e.client),
+		artifact.WithLogger(e.logger),
+		artifact.WithSkipCreation(e.config.SkipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
 	// create the database agnostic engine for builds
 	e.BuildInterface, err = build.New(
 		build.WithContext(ctx),
@@ -197,6 +222,17 @@ func (e *engine) NewResources(ctx context.Context) error {
 		return err
 	}
 
+	// create the database agnostic engine for test results
+	e.TestResultInterface, err = testresult.New(
+		testresult.WithContext(ctx),
+		testresult.WithClient(e.client),
+		testresult.WithLogger(e.logger),
+		testresult.WithSkipCreation(e.config.SkipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
 	// create the database agnostic engine for users
 	e.UserInterface, err = user.New(
 		user.WithContext(ctx),
@@ -19,13 +19,28 @@ func TestSettings_Engine_CreateSettings(t *testing.T) {
 	_settings.SetCloneImage("target/vela-git-slim:latest")
 	_settings.SetTemplateDepth(10)
 	_settings.SetStarlarkExecLimit(100)
+	_settings.SetJsonnetExecTimeout(30)
 	_settings.SetBlockedImages([]settings.ImageRestriction{
 		{Image: new("docker.io/blocked/image:latest"), Reason: new("this image is blocked")},
 	})
 	_settings.SetWarnImages([]settings.ImageRestriction{
 		{Image: new("docker.io/deprecated/image:latest"), Reason: new("this image is deprecated")},
 	})
+	_settings.SetHTTPSTemplateAllowlist([]string{"templates.example.com"})
+	_settings.SetOCITemplateAllowlist([]string{"ghcr.io"})
+	_settings.SetWorkerFlavors([]string{"large"})
+	_settings.SetPolicyRules([]settings.PolicyRule{
+		{
+			Name:   new("no-privileged"),
+			Rule:   new("repo.trusted || none(containers, .privileged)"),
+			Action: new("block"),
+			Reason: new("privileged containers require a trusted repo"),
+		},
+	})
 	_settings.SetRoutes([]string{"vela"})
+	_settings.SetPools([]settings.WorkerPool{
+		{Name: new("secure"), Routes: &[]string{"vela"}, Quotas: &[]settings.PoolQuota{{Org: new("payments"), Limit: new(int32(20))}}},
+	})
 	_settings.SetRepoRoleMap(map[string]string{"admin": "admin", "triage": "read"})
 	_settings.SetOrgRoleMap(map[string]string{"admin": "admin", "member": "read"})
 	_settings.SetTeamRoleMap(map[string]string{"admin": "admin"})
@@ -36,6 +51,12 @@ func TestSettings_Engine_CreateSettings(t *testing.T) {
 	_settings.SetEnableRepoSecrets(true)
 	_settings.SetEnableOrgSecrets(true)
 	_settings.SetEnableSharedSecrets(true)
+	_settings.SetArtifactRetention([]settings.RetentionRule{
+		{RuleScope: settings.RuleScope{Org: new("octocat")}, KeepBuilds: new(int64(10)), KeepDays: new(int64(30))},
+	})
+	_settings.SetLogRetention([]settings.LogRetentionRule{
+		{KeepDays: new(int64(30)), KeepFailedDays: new(int64(90)), KeepDeployments: new(true)},
+	})
 	_settings.SetCreatedAt(1)
 	_settings.SetUpdatedAt(1)
 	_settings.SetUpdatedBy("")
@@ -48,9 +69,9 @@ func TestSettings_Engine_CreateSettings(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery(`INSERT INTO "settings" ("compiler","queue","scm","repo_allowlist","schedule_allowlist","max_dashboard_repos","queue_restart_limit","enable_repo_secrets","enable_org_secrets","enable_shared_secrets","created_at","updated_at","updated_by","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) RETURNING "id"`).
-		WithArgs(`{"clone_image":{"String":"target/vela-git-slim:latest","Valid":true},"template_depth":{"Int64":10,"Valid":true},"starlark_exec_limit":{"Int64":100,"Valid":true},"blocked_images":[{"image":"docker.io/blocked/image:latest","reason":"this image is blocked"}],"warn_images":[{"image":"docker.io/deprecated/image:latest","reason":"this image is deprecated"}]}`,
-			`{"routes":["vela"]}`, `{"repo_role_map":{"admin":"admin","triage":"read"},"org_role_map":{"admin":"admin","member":"read"},"team_role_map":{"admin":"admin"}}`, `{"octocat/hello-world"}`, `{"*"}`, 10, 30, true, true, true, 1, 1, ``, 1).
+	_mock.ExpectQuery(`INSERT INTO "settings" ("compiler","queue","scm","repo_allowlist","schedule_allowlist","max_dashboard_repos","queue_restart_limit","enable_repo_secrets","enable_org_secrets","enable_shared_secrets","artifact_retention","log_retention","created_at","updated_at","updated_by","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16) RETURNING "id"`).
+		WithArgs(`{"clone_image":{"String":"target/vela-git-slim:latest","Valid":true},"template_depth":{"Int64":10,"Valid":true},"starlark_exec_limit":{"Int64":100,"Valid":true},"jsonnet_exec_timeout":{"Int64":30,"Valid":true},"blocked_images":[{"image":"docker.io/blocked/image:latest","reason":"this image is blocked"}],"warn_images":[{"image":"docker.io/deprecated/image:latest","reason":"this image is deprecated"}],"https_template_allowlist":["templates.example.com"],"oci_template_allowlist":["ghcr.io"],"policy_rules":[{"name":"no-privileged","rule":"repo.trusted || none(containers, .privileged)","action":"block","reason":"privileged containers require a trusted repo"}],"worker_flavors":["large"]}`,
+			`{"routes":["vela"],"pools":[{"name":"secure","routes":["vela"],"quotas":[{"org":"payments","limit":20}]}]}`, `{"repo_role_map":{"admin":"admin","triage":"read"},"org_role_map":{"admin":"admin","member":"read"},"team_role_map":{"admin":"admin"}}`, `{"octocat/hello-world"}`, `{"*"}`, 10, 30, true, true, true, `[{"org":"octocat","keep_builds":10,"keep_days":30}]`, `[{"keep_days":30,"keep_failed_days":90,"keep_deployments":true}]`, 1, 1, ``, 1).
 		WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
@@ -19,6 +19,7 @@ func TestSettings_Engine_GetSettings(t *testing.T) {
 	_settings.SetCloneImage("target/vela-git-slim:latest")
 	_settings.SetTemplateDepth(10)
 	_settings.SetStarlarkExecLimit(100)
+	_settings.SetJsonnetExecTimeout(30)
 	_settings.SetRoutes([]string{"vela"})
 	_settings.SetRepoRoleMap(map[string]string{"admin": "admin", "triage": "read"})
 	_settings.SetOrgRoleMap(map[string]string{"admin": "admin", "member": "read"})
@@ -25,6 +25,8 @@ settings (
 	enable_repo_secrets   BOOLEAN,
 	enable_org_secrets    BOOLEAN,
 	enable_shared_secrets BOOLEAN,
+	artifact_retention    JSON DEFAULT NULL,
+	log_retention         JSON DEFAULT NULL,
 	created_at            BIGINT,
 	updated_at            BIGINT,
 	updated_by            VARCHAR(250)
@@ -47,6 +49,8 @@ settings (
 	enable_repo_secrets     BOOLEAN,
 	enable_org_secrets      BOOLEAN,
 	enable_shared_secrets   BOOLEAN,
+	artifact_retention      TEXT,
+	log_retention           TEXT,
 	created_at              INTEGER,
 	updated_at              INTEGER,
 	updated_by              TEXT
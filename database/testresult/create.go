@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// createBatchSize is the number of test results inserted at a time.
+const createBatchSize = 500
+
+// CreateTestResults creates a list of new test results in the database.
+func (e *Engine) CreateTestResults(ctx context.Context, t []*api.TestResult) error {
+	e.logger.WithFields(logrus.Fields{
+		"results": len(t),
+	}).Tracef("creating %d test results", len(t))
+
+	if len(t) == 0 {
+		return nil
+	}
+
+	results := make([]*types.TestResult, 0, len(t))
+
+	for _, r := range t {
+		// cast the API type to database type
+		result := types.TestResultFromAPI(r)
+
+		// validate the necessary fields are populated
+		err := result.Validate()
+		if err != nil {
+			return err
+		}
+
+		results = append(results, result)
+	}
+
+	// send query to the database
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableTestResult).
+		CreateInBatches(results, createBatchSize).Error
+	if err != nil {
+		return err
+	}
+
+	// capture the IDs assigned by the database
+	for i, result := range results {
+		t[i].SetID(result.ID.Int64)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+func TestTestResult_Engine_CreateTestResults(t *testing.T) {
+	// setup types
+	_resultOne := testTestResult(0, 1, 1, "TestAPI_Foo", constants.TestStatusPassed)
+	_resultTwo := testTestResult(0, 1, 1, "TestAPI_Bar", constants.TestStatusFailed)
+	_resultTwo.SetMessage("expected 1, got 2")
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "test_results"
+("build_id","repo_id","artifact_id","step","branch","suite","name","status","message","duration","flaky","created_at")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12),($13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24) RETURNING "id"`).
+		WithArgs(1, 1, 1, "test", "main", "github.com/go-vela/server/api", "TestAPI_Foo", "passed", nil, 1500, false, 1,
+			1, 1, 1, "test", "main", "github.com/go-vela/server/api", "TestAPI_Bar", "failed", "expected 1, got 2", 1500, false, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		results  []*api.TestResult
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			results:  []*api.TestResult{_resultOne, _resultTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			results:  []*api.TestResult{_resultOne, _resultTwo},
+		},
+		{
+			failure:  true,
+			name:     "sqlite3 invalid",
+			database: _sqlite,
+			results:  []*api.TestResult{testTestResult(0, 1, 1, "", constants.TestStatusPassed)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// reset the IDs assigned by a previous test
+			for _, result := range test.results {
+				result.SetID(0)
+			}
+
+			err := test.database.CreateTestResults(context.TODO(), test.results)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateTestResults for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateTestResults for %s returned err: %v", test.name, err)
+			}
+
+			got := []int64{test.results[0].GetID(), test.results[1].GetID()}
+			if !reflect.DeepEqual(got, []int64{1, 2}) {
+				t.Errorf("CreateTestResults for %s assigned IDs %v, want %v", test.name, got, []int64{1, 2})
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// DeleteTestResultsForArtifact deletes the test results parsed from an artifact from the database.
+func (e *Engine) DeleteTestResultsForArtifact(ctx context.Context, a *api.Artifact) error {
+	e.logger.WithFields(logrus.Fields{
+		"artifact": a.GetName(),
+		"build_id": a.GetBuildID(),
+	}).Tracef("deleting test results for artifact %s", a.GetName())
+
+	// send query to the database
+	return e.client.
+		WithContext(ctx).
+		Table(constants.TableTestResult).
+		Where("artifact_id = ?", a.GetID()).
+		Delete(&types.TestResult{}).
+		Error
+}
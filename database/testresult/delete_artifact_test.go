@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/testutils"
+)
+
+func TestTestResult_Engine_DeleteTestResultsForArtifact(t *testing.T) {
+	// setup types
+	_artifact := testutils.APIArtifact()
+	_artifact.SetID(1)
+	_artifact.SetBuildID(1)
+	_artifact.SetName("junit.xml")
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "test_results" WHERE artifact_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTestResults(ctx, []*api.TestResult{
+		testTestResult(0, 1, 1, "TestAPI_Foo", constants.TestStatusPassed),
+		testTestResult(0, 1, 2, "TestAPI_Bar", constants.TestStatusPassed),
+	})
+	if err != nil {
+		t.Errorf("unable to create test results for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteTestResultsForArtifact(ctx, _artifact)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteTestResultsForArtifact for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteTestResultsForArtifact for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// ensure only the results for the artifact were removed
+	got, err := _sqlite.ListTestResultsForBuild(ctx, &api.Build{ID: new(int64(1))})
+	if err != nil {
+		t.Errorf("unable to list test results for sqlite: %v", err)
+	}
+
+	if len(got) != 1 || got[0].GetName() != "TestAPI_Bar" {
+		t.Errorf("DeleteTestResultsForArtifact left %v, want only TestAPI_Bar", got)
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import "context"
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the test_results table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+test_results_build_id
+ON test_results (build_id);
+`
+
+	// CreateArtifactIDIndex represents a query to create an
+	// index on the test_results table for the artifact_id column.
+	CreateArtifactIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+test_results_artifact_id
+ON test_results (artifact_id);
+`
+
+	// CreateRepoBranchIndex represents a query to create an index on
+	// the test_results table for the repo_id, branch and build_id columns.
+	CreateRepoBranchIndex = `
+CREATE INDEX
+IF NOT EXISTS
+test_results_repo_branch
+ON test_results (repo_id, branch, build_id);
+`
+)
+
+// CreateTestResultIndexes creates the indexes for the test_results table in the database.
+func (e *Engine) CreateTestResultIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for test_results table")
+
+	// create the build_id column index for the test_results table
+	err := e.client.
+		WithContext(ctx).
+		Exec(CreateBuildIDIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the artifact_id column index for the test_results table
+	err = e.client.
+		WithContext(ctx).
+		Exec(CreateArtifactIDIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the repo_id, branch and build_id columns index for the test_results table
+	return e.client.
+		WithContext(ctx).
+		Exec(CreateRepoBranchIndex).Error
+}
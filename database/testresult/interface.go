@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// TestResultInterface represents the Vela interface for test result
+// functions with the supported Database backends.
+type TestResultInterface interface {
+	// TestResult Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateTestResultIndexes defines a function that creates the indexes for the test_results table.
+	CreateTestResultIndexes(context.Context) error
+	// CreateTestResultTable defines a function that creates the test_results table.
+	CreateTestResultTable(context.Context, string) error
+
+	// TestResult Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateTestResults defines a function that creates a list of new test results.
+	CreateTestResults(context.Context, []*api.TestResult) error
+	// DeleteTestResultsForArtifact defines a function that deletes the test results parsed from an artifact.
+	DeleteTestResultsForArtifact(context.Context, *api.Artifact) error
+	// ListTestResultsForBuild defines a function that gets a list of test results by build ID.
+	ListTestResultsForBuild(context.Context, *api.Build) ([]*api.TestResult, error)
+	// ListTestResultHistory defines a function that gets a list of test results for the latest builds on a branch.
+	ListTestResultHistory(context.Context, *api.Repo, string, int64, int) ([]*api.TestResult, error)
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListTestResultsForBuild gets a list of test results by build ID from the database.
+func (e *Engine) ListTestResultsForBuild(ctx context.Context, b *api.Build) ([]*api.TestResult, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+	}).Tracef("listing test results for build %d", b.GetNumber())
+
+	// variables to store query results and return value
+	t := new([]types.TestResult)
+	results := []*api.TestResult{}
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableTestResult).
+		Where("build_id = ?", b.GetID()).
+		Order("suite ASC").
+		Order("name ASC").
+		Order("id ASC").
+		Find(&t).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, result := range *t {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := result
+
+		// convert query result to API type
+		results = append(results, tmp.ToAPI())
+	}
+
+	return results, nil
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestTestResult_Engine_ListTestResultsForBuild(t *testing.T) {
+	// setup types
+	_build := testutils.APIBuild()
+	_build.SetID(1)
+	_build.SetRepo(testutils.APIRepo())
+	_build.SetNumber(1)
+
+	_resultOne := testTestResult(1, 1, 1, "TestAPI_Foo", constants.TestStatusPassed)
+	_resultTwo := testTestResult(2, 1, 1, "TestAPI_Bar", constants.TestStatusFailed)
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*types.TestResultFromAPI(_resultTwo), *types.TestResultFromAPI(_resultOne)})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "test_results" WHERE build_id = $1 ORDER BY suite ASC,name ASC,id ASC`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTestResults(ctx, []*api.TestResult{_resultOne, _resultTwo})
+	if err != nil {
+		t.Errorf("unable to create test results for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []*api.TestResult
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.TestResult{_resultTwo, _resultOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.TestResult{_resultTwo, _resultOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListTestResultsForBuild(ctx, _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListTestResultsForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListTestResultsForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListTestResultsForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListTestResultHistory gets a list of test results from the database for
+// the latest builds on a branch of a repo created before the provided build ID.
+func (e *Engine) ListTestResultHistory(ctx context.Context, r *api.Repo, branch string, before int64, builds int) ([]*api.TestResult, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":    r.GetOrg(),
+		"repo":   r.GetName(),
+		"branch": branch,
+	}).Tracef("listing test result history for branch %s of repo %s", branch, r.GetFullName())
+
+	// variables to store query results and return value
+	t := new([]types.TestResult)
+	results := []*api.TestResult{}
+
+	// capture the latest builds on the branch with test results
+	latest := e.client.
+		WithContext(ctx).
+		Table(constants.TableTestResult).
+		Distinct("build_id").
+		Where("repo_id = ?", r.GetID()).
+		Where("branch = ?", branch).
+		Where("build_id < ?", before).
+		Order("build_id DESC").
+		Limit(builds)
+
+	// send query to the database and store result in variable
+	err := e.client.
+		WithContext(ctx).
+		Table(constants.TableTestResult).
+		Where("build_id IN (?)", latest).
+		Order("build_id ASC").
+		Order("id ASC").
+		Find(&t).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, result := range *t {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := result
+
+		// convert query result to API type
+		results = append(results, tmp.ToAPI())
+	}
+
+	return results, nil
+}
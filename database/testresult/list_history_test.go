@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/testutils"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestTestResult_Engine_ListTestResultHistory(t *testing.T) {
+	// setup types
+	_repo := testutils.APIRepo()
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	_resultOne := testTestResult(1, 1, 1, "TestAPI_Foo", constants.TestStatusPassed)
+	_resultTwo := testTestResult(2, 2, 2, "TestAPI_Foo", constants.TestStatusFailed)
+	_resultThree := testTestResult(3, 3, 3, "TestAPI_Foo", constants.TestStatusPassed)
+	_resultFour := testTestResult(4, 4, 4, "TestAPI_Foo", constants.TestStatusPassed)
+
+	_resultOther := testTestResult(5, 5, 5, "TestAPI_Foo", constants.TestStatusFailed)
+	_resultOther.SetBranch("feature")
+
+	_postgres, _mock := testPostgres(t)
+
+	ctx := context.TODO()
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := testutils.CreateMockRows([]any{*types.TestResultFromAPI(_resultTwo), *types.TestResultFromAPI(_resultThree)})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "test_results" WHERE build_id IN (SELECT DISTINCT build_id FROM "test_results" WHERE repo_id = $1 AND branch = $2 AND build_id < $3 ORDER BY build_id DESC LIMIT $4) ORDER BY build_id ASC,id ASC`).
+		WithArgs(1, "main", 4, 2).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTestResults(ctx, []*api.TestResult{_resultOne, _resultTwo, _resultThree, _resultFour, _resultOther})
+	if err != nil {
+		t.Errorf("unable to create test results for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+		want     []*api.TestResult
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.TestResult{_resultTwo, _resultThree},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.TestResult{_resultTwo, _resultThree},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListTestResultHistory(ctx, _repo, "main", 4, 2)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListTestResultHistory for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListTestResultHistory for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListTestResultHistory for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
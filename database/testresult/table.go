@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+
+	"github.com/go-vela/server/constants"
+)
+
+const (
+	// CreatePostgresTable represents a query to create the Postgres test_results table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+test_results (
+	id            BIGSERIAL PRIMARY KEY,
+	build_id      BIGINT,
+	repo_id       BIGINT,
+	artifact_id   BIGINT,
+	step          VARCHAR(250),
+	branch        VARCHAR(500),
+	suite         VARCHAR(1000),
+	name          VARCHAR(1000),
+	status        VARCHAR(50),
+	message       TEXT,
+	duration      BIGINT,
+	flaky         BOOLEAN,
+	created_at    BIGINT
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite test_results table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+test_results (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	artifact_id   INTEGER,
+	step          TEXT,
+	branch        TEXT,
+	suite         TEXT,
+	name          TEXT,
+	status        TEXT,
+	message       TEXT,
+	duration      INTEGER,
+	flaky         BOOLEAN,
+	created_at    INTEGER
+);
+`
+)
+
+// CreateTestResultTable creates the test_results table in the database.
+func (e *Engine) CreateTestResultTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating test_results table")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the test_results table for Postgres
+		return e.client.
+			WithContext(ctx).
+			Exec(CreatePostgresTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the test_results table for Sqlite
+		return e.client.
+			WithContext(ctx).
+			Exec(CreateSqliteTable).Error
+	}
+}
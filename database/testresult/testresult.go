@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testresult
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/go-vela/server/constants"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the TestResultInterface interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the TestResult engine
+		SkipCreation bool
+	}
+
+	// Engine represents the test result functionality that implements the TestResultInterface interface.
+	Engine struct {
+		// engine configuration settings used in test result functions
+		config *config
+
+		ctx context.Context
+
+		// gorm.io/gorm database client used in test result functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in test result functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with test results in the database.
+func New(opts ...EngineOpt) (*Engine, error) {
+	// create new TestResult engine
+	e := new(Engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating test result database objects
+	if e.config.SkipCreation {
+		e.logger.Warning("skipping creation of test_results table and indexes")
+
+		return e, nil
+	}
+
+	// create the test_results table
+	err := e.CreateTestResultTable(e.ctx, e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", constants.TableTestResult, err)
+	}
+
+	// create the indexes for the test_results table
+	err = e.CreateTestResultIndexes(e.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create indexes for %s table: %w", constants.TableTestResult, err)
+	}
+
+	return e, nil
+}
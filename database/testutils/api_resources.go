@@ -18,6 +18,21 @@ import (
 //
 // These are API resources initialized to their zero values for testing.
 
+func APIAnnotation() *api.Annotation {
+	return &api.Annotation{
+		ID:        new(int64),
+		RepoID:    new(int64),
+		BuildID:   new(int64),
+		StepID:    new(int64),
+		File:      new(string),
+		Line:      new(int64),
+		Column:    new(int64),
+		Severity:  new(string),
+		Message:   new(string),
+		CreatedAt: new(int64),
+	}
+}
+
 func APIBuild() *api.Build {
 	return &api.Build{
 		ID:           new(int64),
@@ -131,6 +146,7 @@ func APIRepo() *api.Repo {
 		MergeQueueEvents: new([]string),
 		ApproveBuild:     new(string),
 		ApprovalTimeout:  new(int32),
+		OrphanPolicy:     new(string),
 		InstallID:        new(int64),
 		CustomProps:      new(map[string]any),
 	}
@@ -237,6 +253,40 @@ func APIService() *api.Service {
 	}
 }
 
+func APIArtifact() *api.Artifact {
+	return &api.Artifact{
+		ID:          new(int64),
+		BuildID:     new(int64),
+		RepoID:      new(int64),
+		Name:        new(string),
+		Step:        new(string),
+		ObjectPath:  new(string),
+		Size:        new(int64),
+		Checksum:    new(string),
+		ContentType: new(string),
+		CreatedAt:   new(int64),
+		ExpiresAt:   new(int64),
+	}
+}
+
+func APITestResult() *api.TestResult {
+	return &api.TestResult{
+		ID:         new(int64),
+		BuildID:    new(int64),
+		RepoID:     new(int64),
+		ArtifactID: new(int64),
+		Step:       new(string),
+		Branch:     new(string),
+		Suite:      new(string),
+		Name:       new(string),
+		Status:     new(string),
+		Message:    new(string),
+		Duration:   new(int64),
+		Flaky:      new(bool),
+		CreatedAt:  new(int64),
+	}
+}
+
 func APIStep() *api.Step {
 	return &api.Step{
 		ID:           new(int64),
@@ -261,23 +311,25 @@ func APIStep() *api.Step {
 
 func APIPipeline() *api.Pipeline {
 	return &api.Pipeline{
-		ID:              new(int64),
-		Repo:            APIRepo(),
-		Commit:          new(string),
-		Flavor:          new(string),
-		Platform:        new(string),
-		Ref:             new(string),
-		Type:            new(string),
-		Version:         new(string),
-		ExternalSecrets: new(bool),
-		InternalSecrets: new(bool),
-		Services:        new(bool),
-		Stages:          new(bool),
-		Steps:           new(bool),
-		Templates:       new(bool),
-		Artifact:        new(bool),
-		Warnings:        new([]string),
-		Data:            new([]byte),
+		ID:                new(int64),
+		Repo:              APIRepo(),
+		Commit:            new(string),
+		GeneratedDigest:   new(string),
+		Flavor:            new(string),
+		Platform:          new(string),
+		Ref:               new(string),
+		Type:              new(string),
+		Version:           new(string),
+		ExternalSecrets:   new(bool),
+		InternalSecrets:   new(bool),
+		Services:          new(bool),
+		Stages:            new(bool),
+		Steps:             new(bool),
+		Templates:         new(bool),
+		Artifact:          new(bool),
+		Warnings:          new([]string),
+		ResolvedTemplates: new([]api.ResolvedTemplate),
+		Data:              new([]byte),
 	}
 }
 
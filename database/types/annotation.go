@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/util"
+)
+
+var (
+	// ErrEmptyAnnotationBuildID defines the error type when an
+	// Annotation type has an empty BuildID field provided.
+	ErrEmptyAnnotationBuildID = errors.New("empty annotation build_id provided")
+
+	// ErrEmptyAnnotationMessage defines the error type when an
+	// Annotation type has an empty Message field provided.
+	ErrEmptyAnnotationMessage = errors.New("empty annotation message provided")
+
+	// ErrEmptyAnnotationRepoID defines the error type when an
+	// Annotation type has an empty RepoID field provided.
+	ErrEmptyAnnotationRepoID = errors.New("empty annotation repo_id provided")
+
+	// ErrEmptyAnnotationSeverity defines the error type when an
+	// Annotation type has an empty Severity field provided.
+	ErrEmptyAnnotationSeverity = errors.New("empty annotation severity provided")
+
+	// ErrEmptyAnnotationStepID defines the error type when an
+	// Annotation type has an empty StepID field provided.
+	ErrEmptyAnnotationStepID = errors.New("empty annotation step_id provided")
+)
+
+// Annotation is the database representation of a
+// diagnostic detected in the log for a step.
+type Annotation struct {
+	ID        sql.NullInt64  `sql:"id"`
+	RepoID    sql.NullInt64  `sql:"repo_id"`
+	BuildID   sql.NullInt64  `sql:"build_id"`
+	StepID    sql.NullInt64  `sql:"step_id"`
+	File      sql.NullString `sql:"file"`
+	Line      sql.NullInt64  `sql:"line"`
+	Column    sql.NullInt64  `sql:"column"`
+	Severity  sql.NullString `sql:"severity"`
+	Message   sql.NullString `sql:"message"`
+	CreatedAt sql.NullInt64  `sql:"created_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Annotation type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (a *Annotation) Nullify() *Annotation {
+	if a == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if a.ID.Int64 == 0 {
+		a.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if a.RepoID.Int64 == 0 {
+		a.RepoID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if a.BuildID.Int64 == 0 {
+		a.BuildID.Valid = false
+	}
+
+	// check if the StepID field should be false
+	if a.StepID.Int64 == 0 {
+		a.StepID.Valid = false
+	}
+
+	// check if the File field should be false
+	if len(a.File.String) == 0 {
+		a.File.Valid = false
+	}
+
+	// check if the Line field should be false
+	if a.Line.Int64 == 0 {
+		a.Line.Valid = false
+	}
+
+	// check if the Column field should be false
+	if a.Column.Int64 == 0 {
+		a.Column.Valid = false
+	}
+
+	// check if the Severity field should be false
+	if len(a.Severity.String) == 0 {
+		a.Severity.Valid = false
+	}
+
+	// check if the Message field should be false
+	if len(a.Message.String) == 0 {
+		a.Message.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if a.CreatedAt.Int64 == 0 {
+		a.CreatedAt.Valid = false
+	}
+
+	return a
+}
+
+// ToAPI converts the Annotation type
+// to an API Annotation type.
+func (a *Annotation) ToAPI() *api.Annotation {
+	annotation := new(api.Annotation)
+
+	annotation.SetID(a.ID.Int64)
+	annotation.SetRepoID(a.RepoID.Int64)
+	annotation.SetBuildID(a.BuildID.Int64)
+	annotation.SetStepID(a.StepID.Int64)
+	annotation.SetFile(a.File.String)
+	annotation.SetLine(a.Line.Int64)
+	annotation.SetColumn(a.Column.Int64)
+	annotation.SetSeverity(a.Severity.String)
+	annotation.SetMessage(a.Message.String)
+	annotation.SetCreatedAt(a.CreatedAt.Int64)
+
+	return annotation
+}
+
+// Validate verifies the necessary fields for
+// the Annotation type are populated correctly.
+func (a *Annotation) Validate() error {
+	// verify the RepoID field is populated
+	if a.RepoID.Int64 <= 0 {
+		return ErrEmptyAnnotationRepoID
+	}
+
+	// verify the BuildID field is populated
+	if a.BuildID.Int64 <= 0 {
+		return ErrEmptyAnnotationBuildID
+	}
+
+	// verify the StepID field is populated
+	if a.StepID.Int64 <= 0 {
+		return ErrEmptyAnnotationStepID
+	}
+
+	// verify the Severity field is populated
+	if len(a.Severity.String) == 0 {
+		return ErrEmptyAnnotationSeverity
+	}
+
+	// verify the Message field is populated
+	if len(a.Message.String) == 0 {
+		return ErrEmptyAnnotationMessage
+	}
+
+	// ensure that all Annotation string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	a.File = sql.NullString{String: util.Sanitize(a.File.String), Valid: a.File.Valid}
+	a.Message = sql.NullString{String: util.Sanitize(a.Message.String), Valid: a.Message.Valid}
+
+	return nil
+}
+
+// AnnotationFromAPI converts the API Annotation type
+// to a database Annotation type.
+func AnnotationFromAPI(a *api.Annotation) *Annotation {
+	annotation := &Annotation{
+		ID:        sql.NullInt64{Int64: a.GetID(), Valid: true},
+		RepoID:    sql.NullInt64{Int64: a.GetRepoID(), Valid: true},
+		BuildID:   sql.NullInt64{Int64: a.GetBuildID(), Valid: true},
+		StepID:    sql.NullInt64{Int64: a.GetStepID(), Valid: true},
+		File:      sql.NullString{String: a.GetFile(), Valid: true},
+		Line:      sql.NullInt64{Int64: a.GetLine(), Valid: true},
+		Column:    sql.NullInt64{Int64: a.GetColumn(), Valid: true},
+		Severity:  sql.NullString{String: a.GetSeverity(), Valid: true},
+		Message:   sql.NullString{String: a.GetMessage(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: a.GetCreatedAt(), Valid: true},
+	}
+
+	return annotation.Nullify()
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTypes_Annotation_Nullify(t *testing.T) {
+	// setup types
+	var a *Annotation
+
+	want := &Annotation{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:    sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:   sql.NullInt64{Int64: 0, Valid: false},
+		StepID:    sql.NullInt64{Int64: 0, Valid: false},
+		File:      sql.NullString{String: "", Valid: false},
+		Line:      sql.NullInt64{Int64: 0, Valid: false},
+		Column:    sql.NullInt64{Int64: 0, Valid: false},
+		Severity:  sql.NullString{String: "", Valid: false},
+		Message:   sql.NullString{String: "", Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		annotation *Annotation
+		want       *Annotation
+	}{
+		{
+			annotation: testAnnotation(),
+			want:       testAnnotation(),
+		},
+		{
+			annotation: a,
+			want:       nil,
+		},
+		{
+			annotation: new(Annotation),
+			want:       want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.annotation.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestTypes_Annotation_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Annotation)
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetBuildID(1)
+	want.SetStepID(1)
+	want.SetFile("main.go")
+	want.SetLine(12)
+	want.SetColumn(3)
+	want.SetSeverity("error")
+	want.SetMessage("undefined: foo")
+	want.SetCreatedAt(1563474076)
+
+	// run test
+	got := testAnnotation().ToAPI()
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToAPI() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTypes_Annotation_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure    bool
+		annotation *Annotation
+	}{
+		{
+			failure:    false,
+			annotation: testAnnotation(),
+		},
+		{ // no repo_id set for annotation
+			failure: true,
+			annotation: &Annotation{
+				BuildID:  sql.NullInt64{Int64: 1, Valid: true},
+				StepID:   sql.NullInt64{Int64: 1, Valid: true},
+				Severity: sql.NullString{String: "error", Valid: true},
+				Message:  sql.NullString{String: "undefined: foo", Valid: true},
+			},
+		},
+		{ // no build_id set for annotation
+			failure: true,
+			annotation: &Annotation{
+				RepoID:   sql.NullInt64{Int64: 1, Valid: true},
+				StepID:   sql.NullInt64{Int64: 1, Valid: true},
+				Severity: sql.NullString{String: "error", Valid: true},
+				Message:  sql.NullString{String: "undefined: foo", Valid: true},
+			},
+		},
+		{ // no step_id set for annotation
+			failure: true,
+			annotation: &Annotation{
+				RepoID:   sql.NullInt64{Int64: 1, Valid: true},
+				BuildID:  sql.NullInt64{Int64: 1, Valid: true},
+				Severity: sql.NullString{String: "error", Valid: true},
+				Message:  sql.NullString{String: "undefined: foo", Valid: true},
+			},
+		},
+		{ // no severity set for annotation
+			failure: true,
+			annotation: &Annotation{
+				RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				StepID:  sql.NullInt64{Int64: 1, Valid: true},
+				Message: sql.NullString{String: "undefined: foo", Valid: true},
+			},
+		},
+		{ // no message set for annotation
+			failure: true,
+			annotation: &Annotation{
+				RepoID:   sql.NullInt64{Int64: 1, Valid: true},
+				BuildID:  sql.NullInt64{Int64: 1, Valid: true},
+				StepID:   sql.NullInt64{Int64: 1, Valid: true},
+				Severity: sql.NullString{String: "error", Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.annotation.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestTypes_AnnotationFromAPI(t *testing.T) {
+	// setup types
+	a := new(api.Annotation)
+	a.SetID(1)
+	a.SetRepoID(1)
+	a.SetBuildID(1)
+	a.SetStepID(1)
+	a.SetFile("main.go")
+	a.SetLine(12)
+	a.SetColumn(3)
+	a.SetSeverity("error")
+	a.SetMessage("undefined: foo")
+	a.SetCreatedAt(1563474076)
+
+	want := testAnnotation()
+
+	// run test
+	got := AnnotationFromAPI(a)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AnnotationFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testAnnotation is a test helper function to create an Annotation
+// type with all fields set to a fake value.
+func testAnnotation() *Annotation {
+	return &Annotation{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:    sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:   sql.NullInt64{Int64: 1, Valid: true},
+		StepID:    sql.NullInt64{Int64: 1, Valid: true},
+		File:      sql.NullString{String: "main.go", Valid: true},
+		Line:      sql.NullInt64{Int64: 12, Valid: true},
+		Column:    sql.NullInt64{Int64: 3, Valid: true},
+		Severity:  sql.NullString{String: "error", Valid: true},
+		Message:   sql.NullString{String: "undefined: foo", Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/util"
+)
+
+var (
+	// ErrEmptyArtifactBuildID defines the error type when a
+	// Artifact type has an empty BuildID field provided.
+	ErrEmptyArtifactBuildID = errors.New("empty artifact build_id provided")
+
+	// ErrEmptyArtifactName defines the error type when a
+	// Artifact type has an empty Name field provided.
+	ErrEmptyArtifactName = errors.New("empty artifact name provided")
+
+	// ErrEmptyArtifactObjectPath defines the error type when a
+	// Artifact type has an empty ObjectPath field provided.
+	ErrEmptyArtifactObjectPath = errors.New("empty artifact object_path provided")
+
+	// ErrEmptyArtifactRepoID defines the error type when a
+	// Artifact type has an empty RepoID field provided.
+	ErrEmptyArtifactRepoID = errors.New("empty artifact repo_id provided")
+)
+
+// Artifact is the database representation of a file uploaded by a build.
+type Artifact struct {
+	ID          sql.NullInt64  `sql:"id"`
+	BuildID     sql.NullInt64  `sql:"build_id"`
+	RepoID      sql.NullInt64  `sql:"repo_id"`
+	Name        sql.NullString `sql:"name"`
+	Step        sql.NullString `sql:"step"`
+	ObjectPath  sql.NullString `sql:"object_path"`
+	Size        sql.NullInt64  `sql:"size"`
+	Checksum    sql.NullString `sql:"checksum"`
+	ContentType sql.NullString `sql:"content_type"`
+	CreatedAt   sql.NullInt64  `sql:"created_at"`
+	ExpiresAt   sql.NullInt64  `sql:"expires_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Artifact type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (a *Artifact) Nullify() *Artifact {
+	if a == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if a.ID.Int64 == 0 {
+		a.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if a.BuildID.Int64 == 0 {
+		a.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if a.RepoID.Int64 == 0 {
+		a.RepoID.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(a.Name.String) == 0 {
+		a.Name.Valid = false
+	}
+
+	// check if the Step field should be false
+	if len(a.Step.String) == 0 {
+		a.Step.Valid = false
+	}
+
+	// check if the ObjectPath field should be false
+	if len(a.ObjectPath.String) == 0 {
+		a.ObjectPath.Valid = false
+	}
+
+	// check if the Checksum field should be false
+	if len(a.Checksum.String) == 0 {
+		a.Checksum.Valid = false
+	}
+
+	// check if the ContentType field should be false
+	if len(a.ContentType.String) == 0 {
+		a.ContentType.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if a.CreatedAt.Int64 == 0 {
+		a.CreatedAt.Valid = false
+	}
+
+	// check if the ExpiresAt field should be false
+	if a.ExpiresAt.Int64 == 0 {
+		a.ExpiresAt.Valid = false
+	}
+
+	return a
+}
+
+// ToAPI converts the Artifact type
+// to an API Artifact type.
+func (a *Artifact) ToAPI() *api.Artifact {
+	artifact := new(api.Artifact)
+
+	artifact.SetID(a.ID.Int64)
+	artifact.SetBuildID(a.BuildID.Int64)
+	artifact.SetRepoID(a.RepoID.Int64)
+	artifact.SetName(a.Name.String)
+	artifact.SetStep(a.Step.String)
+	artifact.SetObjectPath(a.ObjectPath.String)
+	artifact.SetSize(a.Size.Int64)
+	artifact.SetChecksum(a.Checksum.String)
+	artifact.SetContentType(a.ContentType.String)
+	artifact.SetCreatedAt(a.CreatedAt.Int64)
+	artifact.SetExpiresAt(a.ExpiresAt.Int64)
+
+	return artifact
+}
+
+// Validate verifies the necessary fields for
+// the Artifact type are populated correctly.
+func (a *Artifact) Validate() error {
+	// verify the BuildID field is populated
+	if a.BuildID.Int64 <= 0 {
+		return ErrEmptyArtifactBuildID
+	}
+
+	// verify the RepoID field is populated
+	if a.RepoID.Int64 <= 0 {
+		return ErrEmptyArtifactRepoID
+	}
+
+	// verify the Name field is populated
+	if len(a.Name.String) == 0 {
+		return ErrEmptyArtifactName
+	}
+
+	// verify the ObjectPath field is populated
+	if len(a.ObjectPath.String) == 0 {
+		return ErrEmptyArtifactObjectPath
+	}
+
+	// ensure that all Artifact string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	a.Name = sql.NullString{String: util.Sanitize(a.Name.String), Valid: a.Name.Valid}
+	a.Step = sql.NullString{String: util.Sanitize(a.Step.String), Valid: a.Step.Valid}
+	a.Checksum = sql.NullString{String: util.Sanitize(a.Checksum.String), Valid: a.Checksum.Valid}
+	a.ContentType = sql.NullString{String: util.Sanitize(a.ContentType.String), Valid: a.ContentType.Valid}
+
+	return nil
+}
+
+// ArtifactFromAPI converts the API Artifact type
+// to a database Artifact type.
+func ArtifactFromAPI(a *api.Artifact) *Artifact {
+	artifact := &Artifact{
+		ID:          sql.NullInt64{Int64: a.GetID(), Valid: true},
+		BuildID:     sql.NullInt64{Int64: a.GetBuildID(), Valid: true},
+		RepoID:      sql.NullInt64{Int64: a.GetRepoID(), Valid: true},
+		Name:        sql.NullString{String: a.GetName(), Valid: true},
+		Step:        sql.NullString{String: a.GetStep(), Valid: true},
+		ObjectPath:  sql.NullString{String: a.GetObjectPath(), Valid: true},
+		Size:        sql.NullInt64{Int64: a.GetSize(), Valid: true},
+		Checksum:    sql.NullString{String: a.GetChecksum(), Valid: true},
+		ContentType: sql.NullString{String: a.GetContentType(), Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: a.GetCreatedAt(), Valid: true},
+		ExpiresAt:   sql.NullInt64{Int64: a.GetExpiresAt(), Valid: true},
+	}
+
+	return artifact.Nullify()
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTypes_Artifact_Nullify(t *testing.T) {
+	// setup types
+	var a *Artifact
+
+	want := &Artifact{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:     sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:      sql.NullInt64{Int64: 0, Valid: false},
+		Name:        sql.NullString{String: "", Valid: false},
+		Step:        sql.NullString{String: "", Valid: false},
+		ObjectPath:  sql.NullString{String: "", Valid: false},
+		Size:        sql.NullInt64{Int64: 0, Valid: false},
+		Checksum:    sql.NullString{String: "", Valid: false},
+		ContentType: sql.NullString{String: "", Valid: false},
+		CreatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		ExpiresAt:   sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		artifact *Artifact
+		want     *Artifact
+	}{
+		{
+			artifact: testArtifact(),
+			want:     testArtifact(),
+		},
+		{
+			artifact: a,
+			want:     nil,
+		},
+		{
+			artifact: new(Artifact),
+			want:     want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.artifact.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestTypes_Artifact_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Artifact)
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetName("coverage.out")
+	want.SetStep("test")
+	want.SetObjectPath("github/octocat/1/coverage.out")
+	want.SetSize(1024)
+	want.SetChecksum("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+	want.SetContentType("text/plain")
+	want.SetCreatedAt(1563474076)
+	want.SetExpiresAt(1566066076)
+
+	// run test
+	got := testArtifact().ToAPI()
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToAPI() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTypes_Artifact_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure  bool
+		artifact *Artifact
+	}{
+		{
+			failure:  false,
+			artifact: testArtifact(),
+		},
+		{ // no build_id set for artifact
+			failure: true,
+			artifact: &Artifact{
+				RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+				Name:       sql.NullString{String: "coverage.out", Valid: true},
+				ObjectPath: sql.NullString{String: "github/octocat/1/coverage.out", Valid: true},
+			},
+		},
+		{ // no repo_id set for artifact
+			failure: true,
+			artifact: &Artifact{
+				BuildID:    sql.NullInt64{Int64: 1, Valid: true},
+				Name:       sql.NullString{String: "coverage.out", Valid: true},
+				ObjectPath: sql.NullString{String: "github/octocat/1/coverage.out", Valid: true},
+			},
+		},
+		{ // no name set for artifact
+			failure: true,
+			artifact: &Artifact{
+				BuildID:    sql.NullInt64{Int64: 1, Valid: true},
+				RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+				ObjectPath: sql.NullString{String: "github/octocat/1/coverage.out", Valid: true},
+			},
+		},
+		{ // no object_path set for artifact
+			failure: true,
+			artifact: &Artifact{
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+				Name:    sql.NullString{String: "coverage.out", Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.artifact.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestTypes_ArtifactFromAPI(t *testing.T) {
+	// setup types
+	a := new(api.Artifact)
+	a.SetID(1)
+	a.SetBuildID(1)
+	a.SetRepoID(1)
+	a.SetName("coverage.out")
+	a.SetStep("test")
+	a.SetObjectPath("github/octocat/1/coverage.out")
+	a.SetSize(1024)
+	a.SetChecksum("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+	a.SetContentType("text/plain")
+	a.SetCreatedAt(1563474076)
+	a.SetExpiresAt(1566066076)
+
+	want := testArtifact()
+
+	// run test
+	got := ArtifactFromAPI(a)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ArtifactFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testArtifact is a test helper function to create an Artifact
+// type with all fields set to a fake value.
+func testArtifact() *Artifact {
+	return &Artifact{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:     sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:      sql.NullInt64{Int64: 1, Valid: true},
+		Name:        sql.NullString{String: "coverage.out", Valid: true},
+		Step:        sql.NullString{String: "test", Valid: true},
+		ObjectPath:  sql.NullString{String: "github/octocat/1/coverage.out", Valid: true},
+		Size:        sql.NullInt64{Int64: 1024, Valid: true},
+		Checksum:    sql.NullString{String: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Valid: true},
+		ContentType: sql.NullString{String: "text/plain", Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: 1563474076, Valid: true},
+		ExpiresAt:   sql.NullInt64{Int64: 1566066076, Valid: true},
+	}
+}
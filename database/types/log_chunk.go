@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ErrEmptyLogChunkLogID defines the error type when a
+// LogChunk type has an empty LogID field provided.
+var ErrEmptyLogChunkLogID = errors.New("empty log chunk log_id provided")
+
+// LogChunk is the database representation of data
+// appended to a log for a step or service in a build.
+type LogChunk struct {
+	ID        sql.NullInt64 `sql:"id"`
+	LogID     sql.NullInt64 `sql:"log_id"`
+	BuildID   sql.NullInt64 `sql:"build_id"`
+	RepoID    sql.NullInt64 `sql:"repo_id"`
+	ServiceID sql.NullInt64 `sql:"service_id"`
+	StepID    sql.NullInt64 `sql:"step_id"`
+	Sequence  sql.NullInt64 `sql:"sequence"`
+	Position  sql.NullInt64 `sql:"position"`
+	Data      []byte        `sql:"data"`
+	CreatedAt sql.NullInt64 `sql:"created_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the LogChunk type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+//
+// The Position field is not nullified since the
+// first chunk of an empty log starts at zero.
+func (c *LogChunk) Nullify() *LogChunk {
+	if c == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if c.ID.Int64 == 0 {
+		c.ID.Valid = false
+	}
+
+	// check if the LogID field should be false
+	if c.LogID.Int64 == 0 {
+		c.LogID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if c.BuildID.Int64 == 0 {
+		c.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if c.RepoID.Int64 == 0 {
+		c.RepoID.Valid = false
+	}
+
+	// check if the ServiceID field should be false
+	if c.ServiceID.Int64 == 0 {
+		c.ServiceID.Valid = false
+	}
+
+	// check if the StepID field should be false
+	if c.StepID.Int64 == 0 {
+		c.StepID.Valid = false
+	}
+
+	// check if the Sequence field should be false
+	if c.Sequence.Int64 == 0 {
+		c.Sequence.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if c.CreatedAt.Int64 == 0 {
+		c.CreatedAt.Valid = false
+	}
+
+	return c
+}
+
+// ToAPI converts the LogChunk type
+// to a API LogChunk type.
+func (c *LogChunk) ToAPI() *api.LogChunk {
+	return &api.LogChunk{
+		Offset: c.Position.Int64,
+		Data:   c.Data,
+	}
+}
+
+// Validate verifies the necessary fields for
+// the LogChunk type are populated correctly.
+func (c *LogChunk) Validate() error {
+	// verify the LogID field is populated
+	if c.LogID.Int64 <= 0 {
+		return ErrEmptyLogChunkLogID
+	}
+
+	// verify the has StepID or ServiceID field populated
+	if c.StepID.Int64 <= 0 && c.ServiceID.Int64 <= 0 {
+		return ErrEmptyLogStepOrServiceID
+	}
+
+	// verify the BuildID field is populated
+	if c.BuildID.Int64 <= 0 {
+		return ErrEmptyLogBuildID
+	}
+
+	// verify the RepoID field is populated
+	if c.RepoID.Int64 <= 0 {
+		return ErrEmptyLogRepoID
+	}
+
+	return nil
+}
+
+// LogChunkFromLog creates the LogChunk type for
+// data appended to a log at the sequence and position.
+func LogChunkFromLog(l *Log, sequence, position int64, data []byte, created int64) *LogChunk {
+	chunk := &LogChunk{
+		LogID:     sql.NullInt64{Int64: l.ID.Int64, Valid: true},
+		BuildID:   sql.NullInt64{Int64: l.BuildID.Int64, Valid: true},
+		RepoID:    sql.NullInt64{Int64: l.RepoID.Int64, Valid: true},
+		ServiceID: sql.NullInt64{Int64: l.ServiceID.Int64, Valid: true},
+		StepID:    sql.NullInt64{Int64: l.StepID.Int64, Valid: true},
+		Sequence:  sql.NullInt64{Int64: sequence, Valid: true},
+		Position:  sql.NullInt64{Int64: position, Valid: true},
+		Data:      data,
+		CreatedAt: sql.NullInt64{Int64: created, Valid: true},
+	}
+
+	return chunk.Nullify()
+}
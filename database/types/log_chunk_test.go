@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestDatabase_LogChunk_Nullify(t *testing.T) {
+	// setup types
+	var c *LogChunk
+
+	want := &LogChunk{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		LogID:     sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:   sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:    sql.NullInt64{Int64: 0, Valid: false},
+		ServiceID: sql.NullInt64{Int64: 0, Valid: false},
+		StepID:    sql.NullInt64{Int64: 0, Valid: false},
+		Sequence:  sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		chunk *LogChunk
+		want  *LogChunk
+	}{
+		{
+			chunk: testLogChunk(),
+			want:  testLogChunk(),
+		},
+		{
+			chunk: c,
+			want:  nil,
+		},
+		{
+			chunk: new(LogChunk),
+			want:  want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.chunk.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestDatabase_LogChunk_ToAPI(t *testing.T) {
+	// setup types
+	want := &api.LogChunk{
+		Offset: 3,
+		Data:   []byte("bar"),
+	}
+
+	// run test
+	got := testLogChunk().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestDatabase_LogChunk_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		chunk   *LogChunk
+	}{
+		{
+			failure: false,
+			chunk:   testLogChunk(),
+		},
+		{ // no log_id set for chunk
+			failure: true,
+			chunk: &LogChunk{
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+				StepID:  sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+		{ // no service_id or step_id set for chunk
+			failure: true,
+			chunk: &LogChunk{
+				LogID:   sql.NullInt64{Int64: 1, Valid: true},
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+		{ // no build_id set for chunk
+			failure: true,
+			chunk: &LogChunk{
+				LogID:  sql.NullInt64{Int64: 1, Valid: true},
+				RepoID: sql.NullInt64{Int64: 1, Valid: true},
+				StepID: sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+		{ // no repo_id set for chunk
+			failure: true,
+			chunk: &LogChunk{
+				LogID:   sql.NullInt64{Int64: 1, Valid: true},
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				StepID:  sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.chunk.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestDatabase_LogChunkFromLog(t *testing.T) {
+	// setup types
+	want := testLogChunk()
+	want.ID = sql.NullInt64{Int64: 0, Valid: false}
+
+	// run test
+	got := LogChunkFromLog(testLog(), 2, 3, []byte("bar"), tsCreate)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LogChunkFromLog is %v, want %v", got, want)
+	}
+}
+
+// testLogChunk is a test helper function to create a LogChunk
+// type with all fields set to a fake value.
+func testLogChunk() *LogChunk {
+	return &LogChunk{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		LogID:     sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:   sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:    sql.NullInt64{Int64: 1, Valid: true},
+		ServiceID: sql.NullInt64{Int64: 1, Valid: true},
+		StepID:    sql.NullInt64{Int64: 1, Valid: true},
+		Sequence:  sql.NullInt64{Int64: 2, Valid: true},
+		Position:  sql.NullInt64{Int64: 3, Valid: true},
+		Data:      []byte("bar"),
+		CreatedAt: sql.NullInt64{Int64: tsCreate, Valid: true},
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/util"
+)
+
+var (
+	// ErrEmptyLogObjectLogID defines the error type when a
+	// LogObject type has an empty LogID field provided.
+	ErrEmptyLogObjectLogID = errors.New("empty log object log_id provided")
+
+	// ErrEmptyLogObjectPath defines the error type when a
+	// LogObject type has an empty ObjectPath field provided.
+	ErrEmptyLogObjectPath = errors.New("empty log object object_path provided")
+)
+
+// LogObject is the database representation of a pointer
+// to a log for a step or service moved to the object storage.
+type LogObject struct {
+	ID         sql.NullInt64  `sql:"id"`
+	LogID      sql.NullInt64  `sql:"log_id"`
+	BuildID    sql.NullInt64  `sql:"build_id"`
+	RepoID     sql.NullInt64  `sql:"repo_id"`
+	ServiceID  sql.NullInt64  `sql:"service_id"`
+	StepID     sql.NullInt64  `sql:"step_id"`
+	ObjectPath sql.NullString `sql:"object_path"`
+	Size       sql.NullInt64  `sql:"size"`
+	CreatedAt  sql.NullInt64  `sql:"created_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the LogObject type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (o *LogObject) Nullify() *LogObject {
+	if o == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if o.ID.Int64 == 0 {
+		o.ID.Valid = false
+	}
+
+	// check if the LogID field should be false
+	if o.LogID.Int64 == 0 {
+		o.LogID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if o.BuildID.Int64 == 0 {
+		o.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if o.RepoID.Int64 == 0 {
+		o.RepoID.Valid = false
+	}
+
+	// check if the ServiceID field should be false
+	if o.ServiceID.Int64 == 0 {
+		o.ServiceID.Valid = false
+	}
+
+	// check if the StepID field should be false
+	if o.StepID.Int64 == 0 {
+		o.StepID.Valid = false
+	}
+
+	// check if the ObjectPath field should be false
+	if len(o.ObjectPath.String) == 0 {
+		o.ObjectPath.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if o.CreatedAt.Int64 == 0 {
+		o.CreatedAt.Valid = false
+	}
+
+	return o
+}
+
+// ToAPI converts the LogObject type
+// to a API Object type.
+func (o *LogObject) ToAPI() *api.Object {
+	return &api.Object{
+		ObjectName:  o.ObjectPath.String,
+		Size:        o.Size.Int64,
+		ContentType: "text/plain",
+	}
+}
+
+// Validate verifies the necessary fields for
+// the LogObject type are populated correctly.
+func (o *LogObject) Validate() error {
+	// verify the LogID field is populated
+	if o.LogID.Int64 <= 0 {
+		return ErrEmptyLogObjectLogID
+	}
+
+	// verify the has StepID or ServiceID field populated
+	if o.StepID.Int64 <= 0 && o.ServiceID.Int64 <= 0 {
+		return ErrEmptyLogStepOrServiceID
+	}
+
+	// verify the ObjectPath field is populated
+	if len(o.ObjectPath.String) == 0 {
+		return ErrEmptyLogObjectPath
+	}
+
+	// ensure that all LogObject string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	o.ObjectPath = sql.NullString{String: util.Sanitize(o.ObjectPath.String), Valid: o.ObjectPath.Valid}
+
+	return nil
+}
+
+// LogObjectFromLog creates the LogObject type
+// for a log moved to the object.
+func LogObjectFromLog(l *api.Log, o *api.Object, created int64) *LogObject {
+	object := &LogObject{
+		LogID:      sql.NullInt64{Int64: l.GetID(), Valid: true},
+		BuildID:    sql.NullInt64{Int64: l.GetBuildID(), Valid: true},
+		RepoID:     sql.NullInt64{Int64: l.GetRepoID(), Valid: true},
+		ServiceID:  sql.NullInt64{Int64: l.GetServiceID(), Valid: true},
+		StepID:     sql.NullInt64{Int64: l.GetStepID(), Valid: true},
+		ObjectPath: sql.NullString{String: o.ObjectName, Valid: true},
+		Size:       sql.NullInt64{Int64: o.Size, Valid: true},
+		CreatedAt:  sql.NullInt64{Int64: created, Valid: true},
+	}
+
+	return object.Nullify()
+}
@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestDatabase_LogObject_Nullify(t *testing.T) {
+	// setup types
+	var o *LogObject
+
+	want := &LogObject{
+		ID:         sql.NullInt64{Int64: 0, Valid: false},
+		LogID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:    sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:     sql.NullInt64{Int64: 0, Valid: false},
+		ServiceID:  sql.NullInt64{Int64: 0, Valid: false},
+		StepID:     sql.NullInt64{Int64: 0, Valid: false},
+		ObjectPath: sql.NullString{String: "", Valid: false},
+		CreatedAt:  sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		object *LogObject
+		want   *LogObject
+	}{
+		{
+			object: testLogObject(),
+			want:   testLogObject(),
+		},
+		{
+			object: o,
+			want:   nil,
+		},
+		{
+			object: new(LogObject),
+			want:   want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.object.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestDatabase_LogObject_ToAPI(t *testing.T) {
+	// setup types
+	want := &api.Object{
+		ObjectName:  "logs/1/1/step_1.log",
+		Size:        3,
+		ContentType: "text/plain",
+	}
+
+	// run test
+	got := testLogObject().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestDatabase_LogObject_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		object  *LogObject
+	}{
+		{
+			failure: false,
+			object:  testLogObject(),
+		},
+		{ // no log_id set for object
+			failure: true,
+			object: &LogObject{
+				StepID:     sql.NullInt64{Int64: 1, Valid: true},
+				ObjectPath: sql.NullString{String: "logs/1/1/step_1.log", Valid: true},
+			},
+		},
+		{ // no service_id or step_id set for object
+			failure: true,
+			object: &LogObject{
+				LogID:      sql.NullInt64{Int64: 1, Valid: true},
+				ObjectPath: sql.NullString{String: "logs/1/1/step_1.log", Valid: true},
+			},
+		},
+		{ // no object_path set for object
+			failure: true,
+			object: &LogObject{
+				LogID:  sql.NullInt64{Int64: 1, Valid: true},
+				StepID: sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.object.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestDatabase_LogObjectFromLog(t *testing.T) {
+	// setup types
+	l := testLog().ToAPI()
+	l.SetServiceID(0)
+
+	want := testLogObject()
+	want.ID = sql.NullInt64{Int64: 0, Valid: false}
+
+	// run test
+	got := LogObjectFromLog(l, &api.Object{ObjectName: "logs/1/1/step_1.log", Size: 3}, tsCreate)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LogObjectFromLog is %v, want %v", got, want)
+	}
+}
+
+// testLogObject is a test helper function to create a LogObject
+// type with all fields set to a fake value.
+func testLogObject() *LogObject {
+	return &LogObject{
+		ID:         sql.NullInt64{Int64: 1, Valid: true},
+		LogID:      sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:    sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+		ServiceID:  sql.NullInt64{Int64: 0, Valid: false},
+		StepID:     sql.NullInt64{Int64: 1, Valid: true},
+		ObjectPath: sql.NullString{String: "logs/1/1/step_1.log", Valid: true},
+		Size:       sql.NullInt64{Int64: 3, Valid: true},
+		CreatedAt:  sql.NullInt64{Int64: tsCreate, Valid: true},
+	}
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+const (
+	// logSearchBlockLines is the maximum number
+	// of lines in a block of a log for search.
+	logSearchBlockLines = 500
+
+	// logSearchBlockSize is the size in bytes
+	// a block of a log for search is ended at.
+	logSearchBlockSize = 64 * 1024
+
+	// logSearchLineSize is the maximum size in
+	// bytes of a line in a block of a log for search.
+	logSearchLineSize = 4 * 1024
+)
+
+// ErrEmptyLogSearchLogID defines the error type when a
+// LogSearch type has an empty LogID field provided.
+var ErrEmptyLogSearchLogID = errors.New("empty log search log_id provided")
+
+// LogSearch is the database representation of a block of
+// lines from a log for a step or service used for search.
+type LogSearch struct {
+	ID        sql.NullInt64  `sql:"id"`
+	LogID     sql.NullInt64  `sql:"log_id"`
+	BuildID   sql.NullInt64  `sql:"build_id"`
+	RepoID    sql.NullInt64  `sql:"repo_id"`
+	ServiceID sql.NullInt64  `sql:"service_id"`
+	StepID    sql.NullInt64  `sql:"step_id"`
+	Line      sql.NullInt64  `sql:"line"`
+	Content   sql.NullString `sql:"content"`
+	CreatedAt sql.NullInt64  `sql:"created_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the LogSearch type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *LogSearch) Nullify() *LogSearch {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the LogID field should be false
+	if s.LogID.Int64 == 0 {
+		s.LogID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if s.BuildID.Int64 == 0 {
+		s.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if s.RepoID.Int64 == 0 {
+		s.RepoID.Valid = false
+	}
+
+	// check if the ServiceID field should be false
+	if s.ServiceID.Int64 == 0 {
+		s.ServiceID.Valid = false
+	}
+
+	// check if the StepID field should be false
+	if s.StepID.Int64 == 0 {
+		s.StepID.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if s.CreatedAt.Int64 == 0 {
+		s.CreatedAt.Valid = false
+	}
+
+	return s
+}
+
+// Match returns the lines of the LogSearch type containing every term,
+// along with up to the provided number of lines before and after.
+//
+// The terms are expected to be lower case as returned by SearchTerms.
+func (s *LogSearch) Match(terms []string, lines int) []*api.LogSearchResult {
+	results := []*api.LogSearchResult{}
+
+	if len(terms) == 0 {
+		return results
+	}
+
+	content := strings.Split(s.Content.String, "\n")
+
+	for i, line := range content {
+		if !matchTerms(strings.ToLower(line), terms) {
+			continue
+		}
+
+		results = append(results, &api.LogSearchResult{
+			RepoID:    s.RepoID.Int64,
+			BuildID:   s.BuildID.Int64,
+			StepID:    s.StepID.Int64,
+			ServiceID: s.ServiceID.Int64,
+			Line:      s.Line.Int64 + int64(i),
+			Text:      line,
+			Before:    content[max(0, i-lines):i],
+			After:     content[i+1 : min(len(content), i+1+lines)],
+		})
+	}
+
+	return results
+}
+
+// Validate verifies the necessary fields for
+// the LogSearch type are populated correctly.
+func (s *LogSearch) Validate() error {
+	// verify the LogID field is populated
+	if s.LogID.Int64 <= 0 {
+		return ErrEmptyLogSearchLogID
+	}
+
+	// verify the has StepID or ServiceID field populated
+	if s.StepID.Int64 <= 0 && s.ServiceID.Int64 <= 0 {
+		return ErrEmptyLogStepOrServiceID
+	}
+
+	return nil
+}
+
+// LogSearchFromLog splits the data of a log into the
+// blocks of lines of the LogSearch type used for search.
+//
+// Lines are numbered from one and lines over the maximum size are
+// truncated. Invalid UTF-8 and NUL characters are replaced, since
+// they can not be stored as text.
+func LogSearchFromLog(l *api.Log, created int64) []*LogSearch {
+	blocks := []*LogSearch{}
+
+	data := strings.TrimSuffix(string(l.GetData()), "\n")
+	if len(data) == 0 {
+		return blocks
+	}
+
+	var (
+		block []string
+		size  int
+		start = int64(1)
+	)
+
+	// flush is a helper function to add the captured lines as a block
+	flush := func() {
+		blocks = append(blocks, (&LogSearch{
+			LogID:     sql.NullInt64{Int64: l.GetID(), Valid: true},
+			BuildID:   sql.NullInt64{Int64: l.GetBuildID(), Valid: true},
+			RepoID:    sql.NullInt64{Int64: l.GetRepoID(), Valid: true},
+			ServiceID: sql.NullInt64{Int64: l.GetServiceID(), Valid: true},
+			StepID:    sql.NullInt64{Int64: l.GetStepID(), Valid: true},
+			Line:      sql.NullInt64{Int64: start, Valid: true},
+			Content:   sql.NullString{String: strings.Join(block, "\n"), Valid: true},
+			CreatedAt: sql.NullInt64{Int64: created, Valid: true},
+		}).Nullify())
+
+		start += int64(len(block))
+		block = nil
+		size = 0
+	}
+
+	for line := range strings.SplitSeq(data, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+
+		if len(line) > logSearchLineSize {
+			line = line[:logSearchLineSize]
+		}
+
+		line = strings.ReplaceAll(strings.ToValidUTF8(line, "\uFFFD"), "\x00", "\uFFFD")
+
+		block = append(block, line)
+		size += len(line) + 1
+
+		if len(block) >= logSearchBlockLines || size >= logSearchBlockSize {
+			flush()
+		}
+	}
+
+	if len(block) > 0 {
+		flush()
+	}
+
+	return blocks
+}
+
+// SearchTerms splits a log search query into the lower case terms
+// every line matching the search is expected to contain.
+func SearchTerms(query string) []string {
+	return strings.Fields(strings.ToLower(query))
+}
+
+// matchTerms is a helper function to check
+// if the line contains every search term.
+func matchTerms(line string, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(line, term) {
+			return false
+		}
+	}
+
+	return true
+}
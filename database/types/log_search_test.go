@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestDatabase_LogSearch_Nullify(t *testing.T) {
+	// setup types
+	var s *LogSearch
+
+	want := &LogSearch{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		LogID:     sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:   sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:    sql.NullInt64{Int64: 0, Valid: false},
+		ServiceID: sql.NullInt64{Int64: 0, Valid: false},
+		StepID:    sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		search *LogSearch
+		want   *LogSearch
+	}{
+		{
+			search: testLogSearch(),
+			want:   testLogSearch(),
+		},
+		{
+			search: s,
+			want:   nil,
+		},
+		{
+			search: new(LogSearch),
+			want:   want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.search.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestDatabase_LogSearch_Match(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name  string
+		query string
+		lines int
+		want  []*api.LogSearchResult
+	}{
+		{
+			name:  "single term with context",
+			query: "PANIC",
+			lines: 1,
+			want: []*api.LogSearchResult{
+				{
+					RepoID:  1,
+					BuildID: 1,
+					StepID:  1,
+					Line:    12,
+					Text:    "panic: runtime error",
+					Before:  []string{"=== RUN TestFoo"},
+					After:   []string{"goroutine 1 [running]:"},
+				},
+			},
+		},
+		{
+			name:  "multiple terms without context",
+			query: "run test",
+			lines: 0,
+			want: []*api.LogSearchResult{
+				{
+					RepoID:  1,
+					BuildID: 1,
+					StepID:  1,
+					Line:    11,
+					Text:    "=== RUN TestFoo",
+					Before:  []string{},
+					After:   []string{},
+				},
+			},
+		},
+		{
+			name:  "no matching lines",
+			query: "foo bar",
+			lines: 2,
+			want:  []*api.LogSearchResult{},
+		},
+		{
+			name:  "empty query",
+			query: " ",
+			lines: 2,
+			want:  []*api.LogSearchResult{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := testLogSearch().Match(SearchTerms(test.query), test.lines)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Match is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDatabase_LogSearch_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		search  *LogSearch
+	}{
+		{
+			failure: false,
+			search:  testLogSearch(),
+		},
+		{ // no log_id set for search
+			failure: true,
+			search: &LogSearch{
+				StepID: sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+		{ // no service_id or step_id set for search
+			failure: true,
+			search: &LogSearch{
+				LogID: sql.NullInt64{Int64: 1, Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.search.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestDatabase_LogSearchFromLog(t *testing.T) {
+	// setup types
+	l := testLog().ToAPI()
+	l.SetServiceID(0)
+
+	many := strings.Repeat("foo\n", logSearchBlockLines+1)
+
+	// setup tests
+	tests := []struct {
+		name  string
+		data  string
+		lines []int64
+		want  []string
+	}{
+		{
+			name: "empty log",
+			data: "",
+		},
+		{
+			name:  "single block",
+			data:  "foo\r\nbar\n",
+			lines: []int64{1},
+			want:  []string{"foo\nbar"},
+		},
+		{
+			name:  "invalid text",
+			data:  "foo\x00\xffbar",
+			lines: []int64{1},
+			want:  []string{"foo\uFFFD\uFFFDbar"},
+		},
+		{
+			name:  "truncated line",
+			data:  strings.Repeat("a", logSearchLineSize+1),
+			lines: []int64{1},
+			want:  []string{strings.Repeat("a", logSearchLineSize)},
+		},
+		{
+			name:  "multiple blocks",
+			data:  many,
+			lines: []int64{1, logSearchBlockLines + 1},
+			want:  []string{strings.TrimSuffix(strings.Repeat("foo\n", logSearchBlockLines), "\n"), "foo"},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			l.SetData([]byte(test.data))
+
+			got := LogSearchFromLog(l, tsCreate)
+
+			if len(got) != len(test.want) {
+				t.Fatalf("LogSearchFromLog returned %d blocks, want %d", len(got), len(test.want))
+			}
+
+			for i, block := range got {
+				if block.Line.Int64 != test.lines[i] {
+					t.Errorf("LogSearchFromLog block %d line is %d, want %d", i, block.Line.Int64, test.lines[i])
+				}
+
+				if block.Content.String != test.want[i] {
+					t.Errorf("LogSearchFromLog block %d content is %q, want %q", i, block.Content.String, test.want[i])
+				}
+
+				if block.LogID.Int64 != l.GetID() || block.StepID.Int64 != l.GetStepID() || block.ServiceID.Valid {
+					t.Errorf("LogSearchFromLog block %d is %v, want fields from log %v", i, block, l)
+				}
+			}
+		})
+	}
+}
+
+// testLogSearch is a test helper function to create a LogSearch
+// type with all fields set to a fake value.
+func testLogSearch() *LogSearch {
+	return &LogSearch{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		LogID:     sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:   sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:    sql.NullInt64{Int64: 1, Valid: true},
+		ServiceID: sql.NullInt64{Int64: 0, Valid: false},
+		StepID:    sql.NullInt64{Int64: 1, Valid: true},
+		Line:      sql.NullInt64{Int64: 10, Valid: true},
+		Content:   sql.NullString{String: "ok\n=== RUN TestFoo\npanic: runtime error\ngoroutine 1 [running]:", Valid: true},
+		CreatedAt: sql.NullInt64{Int64: tsCreate, Valid: true},
+	}
+}
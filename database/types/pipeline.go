@@ -4,7 +4,10 @@ package types
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/lib/pq"
 
@@ -41,27 +44,59 @@ var (
 
 // Pipeline is the database representation of a pipeline.
 type Pipeline struct {
-	ID              sql.NullInt64  `sql:"id"`
-	RepoID          sql.NullInt64  `sql:"repo_id"`
-	Commit          sql.NullString `sql:"commit"`
-	Flavor          sql.NullString `sql:"flavor"`
-	Platform        sql.NullString `sql:"platform"`
-	Ref             sql.NullString `sql:"ref"`
-	Type            sql.NullString `sql:"type"`
-	Version         sql.NullString `sql:"version"`
-	ExternalSecrets sql.NullBool   `sql:"external_secrets"`
-	InternalSecrets sql.NullBool   `sql:"internal_secrets"`
-	Services        sql.NullBool   `sql:"services"`
-	Stages          sql.NullBool   `sql:"stages"`
-	Steps           sql.NullBool   `sql:"steps"`
-	Templates       sql.NullBool   `sql:"templates"`
-	Artifacts       sql.NullBool   `sql:"artifacts"`
-	Warnings        pq.StringArray `sql:"warnings"         gorm:"type:varchar(5000)"`
-	Data            []byte         `sql:"data"`
+	ID                sql.NullInt64        `sql:"id"`
+	RepoID            sql.NullInt64        `sql:"repo_id"`
+	Commit            sql.NullString       `sql:"commit"`
+	GeneratedDigest   sql.NullString       `sql:"generated_digest"`
+	Flavor            sql.NullString       `sql:"flavor"`
+	Platform          sql.NullString       `sql:"platform"`
+	Ref               sql.NullString       `sql:"ref"`
+	Type              sql.NullString       `sql:"type"`
+	Version           sql.NullString       `sql:"version"`
+	ExternalSecrets   sql.NullBool         `sql:"external_secrets"`
+	InternalSecrets   sql.NullBool         `sql:"internal_secrets"`
+	Services          sql.NullBool         `sql:"services"`
+	Stages            sql.NullBool         `sql:"stages"`
+	Steps             sql.NullBool         `sql:"steps"`
+	Templates         sql.NullBool         `sql:"templates"`
+	Artifacts         sql.NullBool         `sql:"artifacts"`
+	Warnings          pq.StringArray       `sql:"warnings"         gorm:"type:varchar(5000)"`
+	ResolvedTemplates ResolvedTemplateJSON `sql:"resolved_templates" gorm:"type:text"`
+	Data              []byte               `sql:"data"`
 
 	Repo Repo `gorm:"foreignKey:RepoID"`
 }
 
+// ResolvedTemplateJSON is the database representation
+// of the templates resolved for a pipeline.
+type ResolvedTemplateJSON []api.ResolvedTemplate
+
+// Value - Implementation of valuer for database/sql for ResolvedTemplateJSON.
+func (r ResolvedTemplateJSON) Value() (driver.Value, error) {
+	// store pipelines without templates as NULL
+	if len(r) == 0 {
+		return nil, nil
+	}
+
+	valueString, err := json.Marshal(r)
+
+	return string(valueString), err
+}
+
+// Scan - Implement the database/sql scanner interface for ResolvedTemplateJSON.
+func (r *ResolvedTemplateJSON) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, &r)
+	case string:
+		return json.Unmarshal([]byte(v), &r)
+	default:
+		return fmt.Errorf("wrong type for resolved templates: %T", v)
+	}
+}
+
 // Compress will manipulate the existing data for the
 // pipeline by compressing that data. This produces
 // a significantly smaller amount of data that is
@@ -122,6 +157,9 @@ func (p *Pipeline) Nullify() *Pipeline {
 		p.Commit.Valid = false
 	}
 
+	// the GeneratedDigest field is never nullified since an empty
+	// digest marks the pipeline at the commit in the unique index
+
 	// check if the Flavor field should be false
 	if len(p.Flavor.String) == 0 {
 		p.Flavor.Valid = false
@@ -158,6 +196,7 @@ func (p *Pipeline) ToAPI() *api.Pipeline {
 	pipeline.SetID(p.ID.Int64)
 	pipeline.SetRepo(p.Repo.ToAPI())
 	pipeline.SetCommit(p.Commit.String)
+	pipeline.SetGeneratedDigest(p.GeneratedDigest.String)
 	pipeline.SetFlavor(p.Flavor.String)
 	pipeline.SetPlatform(p.Platform.String)
 	pipeline.SetRef(p.Ref.String)
@@ -171,6 +210,7 @@ func (p *Pipeline) ToAPI() *api.Pipeline {
 	pipeline.SetTemplates(p.Templates.Bool)
 	pipeline.SetArtifact(p.Artifacts.Bool)
 	pipeline.SetWarnings(p.Warnings)
+	pipeline.SetResolvedTemplates(p.ResolvedTemplates)
 	pipeline.SetData(p.Data)
 
 	return pipeline
@@ -221,6 +261,7 @@ func (p *Pipeline) Validate() error {
 	// that can be returned as JSON are sanitized
 	// to avoid unsafe HTML content
 	p.Commit = sql.NullString{String: util.Sanitize(p.Commit.String), Valid: p.Commit.Valid}
+	p.GeneratedDigest = sql.NullString{String: util.Sanitize(p.GeneratedDigest.String), Valid: p.GeneratedDigest.Valid}
 	p.Flavor = sql.NullString{String: util.Sanitize(p.Flavor.String), Valid: p.Flavor.Valid}
 	p.Platform = sql.NullString{String: util.Sanitize(p.Platform.String), Valid: p.Platform.Valid}
 	p.Ref = sql.NullString{String: util.Sanitize(p.Ref.String), Valid: p.Ref.Valid}
@@ -234,23 +275,25 @@ func (p *Pipeline) Validate() error {
 // to a database Pipeline type.
 func PipelineFromAPI(p *api.Pipeline) *Pipeline {
 	pipeline := &Pipeline{
-		ID:              sql.NullInt64{Int64: p.GetID(), Valid: true},
-		RepoID:          sql.NullInt64{Int64: p.GetRepo().GetID(), Valid: true},
-		Commit:          sql.NullString{String: p.GetCommit(), Valid: true},
-		Flavor:          sql.NullString{String: p.GetFlavor(), Valid: true},
-		Platform:        sql.NullString{String: p.GetPlatform(), Valid: true},
-		Ref:             sql.NullString{String: p.GetRef(), Valid: true},
-		Type:            sql.NullString{String: p.GetType(), Valid: true},
-		Version:         sql.NullString{String: p.GetVersion(), Valid: true},
-		ExternalSecrets: sql.NullBool{Bool: p.GetExternalSecrets(), Valid: true},
-		InternalSecrets: sql.NullBool{Bool: p.GetInternalSecrets(), Valid: true},
-		Services:        sql.NullBool{Bool: p.GetServices(), Valid: true},
-		Stages:          sql.NullBool{Bool: p.GetStages(), Valid: true},
-		Steps:           sql.NullBool{Bool: p.GetSteps(), Valid: true},
-		Templates:       sql.NullBool{Bool: p.GetTemplates(), Valid: true},
-		Artifacts:       sql.NullBool{Bool: p.GetArtifact(), Valid: true},
-		Warnings:        pq.StringArray(p.GetWarnings()),
-		Data:            p.GetData(),
+		ID:                sql.NullInt64{Int64: p.GetID(), Valid: true},
+		RepoID:            sql.NullInt64{Int64: p.GetRepo().GetID(), Valid: true},
+		Commit:            sql.NullString{String: p.GetCommit(), Valid: true},
+		GeneratedDigest:   sql.NullString{String: p.GetGeneratedDigest(), Valid: true},
+		Flavor:            sql.NullString{String: p.GetFlavor(), Valid: true},
+		Platform:          sql.NullString{String: p.GetPlatform(), Valid: true},
+		Ref:               sql.NullString{String: p.GetRef(), Valid: true},
+		Type:              sql.NullString{String: p.GetType(), Valid: true},
+		Version:           sql.NullString{String: p.GetVersion(), Valid: true},
+		ExternalSecrets:   sql.NullBool{Bool: p.GetExternalSecrets(), Valid: true},
+		InternalSecrets:   sql.NullBool{Bool: p.GetInternalSecrets(), Valid: true},
+		Services:          sql.NullBool{Bool: p.GetServices(), Valid: true},
+		Stages:            sql.NullBool{Bool: p.GetStages(), Valid: true},
+		Steps:             sql.NullBool{Bool: p.GetSteps(), Valid: true},
+		Templates:         sql.NullBool{Bool: p.GetTemplates(), Valid: true},
+		Artifacts:         sql.NullBool{Bool: p.GetArtifact(), Valid: true},
+		Warnings:          pq.StringArray(p.GetWarnings()),
+		ResolvedTemplates: p.GetResolvedTemplates(),
+		Data:              p.GetData(),
 	}
 
 	return pipeline.Nullify()
@@ -274,6 +274,7 @@ func TestDatabase_Pipeline_ToAPI(t *testing.T) {
 	want.SetID(1)
 	want.SetRepo(testRepo().ToAPI())
 	want.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	want.SetGeneratedDigest("")
 	want.SetFlavor("large")
 	want.SetPlatform("docker")
 	want.SetRef("refs/heads/main")
@@ -287,6 +288,7 @@ func TestDatabase_Pipeline_ToAPI(t *testing.T) {
 	want.SetTemplates(false)
 	want.SetArtifact(false)
 	want.SetWarnings([]string{"42:this is a warning"})
+	want.SetResolvedTemplates(testResolvedTemplates())
 	want.SetData(testPipelineData())
 
 	// run test
@@ -381,23 +383,25 @@ func TestDatabase_Pipeline_Validate(t *testing.T) {
 func TestDatabase_PipelineFromAPI(t *testing.T) {
 	// setup types
 	want := &Pipeline{
-		ID:              sql.NullInt64{Int64: 1, Valid: true},
-		RepoID:          sql.NullInt64{Int64: 1, Valid: true},
-		Commit:          sql.NullString{String: "48afb5bdc41ad69bf22588491333f7cf71135163", Valid: true},
-		Flavor:          sql.NullString{String: "large", Valid: true},
-		Platform:        sql.NullString{String: "docker", Valid: true},
-		Ref:             sql.NullString{String: "refs/heads/main", Valid: true},
-		Type:            sql.NullString{String: constants.PipelineTypeYAML, Valid: true},
-		Version:         sql.NullString{String: "1", Valid: true},
-		ExternalSecrets: sql.NullBool{Bool: false, Valid: true},
-		InternalSecrets: sql.NullBool{Bool: false, Valid: true},
-		Services:        sql.NullBool{Bool: true, Valid: true},
-		Stages:          sql.NullBool{Bool: false, Valid: true},
-		Steps:           sql.NullBool{Bool: true, Valid: true},
-		Templates:       sql.NullBool{Bool: false, Valid: true},
-		Artifacts:       sql.NullBool{Bool: false, Valid: true},
-		Warnings:        []string{"42:this is a warning"},
-		Data:            testPipelineData(),
+		ID:                sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:            sql.NullInt64{Int64: 1, Valid: true},
+		Commit:            sql.NullString{String: "48afb5bdc41ad69bf22588491333f7cf71135163", Valid: true},
+		GeneratedDigest:   sql.NullString{String: "", Valid: true},
+		Flavor:            sql.NullString{String: "large", Valid: true},
+		Platform:          sql.NullString{String: "docker", Valid: true},
+		Ref:               sql.NullString{String: "refs/heads/main", Valid: true},
+		Type:              sql.NullString{String: constants.PipelineTypeYAML, Valid: true},
+		Version:           sql.NullString{String: "1", Valid: true},
+		ExternalSecrets:   sql.NullBool{Bool: false, Valid: true},
+		InternalSecrets:   sql.NullBool{Bool: false, Valid: true},
+		Services:          sql.NullBool{Bool: true, Valid: true},
+		Stages:            sql.NullBool{Bool: false, Valid: true},
+		Steps:             sql.NullBool{Bool: true, Valid: true},
+		Templates:         sql.NullBool{Bool: false, Valid: true},
+		Artifacts:         sql.NullBool{Bool: false, Valid: true},
+		Warnings:          []string{"42:this is a warning"},
+		ResolvedTemplates: testResolvedTemplates(),
+		Data:              testPipelineData(),
 	}
 
 	p := new(api.Pipeline)
@@ -418,6 +422,7 @@ func TestDatabase_PipelineFromAPI(t *testing.T) {
 	p.SetTemplates(false)
 	p.SetArtifact(false)
 	p.SetWarnings([]string{"42:this is a warning"})
+	p.SetResolvedTemplates(testResolvedTemplates())
 	p.SetData(testPipelineData())
 
 	// run test
@@ -432,28 +437,42 @@ func TestDatabase_PipelineFromAPI(t *testing.T) {
 // type with all fields set to a fake value.
 func testPipeline() *Pipeline {
 	return &Pipeline{
-		ID:              sql.NullInt64{Int64: 1, Valid: true},
-		RepoID:          sql.NullInt64{Int64: 1, Valid: true},
-		Commit:          sql.NullString{String: "48afb5bdc41ad69bf22588491333f7cf71135163", Valid: true},
-		Flavor:          sql.NullString{String: "large", Valid: true},
-		Platform:        sql.NullString{String: "docker", Valid: true},
-		Ref:             sql.NullString{String: "refs/heads/main", Valid: true},
-		Type:            sql.NullString{String: constants.PipelineTypeYAML, Valid: true},
-		Version:         sql.NullString{String: "1", Valid: true},
-		ExternalSecrets: sql.NullBool{Bool: false, Valid: true},
-		InternalSecrets: sql.NullBool{Bool: false, Valid: true},
-		Services:        sql.NullBool{Bool: true, Valid: true},
-		Stages:          sql.NullBool{Bool: false, Valid: true},
-		Steps:           sql.NullBool{Bool: true, Valid: true},
-		Templates:       sql.NullBool{Bool: false, Valid: true},
-		Artifacts:       sql.NullBool{Bool: false, Valid: true},
-		Warnings:        []string{"42:this is a warning"},
-		Data:            testPipelineData(),
+		ID:                sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:            sql.NullInt64{Int64: 1, Valid: true},
+		Commit:            sql.NullString{String: "48afb5bdc41ad69bf22588491333f7cf71135163", Valid: true},
+		Flavor:            sql.NullString{String: "large", Valid: true},
+		Platform:          sql.NullString{String: "docker", Valid: true},
+		Ref:               sql.NullString{String: "refs/heads/main", Valid: true},
+		Type:              sql.NullString{String: constants.PipelineTypeYAML, Valid: true},
+		Version:           sql.NullString{String: "1", Valid: true},
+		ExternalSecrets:   sql.NullBool{Bool: false, Valid: true},
+		InternalSecrets:   sql.NullBool{Bool: false, Valid: true},
+		Services:          sql.NullBool{Bool: true, Valid: true},
+		Stages:            sql.NullBool{Bool: false, Valid: true},
+		Steps:             sql.NullBool{Bool: true, Valid: true},
+		Templates:         sql.NullBool{Bool: false, Valid: true},
+		Artifacts:         sql.NullBool{Bool: false, Valid: true},
+		Warnings:          []string{"42:this is a warning"},
+		ResolvedTemplates: testResolvedTemplates(),
+		Data:              testPipelineData(),
 
 		Repo: *testRepo(),
 	}
 }
 
+// testResolvedTemplates is a test helper function to create
+// the templates resolved for the Pipeline type.
+func testResolvedTemplates() []api.ResolvedTemplate {
+	return []api.ResolvedTemplate{
+		{
+			Name:   new("template"),
+			Source: new("github.com/github/octocat/template.yml@main"),
+			Type:   new("github"),
+			Ref:    new("48afb5bdc41ad69bf22588491333f7cf71135163"),
+		},
+	}
+}
+
 // testPipelineData is a test helper function to create the
 // content for the Data field for the Pipeline type.
 func testPipelineData() []byte {
@@ -74,6 +74,7 @@ type (
 		PreviousName     sql.NullString  `sql:"previous_name"`
 		ApproveBuild     sql.NullString  `sql:"approve_build"`
 		ApprovalTimeout  sql.NullInt32   `sql:"approval_timeout"`
+		OrphanPolicy     sql.NullString  `sql:"orphan_policy"`
 		InstallID        sql.NullInt64   `sql:"install_id"`
 		CustomProps      CustomPropsJSON `sql:"custom_props"`
 
@@ -197,6 +198,7 @@ func (r *Repo) ToAPI() *api.Repo {
 	repo.SetPreviousName(r.PreviousName.String)
 	repo.SetApproveBuild(r.ApproveBuild.String)
 	repo.SetApprovalTimeout(r.ApprovalTimeout.Int32)
+	repo.SetOrphanPolicy(r.OrphanPolicy.String)
 	repo.SetInstallID(r.InstallID.Int64)
 	repo.SetCustomProps(r.CustomProps)
 
@@ -262,6 +264,7 @@ func (r *Repo) Validate() error {
 	r.Branch = sql.NullString{String: util.Sanitize(r.Branch.String), Valid: r.Branch.Valid}
 	r.Visibility = sql.NullString{String: util.Sanitize(r.Visibility.String), Valid: r.Visibility.Valid}
 	r.PipelineType = sql.NullString{String: util.Sanitize(r.PipelineType.String), Valid: r.PipelineType.Valid}
+	r.OrphanPolicy = sql.NullString{String: util.Sanitize(r.OrphanPolicy.String), Valid: r.OrphanPolicy.Valid}
 
 	return nil
 }
@@ -292,6 +295,7 @@ func RepoFromAPI(r *api.Repo) *Repo {
 		PreviousName:    sql.NullString{String: r.GetPreviousName(), Valid: r.PreviousName != nil},
 		ApproveBuild:    sql.NullString{String: r.GetApproveBuild(), Valid: r.ApproveBuild != nil},
 		ApprovalTimeout: sql.NullInt32{Int32: r.GetApprovalTimeout(), Valid: r.ApprovalTimeout != nil},
+		OrphanPolicy:    sql.NullString{String: r.GetOrphanPolicy(), Valid: r.OrphanPolicy != nil},
 		InstallID:       sql.NullInt64{Int64: r.GetInstallID(), Valid: r.InstallID != nil},
 	}
 
@@ -145,6 +145,7 @@ func TestTypes_Repo_ToAPI(t *testing.T) {
 	want.SetPreviousName("oldName")
 	want.SetApproveBuild(constants.ApproveNever)
 	want.SetApprovalTimeout(7)
+	want.SetOrphanPolicy(constants.OrphanPolicyRequeue)
 	want.SetInstallID(0)
 	want.SetCustomProps(map[string]any{"foo": "bar"})
 
@@ -302,6 +303,7 @@ func TestTypes_RepoFromAPI(t *testing.T) {
 	r.SetPreviousName("oldName")
 	r.SetApproveBuild(constants.ApproveNever)
 	r.SetApprovalTimeout(7)
+	r.SetOrphanPolicy(constants.OrphanPolicyRequeue)
 	r.SetInstallID(0)
 	r.SetCustomProps(map[string]any{"foo": "bar"})
 
@@ -344,6 +346,7 @@ func testRepo() *Repo {
 		PreviousName:     sql.NullString{String: "oldName", Valid: true},
 		ApproveBuild:     sql.NullString{String: constants.ApproveNever, Valid: true},
 		ApprovalTimeout:  sql.NullInt32{Int32: 7, Valid: true},
+		OrphanPolicy:     sql.NullString{String: constants.OrphanPolicyRequeue, Valid: true},
 		InstallID:        sql.NullInt64{Int64: 0, Valid: true},
 		CustomProps:      CustomPropsJSON{"foo": "bar"},
 
@@ -97,6 +97,7 @@ func TestTypes_Schedule_ToAPI(t *testing.T) {
 	repo.SetPreviousName("oldName")
 	repo.SetApproveBuild(constants.ApproveNever)
 	repo.SetApprovalTimeout(7)
+	repo.SetOrphanPolicy(constants.OrphanPolicyRequeue)
 	repo.SetCustomProps(map[string]any{"foo": "bar"})
 
 	currTime := time.Now().UTC()
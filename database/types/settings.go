@@ -29,13 +29,15 @@ type (
 		Queue    `json:"queue"    sql:"queue"`
 		SCM      `json:"scm"      sql:"scm"`
 
-		RepoAllowlist       pq.StringArray `json:"repo_allowlist"        sql:"repo_allowlist"        gorm:"type:varchar(1000)"`
-		ScheduleAllowlist   pq.StringArray `json:"schedule_allowlist"    sql:"schedule_allowlist"    gorm:"type:varchar(1000)"`
-		MaxDashboardRepos   sql.NullInt32  `json:"max_dashboard_repos"   sql:"max_dashboard_repos"`
-		QueueRestartLimit   sql.NullInt32  `json:"queue_restart_limit"   sql:"queue_restart_limit"`
-		EnableRepoSecrets   sql.NullBool   `json:"enable_repo_secrets"   sql:"enable_repo_secrets"`
-		EnableOrgSecrets    sql.NullBool   `json:"enable_org_secrets"    sql:"enable_org_secrets"`
-		EnableSharedSecrets sql.NullBool   `json:"enable_shared_secrets" sql:"enable_shared_secrets"`
+		RepoAllowlist       pq.StringArray        `json:"repo_allowlist"        sql:"repo_allowlist"        gorm:"type:varchar(1000)"`
+		ScheduleAllowlist   pq.StringArray        `json:"schedule_allowlist"    sql:"schedule_allowlist"    gorm:"type:varchar(1000)"`
+		MaxDashboardRepos   sql.NullInt32         `json:"max_dashboard_repos"   sql:"max_dashboard_repos"`
+		QueueRestartLimit   sql.NullInt32         `json:"queue_restart_limit"   sql:"queue_restart_limit"`
+		EnableRepoSecrets   sql.NullBool          `json:"enable_repo_secrets"   sql:"enable_repo_secrets"`
+		EnableOrgSecrets    sql.NullBool          `json:"enable_org_secrets"    sql:"enable_org_secrets"`
+		EnableSharedSecrets sql.NullBool          `json:"enable_shared_secrets" sql:"enable_shared_secrets"`
+		ArtifactRetention   RetentionRulesJSON    `json:"artifact_retention" sql:"artifact_retention"`
+		LogRetention        LogRetentionRulesJSON `json:"log_retention" sql:"log_retention"`
 
 		CreatedAt sql.NullInt64  `sql:"created_at"`
 		UpdatedAt sql.NullInt64  `sql:"updated_at"`
@@ -44,16 +46,22 @@ type (
 
 	// Compiler is the database representation of compiler settings.
 	Compiler struct {
-		CloneImage        sql.NullString       `json:"clone_image"         sql:"clone_image"`
-		TemplateDepth     sql.NullInt64        `json:"template_depth"      sql:"template_depth"`
-		StarlarkExecLimit sql.NullInt64        `json:"starlark_exec_limit" sql:"starlark_exec_limit"`
-		BlockedImages     ImageRestrictionJSON `json:"blocked_images"      sql:"blocked_images"`
-		WarnImages        ImageRestrictionJSON `json:"warn_images"         sql:"warn_images"`
+		CloneImage             sql.NullString        `json:"clone_image"              sql:"clone_image"`
+		TemplateDepth          sql.NullInt64         `json:"template_depth"           sql:"template_depth"`
+		StarlarkExecLimit      sql.NullInt64         `json:"starlark_exec_limit"      sql:"starlark_exec_limit"`
+		JsonnetExecTimeout     sql.NullInt64         `json:"jsonnet_exec_timeout"     sql:"jsonnet_exec_timeout"`
+		BlockedImages          ImageRestrictionJSON  `json:"blocked_images"           sql:"blocked_images"`
+		WarnImages             ImageRestrictionJSON  `json:"warn_images"              sql:"warn_images"`
+		HTTPSTemplateAllowlist []string              `json:"https_template_allowlist" sql:"https_template_allowlist"`
+		OCITemplateAllowlist   []string              `json:"oci_template_allowlist"   sql:"oci_template_allowlist"`
+		PolicyRules            []settings.PolicyRule `json:"policy_rules"             sql:"policy_rules"`
+		WorkerFlavors          []string              `json:"worker_flavors"           sql:"worker_flavors"`
 	}
 
 	// Queue is the database representation of queue settings.
 	Queue struct {
-		Routes pq.StringArray `json:"routes" sql:"routes" gorm:"type:varchar(1000)"`
+		Routes pq.StringArray        `json:"routes" sql:"routes" gorm:"type:varchar(1000)"`
+		Pools  []settings.WorkerPool `json:"pools"  sql:"pools"`
 	}
 
 	// SCM is the database representation of SCM settings.
@@ -64,6 +72,10 @@ type (
 	}
 
 	ImageRestrictionJSON []settings.ImageRestriction
+
+	RetentionRulesJSON []settings.RetentionRule
+
+	LogRetentionRulesJSON []settings.LogRetentionRule
 )
 
 // Value - Implementation of valuer for database/sql for ImageRestrictionJSON.
@@ -84,6 +96,48 @@ func (i *ImageRestrictionJSON) Scan(value any) error {
 	}
 }
 
+// Value - Implementation of valuer for database/sql for RetentionRulesJSON.
+func (r RetentionRulesJSON) Value() (driver.Value, error) {
+	valueString, err := json.Marshal(r)
+	return string(valueString), err
+}
+
+// Scan - Implement the database/sql scanner interface for RetentionRulesJSON.
+func (r *RetentionRulesJSON) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		// settings created before artifact retention was introduced
+		return nil
+	case []byte:
+		return json.Unmarshal(v, &r)
+	case string:
+		return json.Unmarshal([]byte(v), &r)
+	default:
+		return fmt.Errorf("wrong type for artifact retention: %T", v)
+	}
+}
+
+// Value - Implementation of valuer for database/sql for LogRetentionRulesJSON.
+func (r LogRetentionRulesJSON) Value() (driver.Value, error) {
+	valueString, err := json.Marshal(r)
+	return string(valueString), err
+}
+
+// Scan - Implement the database/sql scanner interface for LogRetentionRulesJSON.
+func (r *LogRetentionRulesJSON) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		// settings created before log retention was introduced
+		return nil
+	case []byte:
+		return json.Unmarshal(v, &r)
+	case string:
+		return json.Unmarshal([]byte(v), &r)
+	default:
+		return fmt.Errorf("wrong type for log retention: %T", v)
+	}
+}
+
 // Value - Implementation of valuer for database/sql for Compiler.
 func (r Compiler) Value() (driver.Value, error) {
 	valueString, err := json.Marshal(r)
@@ -195,16 +249,24 @@ func (ps *Platform) ToAPI() *settings.Platform {
 	psAPI.SetEnableRepoSecrets(ps.EnableRepoSecrets.Bool)
 	psAPI.SetEnableOrgSecrets(ps.EnableOrgSecrets.Bool)
 	psAPI.SetEnableSharedSecrets(ps.EnableSharedSecrets.Bool)
+	psAPI.SetArtifactRetention(ps.ArtifactRetention)
+	psAPI.SetLogRetention(ps.LogRetention)
 
 	psAPI.Compiler = new(settings.Compiler)
 	psAPI.SetCloneImage(ps.CloneImage.String)
 	psAPI.SetTemplateDepth(int(ps.TemplateDepth.Int64))
 	psAPI.SetStarlarkExecLimit(ps.StarlarkExecLimit.Int64)
+	psAPI.SetJsonnetExecTimeout(ps.JsonnetExecTimeout.Int64)
 	psAPI.SetBlockedImages(ps.BlockedImages)
 	psAPI.SetWarnImages(ps.WarnImages)
+	psAPI.SetHTTPSTemplateAllowlist(ps.HTTPSTemplateAllowlist)
+	psAPI.SetOCITemplateAllowlist(ps.OCITemplateAllowlist)
+	psAPI.SetPolicyRules(ps.PolicyRules)
+	psAPI.SetWorkerFlavors(ps.WorkerFlavors)
 
 	psAPI.Queue = new(settings.Queue)
 	psAPI.SetRoutes(ps.Routes)
+	psAPI.SetPools(ps.Pools)
 
 	psAPI.SCM = new(settings.SCM)
 	psAPI.SetRepoRoleMap(ps.RepoRoleMap)
@@ -235,17 +297,84 @@ func (ps *Platform) Validate() error {
 		return fmt.Errorf("starlark exec limit must be greater than zero, got: %d", ps.StarlarkExecLimit.Int64)
 	}
 
+	// a zero timeout uses the default of the compiler
+	if ps.JsonnetExecTimeout.Int64 < 0 {
+		return fmt.Errorf("jsonnet exec timeout must not be negative, got: %d", ps.JsonnetExecTimeout.Int64)
+	}
+
 	// ensure that all Settings string fields
 	// that can be returned as JSON are sanitized
 	// to avoid unsafe HTML content
 	ps.CloneImage = sql.NullString{String: util.Sanitize(ps.CloneImage.String), Valid: ps.CloneImage.Valid}
 
+	// ensure that all template allowlists are sanitized
+	// to avoid unsafe HTML content
+	for i, v := range ps.HTTPSTemplateAllowlist {
+		ps.HTTPSTemplateAllowlist[i] = util.Sanitize(v)
+	}
+
+	for i, v := range ps.OCITemplateAllowlist {
+		ps.OCITemplateAllowlist[i] = util.Sanitize(v)
+	}
+
+	// ensure that all WorkerFlavors are sanitized
+	// to avoid unsafe HTML content
+	for i, v := range ps.WorkerFlavors {
+		ps.WorkerFlavors[i] = util.Sanitize(v)
+	}
+
+	// verify artifact retention rules are well formed and
+	// sanitized to avoid unsafe HTML content
+	for i, rule := range ps.ArtifactRetention {
+		err := rule.Validate()
+		if err != nil {
+			return err
+		}
+
+		if len(rule.GetOrg()) > 0 {
+			ps.ArtifactRetention[i].SetOrg(util.Sanitize(rule.GetOrg()))
+		}
+
+		if len(rule.GetRepo()) > 0 {
+			ps.ArtifactRetention[i].SetRepo(util.Sanitize(rule.GetRepo()))
+		}
+	}
+
+	// verify log retention rules are well formed and
+	// sanitized to avoid unsafe HTML content
+	for i, rule := range ps.LogRetention {
+		err := rule.Validate()
+		if err != nil {
+			return err
+		}
+
+		if len(rule.GetOrg()) > 0 {
+			ps.LogRetention[i].SetOrg(util.Sanitize(rule.GetOrg()))
+		}
+
+		if len(rule.GetRepo()) > 0 {
+			ps.LogRetention[i].SetRepo(util.Sanitize(rule.GetRepo()))
+		}
+	}
+
 	// ensure that all Queue.Routes are sanitized
 	// to avoid unsafe HTML content
 	for i, v := range ps.Routes {
 		ps.Routes[i] = util.Sanitize(v)
 	}
 
+	// ensure that all Queue.Pools are sanitized
+	// to avoid unsafe HTML content
+	for i := range ps.Pools {
+		pool := &ps.Pools[i]
+		pool.SetName(util.Sanitize(pool.GetName()))
+
+		quotas := pool.GetQuotas()
+		for j := range quotas {
+			quotas[j].SetOrg(util.Sanitize(quotas[j].GetOrg()))
+		}
+	}
+
 	// ensure that all RepoAllowlist are sanitized
 	// to avoid unsafe HTML content
 	for i, v := range ps.RepoAllowlist {
@@ -283,14 +412,20 @@ func SettingsFromAPI(s *settings.Platform) *Platform {
 	settings := &Platform{
 		ID: sql.NullInt32{Int32: s.GetID(), Valid: true},
 		Compiler: Compiler{
-			CloneImage:        sql.NullString{String: s.GetCloneImage(), Valid: true},
-			TemplateDepth:     sql.NullInt64{Int64: int64(s.GetTemplateDepth()), Valid: true},
-			StarlarkExecLimit: sql.NullInt64{Int64: s.GetStarlarkExecLimit(), Valid: true},
-			BlockedImages:     s.GetBlockedImages(),
-			WarnImages:        s.GetWarnImages(),
+			CloneImage:             sql.NullString{String: s.GetCloneImage(), Valid: true},
+			TemplateDepth:          sql.NullInt64{Int64: int64(s.GetTemplateDepth()), Valid: true},
+			StarlarkExecLimit:      sql.NullInt64{Int64: s.GetStarlarkExecLimit(), Valid: true},
+			JsonnetExecTimeout:     sql.NullInt64{Int64: s.GetJsonnetExecTimeout(), Valid: true},
+			BlockedImages:          s.GetBlockedImages(),
+			WarnImages:             s.GetWarnImages(),
+			HTTPSTemplateAllowlist: s.GetHTTPSTemplateAllowlist(),
+			OCITemplateAllowlist:   s.GetOCITemplateAllowlist(),
+			PolicyRules:            s.GetPolicyRules(),
+			WorkerFlavors:          s.GetWorkerFlavors(),
 		},
 		Queue: Queue{
 			Routes: pq.StringArray(s.GetRoutes()),
+			Pools:  s.GetPools(),
 		},
 		SCM: SCM{
 			RepoRoleMap: s.GetRepoRoleMap(),
@@ -304,6 +439,8 @@ func SettingsFromAPI(s *settings.Platform) *Platform {
 		EnableRepoSecrets:   sql.NullBool{Bool: s.GetEnableRepoSecrets(), Valid: true},
 		EnableOrgSecrets:    sql.NullBool{Bool: s.GetEnableOrgSecrets(), Valid: true},
 		EnableSharedSecrets: sql.NullBool{Bool: s.GetEnableSharedSecrets(), Valid: true},
+		ArtifactRetention:   s.GetArtifactRetention(),
+		LogRetention:        s.GetLogRetention(),
 		CreatedAt:           sql.NullInt64{Int64: s.GetCreatedAt(), Valid: true},
 		UpdatedAt:           sql.NullInt64{Int64: s.GetUpdatedAt(), Valid: true},
 		UpdatedBy:           sql.NullString{String: s.GetUpdatedBy(), Valid: true},
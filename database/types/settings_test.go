@@ -58,6 +58,12 @@ func TestTypes_Platform_ToAPI(t *testing.T) {
 	want.SetEnableRepoSecrets(true)
 	want.SetEnableOrgSecrets(true)
 	want.SetEnableSharedSecrets(true)
+	want.SetArtifactRetention([]api.RetentionRule{
+		{RuleScope: api.RuleScope{Org: new("github")}, KeepBuilds: new(int64(10)), KeepTags: new(true)},
+	})
+	want.SetLogRetention([]api.LogRetentionRule{
+		{KeepDays: new(int64(30)), KeepFailedDays: new(int64(90)), KeepDeployments: new(true)},
+	})
 	want.SetCreatedAt(0)
 	want.SetUpdatedAt(0)
 	want.SetUpdatedBy("")
@@ -66,15 +72,30 @@ func TestTypes_Platform_ToAPI(t *testing.T) {
 	want.SetCloneImage("target/vela-git-slim:latest")
 	want.SetTemplateDepth(10)
 	want.SetStarlarkExecLimit(100)
+	want.SetJsonnetExecTimeout(30)
 	want.SetBlockedImages([]api.ImageRestriction{
 		{Image: new("docker.io/blocked/image:latest"), Reason: new("this image is blocked")},
 	})
 	want.SetWarnImages([]api.ImageRestriction{
 		{Image: new("docker.io/deprecated/image:latest"), Reason: new("this image is deprecated")},
 	})
+	want.SetHTTPSTemplateAllowlist([]string{"templates.example.com"})
+	want.SetOCITemplateAllowlist([]string{"ghcr.io"})
+	want.SetWorkerFlavors([]string{"large"})
+	want.SetPolicyRules([]api.PolicyRule{
+		{
+			Name:   new("no-privileged"),
+			Rule:   new("repo.trusted || none(containers, .privileged)"),
+			Action: new("block"),
+			Reason: new("privileged containers require a trusted repo"),
+		},
+	})
 
 	want.Queue = new(api.Queue)
 	want.SetRoutes([]string{"vela"})
+	want.SetPools([]api.WorkerPool{
+		{Name: new("secure"), Routes: &[]string{"vela"}, Quotas: &[]api.PoolQuota{{Org: new("payments"), Limit: new(int32(20))}}},
+	})
 
 	want.SCM = new(api.SCM)
 	want.SetRepoRoleMap(map[string]string{
@@ -140,6 +161,19 @@ func TestTypes_Platform_Validate(t *testing.T) {
 				},
 			},
 		},
+		{ // negative JsonnetExecTimeout set for settings
+			failure: true,
+			settings: &Platform{
+				ID:                sql.NullInt32{Int32: 1, Valid: true},
+				MaxDashboardRepos: sql.NullInt32{Int32: 10, Valid: true},
+				Compiler: Compiler{
+					CloneImage:         sql.NullString{String: "target/vela-git-slim:latest", Valid: true},
+					TemplateDepth:      sql.NullInt64{Int64: 10, Valid: true},
+					StarlarkExecLimit:  sql.NullInt64{Int64: 100, Valid: true},
+					JsonnetExecTimeout: sql.NullInt64{Int64: -1, Valid: true},
+				},
+			},
+		},
 		{ // no MaxDashboardRepos set for settings
 			failure: true,
 			settings: &Platform{
@@ -164,6 +198,36 @@ func TestTypes_Platform_Validate(t *testing.T) {
 				},
 			},
 		},
+		{ // invalid artifact retention rule set for settings
+			failure: true,
+			settings: &Platform{
+				ID:                sql.NullInt32{Int32: 1, Valid: true},
+				MaxDashboardRepos: sql.NullInt32{Int32: 10, Valid: true},
+				Compiler: Compiler{
+					CloneImage:        sql.NullString{String: "target/vela-git-slim:latest", Valid: true},
+					TemplateDepth:     sql.NullInt64{Int64: 10, Valid: true},
+					StarlarkExecLimit: sql.NullInt64{Int64: 100, Valid: true},
+				},
+				ArtifactRetention: []api.RetentionRule{
+					{RuleScope: api.RuleScope{Repo: new("hello-world")}, KeepBuilds: new(int64(10))},
+				},
+			},
+		},
+		{ // invalid log retention rule set for settings
+			failure: true,
+			settings: &Platform{
+				ID:                sql.NullInt32{Int32: 1, Valid: true},
+				MaxDashboardRepos: sql.NullInt32{Int32: 10, Valid: true},
+				Compiler: Compiler{
+					CloneImage:        sql.NullString{String: "target/vela-git-slim:latest", Valid: true},
+					TemplateDepth:     sql.NullInt64{Int64: 10, Valid: true},
+					StarlarkExecLimit: sql.NullInt64{Int64: 100, Valid: true},
+				},
+				LogRetention: []api.LogRetentionRule{
+					{KeepDays: new(int64(-1))},
+				},
+			},
+		},
 		{ // no queue fields set for settings
 			failure: false,
 			settings: &Platform{
@@ -208,6 +272,12 @@ func TestTypes_Platform_PlatformFromAPI(t *testing.T) {
 	s.SetEnableRepoSecrets(true)
 	s.SetEnableOrgSecrets(true)
 	s.SetEnableSharedSecrets(true)
+	s.SetArtifactRetention([]api.RetentionRule{
+		{RuleScope: api.RuleScope{Org: new("github")}, KeepBuilds: new(int64(10)), KeepTags: new(true)},
+	})
+	s.SetLogRetention([]api.LogRetentionRule{
+		{KeepDays: new(int64(30)), KeepFailedDays: new(int64(90)), KeepDeployments: new(true)},
+	})
 	s.SetCreatedAt(0)
 	s.SetUpdatedAt(0)
 	s.SetUpdatedBy("")
@@ -216,15 +286,30 @@ func TestTypes_Platform_PlatformFromAPI(t *testing.T) {
 	s.SetCloneImage("target/vela-git-slim:latest")
 	s.SetTemplateDepth(10)
 	s.SetStarlarkExecLimit(100)
+	s.SetJsonnetExecTimeout(30)
 	s.SetBlockedImages([]api.ImageRestriction{
 		{Image: new("docker.io/blocked/image:latest"), Reason: new("this image is blocked")},
 	})
 	s.SetWarnImages([]api.ImageRestriction{
 		{Image: new("docker.io/deprecated/image:latest"), Reason: new("this image is deprecated")},
 	})
+	s.SetHTTPSTemplateAllowlist([]string{"templates.example.com"})
+	s.SetOCITemplateAllowlist([]string{"ghcr.io"})
+	s.SetWorkerFlavors([]string{"large"})
+	s.SetPolicyRules([]api.PolicyRule{
+		{
+			Name:   new("no-privileged"),
+			Rule:   new("repo.trusted || none(containers, .privileged)"),
+			Action: new("block"),
+			Reason: new("privileged containers require a trusted repo"),
+		},
+	})
 
 	s.Queue = new(api.Queue)
 	s.SetRoutes([]string{"vela"})
+	s.SetPools([]api.WorkerPool{
+		{Name: new("secure"), Routes: &[]string{"vela"}, Quotas: &[]api.PoolQuota{{Org: new("payments"), Limit: new(int32(20))}}},
+	})
 
 	s.SCM = new(api.SCM)
 	s.SetRepoRoleMap(map[string]string{
@@ -255,18 +340,33 @@ func testPlatform() *Platform {
 	return &Platform{
 		ID: sql.NullInt32{Int32: 1, Valid: true},
 		Compiler: Compiler{
-			CloneImage:        sql.NullString{String: "target/vela-git-slim:latest", Valid: true},
-			TemplateDepth:     sql.NullInt64{Int64: 10, Valid: true},
-			StarlarkExecLimit: sql.NullInt64{Int64: 100, Valid: true},
+			CloneImage:         sql.NullString{String: "target/vela-git-slim:latest", Valid: true},
+			TemplateDepth:      sql.NullInt64{Int64: 10, Valid: true},
+			StarlarkExecLimit:  sql.NullInt64{Int64: 100, Valid: true},
+			JsonnetExecTimeout: sql.NullInt64{Int64: 30, Valid: true},
 			BlockedImages: []api.ImageRestriction{
 				{Image: new("docker.io/blocked/image:latest"), Reason: new("this image is blocked")},
 			},
 			WarnImages: []api.ImageRestriction{
 				{Image: new("docker.io/deprecated/image:latest"), Reason: new("this image is deprecated")},
 			},
+			HTTPSTemplateAllowlist: []string{"templates.example.com"},
+			OCITemplateAllowlist:   []string{"ghcr.io"},
+			WorkerFlavors:          []string{"large"},
+			PolicyRules: []api.PolicyRule{
+				{
+					Name:   new("no-privileged"),
+					Rule:   new("repo.trusted || none(containers, .privileged)"),
+					Action: new("block"),
+					Reason: new("privileged containers require a trusted repo"),
+				},
+			},
 		},
 		Queue: Queue{
 			Routes: []string{"vela"},
+			Pools: []api.WorkerPool{
+				{Name: new("secure"), Routes: &[]string{"vela"}, Quotas: &[]api.PoolQuota{{Org: new("payments"), Limit: new(int32(20))}}},
+			},
 		},
 		SCM: SCM{
 			RepoRoleMap: map[string]string{
@@ -288,8 +388,14 @@ func testPlatform() *Platform {
 		EnableRepoSecrets:   sql.NullBool{Bool: true, Valid: true},
 		EnableOrgSecrets:    sql.NullBool{Bool: true, Valid: true},
 		EnableSharedSecrets: sql.NullBool{Bool: true, Valid: true},
-		CreatedAt:           sql.NullInt64{Int64: 0, Valid: true},
-		UpdatedAt:           sql.NullInt64{Int64: 0, Valid: true},
-		UpdatedBy:           sql.NullString{String: "", Valid: true},
+		ArtifactRetention: []api.RetentionRule{
+			{RuleScope: api.RuleScope{Org: new("github")}, KeepBuilds: new(int64(10)), KeepTags: new(true)},
+		},
+		LogRetention: []api.LogRetentionRule{
+			{KeepDays: new(int64(30)), KeepFailedDays: new(int64(90)), KeepDeployments: new(true)},
+		},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: true},
+		UpdatedBy: sql.NullString{String: "", Valid: true},
 	}
 }
@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/util"
+)
+
+var (
+	// ErrEmptyTestResultBuildID defines the error type when a
+	// TestResult type has an empty BuildID field provided.
+	ErrEmptyTestResultBuildID = errors.New("empty test result build_id provided")
+
+	// ErrEmptyTestResultName defines the error type when a
+	// TestResult type has an empty Name field provided.
+	ErrEmptyTestResultName = errors.New("empty test result name provided")
+
+	// ErrEmptyTestResultRepoID defines the error type when a
+	// TestResult type has an empty RepoID field provided.
+	ErrEmptyTestResultRepoID = errors.New("empty test result repo_id provided")
+
+	// ErrEmptyTestResultStatus defines the error type when a
+	// TestResult type has an empty Status field provided.
+	ErrEmptyTestResultStatus = errors.New("empty test result status provided")
+)
+
+// TestResult is the database representation of the result of a
+// single test parsed from a test report uploaded for a build.
+type TestResult struct {
+	ID         sql.NullInt64  `sql:"id"`
+	BuildID    sql.NullInt64  `sql:"build_id"`
+	RepoID     sql.NullInt64  `sql:"repo_id"`
+	ArtifactID sql.NullInt64  `sql:"artifact_id"`
+	Step       sql.NullString `sql:"step"`
+	Branch     sql.NullString `sql:"branch"`
+	Suite      sql.NullString `sql:"suite"`
+	Name       sql.NullString `sql:"name"`
+	Status     sql.NullString `sql:"status"`
+	Message    sql.NullString `sql:"message"`
+	Duration   sql.NullInt64  `sql:"duration"`
+	Flaky      sql.NullBool   `sql:"flaky"`
+	CreatedAt  sql.NullInt64  `sql:"created_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the TestResult type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (t *TestResult) Nullify() *TestResult {
+	if t == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if t.ID.Int64 == 0 {
+		t.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if t.BuildID.Int64 == 0 {
+		t.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if t.RepoID.Int64 == 0 {
+		t.RepoID.Valid = false
+	}
+
+	// check if the ArtifactID field should be false
+	if t.ArtifactID.Int64 == 0 {
+		t.ArtifactID.Valid = false
+	}
+
+	// check if the Step field should be false
+	if len(t.Step.String) == 0 {
+		t.Step.Valid = false
+	}
+
+	// check if the Branch field should be false
+	if len(t.Branch.String) == 0 {
+		t.Branch.Valid = false
+	}
+
+	// check if the Suite field should be false
+	if len(t.Suite.String) == 0 {
+		t.Suite.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(t.Name.String) == 0 {
+		t.Name.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(t.Status.String) == 0 {
+		t.Status.Valid = false
+	}
+
+	// check if the Message field should be false
+	if len(t.Message.String) == 0 {
+		t.Message.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if t.CreatedAt.Int64 == 0 {
+		t.CreatedAt.Valid = false
+	}
+
+	return t
+}
+
+// ToAPI converts the TestResult type
+// to an API TestResult type.
+func (t *TestResult) ToAPI() *api.TestResult {
+	result := new(api.TestResult)
+
+	result.SetID(t.ID.Int64)
+	result.SetBuildID(t.BuildID.Int64)
+	result.SetRepoID(t.RepoID.Int64)
+	result.SetArtifactID(t.ArtifactID.Int64)
+	result.SetStep(t.Step.String)
+	result.SetBranch(t.Branch.String)
+	result.SetSuite(t.Suite.String)
+	result.SetName(t.Name.String)
+	result.SetStatus(t.Status.String)
+	result.SetMessage(t.Message.String)
+	result.SetDuration(t.Duration.Int64)
+	result.SetFlaky(t.Flaky.Bool)
+	result.SetCreatedAt(t.CreatedAt.Int64)
+
+	return result
+}
+
+// Validate verifies the necessary fields for
+// the TestResult type are populated correctly.
+func (t *TestResult) Validate() error {
+	// verify the BuildID field is populated
+	if t.BuildID.Int64 <= 0 {
+		return ErrEmptyTestResultBuildID
+	}
+
+	// verify the RepoID field is populated
+	if t.RepoID.Int64 <= 0 {
+		return ErrEmptyTestResultRepoID
+	}
+
+	// verify the Name field is populated
+	if len(t.Name.String) == 0 {
+		return ErrEmptyTestResultName
+	}
+
+	// verify the Status field is populated
+	if len(t.Status.String) == 0 {
+		return ErrEmptyTestResultStatus
+	}
+
+	// ensure that all TestResult string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	t.Step = sql.NullString{String: util.Sanitize(t.Step.String), Valid: t.Step.Valid}
+	t.Suite = sql.NullString{String: util.Sanitize(t.Suite.String), Valid: t.Suite.Valid}
+	t.Name = sql.NullString{String: util.Sanitize(t.Name.String), Valid: t.Name.Valid}
+	t.Message = sql.NullString{String: util.Sanitize(t.Message.String), Valid: t.Message.Valid}
+
+	return nil
+}
+
+// TestResultFromAPI converts the API TestResult type
+// to a database TestResult type.
+func TestResultFromAPI(t *api.TestResult) *TestResult {
+	result := &TestResult{
+		ID:         sql.NullInt64{Int64: t.GetID(), Valid: true},
+		BuildID:    sql.NullInt64{Int64: t.GetBuildID(), Valid: true},
+		RepoID:     sql.NullInt64{Int64: t.GetRepoID(), Valid: true},
+		ArtifactID: sql.NullInt64{Int64: t.GetArtifactID(), Valid: true},
+		Step:       sql.NullString{String: t.GetStep(), Valid: true},
+		Branch:     sql.NullString{String: t.GetBranch(), Valid: true},
+		Suite:      sql.NullString{String: t.GetSuite(), Valid: true},
+		Name:       sql.NullString{String: t.GetName(), Valid: true},
+		Status:     sql.NullString{String: t.GetStatus(), Valid: true},
+		Message:    sql.NullString{String: t.GetMessage(), Valid: true},
+		Duration:   sql.NullInt64{Int64: t.GetDuration(), Valid: true},
+		Flaky:      sql.NullBool{Bool: t.GetFlaky(), Valid: true},
+		CreatedAt:  sql.NullInt64{Int64: t.GetCreatedAt(), Valid: true},
+	}
+
+	return result.Nullify()
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTypes_TestResult_Nullify(t *testing.T) {
+	// setup types
+	var tr *TestResult
+
+	want := &TestResult{
+		ID:         sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:    sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:     sql.NullInt64{Int64: 0, Valid: false},
+		ArtifactID: sql.NullInt64{Int64: 0, Valid: false},
+		Step:       sql.NullString{String: "", Valid: false},
+		Branch:     sql.NullString{String: "", Valid: false},
+		Suite:      sql.NullString{String: "", Valid: false},
+		Name:       sql.NullString{String: "", Valid: false},
+		Status:     sql.NullString{String: "", Valid: false},
+		Message:    sql.NullString{String: "", Valid: false},
+		Duration:   sql.NullInt64{Int64: 0, Valid: false},
+		Flaky:      sql.NullBool{Bool: false, Valid: false},
+		CreatedAt:  sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		result *TestResult
+		want   *TestResult
+	}{
+		{
+			result: testTestResult(),
+			want:   testTestResult(),
+		},
+		{
+			result: tr,
+			want:   nil,
+		},
+		{
+			result: new(TestResult),
+			want:   want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.result.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestTypes_TestResult_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.TestResult)
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetArtifactID(1)
+	want.SetStep("test")
+	want.SetBranch("main")
+	want.SetSuite("github.com/go-vela/server/api")
+	want.SetName("TestAPI_Foo")
+	want.SetStatus("failed")
+	want.SetMessage("expected 1, got 2")
+	want.SetDuration(1500)
+	want.SetFlaky(true)
+	want.SetCreatedAt(1563474076)
+
+	// run test
+	got := testTestResult().ToAPI()
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ToAPI() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTypes_TestResult_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		result  *TestResult
+	}{
+		{
+			failure: false,
+			result:  testTestResult(),
+		},
+		{ // no build_id set for test result
+			failure: true,
+			result: &TestResult{
+				RepoID: sql.NullInt64{Int64: 1, Valid: true},
+				Name:   sql.NullString{String: "TestAPI_Foo", Valid: true},
+				Status: sql.NullString{String: "passed", Valid: true},
+			},
+		},
+		{ // no repo_id set for test result
+			failure: true,
+			result: &TestResult{
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				Name:    sql.NullString{String: "TestAPI_Foo", Valid: true},
+				Status:  sql.NullString{String: "passed", Valid: true},
+			},
+		},
+		{ // no name set for test result
+			failure: true,
+			result: &TestResult{
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+				Status:  sql.NullString{String: "passed", Valid: true},
+			},
+		},
+		{ // no status set for test result
+			failure: true,
+			result: &TestResult{
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+				Name:    sql.NullString{String: "TestAPI_Foo", Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.result.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestTypes_TestResultFromAPI(t *testing.T) {
+	// setup types
+	tr := new(api.TestResult)
+	tr.SetID(1)
+	tr.SetBuildID(1)
+	tr.SetRepoID(1)
+	tr.SetArtifactID(1)
+	tr.SetStep("test")
+	tr.SetBranch("main")
+	tr.SetSuite("github.com/go-vela/server/api")
+	tr.SetName("TestAPI_Foo")
+	tr.SetStatus("failed")
+	tr.SetMessage("expected 1, got 2")
+	tr.SetDuration(1500)
+	tr.SetFlaky(true)
+	tr.SetCreatedAt(1563474076)
+
+	want := testTestResult()
+
+	// run test
+	got := TestResultFromAPI(tr)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TestResultFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testTestResult is a test helper function to create a TestResult
+// type with all fields set to a fake value.
+func testTestResult() *TestResult {
+	return &TestResult{
+		ID:         sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:    sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+		ArtifactID: sql.NullInt64{Int64: 1, Valid: true},
+		Step:       sql.NullString{String: "test", Valid: true},
+		Branch:     sql.NullString{String: "main", Valid: true},
+		Suite:      sql.NullString{String: "github.com/go-vela/server/api", Valid: true},
+		Name:       sql.NullString{String: "TestAPI_Foo", Valid: true},
+		Status:     sql.NullString{String: "failed", Valid: true},
+		Message:    sql.NullString{String: "expected 1, got 2", Valid: true},
+		Duration:   sql.NullInt64{Int64: 1500, Valid: true},
+		Flaky:      sql.NullBool{Bool: true, Valid: true},
+		CreatedAt:  sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/lib/pq"
 
@@ -26,6 +28,10 @@ var (
 	// ErrExceededRunningBuildIDsLimit defines the error type when a
 	// Worker type has RunningBuildIDs field provided that exceeds the database limit.
 	ErrExceededRunningBuildIDsLimit = errors.New("exceeded running build ids limit")
+
+	// ErrExceededWorkerLabelsLimit defines the error type when a
+	// Worker type has Labels field provided that exceeds the database limit.
+	ErrExceededWorkerLabelsLimit = errors.New("exceeded worker labels limit")
 )
 
 // Worker is the database representation of a worker.
@@ -34,7 +40,10 @@ type Worker struct {
 	Hostname            sql.NullString `sql:"hostname"`
 	Address             sql.NullString `sql:"address"`
 	Routes              pq.StringArray `sql:"routes"                 gorm:"type:varchar(1000)"`
+	Labels              pq.StringArray `sql:"labels"                 gorm:"type:varchar(1000)"`
 	Active              sql.NullBool   `sql:"active"`
+	Draining            sql.NullBool   `sql:"draining"`
+	DrainStartedAt      sql.NullInt64  `sql:"drain_started_at"`
 	Status              sql.NullString `sql:"status"`
 	LastStatusUpdateAt  sql.NullInt64  `sql:"last_status_update_at"`
 	RunningBuildIDs     pq.StringArray `sql:"running_build_ids"      gorm:"type:varchar(500)"`
@@ -70,6 +79,11 @@ func (w *Worker) Nullify() *Worker {
 		w.Address.Valid = false
 	}
 
+	// check if the DrainStartedAt field should be false
+	if w.DrainStartedAt.Int64 == 0 {
+		w.DrainStartedAt.Valid = false
+	}
+
 	// check if the Status field should be false
 	if len(w.Status.String) == 0 {
 		w.Status.Valid = false
@@ -111,7 +125,23 @@ func (w *Worker) ToAPI(builds []*api.Build) *api.Worker {
 	worker.SetHostname(w.Hostname.String)
 	worker.SetAddress(w.Address.String)
 	worker.SetRoutes(w.Routes)
+
+	// labels are stored as key=value pairs
+	var labels map[string]string
+
+	if len(w.Labels) > 0 {
+		labels = make(map[string]string, len(w.Labels))
+
+		for _, label := range w.Labels {
+			key, value, _ := strings.Cut(label, "=")
+			labels[key] = value
+		}
+	}
+
+	worker.SetLabels(labels)
 	worker.SetActive(w.Active.Bool)
+	worker.SetDraining(w.Draining.Bool)
+	worker.SetDrainStartedAt(w.DrainStartedAt.Int64)
 	worker.SetStatus(w.Status.String)
 	worker.SetLastStatusUpdateAt(w.LastStatusUpdateAt.Int64)
 	worker.SetRunningBuilds(builds)
@@ -149,6 +179,17 @@ func (w *Worker) Validate() error {
 		return ErrExceededRunningBuildIDsLimit
 	}
 
+	// calculate total size of Labels
+	total = 0
+	for _, l := range w.Labels {
+		total += len(l)
+	}
+
+	// verify the Labels field is within the database constraints
+	if (total + len(w.Labels) - 1) > constants.WorkerLabelsMaxSize {
+		return ErrExceededWorkerLabelsLimit
+	}
+
 	// ensure that all Worker string fields
 	// that can be returned as JSON are sanitized
 	// to avoid unsafe HTML content
@@ -161,6 +202,12 @@ func (w *Worker) Validate() error {
 		w.Routes[i] = util.Sanitize(v)
 	}
 
+	// ensure that all Labels are sanitized
+	// to avoid unsafe HTML content
+	for i, v := range w.Labels {
+		w.Labels[i] = util.Sanitize(v)
+	}
+
 	return nil
 }
 
@@ -173,12 +220,24 @@ func WorkerFromAPI(w *api.Worker) *Worker {
 		rBs = append(rBs, fmt.Sprint(b.GetID()))
 	}
 
+	var labels []string
+
+	for key, value := range w.GetLabels() {
+		labels = append(labels, key+"="+value)
+	}
+
+	// sort the labels to store them in a stable order
+	slices.Sort(labels)
+
 	worker := &Worker{
 		ID:                  sql.NullInt64{Int64: w.GetID(), Valid: true},
 		Hostname:            sql.NullString{String: w.GetHostname(), Valid: true},
 		Address:             sql.NullString{String: w.GetAddress(), Valid: true},
 		Routes:              pq.StringArray(w.GetRoutes()),
+		Labels:              pq.StringArray(labels),
 		Active:              sql.NullBool{Bool: w.GetActive(), Valid: true},
+		Draining:            sql.NullBool{Bool: w.GetDraining(), Valid: true},
+		DrainStartedAt:      sql.NullInt64{Int64: w.GetDrainStartedAt(), Valid: true},
 		Status:              sql.NullString{String: w.GetStatus(), Valid: true},
 		LastStatusUpdateAt:  sql.NullInt64{Int64: w.GetLastStatusUpdateAt(), Valid: true},
 		RunningBuildIDs:     pq.StringArray(rBs),
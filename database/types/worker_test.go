@@ -6,9 +6,11 @@ import (
 	"database/sql"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database/testutils"
 )
 
@@ -21,6 +23,8 @@ func TestTypes_Worker_Nullify(t *testing.T) {
 		Hostname:            sql.NullString{String: "", Valid: false},
 		Address:             sql.NullString{String: "", Valid: false},
 		Active:              sql.NullBool{Bool: false, Valid: false},
+		Draining:            sql.NullBool{Bool: false, Valid: false},
+		DrainStartedAt:      sql.NullInt64{Int64: 0, Valid: false},
 		Status:              sql.NullString{String: "", Valid: false},
 		LastStatusUpdateAt:  sql.NullInt64{Int64: 0, Valid: false},
 		LastBuildStartedAt:  sql.NullInt64{Int64: 0, Valid: false},
@@ -69,7 +73,10 @@ func TestTypes_Worker_ToAPI(t *testing.T) {
 	want.SetHostname("worker_0")
 	want.SetAddress("http://localhost:8080")
 	want.SetRoutes([]string{"vela"})
+	want.SetLabels(map[string]string{"arch": "arm64", "gpu": "false"})
 	want.SetActive(true)
+	want.SetDraining(true)
+	want.SetDrainStartedAt(1563474077)
 	want.SetStatus("available")
 	want.SetLastStatusUpdateAt(1563474077)
 	want.SetRunningBuilds([]*api.Build{rB})
@@ -125,6 +132,17 @@ func TestTypes_Worker_Validate(t *testing.T) {
 				LastCheckedIn:   sql.NullInt64{Int64: 1563474077, Valid: true},
 			},
 		},
+		{ // invalid Labels set for worker
+			failure: true,
+			worker: &Worker{
+				ID:            sql.NullInt64{Int64: 1, Valid: true},
+				Address:       sql.NullString{String: "http://localhost:8080", Valid: true},
+				Hostname:      sql.NullString{String: "worker_0", Valid: true},
+				Active:        sql.NullBool{Bool: true, Valid: true},
+				Labels:        []string{strings.Repeat("a", constants.WorkerLabelsMaxSize) + "=b"},
+				LastCheckedIn: sql.NullInt64{Int64: 1563474077, Valid: true},
+			},
+		},
 	}
 
 	// run tests
@@ -156,7 +174,10 @@ func TestTypes_Worker_WorkerFromAPI(t *testing.T) {
 	w.SetHostname("worker_0")
 	w.SetAddress("http://localhost:8080")
 	w.SetRoutes([]string{"vela"})
+	w.SetLabels(map[string]string{"gpu": "false", "arch": "arm64"})
 	w.SetActive(true)
+	w.SetDraining(true)
+	w.SetDrainStartedAt(1563474077)
 	w.SetStatus("available")
 	w.SetLastStatusUpdateAt(1563474077)
 	w.SetRunningBuilds([]*api.Build{rB})
@@ -183,7 +204,10 @@ func testWorker() *Worker {
 		Hostname:            sql.NullString{String: "worker_0", Valid: true},
 		Address:             sql.NullString{String: "http://localhost:8080", Valid: true},
 		Routes:              []string{"vela"},
+		Labels:              []string{"arch=arm64", "gpu=false"},
 		Active:              sql.NullBool{Bool: true, Valid: true},
+		Draining:            sql.NullBool{Bool: true, Valid: true},
+		DrainStartedAt:      sql.NullInt64{Int64: 1563474077, Valid: true},
 		Status:              sql.NullString{String: "available", Valid: true},
 		LastStatusUpdateAt:  sql.NullInt64{Int64: 1563474077, Valid: true},
 		RunningBuildIDs:     []string{"1"},
@@ -38,6 +38,8 @@ type WorkerInterface interface {
 	GetWorkerForHostname(context.Context, string) (*api.Worker, error)
 	// ListWorkers defines a function that gets a list of all workers.
 	ListWorkers(context.Context, string, int64, int64) ([]*api.Worker, error)
+	// ReapWorker defines a function that marks an unchanged worker as errored.
+	ReapWorker(context.Context, *api.Worker) (bool, error)
 	// UpdateWorker defines a function that updates an existing worker.
 	UpdateWorker(context.Context, *api.Worker) (*api.Worker, error)
 }
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+// ReapWorker marks an existing worker as errored and clears its running builds
+// in the database. The worker is only updated when its status was not changed
+// since it was retrieved, so only one server reaps a worker and it reports
+// whether the worker was reaped by this call.
+func (e *Engine) ReapWorker(ctx context.Context, w *api.Worker) (bool, error) {
+	e.logger.WithFields(logrus.Fields{
+		"worker": w.GetHostname(),
+	}).Tracef("reaping worker %s", w.GetHostname())
+
+	// send query to the database
+	result := e.client.
+		WithContext(ctx).
+		Table(constants.TableWorker).
+		Where("id = ?", w.GetID()).
+		Where("COALESCE(status, '') = ?", w.GetStatus()).
+		Where("COALESCE(last_status_update_at, 0) = ?", w.GetLastStatusUpdateAt()).
+		Updates(map[string]any{
+			"status":                constants.WorkerStatusError,
+			"last_status_update_at": time.Now().UTC().Unix(),
+			"running_build_ids":     pq.StringArray{},
+		})
+
+	return result.RowsAffected > 0, result.Error
+}
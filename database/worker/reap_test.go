@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/database/testutils"
+)
+
+func TestWorker_Engine_ReapWorker(t *testing.T) {
+	// setup types
+	_worker := testWorker()
+	_worker.SetID(1)
+	_worker.SetHostname("worker_0")
+	_worker.SetAddress("localhost")
+	_worker.SetActive(true)
+	_worker.SetStatus(constants.WorkerStatusBusy)
+	_worker.SetLastStatusUpdateAt(1)
+
+	_postgres, _mock := testPostgres(t)
+
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "workers" SET "last_status_update_at"=$1,"running_build_ids"=$2,"status"=$3 WHERE id = $4 AND COALESCE(status, '') = $5 AND COALESCE(last_status_update_at, 0) = $6`).
+		WithArgs(testutils.AnyArgument{}, "{}", constants.WorkerStatusError, 1, constants.WorkerStatusBusy, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.CreateWorker(context.TODO(), _worker)
+	if err != nil {
+		t.Errorf("unable to create test worker for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *Engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ReapWorker(context.TODO(), _worker)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ReapWorker for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ReapWorker for %s returned err: %v", test.name, err)
+			}
+
+			if !got {
+				t.Errorf("ReapWorker for %s is %v, want %v", test.name, got, true)
+			}
+		})
+	}
+
+	// the worker was already reaped
+	got, err := _sqlite.ReapWorker(context.TODO(), _worker)
+	if err != nil {
+		t.Errorf("ReapWorker for reaped worker returned err: %v", err)
+	}
+
+	if got {
+		t.Errorf("ReapWorker for reaped worker is %v, want %v", got, false)
+	}
+
+	w, err := _sqlite.GetWorker(context.TODO(), _worker.GetID())
+	if err != nil {
+		t.Errorf("unable to get reaped worker: %v", err)
+	}
+
+	if w.GetStatus() != constants.WorkerStatusError {
+		t.Errorf("reaped worker status is %s, want %s", w.GetStatus(), constants.WorkerStatusError)
+	}
+
+	if len(w.GetRunningBuilds()) != 0 {
+		t.Errorf("reaped worker has %d running builds, want 0", len(w.GetRunningBuilds()))
+	}
+}
@@ -18,7 +18,10 @@ workers (
 	hostname               VARCHAR(250),
 	address                VARCHAR(250),
 	routes                 VARCHAR(1000),
+	labels                 VARCHAR(1000),
 	active                 BOOLEAN,
+	draining               BOOLEAN,
+	drain_started_at       BIGINT,
 	status                 VARCHAR(50),
 	last_status_update_at  BIGINT,
 	running_build_ids      VARCHAR(500),
@@ -38,7 +41,10 @@ workers (
 	hostname               TEXT,
 	address                TEXT,
 	routes                 TEXT,
+	labels                 TEXT,
 	active                 BOOLEAN,
+	draining               BOOLEAN,
+	drain_started_at       INTEGER,
 	status                 VARCHAR(50),
 	last_status_update_at  INTEGER,
 	running_build_ids      VARCHAR(500),
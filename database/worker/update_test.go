@@ -16,6 +16,7 @@ func TestWorker_Engine_UpdateWorker(t *testing.T) {
 	_worker.SetID(1)
 	_worker.SetHostname("worker_0")
 	_worker.SetAddress("localhost")
+	_worker.SetLabels(map[string]string{"gpu": "true"})
 	_worker.SetActive(true)
 
 	_postgres, _mock := testPostgres(t)
@@ -24,9 +25,9 @@ func TestWorker_Engine_UpdateWorker(t *testing.T) {
 
 	// ensure the mock expects the query
 	_mock.ExpectExec(`UPDATE "workers"
-SET "hostname"=$1,"address"=$2,"routes"=$3,"active"=$4,"status"=$5,"last_status_update_at"=$6,"running_build_ids"=$7,"last_build_started_at"=$8,"last_build_finished_at"=$9,"last_checked_in"=$10,"build_limit"=$11
-WHERE "id" = $12`).
-		WithArgs("worker_0", "localhost", nil, true, nil, nil, `{"1"}`, nil, nil, nil, nil, 1).
+SET "hostname"=$1,"address"=$2,"routes"=$3,"labels"=$4,"active"=$5,"draining"=$6,"drain_started_at"=$7,"status"=$8,"last_status_update_at"=$9,"running_build_ids"=$10,"last_build_started_at"=$11,"last_build_finished_at"=$12,"last_checked_in"=$13,"build_limit"=$14
+WHERE "id" = $15`).
+		WithArgs("worker_0", "localhost", nil, `{"gpu=true"}`, true, false, nil, nil, nil, `{"1"}`, nil, nil, nil, nil, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_sqlite := testSqlite(t)
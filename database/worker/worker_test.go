@@ -214,7 +214,10 @@ func testWorker() *api.Worker {
 		Hostname:            new(string),
 		Address:             new(string),
 		Routes:              new([]string),
+		Labels:              new(map[string]string),
 		Active:              new(bool),
+		Draining:            new(bool),
+		DrainStartedAt:      new(int64),
 		Status:              new(string),
 		LastStatusUpdateAt:  new(int64),
 		RunningBuilds:       &[]*api.Build{b},
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package problemmatcher
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+// command matches a workflow command in the form of:
+//
+//	::error file=main.go,line=12,col=3::undefined: foo
+//
+// https://docs.github.com/en/actions/reference/workflow-commands-for-github-actions
+var command = regexp.MustCompile(`^\s*::(error|warning|notice)(?:\s+([^:]*))?::(.*)$`)
+
+// unescaper restores the characters escaped in the data of a workflow command.
+var unescaper = strings.NewReplacer("%0D", "\r", "%0A", "\n", "%3A", ":", "%2C", ",", "%25", "%")
+
+// matchCommand is a helper function to parse an error,
+// warning or notice workflow command from a line of a log.
+func matchCommand(line string) *api.Annotation {
+	match := command.FindStringSubmatch(line)
+	if match == nil {
+		return nil
+	}
+
+	var (
+		file   string
+		number int64
+		column int64
+	)
+
+	// parse the comma separated properties of the command
+	for property := range strings.SplitSeq(match[2], ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(property), "=")
+		if !ok {
+			continue
+		}
+
+		value = unescaper.Replace(value)
+
+		switch key {
+		case "file":
+			file = value
+		case "line":
+			number, _ = strconv.ParseInt(value, 10, 64)
+		case "col":
+			column, _ = strconv.ParseInt(value, 10, 64)
+		}
+	}
+
+	severity := constants.AnnotationError
+
+	switch match[1] {
+	case "warning":
+		severity = constants.AnnotationWarning
+	case "notice":
+		severity = constants.AnnotationNotice
+	}
+
+	return newAnnotation(file, number, column, severity, unescaper.Replace(match[3]))
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package problemmatcher
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+var (
+	// golang matches an error from the Go compiler, go vet or a
+	// failed Go test, where the column is optional, in the form of:
+	//
+	//	./main.go:12:3: undefined: foo
+	golang = regexp.MustCompile(`^\s*([^\s:]+\.go):(\d+)(?::(\d+))?: (.+)$`)
+
+	// diagnostic matches a diagnostic from compilers and linters
+	// with an optional severity, in the form of:
+	//
+	//	src/main.c:12:3: warning: unused variable 'foo'
+	//
+	// The file is expected to contain a path separator or an
+	// extension, to avoid matching timestamps in the log.
+	diagnostic = regexp.MustCompile(`^([^\s:]*[./][^\s:]*):(\d+):(\d+):\s*(?:(?i)(fatal error|error|warning|note|info)\s*:\s*)?(.+)$`)
+)
+
+// matchGo is a helper function to parse an
+// error reported by Go from a line of a log.
+func matchGo(line string) *api.Annotation {
+	match := golang.FindStringSubmatch(line)
+	if match == nil {
+		return nil
+	}
+
+	number, _ := strconv.ParseInt(match[2], 10, 64)
+	column, _ := strconv.ParseInt(match[3], 10, 64)
+
+	return newAnnotation(match[1], number, column, constants.AnnotationError, match[4])
+}
+
+// matchDiagnostic is a helper function to parse a
+// file:line:column diagnostic from a line of a log.
+//
+// Diagnostics without a severity are reported as errors.
+func matchDiagnostic(line string) *api.Annotation {
+	match := diagnostic.FindStringSubmatch(line)
+	if match == nil {
+		return nil
+	}
+
+	number, _ := strconv.ParseInt(match[2], 10, 64)
+	column, _ := strconv.ParseInt(match[3], 10, 64)
+
+	severity := constants.AnnotationError
+
+	switch strings.ToLower(match[4]) {
+	case "warning":
+		severity = constants.AnnotationWarning
+	case "note", "info":
+		severity = constants.AnnotationNotice
+	}
+
+	return newAnnotation(match[1], number, column, severity, match[5])
+}
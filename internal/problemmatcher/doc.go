@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package problemmatcher provides the ability for Vela to parse
+// the logs for a step into annotations for well-known patterns.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/internal/problemmatcher"
+package problemmatcher
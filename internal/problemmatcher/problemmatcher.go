@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package problemmatcher
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+const (
+	// MaxAnnotations is the maximum number of annotations parsed from a log.
+	MaxAnnotations = 100
+
+	// maxLineLength is the length, in bytes, of the longest log line that is matched.
+	maxLineLength = 64 * 1024
+
+	// maxMessageLength is the length, in bytes, a message is truncated to.
+	maxMessageLength = 1024
+)
+
+// ansi matches the escape sequences used to color the output in a log.
+var ansi = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+
+// matcher represents a function that parses a line
+// of a log, returning nil when the line does not match.
+type matcher func(line string) *api.Annotation
+
+// matchers are the functions tried in order for each line
+// of a log, where only the first matching function is used.
+var matchers = []matcher{
+	matchCommand,
+	matchGo,
+	matchDiagnostic,
+}
+
+// Match parses the data of a log and returns the annotations
+// for the lines matching any of the well-known patterns.
+//
+// The annotations only capture the file, line, column, severity and
+// message of each match. Duplicate matches are returned once and no more
+// than the maximum number of annotations are returned.
+func Match(data []byte) []*api.Annotation {
+	annotations := []*api.Annotation{}
+	seen := make(map[string]bool)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	for scanner.Scan() {
+		line := ansi.ReplaceAllString(strings.TrimRight(scanner.Text(), "\r"), "")
+
+		for _, match := range matchers {
+			a := match(line)
+			if a == nil {
+				continue
+			}
+
+			a.SetMessage(truncate(strings.TrimSpace(a.GetMessage())))
+
+			key := fmt.Sprintf("%s:%d:%d:%s:%s", a.GetFile(), a.GetLine(), a.GetColumn(), a.GetSeverity(), a.GetMessage())
+
+			if !seen[key] {
+				seen[key] = true
+
+				annotations = append(annotations, a)
+			}
+
+			break
+		}
+
+		if len(annotations) >= MaxAnnotations {
+			break
+		}
+	}
+
+	// a line over the maximum length ends the scan, keeping
+	// the annotations matched before the line was reached
+	return annotations
+}
+
+// newAnnotation is a helper function to create an annotation with the provided fields.
+func newAnnotation(file string, line, column int64, severity, message string) *api.Annotation {
+	a := new(api.Annotation)
+
+	a.SetFile(strings.TrimPrefix(file, "./"))
+	a.SetLine(line)
+	a.SetColumn(column)
+	a.SetSeverity(severity)
+	a.SetMessage(message)
+
+	return a
+}
+
+// truncate is a helper function to shorten a
+// message to the maximum message length.
+func truncate(message string) string {
+	if len(message) <= maxMessageLength {
+		return message
+	}
+
+	message = message[:maxMessageLength]
+
+	// avoid splitting a multi-byte character at the end of the message
+	for len(message) > 0 && !utf8.ValidString(message) {
+		message = message[:len(message)-1]
+	}
+
+	return message + "..."
+}
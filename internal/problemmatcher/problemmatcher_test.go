@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package problemmatcher
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+func TestProblemMatcher_Match(t *testing.T) {
+	// setup types
+	data, err := os.ReadFile("testdata/build.log")
+	if err != nil {
+		t.Errorf("unable to read testdata/build.log: %v", err)
+	}
+
+	want := []*api.Annotation{
+		newAnnotation("main.go", 12, 3, constants.AnnotationError, "undefined: foo"),
+		newAnnotation("math_test.go", 42, 0, constants.AnnotationError, "expected 2, got 3"),
+		newAnnotation("src/parser.c", 7, 10, constants.AnnotationWarning, "unused variable 'x'"),
+		newAnnotation("src/parser.c", 21, 1, constants.AnnotationNotice, "previous definition is here"),
+		newAnnotation("lib/util.rb", 3, 5, constants.AnnotationError, "Style/StringLiterals: prefer single quotes"),
+		newAnnotation("app.js", 1, 5, constants.AnnotationWarning, "Unexpected console statement"),
+		newAnnotation("", 0, 0, constants.AnnotationError, "build failed\nexit code 1"),
+		newAnnotation("", 0, 0, constants.AnnotationNotice, "deploy skipped"),
+	}
+
+	// run test
+	got := Match(data)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Match is %v, want %v", got, want)
+	}
+}
+
+func TestProblemMatcher_Match_Limits(t *testing.T) {
+	// setup types
+	lines := []string{}
+
+	for i := range MaxAnnotations + 10 {
+		lines = append(lines, fmt.Sprintf("main.go:%d:1: %s", i+1, strings.Repeat("x", maxMessageLength+10)))
+	}
+
+	// run test
+	got := Match([]byte(strings.Join(lines, "\n")))
+
+	if len(got) != MaxAnnotations {
+		t.Errorf("Match returned %d annotations, want %d", len(got), MaxAnnotations)
+	}
+
+	if len(got[0].GetMessage()) != maxMessageLength+3 {
+		t.Errorf("Match message length is %d, want %d", len(got[0].GetMessage()), maxMessageLength+3)
+	}
+}
+
+func TestProblemMatcher_Match_Empty(t *testing.T) {
+	// run test
+	got := Match(nil)
+
+	if len(got) != 0 {
+		t.Errorf("Match is %v, want no annotations", got)
+	}
+}
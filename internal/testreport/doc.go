@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package testreport provides the ability for Vela to parse
+// the test reports uploaded for a build into test results.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/internal/testreport"
+package testreport
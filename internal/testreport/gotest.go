@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testreport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+// goEvent represents a single event in a Go test2json report.
+//
+// https://pkg.go.dev/cmd/test2json
+type goEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// parseGo is a helper function to parse the results from a Go test2json report.
+func parseGo(data []byte) ([]*api.TestResult, error) {
+	results := []*api.TestResult{}
+
+	// output captured for each test that has not finished yet
+	output := make(map[string]*strings.Builder)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxReportSize)
+
+	events := 0
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+
+		// go test -json interleaves build output that is not JSON
+		if len(line) == 0 || line[0] != '{' {
+			continue
+		}
+
+		event := goEvent{}
+
+		err := json.Unmarshal(line, &event)
+		if err != nil {
+			continue
+		}
+
+		events++
+
+		// skip events for the package rather than a test
+		if len(event.Test) == 0 {
+			continue
+		}
+
+		key := event.Package + "\x00" + event.Test
+
+		switch event.Action {
+		case "output":
+			if _, ok := output[key]; !ok {
+				output[key] = new(strings.Builder)
+			}
+
+			// keep the captured output bounded for chatty tests
+			if output[key].Len() < maxMessageLength*2 {
+				output[key].WriteString(event.Output)
+			}
+		case "pass", "fail", "skip":
+			status := constants.TestStatusPassed
+			message := ""
+
+			switch event.Action {
+			case "fail":
+				status = constants.TestStatusFailed
+			case "skip":
+				status = constants.TestStatusSkipped
+			}
+
+			if status != constants.TestStatusPassed && output[key] != nil {
+				message = output[key].String()
+			}
+
+			delete(output, key)
+
+			results = append(results, newResult(event.Package, event.Test, status, message, int64(math.Round(event.Elapsed*1000))))
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Go test report: %w", err)
+	}
+
+	if events == 0 {
+		return nil, fmt.Errorf("unable to parse Go test report: no test2json events found")
+	}
+
+	return results, nil
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testreport
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+type (
+	// junitSuite represents a testsuite or testsuites element in a JUnit XML report.
+	junitSuite struct {
+		Name   string       `xml:"name,attr"`
+		Suites []junitSuite `xml:"testsuite"`
+		Cases  []junitCase  `xml:"testcase"`
+	}
+
+	// junitCase represents a testcase element in a JUnit XML report.
+	junitCase struct {
+		Name      string        `xml:"name,attr"`
+		ClassName string        `xml:"classname,attr"`
+		Time      string        `xml:"time,attr"`
+		Failure   *junitMessage `xml:"failure"`
+		Error     *junitMessage `xml:"error"`
+		Skipped   *junitMessage `xml:"skipped"`
+	}
+
+	// junitMessage represents a failure, error or skipped element in a JUnit XML report.
+	junitMessage struct {
+		Message string `xml:"message,attr"`
+		Body    string `xml:",chardata"`
+	}
+)
+
+// parseJUnit is a helper function to parse the results from a JUnit XML report.
+func parseJUnit(data []byte) ([]*api.TestResult, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	// find the root element of the report
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("unable to parse JUnit report: no testsuites or testsuite element found")
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse JUnit report: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if start.Name.Local != "testsuites" && start.Name.Local != "testsuite" {
+			return nil, fmt.Errorf("unable to parse JUnit report: unexpected root element %s", start.Name.Local)
+		}
+
+		root := junitSuite{}
+
+		err = decoder.DecodeElement(&root, &start)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse JUnit report: %w", err)
+		}
+
+		results := []*api.TestResult{}
+
+		collectJUnit(root, &results)
+
+		return results, nil
+	}
+}
+
+// collectJUnit is a helper function to gather the results from a JUnit suite and its nested suites.
+func collectJUnit(suite junitSuite, results *[]*api.TestResult) {
+	for _, c := range suite.Cases {
+		name := c.ClassName
+		if len(name) == 0 {
+			name = suite.Name
+		}
+
+		status := constants.TestStatusPassed
+		message := ""
+
+		switch {
+		case c.Failure != nil:
+			status = constants.TestStatusFailed
+			message = c.Failure.String()
+		case c.Error != nil:
+			status = constants.TestStatusFailed
+			message = c.Error.String()
+		case c.Skipped != nil:
+			status = constants.TestStatusSkipped
+			message = c.Skipped.String()
+		}
+
+		*results = append(*results, newResult(name, c.Name, status, message, junitDuration(c.Time)))
+	}
+
+	for _, s := range suite.Suites {
+		collectJUnit(s, results)
+	}
+}
+
+// String returns the message attribute and body of the element.
+func (m *junitMessage) String() string {
+	body := strings.TrimSpace(m.Body)
+
+	switch {
+	case len(m.Message) == 0, strings.Contains(body, m.Message):
+		return body
+	case len(body) == 0:
+		return m.Message
+	default:
+		return m.Message + "\n" + body
+	}
+}
+
+// junitDuration is a helper function to convert the time
+// attribute of a JUnit test case, in seconds, to milliseconds.
+func junitDuration(value string) int64 {
+	// some reporters format the time with thousands separators
+	seconds, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(value), ",", ""), 64)
+	if err != nil || seconds < 0 || math.IsInf(seconds, 0) {
+		return 0
+	}
+
+	return int64(math.Round(seconds * 1000))
+}
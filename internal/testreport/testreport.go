@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testreport
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+const (
+	// MaxReportSize is the largest test report, in bytes, that will be parsed.
+	MaxReportSize = 50 * 1024 * 1024
+
+	// maxMessageLength is the length, in bytes, a failure message is truncated to.
+	maxMessageLength = 4096
+)
+
+// Parse reads a test report in the provided format and
+// returns the results for the tests found in the report.
+//
+// The results only capture the suite, name, status, message and
+// duration (in milliseconds) of each test found in the report.
+func Parse(format string, r io.Reader) ([]*api.TestResult, error) {
+	// read one byte past the limit to detect reports that are too large
+	data, err := io.ReadAll(io.LimitReader(r, MaxReportSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read test report: %w", err)
+	}
+
+	if len(data) > MaxReportSize {
+		return nil, fmt.Errorf("test report exceeds the maximum size of %d bytes", MaxReportSize)
+	}
+
+	switch format {
+	case constants.TestReportJUnit:
+		return parseJUnit(data)
+	case constants.TestReportGo:
+		return parseGo(data)
+	default:
+		return nil, fmt.Errorf("invalid test report format provided: %s", format)
+	}
+}
+
+// newResult is a helper function to create a test result with the provided fields.
+func newResult(suite, name, status, message string, duration int64) *api.TestResult {
+	t := new(api.TestResult)
+
+	t.SetSuite(suite)
+	t.SetName(name)
+	t.SetStatus(status)
+	t.SetMessage(truncate(strings.TrimSpace(message)))
+	t.SetDuration(duration)
+
+	return t
+}
+
+// truncate is a helper function to shorten a
+// failure message to the maximum message length.
+func truncate(message string) string {
+	if len(message) <= maxMessageLength {
+		return message
+	}
+
+	message = message[:maxMessageLength]
+
+	// avoid splitting a multi-byte character at the end of the message
+	for len(message) > 0 && !utf8.ValidString(message) {
+		message = message[:len(message)-1]
+	}
+
+	return message + "..."
+}
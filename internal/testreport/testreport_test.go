@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package testreport
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+func TestTestReport_Parse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		format  string
+		file    string
+		want    []*api.TestResult
+	}{
+		{
+			failure: false,
+			name:    "junit",
+			format:  constants.TestReportJUnit,
+			file:    "testdata/junit.xml",
+			want: []*api.TestResult{
+				newResult("com.example.CalculatorTest", "testAdd", constants.TestStatusPassed, "", 500),
+				newResult("com.example.CalculatorTest", "testDivide", constants.TestStatusFailed, "java.lang.AssertionError: expected 2 but was 3\n\tat com.example.CalculatorTest.testDivide(CalculatorTest.java:42)", 1250),
+				newResult("com.example.CalculatorTest", "testMultiply", constants.TestStatusSkipped, "not implemented", 0),
+				newResult("com.example.ParserTest$Nested", "testParse", constants.TestStatusFailed, "NullPointerException", 1000250),
+			},
+		},
+		{
+			failure: false,
+			name:    "go",
+			format:  constants.TestReportGo,
+			file:    "testdata/go.json",
+			want: []*api.TestResult{
+				newResult("github.com/octocat/hello-world", "TestAdd", constants.TestStatusPassed, "", 10),
+				newResult("github.com/octocat/hello-world", "TestDivide", constants.TestStatusFailed, "=== RUN   TestDivide\n    math_test.go:42: expected 2, got 3\n--- FAIL: TestDivide (1.50s)", 1500),
+				newResult("github.com/octocat/hello-world", "TestMultiply", constants.TestStatusSkipped, "math_test.go:50: not implemented", 0),
+			},
+		},
+		{
+			failure: true,
+			name:    "junit with go report",
+			format:  constants.TestReportJUnit,
+			file:    "testdata/go.json",
+		},
+		{
+			failure: true,
+			name:    "go with junit report",
+			format:  constants.TestReportGo,
+			file:    "testdata/junit.xml",
+		},
+		{
+			failure: true,
+			name:    "invalid format",
+			format:  "tap",
+			file:    "testdata/junit.xml",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := os.Open(test.file)
+			if err != nil {
+				t.Fatalf("unable to open %s: %v", test.file, err)
+			}
+
+			defer f.Close()
+
+			got, err := Parse(test.format, f)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Parse for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestTestReport_Parse_TooLarge(t *testing.T) {
+	// run test
+	_, err := Parse(constants.TestReportJUnit, strings.NewReader(strings.Repeat(" ", MaxReportSize+1)))
+	if err == nil {
+		t.Errorf("Parse should have returned err")
+	}
+}
+
+func TestTestReport_truncate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "short",
+			message: "expected 2, got 3",
+			want:    "expected 2, got 3",
+		},
+		{
+			name:    "long",
+			message: strings.Repeat("a", maxMessageLength+10),
+			want:    strings.Repeat("a", maxMessageLength) + "...",
+		},
+		{
+			name:    "multi-byte boundary",
+			message: strings.Repeat("a", maxMessageLength-1) + "é",
+			want:    strings.Repeat("a", maxMessageLength-1) + "...",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := truncate(test.message)
+
+			if got != test.want {
+				t.Errorf("truncate for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
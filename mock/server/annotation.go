@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+const (
+	// AnnotationsResp represents a JSON return for a list of annotations.
+	AnnotationsResp = `[
+  {
+    "id": 1,
+    "repo_id": 1,
+    "build_id": 1,
+    "step_id": 1,
+    "file": "main.go",
+    "line": 12,
+    "column": 3,
+    "severity": "error",
+    "message": "undefined: foo",
+    "created_at": 1563475419
+  },
+  {
+    "id": 2,
+    "repo_id": 1,
+    "build_id": 1,
+    "step_id": 1,
+    "file": "app.js",
+    "line": 1,
+    "column": 5,
+    "severity": "warning",
+    "message": "Unexpected console statement",
+    "created_at": 1563475419
+  }
+]`
+)
+
+// getAnnotations returns mock JSON for a http GET.
+func getAnnotations(c *gin.Context) {
+	data := []byte(AnnotationsResp)
+
+	var body []api.Annotation
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestAnnotation_ActiveAnnotationsResp(t *testing.T) {
+	annotations := []api.Annotation{}
+
+	err := json.Unmarshal([]byte(AnnotationsResp), &annotations)
+	if err != nil {
+		t.Errorf("error unmarshaling annotations: %v", err)
+	}
+
+	tAnnotation := reflect.TypeFor[api.Annotation]()
+
+	for _, annotation := range annotations {
+		for i := 0; i < tAnnotation.NumField(); i++ {
+			if reflect.ValueOf(annotation).Field(i).IsNil() {
+				t.Errorf("AnnotationsResp missing field %s", tAnnotation.Field(i).Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+const (
+	// ArtifactResp represents a JSON return for a single artifact.
+	ArtifactResp = `{
+  "id": 1,
+  "build_id": 1,
+  "repo_id": 1,
+  "name": "coverage.out",
+  "step": "test",
+  "object_path": "github/octocat/1/coverage.out",
+  "size": 1024,
+  "checksum": "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+  "content_type": "text/plain",
+  "created_at": 1563475419,
+  "expires_at": 1566067419
+}`
+
+	// ArtifactsResp represents a JSON return for one to many artifacts.
+	ArtifactsResp = `[
+  {
+    "id": 2,
+    "build_id": 1,
+    "repo_id": 1,
+    "name": "app.tar.gz",
+    "step": "build",
+    "object_path": "github/octocat/1/app.tar.gz",
+    "size": 2048,
+    "checksum": "sha256:4355a46b19d348dc2f57c046f8ef63d4538ebb936000f3c9ee954a27460dd865",
+    "content_type": "application/gzip",
+    "created_at": 1563475419,
+    "expires_at": 1566067419
+  },
+  {
+    "id": 1,
+    "build_id": 1,
+    "repo_id": 1,
+    "name": "coverage.out",
+    "step": "test",
+    "object_path": "github/octocat/1/coverage.out",
+    "size": 1024,
+    "checksum": "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+    "content_type": "text/plain",
+    "created_at": 1563475419,
+    "expires_at": 1566067419
+  }
+]`
+
+	// ArtifactDownloadResp represents a JSON return for a presigned artifact download URL.
+	ArtifactDownloadResp = `{
+  "url": "http://storage.example.com/vela/github/octocat/1/coverage.out?X-Amz-Signature=abc123"
+}`
+
+	// ArtifactRetentionResp represents a JSON return for an artifact retention report.
+	ArtifactRetentionResp = `{
+  "artifacts": [
+    {
+      "id": 1,
+      "build_id": 1,
+      "repo_id": 1,
+      "name": "coverage.out",
+      "step": "test",
+      "object_path": "github/octocat/1/coverage.out",
+      "size": 1024,
+      "checksum": "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+      "content_type": "text/plain",
+      "created_at": 1563475419,
+      "expires_at": 1566067419
+    }
+  ],
+  "count": 1,
+  "bytes": 1024
+}`
+)
+
+// getArtifacts returns mock JSON for a http GET.
+func getArtifacts(c *gin.Context) {
+	data := []byte(ArtifactsResp)
+
+	var body []api.Artifact
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getArtifact has a param :artifact returns mock JSON for a http GET.
+//
+// Pass "0" to :artifact to test receiving a http 404 response.
+func getArtifact(c *gin.Context) {
+	a := c.Param("artifact")
+
+	if strings.EqualFold(a, "0") {
+		msg := fmt.Sprintf("Artifact %s does not exist", a)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ArtifactResp)
+
+	var body api.Artifact
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addArtifact returns mock JSON for a http POST.
+func addArtifact(c *gin.Context) {
+	data := []byte(ArtifactResp)
+
+	var body api.Artifact
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// getArtifactDownloadURL has a param :artifact returns mock JSON for a http GET.
+//
+// Pass "0" to :artifact to test receiving a http 404 response.
+func getArtifactDownloadURL(c *gin.Context) {
+	a := c.Param("artifact")
+
+	if strings.EqualFold(a, "0") {
+		msg := fmt.Sprintf("Artifact %s does not exist", a)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ArtifactDownloadResp)
+
+	var body api.PresignURL
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getArtifactRetention returns mock JSON for a http GET.
+func getArtifactRetention(c *gin.Context) {
+	data := []byte(ArtifactRetentionResp)
+
+	var body api.ArtifactRetentionReport
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
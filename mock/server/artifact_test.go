@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestArtifact_ActiveArtifactResp(t *testing.T) {
+	testArtifact := api.Artifact{}
+
+	err := json.Unmarshal([]byte(ArtifactResp), &testArtifact)
+	if err != nil {
+		t.Errorf("error unmarshaling artifact: %v", err)
+	}
+
+	tArtifact := reflect.TypeFor[api.Artifact]()
+
+	for i := 0; i < tArtifact.NumField(); i++ {
+		if reflect.ValueOf(testArtifact).Field(i).IsNil() {
+			t.Errorf("ArtifactResp missing field %s", tArtifact.Field(i).Name)
+		}
+	}
+}
+
+func TestArtifact_ArtifactRetentionResp(t *testing.T) {
+	testReport := api.ArtifactRetentionReport{}
+
+	err := json.Unmarshal([]byte(ArtifactRetentionResp), &testReport)
+	if err != nil {
+		t.Errorf("error unmarshaling artifact retention report: %v", err)
+	}
+
+	if len(testReport.Artifacts) != int(testReport.Count) {
+		t.Errorf("ArtifactRetentionResp count is %d, want %d", testReport.Count, len(testReport.Artifacts))
+	}
+
+	if testReport.Bytes != testReport.Artifacts[0].GetSize() {
+		t.Errorf("ArtifactRetentionResp bytes is %d, want %d", testReport.Bytes, testReport.Artifacts[0].GetSize())
+	}
+}
@@ -361,6 +361,29 @@ func restartBuild(c *gin.Context) {
 	c.JSON(http.StatusCreated, body)
 }
 
+// addChildBuild has a param :build returns mock JSON for a http POST.
+//
+// Pass "0" to :build to test receiving a http 404 response.
+func addChildBuild(c *gin.Context) {
+	b := c.Param("build")
+
+	if strings.EqualFold(b, "0") {
+		msg := fmt.Sprintf("Build %s does not exist", b)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(BuildResp)
+
+	var body api.Build
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
 // cancelBuild has a param :build returns mock JSON for a http DELETE.
 //
 // Pass "0" to :build to test receiving a http 404 response.
@@ -24,6 +24,12 @@ const (
   "data": "SGVsbG8sIFdvcmxkIQ==",
   "created_at": 1
 }`
+
+	// LogChunkResp represents a JSON return for a chunk appended to a log.
+	LogChunkResp = `{
+  "offset": 13,
+  "data": "SGVsbG8sIFdvcmxkIQ=="
+}`
 )
 
 // getServiceLog has a param :service returns mock JSON for a http GET.
@@ -149,3 +155,97 @@ func removeStepLog(c *gin.Context) {
 
 	c.JSON(http.StatusOK, fmt.Sprintf("Log %s removed", s))
 }
+
+// appendServiceLog has a param :service returns mock JSON for a http POST.
+//
+// Pass "0" to :service to test receiving a http 404 response.
+func appendServiceLog(c *gin.Context) {
+	s := c.Param("service")
+
+	if strings.EqualFold(s, "0") {
+		msg := fmt.Sprintf("Log %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(LogChunkResp)
+
+	var body api.LogChunk
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// streamServiceLog has a param :service returns mock server-sent events for a http GET.
+//
+// Pass "0" to :service to test receiving a http 404 response.
+func streamServiceLog(c *gin.Context) {
+	s := c.Param("service")
+
+	if strings.EqualFold(s, "0") {
+		msg := fmt.Sprintf("Log %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(LogChunkResp)
+
+	var body api.LogChunk
+
+	_ = json.Unmarshal(data, &body)
+
+	c.SSEvent("log", body)
+	c.SSEvent("done", "success")
+}
+
+// appendStepLog has a param :step returns mock JSON for a http POST.
+//
+// Pass "0" to :step to test receiving a http 404 response.
+func appendStepLog(c *gin.Context) {
+	s := c.Param("step")
+
+	if strings.EqualFold(s, "0") {
+		msg := fmt.Sprintf("Log %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(LogChunkResp)
+
+	var body api.LogChunk
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// streamStepLog has a param :step returns mock server-sent events for a http GET.
+//
+// Pass "0" to :step to test receiving a http 404 response.
+func streamStepLog(c *gin.Context) {
+	s := c.Param("step")
+
+	if strings.EqualFold(s, "0") {
+		msg := fmt.Sprintf("Log %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(LogChunkResp)
+
+	var body api.LogChunk
+
+	_ = json.Unmarshal(data, &body)
+
+	c.SSEvent("log", body)
+	c.SSEvent("done", "success")
+}
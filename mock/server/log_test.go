@@ -26,3 +26,16 @@ func TestLog_ActiveLogResp(t *testing.T) {
 		}
 	}
 }
+
+func TestLog_ActiveLogChunkResp(t *testing.T) {
+	testChunk := api.LogChunk{}
+
+	err := json.Unmarshal([]byte(LogChunkResp), &testChunk)
+	if err != nil {
+		t.Errorf("error unmarshaling log chunk: %v", err)
+	}
+
+	if testChunk.Offset == 0 || len(testChunk.Data) == 0 {
+		t.Errorf("LogChunkResp missing fields: %+v", testChunk)
+	}
+}
@@ -158,6 +158,7 @@ templates:
     "approve_build": "first-time"
   },
   "commit": "8496deb0aeacd9d95078ac8d38edb447631ef369",
+  "generated_digest": "",
   "flavor": "",
   "platform": "",
   "ref": "refs/heads/main",
@@ -173,6 +174,14 @@ templates:
   "warnings": [
     "42:this is a warning"
   ],
+  "resolved_templates": [
+    {
+      "name": "sample",
+      "source": "github.com/github/octocat/template.yml@main",
+      "type": "github",
+      "ref": "48afb5bdc41ad69bf22588491333f7cf71135163"
+    }
+  ],
   "data": "LS0tCnZlcnNpb246ICIxIgoKc3RlcHM6CiAgLSBuYW1lOiBlY2hvCiAgICBpbWFnZTogYWxwaW5lOmxhdGVzdAogICAgY29tbWFuZHM6IFtlY2hvIGZvb10="
 }`
 
@@ -234,6 +243,7 @@ templates:
       "approve_build": "first-time"
     },
     "commit": "a49aaf4afae6431a79239c95247a2b169fd9f067",
+    "generated_digest": "",
     "flavor": "",
     "platform": "",
     "ref": "refs/heads/main",
@@ -304,6 +314,7 @@ templates:
       "approve_build": "first-time"
     },
     "commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+    "generated_digest": "",
     "flavor": "",
     "platform": "",
     "ref": "refs/heads/main",
@@ -327,6 +338,84 @@ sample:
   source: github.com/go-vela/vela-tutorials/templates/sample.yml
   type: github
 `
+
+	// ValidateDiagnosticsResp represents a JSON return for the diagnostics of a validated pipeline.
+	ValidateDiagnosticsResp = `{
+  "valid": false,
+  "diagnostics": [
+    {
+      "severity": "error",
+      "code": "missing-image",
+      "message": "no image provided for step test",
+      "path": "steps[0].image",
+      "line": 4,
+      "column": 5
+    }
+  ]
+}`
+
+	// DiffResp represents a YAML return for the differences between pipelines.
+	DiffResp = `---
+steps:
+  - name: docker
+    stage: publish
+    change: added
+    image:
+      from: ""
+      to: docker:dind
+  - name: unit
+    stage: test
+    change: changed
+    image:
+      from: golang:1.22
+      to: golang:1.23
+    fields:
+      - commands
+secrets:
+  - name: docker_password
+    change: added
+    key:
+      from: ""
+      to: org/repo/docker_password
+privilege_escalations:
+  - step publish:docker runs privileged
+`
+
+	// SimulateResp represents a YAML return for a simulated pipeline.
+	SimulateResp = `---
+scenarios:
+  - scenario: push to main
+    steps:
+      - go_test
+      - go_build
+    secrets:
+      - docker_username
+      - docker_password
+  - scenario: pull request
+    steps:
+      - go_test
+table:
+  - type: step
+    name: go_test
+    runs:
+      push to main: true
+      pull request: true
+  - type: step
+    name: go_build
+    runs:
+      push to main: true
+      pull request: false
+  - type: secret
+    name: docker_username
+    runs:
+      push to main: true
+      pull request: false
+  - type: secret
+    name: docker_password
+    runs:
+      push to main: true
+      pull request: false
+`
 )
 
 // getPipelines returns mock JSON for a http GET.
@@ -439,6 +528,52 @@ func compilePipeline(c *gin.Context) {
 	writeYAML(c, http.StatusOK, body)
 }
 
+// simulatePipeline has a param :pipeline returns mock YAML for a http POST.
+//
+// Pass "0" to :pipeline to test receiving a http 404 response.
+func simulatePipeline(c *gin.Context) {
+	p := c.Param("pipeline")
+
+	if strings.EqualFold(p, "0") {
+		msg := fmt.Sprintf("Pipeline %s does not exist", p)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(SimulateResp)
+
+	var body map[string]any
+
+	_ = yml.Unmarshal(data, &body)
+
+	writeYAML(c, http.StatusOK, body)
+}
+
+// diffPipeline has a param :pipeline returns mock YAML for a http POST.
+//
+// Pass "0" to :pipeline to test receiving a http 404 response.
+func diffPipeline(c *gin.Context) {
+	p := c.Param("pipeline")
+
+	if strings.EqualFold(p, "0") {
+		msg := fmt.Sprintf("Pipeline %s does not exist", p)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, api.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(DiffResp)
+
+	var body map[string]any
+
+	_ = yml.Unmarshal(data, &body)
+
+	writeYAML(c, http.StatusOK, body)
+}
+
 // expandPipeline has a param :pipeline returns mock YAML for a http GET.
 //
 // Pass "0" to :pipeline to test receiving a http 404 response.
@@ -510,5 +645,15 @@ func validatePipeline(c *gin.Context) {
 		return
 	}
 
+	if strings.EqualFold(c.Query("diagnostics"), "true") {
+		var body map[string]any
+
+		_ = json.Unmarshal([]byte(ValidateDiagnosticsResp), &body)
+
+		c.JSON(http.StatusOK, body)
+
+		return
+	}
+
 	c.JSON(http.StatusOK, "pipeline is valid")
 }
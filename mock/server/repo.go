@@ -62,6 +62,7 @@ const (
   "merge_queue_events": [],
   "approve_build": "fork-always",
   "approval_timeout": 7,
+  "orphan_policy": "fail",
   "previous_name": "",
   "install_id": 0,
   "custom_props": {
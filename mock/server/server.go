@@ -18,6 +18,7 @@ func FakeHandler() http.Handler {
 	e := gin.New()
 
 	// mock endpoints for admin calls
+	e.GET("/api/v1/admin/artifacts/retention", getArtifactRetention)
 	e.PUT("/api/v1/admin/build", updateBuild)
 	e.GET("/api/v1/admin/builds/queue", buildQueue)
 	e.PUT("/api/v1/admin/deployment", updateDeployment)
@@ -38,6 +39,7 @@ func FakeHandler() http.Handler {
 	e.POST("/api/v1/repos/:org/:repo/builds/:build", restartBuild)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/cancel", cancelBuild)
 	e.POST("/api/v1/repos/:org/:repo/builds/:build/approve", approveBuild)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/children", addChildBuild)
 	e.GET("/api/v1/repos/:org/:repo/builds/:build/logs", getLogs)
 	e.GET("/api/v1/repos/:org/:repo/builds", getBuilds)
 	e.POST("/api/v1/repos/:org/:repo/builds", addBuild)
@@ -72,10 +74,14 @@ func FakeHandler() http.Handler {
 	e.GET("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs", getServiceLog)
 	e.POST("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs", addServiceLog)
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs", updateServiceLog)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs/chunks", appendServiceLog)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs/stream", streamServiceLog)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs", removeServiceLog)
 	e.GET("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", getStepLog)
 	e.POST("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", addStepLog)
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", updateStepLog)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/chunks", appendStepLog)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/stream", streamStepLog)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", removeStepLog)
 
 	// mock endpoints for pipeline calls
@@ -85,6 +91,8 @@ func FakeHandler() http.Handler {
 	e.PUT("/api/v1/pipelines/:org/:repo/:pipeline", updatePipeline)
 	e.DELETE("/api/v1/pipelines/:org/:repo/:pipeline", removePipeline)
 	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/compile", compilePipeline)
+	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/diff", diffPipeline)
+	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/simulate", simulatePipeline)
 	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/expand", expandPipeline)
 	e.GET("/api/v1/pipelines/:org/:repo/:pipeline/templates", getTemplates)
 	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/validate", validatePipeline)
@@ -160,6 +168,19 @@ func FakeHandler() http.Handler {
 	// mock endpoint for queue credentials
 	e.GET("/api/v1/queue/info", getQueueCreds)
 
+	// mock endpoints for artifact calls
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/artifacts", getArtifacts)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/artifacts", addArtifact)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/artifacts/:artifact", getArtifact)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/artifacts/:artifact/download-url", getArtifactDownloadURL)
+
+	// mock endpoints for test result calls
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/tests", getTestResults)
+
+	// mock endpoints for annotation calls
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/annotations", getAnnotations)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/steps/:step/annotations", getAnnotations)
+
 	// mock endpoint for storage sts credentials
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build/storage/:name/upload-url", getPresignedPutURL)
 
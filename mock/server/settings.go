@@ -19,11 +19,26 @@ const (
 			"compiler": {
 				"clone_image": "target/vela-git-slim",
 				"template_depth": 3,
-				"starlark_exec_limit": 100
+				"starlark_exec_limit": 100,
+				"jsonnet_exec_timeout": 30
 			},
 			"queue": {
 				"routes": [
 					"vela"
+				],
+				"pools": [
+					{
+						"name": "secure",
+						"routes": [
+							"vela"
+						],
+						"quotas": [
+							{
+								"org": "payments",
+								"limit": 20
+							}
+						]
+					}
 				]
 			},
 			"scm": {
@@ -50,6 +65,21 @@ const (
 			"enable_repo_secrets": true,
 			"enable_org_secrets": true,
 			"enable_shared_secrets": true,
+			"artifact_retention": [
+				{
+					"keep_builds": 10,
+					"keep_days": 30,
+					"keep_tags": true,
+					"keep_deployments": true
+				}
+			],
+			"log_retention": [
+				{
+					"keep_days": 30,
+					"keep_failed_days": 90,
+					"keep_deployments": true
+				}
+			],
 			"created_at": 1,
 			"updated_at": 1,
 			"updated_by": "octocat"
@@ -62,12 +92,30 @@ const (
 			"compiler": {
 				"clone_image": "target/vela-git-slim:latest",
 				"template_depth": 5,
-				"starlark_exec_limit": 123
+				"starlark_exec_limit": 123,
+				"jsonnet_exec_timeout": 45
 			},
 			"queue": {
 				"routes": [
 					"vela",
 					"large"
+				],
+				"pools": [
+					{
+						"name": "secure",
+						"routes": [
+							"large"
+						],
+						"labels": [
+							"arch=amd64"
+						],
+						"quotas": [
+							{
+								"org": "payments",
+								"limit": 20
+							}
+						]
+					}
 				]
 			},
 			"scm": {
@@ -93,6 +141,30 @@ const (
 			"enable_repo_secrets": true,
 			"enable_org_secrets": true,
 			"enable_shared_secrets": false,
+			"artifact_retention": [
+				{
+					"keep_builds": 10,
+					"keep_days": 30,
+					"keep_tags": true,
+					"keep_deployments": true
+				},
+				{
+					"org": "octocat",
+					"keep_builds": 5
+				}
+			],
+			"log_retention": [
+				{
+					"keep_days": 30,
+					"keep_failed_days": 90,
+					"keep_deployments": true
+				},
+				{
+					"org": "octocat",
+					"repo": "hello-world",
+					"keep_days": 7
+				}
+			],
 			"created_at": 1,
 			"updated_at": 1,
 			"updated_by": "octocat"
@@ -105,7 +177,8 @@ const (
 		"compiler": {
 			"clone_image": "target/vela-git-slim:latest",
 			"template_depth": 5,
-			"starlark_exec_limit": 123
+			"starlark_exec_limit": 123,
+			"jsonnet_exec_timeout": 45
 		},
 		"queue": {
 			"routes": [
@@ -136,6 +209,8 @@ const (
 		"enable_repo_secrets": true,
 		"enable_org_secrets": true,
 		"enable_shared_secrets": true,
+		"artifact_retention": [],
+		"log_retention": [],
 		"created_at": 1,
 		"updated_at": 1,
 		"updated_by": "octocat"
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+const (
+	// TestReportResp represents a JSON return for the test results of a build.
+	TestReportResp = `{
+  "total": 2,
+  "passed": 1,
+  "failed": 1,
+  "skipped": 0,
+  "flaky": 1,
+  "duration": 1510,
+  "tests": [
+    {
+      "id": 2,
+      "build_id": 1,
+      "repo_id": 1,
+      "artifact_id": 1,
+      "step": "test",
+      "branch": "main",
+      "suite": "github.com/octocat/hello-world",
+      "name": "TestAdd",
+      "status": "passed",
+      "message": "",
+      "duration": 10,
+      "flaky": false,
+      "created_at": 1563475419
+    },
+    {
+      "id": 1,
+      "build_id": 1,
+      "repo_id": 1,
+      "artifact_id": 1,
+      "step": "test",
+      "branch": "main",
+      "suite": "github.com/octocat/hello-world",
+      "name": "TestDivide",
+      "status": "failed",
+      "message": "math_test.go:42: expected 2, got 3",
+      "duration": 1500,
+      "flaky": true,
+      "created_at": 1563475419
+    }
+  ]
+}`
+)
+
+// getTestResults returns mock JSON for a http GET.
+func getTestResults(c *gin.Context) {
+	data := []byte(TestReportResp)
+
+	var body api.TestReport
+
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
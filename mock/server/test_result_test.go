@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTestResult_ActiveTestReportResp(t *testing.T) {
+	testReport := api.TestReport{}
+
+	err := json.Unmarshal([]byte(TestReportResp), &testReport)
+	if err != nil {
+		t.Errorf("error unmarshaling test report: %v", err)
+	}
+
+	if len(testReport.Tests) != int(testReport.Total) {
+		t.Errorf("TestReportResp total is %d, want %d", testReport.Total, len(testReport.Tests))
+	}
+
+	tResult := reflect.TypeFor[api.TestResult]()
+
+	for _, result := range testReport.Tests {
+		for i := 0; i < tResult.NumField(); i++ {
+			if reflect.ValueOf(*result).Field(i).IsNil() {
+				t.Errorf("TestReportResp missing field %s", tResult.Field(i).Name)
+			}
+		}
+	}
+}
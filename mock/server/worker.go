@@ -25,7 +25,13 @@ const (
 			"docker",
 			"large:docker"
 			],
+			"labels": {
+			"arch": "amd64",
+			"docker": "true"
+			},
 			"active": true,
+			"draining": false,
+			"drain_started_at": 0,
 			"last_checked_in": 1602612590,
 			"status": "busy",
 			"last_status_update_at": 1602612590,
@@ -105,7 +111,13 @@ const (
 			  "docker",
 			  "large:docker"
 			],
+			"labels": {
+			  "arch": "amd64",
+			  "docker": "true"
+			},
 			"active": true,
+			"draining": false,
+			"drain_started_at": 0,
 			"last_checked_in": 1602612590,
 			"status": "available",
 			"last_status_update_at": 1602612590,
@@ -153,7 +165,13 @@ const (
 			  "docker",
 			  "large:docker"
 			],
+			"labels": {
+			  "arch": "amd64",
+			  "docker": "true"
+			},
 			"active": true,
+			"draining": false,
+			"drain_started_at": 0,
 			"last_checked_in": 1602612590,
 			"status": "idle",
 			"last_status_update_at": 1602612590,
@@ -184,7 +202,21 @@ const (
 	//not actual credentials.
 	QueueInfoResp = `{
 		"queue_public_key": "DXeyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ98zmko=",
-		"queue_address": "redis://redis:6000"
+		"queue_address": "redis://redis:6000",
+		"pools": [
+			{
+				"name": "secure",
+				"routes": ["vela:secure"],
+				"quotas": [
+					{
+						"org": "payments",
+						"limit": 20,
+						"active": 20,
+						"held": 3
+					}
+				]
+			}
+		]
 	}`
 
 	// PresignedPutResp represents a JSON return for an admin requesting a presigned put url.
@@ -99,4 +99,13 @@ var Flags = []cli.Flag{
 			cli.File("/vela/signing.pub"),
 		),
 	},
+	&cli.StringFlag{
+		Name:  "queue.worker.hostname",
+		Usage: "hostname of the worker popping items off the queue, used to refuse items to draining workers",
+		Sources: cli.NewValueSourceChain(
+			cli.EnvVar("VELA_QUEUE_WORKER_HOSTNAME"),
+			cli.EnvVar("QUEUE_WORKER_HOSTNAME"),
+			cli.File("/vela/queue/worker_hostname"),
+		),
+	},
 }
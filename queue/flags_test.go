@@ -60,6 +60,17 @@ func TestDatabase_Flags(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "worker hostname",
+			flags: map[string]string{
+				"queue.driver":          "redis",
+				"queue.addr":            "redis://redis.example.com",
+				"queue.routes":          "vela,worker",
+				"queue.public-key":      "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+				"queue.worker.hostname": "worker_0",
+			},
+			wantErr: false,
+		},
 		{
 			name: "empty driver",
 			flags: map[string]string{
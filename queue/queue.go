@@ -25,6 +25,7 @@ func FromCLICommand(ctx context.Context, c *cli.Command) (Service, error) {
 		Timeout:    c.Duration("queue.pop.timeout"),
 		PrivateKey: c.String("queue.private-key"),
 		PublicKey:  c.String("queue.public-key"),
+		Hostname:   c.String("queue.worker.hostname"),
 	}
 
 	// setup the queue
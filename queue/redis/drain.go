@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+)
+
+// drainingKey is the key of the set of
+// workers refused items off the queue.
+const drainingKey = "vela:workers:draining"
+
+// Drain sets whether the worker is refused items off the queue.
+func (c *Client) Drain(ctx context.Context, hostname string, draining bool) error {
+	c.Logger.Tracef("setting draining for worker %s to %t", hostname, draining)
+
+	if draining {
+		// add the worker to the set of draining workers
+		//
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SAdd
+		return c.Redis.SAdd(ctx, drainingKey, hostname).Err()
+	}
+
+	// remove the worker from the set of draining workers
+	//
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SRem
+	return c.Redis.SRem(ctx, drainingKey, hostname).Err()
+}
+
+// draining is a helper function to check whether
+// the worker of the client is refused items off the queue.
+func (c *Client) draining(ctx context.Context) (bool, error) {
+	// items are only refused to workers
+	if len(c.config.Hostname) == 0 {
+		return false, nil
+	}
+
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SIsMember
+	return c.Redis.SIsMember(ctx, drainingKey, c.config.Hostname).Result()
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-vela/server/queue/models"
+)
+
+func TestRedis_Drain(t *testing.T) {
+	// setup types
+	// use global variables in redis_test.go
+	_item := &models.Item{
+		Build: _build,
+	}
+
+	// setup queue item
+	bytes, err := json.Marshal(_item)
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	// overwrite timeout to be 1s and set the worker hostname
+	_redis.config.Timeout = 1 * time.Second
+	_redis.config.Hostname = "worker_0"
+
+	err = _redis.Push(context.Background(), "vela", bytes)
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		draining bool
+		want     *models.Item
+	}{
+		{
+			name:     "draining",
+			draining: true,
+			want:     nil,
+		},
+		{
+			name:     "not draining",
+			draining: false,
+			want:     _item,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := _redis.Drain(context.Background(), "worker_0", test.draining)
+			if err != nil {
+				t.Errorf("Drain returned err: %v", err)
+			}
+
+			got, err := _redis.Pop(context.Background(), nil)
+			if err != nil {
+				t.Errorf("Pop returned err: %v", err)
+			}
+
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Pop() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -70,6 +70,18 @@ func WithTimeout(timeout time.Duration) ClientOpt {
 	}
 }
 
+// WithHostname sets the hostname of the worker in the queue client for Redis.
+func WithHostname(hostname string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring hostname in redis queue client")
+
+		// set the worker hostname in the redis client
+		c.config.Hostname = hostname
+
+		return nil
+	}
+}
+
 // WithPrivateKey sets the private key in the queue client for Redis.
 func WithPrivateKey(key string) ClientOpt {
 	return func(c *Client) error {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/crypto/nacl/sign"
@@ -27,6 +28,25 @@ func (c *Client) Pop(ctx context.Context, inRoutes []string) (*models.Item, erro
 
 	c.Logger.Tracef("popping item from queue %s", routes)
 
+	// check if the worker is refused items off the queue
+	draining, err := c.draining(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a draining worker finishes its running builds without
+	// new items, so wait out the timeout as if none were queued
+	if draining {
+		c.Logger.Tracef("refusing item from queue %s to draining worker %s", routes, c.config.Hostname)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.config.Timeout):
+			return nil, nil
+		}
+	}
+
 	// build a redis queue command to pop an item from queue
 	//
 	// https://pkg.go.dev/github.com/go-redis/redis?tab=doc#Client.BLPop
@@ -48,7 +68,27 @@ func (c *Client) Pop(ctx context.Context, inRoutes []string) (*models.Item, erro
 	// extract signed item from pop results
 	signed := []byte(result[1])
 
-	var opened, out []byte
+	item, err := c.open(signed)
+	if err != nil {
+		return nil, err
+	}
+
+	// builds of an org over its quota in a worker pool wait for a slot
+	held, err := c.holdUnreserved(ctx, result[0], signed, item)
+	if err != nil {
+		return nil, err
+	}
+
+	if held {
+		return nil, nil
+	}
+
+	return item, nil
+}
+
+// open is a helper function to open a signed item popped off the queue.
+func (c *Client) open(signed []byte) (*models.Item, error) {
+	var out []byte
 
 	// open the item using the public key generated using sign
 	//
@@ -61,7 +101,7 @@ func (c *Client) Pop(ctx context.Context, inRoutes []string) (*models.Item, erro
 	// unmarshal result into queue item
 	item := new(models.Item)
 
-	err = json.Unmarshal(opened, item)
+	err := json.Unmarshal(opened, item)
 	if err != nil {
 		return nil, err
 	}
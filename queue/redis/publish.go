@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+)
+
+// Publish broadcasts a message to every subscriber of the channel.
+func (c *Client) Publish(ctx context.Context, channel string, message []byte) error {
+	c.Logger.Tracef("publishing message to channel %s", channel)
+
+	// send a redis command to publish the message to the channel
+	//
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.Publish
+	return c.Redis.Publish(ctx, channel, message).Err()
+}
@@ -26,6 +26,8 @@ type config struct {
 	PrivateKey *[64]byte
 	// key for opening items popped from the Redis client
 	PublicKey *[32]byte
+	// specifies the hostname of the worker popping items from the Redis client
+	Hostname string
 }
 
 type Client struct {
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/queue/models"
+)
+
+// reserveScript adds a build to the set of builds holding a slot of
+// a quota while the set is under the limit and no builds are held,
+// so concurrent servers and workers never exceed the limit.
+//
+// KEYS[1] is the set of reserved builds, KEYS[2] the route of the held
+// builds, ARGV[1] the build and ARGV[2] the limit.
+var reserveScript = redis.NewScript(`
+if redis.call("SISMEMBER", KEYS[1], ARGV[1]) == 1 then
+	return 1
+end
+
+if redis.call("LLEN", KEYS[2]) > 0 then
+	return 0
+end
+
+if redis.call("SCARD", KEYS[1]) >= tonumber(ARGV[2]) then
+	return 0
+end
+
+redis.call("SADD", KEYS[1], ARGV[1])
+
+return 1
+`)
+
+// popHeldScript pops the first held build off its route once the build
+// reserved a slot of the quota. It returns -1 when the first held item
+// changed since it was read, so the caller reads it again.
+//
+// KEYS[1] is the route of the held builds, KEYS[2] the set of reserved
+// builds, ARGV[1] the first held item, ARGV[2] its build and ARGV[3] the limit.
+var popHeldScript = redis.NewScript(`
+if redis.call("LINDEX", KEYS[1], 0) ~= ARGV[1] then
+	return -1
+end
+
+if redis.call("SISMEMBER", KEYS[2], ARGV[2]) == 0 then
+	if redis.call("SCARD", KEYS[2]) >= tonumber(ARGV[3]) then
+		return 0
+	end
+
+	redis.call("SADD", KEYS[2], ARGV[2])
+end
+
+redis.call("LPOP", KEYS[1])
+
+return 1
+`)
+
+// Reserve reserves a slot of the quota of the org in the worker pool for the
+// build, reporting whether the build holds a slot. A slot is not reserved
+// when the quota is reached or builds of the org are already held.
+func (c *Client) Reserve(ctx context.Context, pool *settings.WorkerPool, org string, build int64) (bool, error) {
+	quota := pool.QuotaForOrg(org)
+	if quota == nil {
+		return true, nil
+	}
+
+	c.Logger.Tracef("reserving slot of worker pool %s for build %d", pool.GetName(), build)
+
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Script.Run
+	reserved, err := reserveScript.Run(ctx, c.Redis, []string{pool.ReservedKey(org), pool.HoldRoute(org)}, build, quota.GetLimit()).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return reserved == 1, nil
+}
+
+// Release frees the slot of the quota of the org in the worker pool held by the build.
+func (c *Client) Release(ctx context.Context, pool *settings.WorkerPool, org string, build int64) error {
+	c.Logger.Tracef("releasing slot of worker pool %s for build %d", pool.GetName(), build)
+
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SRem
+	return c.Redis.SRem(ctx, pool.ReservedKey(org), build).Err()
+}
+
+// Reserved returns the builds holding a slot of the quota of the org in the worker pool.
+func (c *Client) Reserved(ctx context.Context, pool *settings.WorkerPool, org string) ([]int64, error) {
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.SMembers
+	members, err := c.Redis.SMembers(ctx, pool.ReservedKey(org)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	builds := make([]int64, 0, len(members))
+
+	for _, member := range members {
+		build, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
+
+// PopHeld grabs the first build of the org held in the worker pool off the
+// queue once a slot of the quota is reserved for it. It returns nil when no
+// build is held or the quota is reached.
+func (c *Client) PopHeld(ctx context.Context, pool *settings.WorkerPool, org string) (*models.Item, error) {
+	quota := pool.QuotaForOrg(org)
+	if quota == nil {
+		return nil, nil
+	}
+
+	hold := pool.HoldRoute(org)
+
+	c.Logger.Tracef("popping held item from queue %s", hold)
+
+	for {
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.LIndex
+		signed, err := c.Redis.LIndex(ctx, hold, 0).Bytes()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+
+		item, err := c.open(signed)
+		if err != nil {
+			return nil, err
+		}
+
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Script.Run
+		popped, err := popHeldScript.Run(ctx, c.Redis, []string{hold, pool.ReservedKey(org)}, signed, item.Build.GetID(), quota.GetLimit()).Int()
+		if err != nil {
+			return nil, err
+		}
+
+		switch popped {
+		case 1:
+			return item, nil
+		case 0:
+			return nil, nil
+		}
+	}
+}
+
+// holdUnreserved is a helper function to hold a build popped off a route of
+// a worker pool when its org has no slot of the quota reserved for it, so
+// builds only reach workers once they hold a slot. It reports whether the
+// build was held.
+//
+// The worker pools are read from the settings of the client, so the check
+// only applies to clients provided the platform settings with SetSettings.
+// Without them, quotas are only enforced when the build is published.
+func (c *Client) holdUnreserved(ctx context.Context, route string, signed []byte, item *models.Item) (bool, error) {
+	org := item.Build.GetRepo().GetOrg()
+
+	// the slots reserved in other pools are freed when the build is held
+	reserved := []settings.WorkerPool{}
+
+	for _, pool := range c.GetPools() {
+		if pool.QuotaForOrg(org) == nil || !pool.HasRoute(route) {
+			continue
+		}
+
+		ok, err := c.Reserve(ctx, &pool, org, item.Build.GetID())
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			reserved = append(reserved, pool)
+
+			continue
+		}
+
+		for _, r := range reserved {
+			err = c.Release(ctx, &r, org, item.Build.GetID())
+			if err != nil {
+				c.Logger.WithError(err).Warnf("unable to release slot of worker pool %s for build %d", r.GetName(), item.Build.GetID())
+			}
+		}
+
+		c.Logger.Debugf("holding build %d over the quota of worker pool %s", item.Build.GetID(), pool.GetName())
+
+		// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.RPush
+		return true, c.Redis.RPush(ctx, pool.HoldRoute(org), signed).Err()
+	}
+
+	return false, nil
+}
@@ -0,0 +1,370 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/api/types/settings"
+	"github.com/go-vela/server/queue/models"
+)
+
+func TestRedis_Reserve(t *testing.T) {
+	// setup types
+	ctx := context.Background()
+
+	pool := testPool()
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "secure")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name  string
+		org   string
+		build int64
+		want  bool
+	}{
+		{name: "under quota", org: "github", build: 1, want: true},
+		{name: "already reserved", org: "github", build: 1, want: true},
+		{name: "under quota again", org: "github", build: 2, want: true},
+		{name: "over quota", org: "github", build: 3, want: false},
+		{name: "org without quota", org: "octocat", build: 4, want: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := _redis.Reserve(ctx, pool, test.org, test.build)
+			if err != nil {
+				t.Errorf("Reserve returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Reserve is %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	reserved, err := _redis.Reserved(ctx, pool, "github")
+	if err != nil {
+		t.Errorf("Reserved returned err: %v", err)
+	}
+
+	if len(reserved) != 2 {
+		t.Errorf("Reserved is %v, want 2 builds", reserved)
+	}
+
+	err = _redis.Release(ctx, pool, "github", 1)
+	if err != nil {
+		t.Errorf("Release returned err: %v", err)
+	}
+
+	reserved, err = _redis.Reserved(ctx, pool, "github")
+	if err != nil {
+		t.Errorf("Reserved returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(reserved, []int64{2}) {
+		t.Errorf("Reserved is %v, want %v", reserved, []int64{2})
+	}
+
+	// builds wait behind the builds of the org already held
+	err = _redis.Redis.RPush(ctx, pool.HoldRoute("github"), "held").Err()
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	got, err := _redis.Reserve(ctx, pool, "github", 3)
+	if err != nil {
+		t.Errorf("Reserve returned err: %v", err)
+	}
+
+	if got {
+		t.Errorf("Reserve with held builds is %v, want %v", got, false)
+	}
+}
+
+func TestRedis_PopHeld(t *testing.T) {
+	// setup types
+	ctx := context.Background()
+
+	pool := testPool()
+
+	first := testHeldItem(10)
+	second := testHeldItem(11)
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "secure")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	// no build is held
+	got, err := _redis.PopHeld(ctx, pool, "github")
+	if err != nil {
+		t.Errorf("PopHeld returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("PopHeld without held builds is %v, want nil", got)
+	}
+
+	for _, item := range []*models.Item{first, second} {
+		bytes, err := json.Marshal(item)
+		if err != nil {
+			t.Errorf("unable to marshal queue item: %v", err)
+		}
+
+		err = _redis.Push(ctx, pool.HoldRoute("github"), bytes)
+		if err != nil {
+			t.Errorf("unable to push item to queue: %v", err)
+		}
+	}
+
+	// the first build held reserves a slot
+	reserved, err := _redis.Reserve(ctx, pool, "github", 1)
+	if err != nil || reserved {
+		t.Errorf("Reserve with held builds is %v, want %v: %v", reserved, false, err)
+	}
+
+	got, err = _redis.PopHeld(ctx, pool, "github")
+	if err != nil {
+		t.Errorf("PopHeld returned err: %v", err)
+	}
+
+	if diff := cmp.Diff(first, got); diff != "" {
+		t.Errorf("PopHeld mismatch (-want +got):\n%s", diff)
+	}
+
+	// the second build held reserves the last slot
+	got, err = _redis.PopHeld(ctx, pool, "github")
+	if err != nil {
+		t.Errorf("PopHeld returned err: %v", err)
+	}
+
+	if diff := cmp.Diff(second, got); diff != "" {
+		t.Errorf("PopHeld mismatch (-want +got):\n%s", diff)
+	}
+
+	err = _redis.Push(ctx, pool.HoldRoute("github"), []byte(`{"build":{"id":12}}`))
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	// the org reached its quota
+	got, err = _redis.PopHeld(ctx, pool, "github")
+	if err != nil {
+		t.Errorf("PopHeld returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("PopHeld over quota is %v, want nil", got)
+	}
+
+	length, err := _redis.RouteLength(ctx, pool.HoldRoute("github"))
+	if err != nil {
+		t.Errorf("unable to get queue length: %v", err)
+	}
+
+	if length != 1 {
+		t.Errorf("held builds are %d, want 1", length)
+	}
+}
+
+func TestRedis_Pop_HoldUnreserved(t *testing.T) {
+	// setup types
+	ctx := context.Background()
+
+	pool := testPool()
+
+	ps := new(settings.Platform)
+	ps.Queue = new(settings.Queue)
+	ps.SetRoutes([]string{"vela", "secure"})
+	ps.SetPools([]settings.WorkerPool{*pool})
+
+	item := testHeldItem(10)
+
+	bytes, err := json.Marshal(item)
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "secure")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	_redis.SetSettings(ps)
+	_redis.config.Timeout = 1 * time.Second
+
+	// the org holds every slot of its quota
+	for _, build := range []int64{1, 2} {
+		_, err = _redis.Reserve(ctx, pool, "github", build)
+		if err != nil {
+			t.Errorf("unable to reserve slot: %v", err)
+		}
+	}
+
+	err = _redis.Push(ctx, "secure", bytes)
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	// the build popped without a slot is held
+	got, err := _redis.Pop(ctx, []string{"secure"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop without a slot is %v, want nil", got)
+	}
+
+	length, err := _redis.RouteLength(ctx, pool.HoldRoute("github"))
+	if err != nil {
+		t.Errorf("unable to get queue length: %v", err)
+	}
+
+	if length != 1 {
+		t.Errorf("held builds are %d, want 1", length)
+	}
+
+	// the build popped with a slot reaches the worker
+	err = _redis.Release(ctx, pool, "github", 1)
+	if err != nil {
+		t.Errorf("Release returned err: %v", err)
+	}
+
+	held, err := _redis.PopHeld(ctx, pool, "github")
+	if err != nil || held == nil {
+		t.Errorf("unable to pop held build: %v", err)
+	}
+
+	err = _redis.Push(ctx, "secure", bytes)
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	got, err = _redis.Pop(ctx, []string{"secure"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if diff := cmp.Diff(item, got); diff != "" {
+		t.Errorf("Pop mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRedis_Pop_HoldUnreserved_OverlappingPools(t *testing.T) {
+	// setup types
+	ctx := context.Background()
+
+	pool := testPool()
+
+	quota := settings.PoolQuota{}
+	quota.SetOrg("github")
+	quota.SetLimit(1)
+
+	shared := new(settings.WorkerPool)
+	shared.SetName("shared")
+	shared.SetRoutes([]string{"vela", "secure"})
+	shared.SetQuotas([]settings.PoolQuota{quota})
+
+	ps := new(settings.Platform)
+	ps.Queue = new(settings.Queue)
+	ps.SetRoutes([]string{"vela", "secure"})
+	ps.SetPools([]settings.WorkerPool{*pool, *shared})
+
+	item := testHeldItem(10)
+
+	bytes, err := json.Marshal(item)
+	if err != nil {
+		t.Errorf("unable to marshal queue item: %v", err)
+	}
+
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela", "secure")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	_redis.SetSettings(ps)
+	_redis.config.Timeout = 1 * time.Second
+
+	// the org holds every slot of its quota in the shared pool
+	_, err = _redis.Reserve(ctx, shared, "github", 1)
+	if err != nil {
+		t.Errorf("unable to reserve slot: %v", err)
+	}
+
+	err = _redis.Push(ctx, "secure", bytes)
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	// the build is held in the shared pool
+	got, err := _redis.Pop(ctx, []string{"secure"})
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop without a slot is %v, want nil", got)
+	}
+
+	length, err := _redis.RouteLength(ctx, shared.HoldRoute("github"))
+	if err != nil {
+		t.Errorf("unable to get queue length: %v", err)
+	}
+
+	if length != 1 {
+		t.Errorf("held builds are %d, want 1", length)
+	}
+
+	// the slot reserved in the first pool is freed
+	reserved, err := _redis.Reserved(ctx, pool, "github")
+	if err != nil {
+		t.Errorf("Reserved returned err: %v", err)
+	}
+
+	if len(reserved) != 0 {
+		t.Errorf("Reserved is %v, want no builds", reserved)
+	}
+}
+
+// testPool is a test helper function to create a WorkerPool
+// type with a quota of two builds for the github org.
+func testPool() *settings.WorkerPool {
+	quota := settings.PoolQuota{}
+	quota.SetOrg("github")
+	quota.SetLimit(2)
+
+	pool := new(settings.WorkerPool)
+	pool.SetName("secure")
+	pool.SetRoutes([]string{"secure"})
+	pool.SetQuotas([]settings.PoolQuota{quota})
+
+	return pool
+}
+
+// testHeldItem is a test helper function to create
+// a queue item for a build of the github org.
+func testHeldItem(id int64) *models.Item {
+	b := new(api.Build)
+	b.SetID(id)
+	b.SetRepo(_build.GetRepo())
+
+	return &models.Item{Build: b, ItemVersion: models.ItemVersion}
+}
@@ -19,7 +19,10 @@ func (c *Client) Route(w *pipeline.Worker) (string, error) {
 	buf := bytes.Buffer{}
 
 	// if pipline does not specify route information return default
-	if w.Empty() {
+	//
+	// The worker labels do not decide the route, they restrict
+	// the workers allowed to poll the route the build is placed in.
+	if len(w.Flavor) == 0 && len(w.Platform) == 0 {
 		return constants.DefaultRoute, nil
 	}
 
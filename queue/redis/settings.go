@@ -15,5 +15,6 @@ func (c *Client) GetSettings() settings.Queue {
 func (c *Client) SetSettings(s *settings.Platform) {
 	if s != nil {
 		c.SetRoutes(s.GetRoutes())
+		c.SetPools(s.GetPools())
 	}
 }
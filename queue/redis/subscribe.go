@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+)
+
+// subscribeBuffer is the number of messages buffered for a subscriber.
+const subscribeBuffer = 100
+
+// Subscribe listens for the messages published to the channel.
+//
+// The returned channel is closed once the provided context is canceled.
+func (c *Client) Subscribe(ctx context.Context, channel string) (<-chan []byte, error) {
+	c.Logger.Tracef("subscribing to channel %s", channel)
+
+	// create a redis subscription to the channel
+	//
+	// https://pkg.go.dev/github.com/redis/go-redis/v9#Client.Subscribe
+	pubsub := c.Redis.Subscribe(ctx, channel)
+
+	// wait for the subscription to be confirmed so no
+	// messages published after returning are missed
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		_ = pubsub.Close()
+
+		return nil, err
+	}
+
+	messages := make(chan []byte, subscribeBuffer)
+
+	go func() {
+		defer close(messages)
+		defer pubsub.Close()
+
+		ch := pubsub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				select {
+				case messages <- []byte(msg.Payload):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return messages, nil
+}
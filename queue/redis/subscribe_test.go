@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRedis_Subscribe(t *testing.T) {
+	// setup redis mock
+	_redis, err := NewTest(_signingPrivateKey, _signingPublicKey, "vela")
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// run test
+	messages, err := _redis.Subscribe(ctx, "vela:logs")
+	if err != nil {
+		t.Fatalf("Subscribe returned err: %v", err)
+	}
+
+	for _, message := range []string{"foo", "bar"} {
+		err = _redis.Publish(context.Background(), "vela:logs", []byte(message))
+		if err != nil {
+			t.Errorf("Publish returned err: %v", err)
+		}
+	}
+
+	for _, want := range []string{"foo", "bar"} {
+		select {
+		case got := <-messages:
+			if string(got) != want {
+				t.Errorf("Subscribe is %s, want %s", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Subscribe did not receive %s", want)
+		}
+	}
+
+	cancel()
+
+	// ensure the channel is closed once the context is canceled
+	select {
+	case _, ok := <-messages:
+		if ok {
+			t.Errorf("Subscribe should have closed the channel")
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Subscribe did not close the channel")
+	}
+}
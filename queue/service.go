@@ -31,10 +31,39 @@ type Service interface {
 	// item off the queue.
 	Pop(context.Context, []string) (*models.Item, error)
 
+	// PopHeld defines a function that grabs the first
+	// item held over the quota of an org in a worker
+	// pool off the queue once it reserved a slot.
+	PopHeld(context.Context, *settings.WorkerPool, string) (*models.Item, error)
+
+	// Reserve defines a function that reserves a slot
+	// of the quota of an org in a worker pool for a build.
+	Reserve(context.Context, *settings.WorkerPool, string, int64) (bool, error)
+
+	// Release defines a function that frees the slot
+	// of the quota of an org in a worker pool held by a build.
+	Release(context.Context, *settings.WorkerPool, string, int64) error
+
+	// Reserved defines a function that lists the builds holding
+	// a slot of the quota of an org in a worker pool.
+	Reserved(context.Context, *settings.WorkerPool, string) ([]int64, error)
+
+	// Drain defines a function that sets whether
+	// a worker is refused items off the queue.
+	Drain(context.Context, string, bool) error
+
 	// Push defines a function that publishes an
 	// item to the specified route in the queue.
 	Push(context.Context, string, []byte) error
 
+	// Publish defines a function that broadcasts
+	// a message to the subscribers of a channel.
+	Publish(context.Context, string, []byte) error
+
+	// Subscribe defines a function that listens for
+	// the messages published to a channel.
+	Subscribe(context.Context, string) (<-chan []byte, error)
+
 	// Ping defines a function that checks the
 	// connection to the queue.
 	Ping(context.Context) error
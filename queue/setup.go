@@ -34,6 +34,8 @@ type Setup struct {
 	PrivateKey string
 	// public key in base64 used for opening items popped from the queue
 	PublicKey string
+	// specifies the hostname of the worker popping items from the queue
+	Hostname string
 }
 
 // Redis creates and returns a Vela service capable
@@ -52,6 +54,7 @@ func (s *Setup) Redis(ctx context.Context) (Service, error) {
 		redis.WithTimeout(s.Timeout),
 		redis.WithPrivateKey(s.PrivateKey),
 		redis.WithPublicKey(s.PublicKey),
+		redis.WithHostname(s.Hostname),
 	)
 }
 
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 )
@@ -35,6 +36,65 @@ func TestQueue_Setup_Redis(t *testing.T) {
 	}
 }
 
+func TestQueue_Setup_Redis_Draining(t *testing.T) {
+	// setup types
+	ctx := context.Background()
+
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	_setup := &Setup{
+		Driver:     "redis",
+		Address:    fmt.Sprintf("redis://%s", _redis.Addr()),
+		Routes:     []string{"foo"},
+		Cluster:    false,
+		Timeout:    1 * time.Second,
+		PrivateKey: "bOiFT7Y9e0jpOqaapTa3NzUkAve3VdRvyowgsY/vtlcK5L4RADOh9uTe1UVLdu3l/a0hvhiIkkLidUwVBhASWA==",
+		PublicKey:  "CuS+EQAzofbk3tVFS3bt5f2tIb4YiJJC4nVMFQYQElg=",
+		Hostname:   "worker_0",
+	}
+
+	queue, err := _setup.Redis(ctx)
+	if err != nil {
+		t.Errorf("Redis returned err: %v", err)
+	}
+
+	err = queue.Push(ctx, "foo", []byte(`{"build":{"id":1}}`))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	err = queue.Drain(ctx, "worker_0", true)
+	if err != nil {
+		t.Errorf("Drain returned err: %v", err)
+	}
+
+	// the draining worker is refused the queued item
+	got, err := queue.Pop(ctx, nil)
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop for draining worker is %v, want nil", got)
+	}
+
+	length, err := queue.Length(ctx)
+	if err != nil {
+		t.Errorf("Length returned err: %v", err)
+	}
+
+	if length != 1 {
+		t.Errorf("Length is %d, want 1", length)
+	}
+}
+
 func TestQueue_Setup_Kafka(t *testing.T) {
 	// setup types
 	_setup := &Setup{
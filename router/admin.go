@@ -12,11 +12,13 @@ import (
 // AdminHandlers is a function that extends the provided base router group
 // with the API handlers for admin functionality.
 //
+// GET    	 /api/v1/admin/artifacts/retention
 // GET    	 /api/v1/admin/builds/queue
 // PUT    	 /api/v1/admin/build
 // PUT    	 /api/v1/admin/clean
 // PUT    	 /api/v1/admin/deployment
 // PUT    	 /api/v1/admin/hook
+// POST   	 /api/v1/admin/log/offload
 // PUT    	 /api/v1/admin/repo
 // PUT    	 /api/v1/admin/secret
 // PUT    	 /api/v1/admin/service
@@ -30,6 +32,9 @@ func AdminHandlers(base *gin.RouterGroup) {
 	// Admin endpoints
 	_admin := base.Group("/admin", perm.MustPlatformAdmin())
 	{
+		// Admin artifact retention endpoint
+		_admin.GET("/artifacts/retention", admin.GetArtifactRetention)
+
 		// Admin build queue endpoint
 		_admin.GET("/builds/queue", admin.AllBuildsQueue)
 
@@ -42,6 +47,9 @@ func AdminHandlers(base *gin.RouterGroup) {
 		// Admin logs cleanup endpoint
 		_admin.DELETE("/log/cleanup", admin.CleanLogs)
 
+		// Admin logs offload endpoint
+		_admin.POST("/log/offload", admin.OffloadLogs)
+
 		// Admin deployment endpoint
 		_admin.PUT("/deployment", admin.UpdateDeployment)
 
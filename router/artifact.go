@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/server/api/artifact"
+	"github.com/go-vela/server/router/middleware"
+	amiddleware "github.com/go-vela/server/router/middleware/artifact"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// ArtifactHandlers is a function that extends the provided base router group
+// with the API handlers for artifact functionality.
+//
+// POST   /api/v1/repos/:org/:repo/builds/:build/artifacts
+// GET    /api/v1/repos/:org/:repo/builds/:build/artifacts
+// GET    /api/v1/repos/:org/:repo/builds/:build/artifacts/:artifact
+// GET    /api/v1/repos/:org/:repo/builds/:build/artifacts/:artifact/download-url .
+func ArtifactHandlers(base *gin.RouterGroup) {
+	// Artifacts endpoints
+	artifacts := base.Group("/artifacts")
+	{
+		artifacts.POST("", perm.MustBuildAccess(), middleware.Payload(), artifact.CreateArtifact)
+		artifacts.GET("", perm.MustRead(), artifact.ListArtifacts)
+
+		// Artifact endpoints
+		a := artifacts.Group("/:artifact", amiddleware.Establish())
+		{
+			a.GET("", perm.MustRead(), artifact.GetArtifact)
+			a.GET("/download-url", perm.MustRead(), artifact.GetArtifactDownloadURL)
+		} // end of artifact endpoints
+	} // end of artifacts endpoints
+}
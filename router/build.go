@@ -5,8 +5,10 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/go-vela/server/api/annotation"
 	"github.com/go-vela/server/api/build"
 	"github.com/go-vela/server/api/log"
+	"github.com/go-vela/server/api/testresult"
 	"github.com/go-vela/server/router/middleware"
 	bmiddleware "github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/perm"
@@ -23,6 +25,7 @@ import (
 // DELETE /api/v1/repos/:org/:repo/builds/:build
 // POST   /api/v1/repos/:org/:repo/builds/:build/approve
 // DELETE /api/v1/repos/:org/:repo/builds/:build/cancel
+// POST   /api/v1/repos/:org/:repo/builds/:build/children
 // GET    /api/v1/repos/:org/:repo/builds/:build/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/token
 // GET    /api/v1/repos/:org/:repo/builds/:build/executable
@@ -44,11 +47,18 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/annotations
 // GET    /api/v1/repos/:org/:repo/builds/:build/graph
 // GET    /api/v1/repos/:org/:repo/builds/:build/id_token
 // GET    /api/v1/repos/:org/:repo/builds/:build/id_request_token
 // GET    /api/v1/repos/:org/:repo/builds/:build/install_token
 // POST   /api/v1/repos/:org/:repo/builds/:build/install_token
+// POST   /api/v1/repos/:org/:repo/builds/:build/artifacts
+// GET    /api/v1/repos/:org/:repo/builds/:build/artifacts
+// GET    /api/v1/repos/:org/:repo/builds/:build/artifacts/:artifact
+// GET    /api/v1/repos/:org/:repo/builds/:build/artifacts/:artifact/download-url
+// GET    /api/v1/repos/:org/:repo/builds/:build/tests
+// GET    /api/v1/repos/:org/:repo/builds/:build/annotations
 // PUT   /api/v1/repos/:org/:repo/builds/:build/storage/:name/upload-url
 // GET   /api/v1/repos/:org/:repo/builds/:build/storage/ .
 func BuildHandlers(base *gin.RouterGroup) {
@@ -67,6 +77,7 @@ func BuildHandlers(base *gin.RouterGroup) {
 			b.DELETE("", perm.MustPlatformAdmin(), build.DeleteBuild)
 			b.POST("/approve", perm.MustAdmin(), build.ApproveBuild)
 			b.DELETE("/cancel", perm.MustWrite(), build.CancelBuild)
+			b.POST("/children", perm.MustBuildAccess(), build.CreateChildBuild)
 			b.GET("/logs", perm.MustRead(), log.ListLogsForBuild)
 			b.GET("/token", perm.MustWorkerAuthToken(), build.GetBuildToken)
 			b.GET("/id_token", perm.MustIDRequestToken(), build.GetIDToken)
@@ -75,6 +86,8 @@ func BuildHandlers(base *gin.RouterGroup) {
 			b.POST("/install_token", perm.MustBuildAccess(), build.PostInstallToken)
 			b.GET("/graph", perm.MustRead(), build.GetBuildGraph)
 			b.GET("/executable", perm.MustBuildAccess(), build.GetBuildExecutable)
+			b.GET("/tests", perm.MustRead(), testresult.ListTestResults)
+			b.GET("/annotations", perm.MustRead(), annotation.ListAnnotationsForBuild)
 
 			// Service endpoints
 			// * Log endpoints
@@ -84,6 +97,9 @@ func BuildHandlers(base *gin.RouterGroup) {
 			// * Log endpoints
 			StepHandlers(b)
 
+			// Artifact endpoints
+			ArtifactHandlers(b)
+
 			StorageHandlers(b)
 		} // end of build endpoints
 	} // end of builds endpoints
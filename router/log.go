@@ -15,6 +15,8 @@ import (
 // POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/stream
 // DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs .
 func LogServiceHandlers(base *gin.RouterGroup) {
 	// Logs endpoints
@@ -23,6 +25,8 @@ func LogServiceHandlers(base *gin.RouterGroup) {
 		logs.POST("", perm.MustAdmin(), log.CreateServiceLog)
 		logs.GET("", perm.MustRead(), log.GetServiceLog)
 		logs.PUT("", perm.MustBuildAccess(), log.UpdateServiceLog)
+		logs.POST("/chunks", perm.MustBuildAccess(), log.AppendServiceLog)
+		logs.GET("/stream", perm.MustRead(), log.StreamServiceLog)
 		logs.DELETE("", perm.MustPlatformAdmin(), log.DeleteServiceLog)
 	} // end of logs endpoints
 }
@@ -33,6 +37,8 @@ func LogServiceHandlers(base *gin.RouterGroup) {
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/chunks
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/stream
 // DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs .
 func LogStepHandlers(base *gin.RouterGroup) {
 	// Logs endpoints
@@ -41,6 +47,8 @@ func LogStepHandlers(base *gin.RouterGroup) {
 		logs.POST("", perm.MustAdmin(), log.CreateStepLog)
 		logs.GET("", perm.MustRead(), log.GetStepLog)
 		logs.PUT("", perm.MustBuildAccess(), log.UpdateStepLog)
+		logs.POST("/chunks", perm.MustBuildAccess(), log.AppendStepLog)
+		logs.GET("/stream", perm.MustRead(), log.StreamStepLog)
 		logs.DELETE("", perm.MustPlatformAdmin(), log.DeleteStepLog)
 	} // end of logs endpoints
 }
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/util"
+)
+
+// Retrieve gets the artifact in the given context.
+func Retrieve(c *gin.Context) *api.Artifact {
+	return FromContext(c)
+}
+
+// Establish sets the artifact in the given context.
+func Establish() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// capture middleware values
+		l := c.MustGet("logger").(*logrus.Entry)
+		b := build.Retrieve(c)
+		o := org.Retrieve(c)
+		r := repo.Retrieve(c)
+		ctx := c.Request.Context()
+
+		if r == nil {
+			retErr := fmt.Errorf("repo %s/%s not found", o, util.PathParameter(c, "repo"))
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		if b == nil {
+			retErr := fmt.Errorf("build %s not found for repo %s", util.PathParameter(c, "build"), r.GetFullName())
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		aParam := util.PathParameter(c, "artifact")
+		if len(aParam) == 0 {
+			retErr := fmt.Errorf("no artifact parameter provided")
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		l.Debugf("reading artifact %s", aParam)
+
+		a, err := database.FromContext(c).GetArtifactForBuild(ctx, b, aParam)
+		if err != nil {
+			retErr := fmt.Errorf("unable to read artifact %s/%d/%s: %w", r.GetFullName(), b.GetNumber(), aParam, err)
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		l = l.WithFields(logrus.Fields{
+			"artifact":    a.GetName(),
+			"artifact_id": a.GetID(),
+		})
+
+		// update the logger with the new fields
+		c.Set("logger", l)
+
+		ToContext(c, a)
+		c.Next()
+	}
+}
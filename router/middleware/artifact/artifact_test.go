@@ -0,0 +1,325 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+)
+
+func TestArtifact_Retrieve(t *testing.T) {
+	// setup types
+	want := new(api.Artifact)
+	want.SetID(1)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+	ToContext(context, want)
+
+	// run test
+	got := Retrieve(context)
+
+	if got != want {
+		t.Errorf("Retrieve is %v, want %v", got, want)
+	}
+}
+
+func TestArtifact_Establish(t *testing.T) {
+	// setup types
+	owner := new(api.User)
+	owner.SetID(1)
+
+	r := new(api.Repo)
+	r.SetID(1)
+	r.SetOwner(owner)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+
+	b := new(api.Build)
+	b.SetID(1)
+	b.SetRepo(r)
+	b.SetNumber(1)
+
+	want := new(api.Artifact)
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetName("coverage.out")
+	want.SetStep("test")
+	want.SetObjectPath("foo/bar/1/coverage.out")
+	want.SetSize(1024)
+	want.SetChecksum("")
+	want.SetContentType("text/plain")
+	want.SetCreatedAt(1)
+	want.SetExpiresAt(0)
+
+	got := new(api.Artifact)
+
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer func() {
+		_ = db.DeleteBuild(context.TODO(), b)
+		_ = db.DeleteRepo(context.TODO(), r)
+		_ = db.DeleteArtifact(context.TODO(), want)
+		db.Close()
+	}()
+
+	_, _ = db.CreateRepo(context.TODO(), r)
+	_, _ = db.CreateBuild(context.TODO(), b)
+	_, _ = db.CreateArtifact(context.TODO(), want)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodGet, "/foo/bar/builds/1/artifacts/coverage.out", nil)
+
+	// setup mock server
+	engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logrus.StandardLogger())) })
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(org.Establish())
+	engine.Use(repo.Establish())
+	engine.Use(build.Establish())
+	engine.Use(Establish())
+	engine.GET("/:org/:repo/builds/:build/artifacts/:artifact", func(c *gin.Context) {
+		got = Retrieve(c)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("Establish returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Establish is %v, want %v", got, want)
+	}
+}
+
+func TestArtifact_Establish_NoRepo(t *testing.T) {
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+	defer db.Close()
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodGet, "/foo/bar/builds/1/artifacts/coverage.out", nil)
+
+	// setup mock server
+	engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logrus.StandardLogger())) })
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(Establish())
+	engine.GET("/:org/:repo/builds/:build/artifacts/:artifact", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("Establish returned %v, want %v", resp.Code, http.StatusNotFound)
+	}
+}
+
+func TestArtifact_Establish_NoBuild(t *testing.T) {
+	// setup types
+	owner := new(api.User)
+	owner.SetID(1)
+
+	r := new(api.Repo)
+	r.SetID(1)
+	r.SetOwner(owner)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer func() {
+		_ = db.DeleteRepo(context.TODO(), r)
+		db.Close()
+	}()
+
+	_, _ = db.CreateRepo(context.TODO(), r)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodGet, "/foo/bar/builds/1/artifacts/coverage.out", nil)
+
+	// setup mock server
+	engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logrus.StandardLogger())) })
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(org.Establish())
+	engine.Use(repo.Establish())
+	engine.Use(Establish())
+	engine.GET("/:org/:repo/builds/:build/artifacts/:artifact", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("Establish returned %v, want %v", resp.Code, http.StatusNotFound)
+	}
+}
+
+func TestArtifact_Establish_NoArtifactParameter(t *testing.T) {
+	// setup types
+	owner := new(api.User)
+	owner.SetID(1)
+
+	r := new(api.Repo)
+	r.SetID(1)
+	r.SetOwner(owner)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+
+	b := new(api.Build)
+	b.SetID(1)
+	b.SetRepo(r)
+	b.SetNumber(1)
+
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer func() {
+		_ = db.DeleteBuild(context.TODO(), b)
+		_ = db.DeleteRepo(context.TODO(), r)
+		db.Close()
+	}()
+
+	_, _ = db.CreateRepo(context.TODO(), r)
+	_, _ = db.CreateBuild(context.TODO(), b)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodGet, "/foo/bar/builds/1/artifacts", nil)
+
+	// setup mock server
+	engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logrus.StandardLogger())) })
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(org.Establish())
+	engine.Use(repo.Establish())
+	engine.Use(build.Establish())
+	engine.Use(Establish())
+	engine.GET("/:org/:repo/builds/:build/artifacts", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusBadRequest {
+		t.Errorf("Establish returned %v, want %v", resp.Code, http.StatusBadRequest)
+	}
+}
+
+func TestArtifact_Establish_NoArtifact(t *testing.T) {
+	// setup types
+	owner := new(api.User)
+	owner.SetID(1)
+
+	r := new(api.Repo)
+	r.SetID(1)
+	r.SetOwner(owner)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+
+	b := new(api.Build)
+	b.SetID(1)
+	b.SetRepo(r)
+	b.SetNumber(1)
+
+	// setup database
+	db, err := database.NewTest()
+	if err != nil {
+		t.Errorf("unable to create test database engine: %v", err)
+	}
+
+	defer func() {
+		_ = db.DeleteBuild(context.TODO(), b)
+		_ = db.DeleteRepo(context.TODO(), r)
+		db.Close()
+	}()
+
+	_, _ = db.CreateRepo(context.TODO(), r)
+	_, _ = db.CreateBuild(context.TODO(), b)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodGet, "/foo/bar/builds/1/artifacts/coverage.out", nil)
+
+	// setup mock server
+	engine.Use(func(c *gin.Context) { c.Set("logger", logrus.NewEntry(logrus.StandardLogger())) })
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(org.Establish())
+	engine.Use(repo.Establish())
+	engine.Use(build.Establish())
+	engine.Use(Establish())
+	engine.GET("/:org/:repo/builds/:build/artifacts/:artifact", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusNotFound {
+		t.Errorf("Establish returned %v, want %v", resp.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package step provides the ability for inserting
+// Vela artifact resources into or extracting Vela artifact
+// resources from the middleware chain for the API.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/router/middleware/artifact"
+package artifact
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ArtifactRetention is a middleware function that attaches the duration
+// artifacts are kept for to determine when uploaded artifacts expire.
+func ArtifactRetention(retention time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("artifact-retention", retention)
+		c.Next()
+	}
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddleware_ArtifactRetention(t *testing.T) {
+	// setup types
+	var got time.Duration
+
+	want := 720 * time.Hour
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequestWithContext(t.Context(), http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(ArtifactRetention(want))
+	engine.GET("/health", func(c *gin.Context) {
+		got = c.Value("artifact-retention").(time.Duration)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("ArtifactRetention returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ArtifactRetention is %v, want %v", got, want)
+	}
+}
@@ -46,6 +46,17 @@ func TestMiddleware_CompilerNative(t *testing.T) {
 			Reason: new("this image is deprecated"),
 		},
 	})
+	want.SetHTTPSTemplateAllowlist([]string{"templates.example.com"})
+	want.SetOCITemplateAllowlist([]string{"ghcr.io"})
+	want.SetWorkerFlavors([]string{"large"})
+	want.SetPolicyRules([]settings.PolicyRule{
+		{
+			Name:   new("no-privileged"),
+			Rule:   new("repo.trusted || none(containers, .privileged)"),
+			Action: new("block"),
+			Reason: new("privileged containers require a trusted repo"),
+		},
+	})
 
 	var got compiler.Engine
 
@@ -78,6 +89,10 @@ func TestMiddleware_CompilerNative(t *testing.T) {
 			s.SetCloneImage(wantCloneImage)
 			s.SetBlockedImages(*want.BlockedImages)
 			s.SetWarnImages(*want.WarnImages)
+			s.SetHTTPSTemplateAllowlist(want.GetHTTPSTemplateAllowlist())
+			s.SetOCITemplateAllowlist(want.GetOCITemplateAllowlist())
+			s.SetPolicyRules(want.GetPolicyRules())
+			s.SetWorkerFlavors(want.GetWorkerFlavors())
 
 			sMiddleware.ToContext(c, &s)
 
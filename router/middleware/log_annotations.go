@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// LogAnnotations is a middleware function that attaches the flag to
+// determine if the logs of finished steps are parsed for annotations.
+func LogAnnotations(annotate bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("log-annotations", annotate)
+		c.Next()
+	}
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// LogAnnotationsSCM is a middleware function that attaches the flag to
+// determine if the annotations for finished steps are sent to the scm.
+func LogAnnotationsSCM(send bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("log-annotations-scm", send)
+		c.Next()
+	}
+}
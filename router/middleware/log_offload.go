@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// LogOffload is a middleware function that attaches the flag to
+// determine if the logs of finished builds are moved to the storage.
+func LogOffload(offload bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("log-offload", offload)
+		c.Next()
+	}
+}
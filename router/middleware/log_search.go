@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// LogSearch is a middleware function that attaches the flag to
+// determine if the logs of finished builds are indexed for search.
+func LogSearch(search bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("log-search", search)
+		c.Next()
+	}
+}
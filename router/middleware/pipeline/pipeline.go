@@ -74,7 +74,9 @@ func Establish() gin.HandlerFunc {
 				WithCommit(p).
 				WithMetadata(c.MustGet("metadata").(*internal.Metadata)).
 				WithBuild(b).
+				WithRepo(r).
 				WithUser(u).
+				WithSCM(scm.FromContext(c)).
 				Compile(ctx, config)
 			if err != nil {
 				retErr := fmt.Errorf("unable to compile pipeline configuration for %s: %w", entry, err)
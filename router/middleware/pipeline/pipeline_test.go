@@ -87,6 +87,7 @@ func TestPipeline_Establish(t *testing.T) {
 	want.SetID(1)
 	want.SetRepo(r)
 	want.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	want.SetGeneratedDigest("")
 	want.SetFlavor("")
 	want.SetPlatform("")
 	want.SetRef("refs/heads/main")
@@ -100,6 +101,7 @@ func TestPipeline_Establish(t *testing.T) {
 	want.SetTemplates(false)
 	want.SetArtifact(false)
 	want.SetWarnings([]string{})
+	want.SetResolvedTemplates(nil)
 	want.SetData([]byte{})
 
 	got := new(api.Pipeline)
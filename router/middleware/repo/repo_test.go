@@ -13,6 +13,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/database/testutils"
 	"github.com/go-vela/server/router/middleware/org"
@@ -70,6 +71,7 @@ func TestRepo_Establish(t *testing.T) {
 	want.SetPreviousName("")
 	want.SetApproveBuild("")
 	want.SetApprovalTimeout(7)
+	want.SetOrphanPolicy(constants.OrphanPolicyFail)
 	want.SetInstallID(0)
 	want.SetCustomProps(map[string]any{"foo": "bar"})
 
@@ -45,7 +45,10 @@ func TestWorker_Establish(t *testing.T) {
 	want.SetHostname("worker_0")
 	want.SetAddress("localhost")
 	want.SetRoutes([]string{"foo", "bar", "baz"})
+	want.SetLabels(map[string]string{"arch": "amd64"})
 	want.SetActive(true)
+	want.SetDraining(true)
+	want.SetDrainStartedAt(12345)
 	want.SetStatus("available")
 	want.SetLastStatusUpdateAt(12345)
 	want.SetRunningBuilds([]*api.Build{b})
@@ -23,6 +23,8 @@ import (
 // GET    /api/v1/pipelines/:org/:repo/:pipeline/templates
 // POST   /api/v1/pipelines/:org/:repo/:pipeline/expand
 // POST   /api/v1/pipelines/:org/:repo/:pipeline/compile
+// POST   /api/v1/pipelines/:org/:repo/:pipeline/diff
+// POST   /api/v1/pipelines/:org/:repo/:pipeline/simulate
 // POST   /api/v1/pipelines/:org/:repo/:pipeline/validate .
 func PipelineHandlers(base *gin.RouterGroup) {
 	// Pipelines endpoints
@@ -38,6 +40,8 @@ func PipelineHandlers(base *gin.RouterGroup) {
 			_pipeline.DELETE("", perm.MustPlatformAdmin(), pipeline.DeletePipeline)
 			_pipeline.GET("/templates", perm.MustRead(), pipeline.GetTemplates)
 			_pipeline.POST("/compile", perm.MustRead(), pipeline.CompilePipeline)
+			_pipeline.POST("/diff", perm.MustWrite(), pipeline.DiffPipeline)
+			_pipeline.POST("/simulate", perm.MustRead(), pipeline.SimulatePipeline)
 			_pipeline.POST("/expand", perm.MustRead(), pipeline.ExpandPipeline)
 			_pipeline.POST("/validate", perm.MustRead(), pipeline.ValidatePipeline)
 		} // end of pipeline endpoints
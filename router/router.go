@@ -36,6 +36,7 @@ import (
 	"github.com/go-vela/server/api/auth"
 	apiBuild "github.com/go-vela/server/api/build"
 	apiRepo "github.com/go-vela/server/api/repo"
+	apiStorage "github.com/go-vela/server/api/storage"
 	"github.com/go-vela/server/api/webhook"
 	"github.com/go-vela/server/router/middleware"
 	"github.com/go-vela/server/router/middleware/build"
@@ -43,6 +44,7 @@ import (
 	"github.com/go-vela/server/router/middleware/org"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/storage/filesystem"
 )
 
 const (
@@ -96,6 +98,12 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 	// Version endpoint
 	r.GET("/version", api.Version)
 
+	// Pipeline schema endpoint
+	//
+	// The schema exposes the compiler settings of the platform,
+	// so it is only available to authenticated users.
+	r.GET(base+"/schema/pipeline", claims.Establish(), user.Establish(), api.GetPipelineSchema)
+
 	// Webhook endpoint
 	r.POST("/webhook", webhook.PostWebhook)
 
@@ -103,6 +111,10 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 	r.GET("/_services/token/.well-known/openid-configuration", api.GetOpenIDConfig)
 	r.GET("/_services/token/.well-known/jwks", api.GetJWKS)
 
+	// Signed filesystem storage endpoints
+	r.GET(filesystem.PathPrefix+"/:bucket/*object", apiStorage.GetObject)
+	r.PUT(filesystem.PathPrefix+"/:bucket/*object", apiStorage.PutObject)
+
 	// Authentication endpoints
 	authenticate := r.Group("/authenticate")
 	{
@@ -6,12 +6,18 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/go-vela/server/api/build"
+	"github.com/go-vela/server/api/log"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+	"github.com/go-vela/server/router/middleware/repo"
 )
 
 // SearchHandlers is a function that extends the provided base router group
 // with the API handlers for resource search functionality.
 //
-// GET    /api/v1/search/builds/:id .
+// GET    /api/v1/search/builds/:id
+// GET    /api/v1/search/logs/:org
+// GET    /api/v1/search/logs/:org/:repo .
 func SearchHandlers(base *gin.RouterGroup) {
 	// Search endpoints
 	search := base.Group("/search")
@@ -21,5 +27,12 @@ func SearchHandlers(base *gin.RouterGroup) {
 		{
 			b.GET("/:id", build.GetBuildByID)
 		}
+
+		// Log endpoints
+		l := search.Group("/logs/:org", org.Establish())
+		{
+			l.GET("", log.SearchLogsForOrg)
+			l.GET("/:repo", repo.Establish(), perm.MustRead(), log.SearchLogsForRepo)
+		}
 	} // end of search endpoints
 }
@@ -5,6 +5,7 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/go-vela/server/api/annotation"
 	"github.com/go-vela/server/api/step"
 	"github.com/go-vela/server/router/middleware"
 	"github.com/go-vela/server/router/middleware/perm"
@@ -22,7 +23,8 @@ import (
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/annotations .
 func StepHandlers(base *gin.RouterGroup) {
 	// Steps endpoints
 	steps := base.Group("/steps")
@@ -36,6 +38,7 @@ func StepHandlers(base *gin.RouterGroup) {
 			s.GET("", perm.MustRead(), step.GetStep)
 			s.PUT("", perm.MustBuildAccess(), middleware.Payload(), step.UpdateStep)
 			s.DELETE("", perm.MustPlatformAdmin(), step.DeleteStep)
+			s.GET("/annotations", perm.MustRead(), annotation.ListAnnotationsForStep)
 
 			// Log endpoints
 			LogStepHandlers(s)
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+
+	"github.com/go-vela/server/api/types/settings"
+)
+
+// imageDefinitions are the definitions with an image property restricted by the platform.
+var imageDefinitions = []string{"Step", "Service", "Origin"}
+
+// ApplySettings adds hints for the compiler settings of the platform to the
+// pipeline schema, so editors can flag configurations the platform rejects.
+//
+// Blocked images are excluded from the image properties, with exact images
+// rendered as an enum and glob patterns rendered as regular expressions.
+// When the platform restricts worker flavors, they are rendered as an enum.
+func ApplySettings(s *jsonschema.Schema, cs *settings.Compiler) {
+	if s == nil || s.Definitions == nil {
+		return
+	}
+
+	if blocked := cs.GetBlockedImages(); len(blocked) > 0 {
+		for _, name := range imageDefinitions {
+			def, ok := s.Definitions[name]
+			if !ok || def.Properties == nil {
+				continue
+			}
+
+			if image, ok := def.Properties.Get("image"); ok {
+				blockImages(image, blocked)
+			}
+		}
+	}
+
+	if flavors := cs.GetWorkerFlavors(); len(flavors) > 0 {
+		def, ok := s.Definitions["Worker"]
+		if !ok || def.Properties == nil {
+			return
+		}
+
+		if flavor, ok := def.Properties.Get("flavor"); ok {
+			flavor.Enum = []any{}
+
+			for _, f := range flavors {
+				flavor.Enum = append(flavor.Enum, f)
+			}
+		}
+	}
+}
+
+// blockImages is a helper function to exclude the blocked images from the property.
+func blockImages(image *jsonschema.Schema, blocked []settings.ImageRestriction) {
+	exact := []any{}
+	not := []*jsonschema.Schema{}
+	reasons := []string{}
+
+	for _, restriction := range blocked {
+		pattern := restriction.GetImage()
+		if len(pattern) == 0 {
+			continue
+		}
+
+		if strings.ContainsAny(pattern, `*?[\`) {
+			not = append(not, &jsonschema.Schema{Pattern: globToRegexp(pattern)})
+		} else {
+			exact = append(exact, pattern)
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s (%s)", pattern, restriction.GetReason()))
+	}
+
+	if len(exact) > 0 {
+		not = append(not, &jsonschema.Schema{Enum: exact})
+	}
+
+	if len(not) == 0 {
+		return
+	}
+
+	image.Not = &jsonschema.Schema{AnyOf: not}
+	image.Description = fmt.Sprintf("%s\nBlocked images: %s", image.Description, strings.Join(reasons, ", "))
+}
+
+// globToRegexp is a helper function to convert the glob pattern
+// used to match images into an anchored regular expression.
+func globToRegexp(pattern string) string {
+	var sb strings.Builder
+
+	sb.WriteString("^")
+
+	inClass := false
+
+	for i := 0; i < len(pattern); i++ {
+		ch := pattern[i]
+
+		switch {
+		case inClass:
+			if ch == ']' {
+				inClass = false
+			}
+
+			sb.WriteByte(ch)
+		case ch == '*':
+			sb.WriteString("[^/]*")
+		case ch == '?':
+			sb.WriteString("[^/]")
+		case ch == '[':
+			inClass = true
+
+			sb.WriteByte(ch)
+		case ch == '\\' && i+1 < len(pattern):
+			i++
+
+			sb.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	return sb.String()
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-vela/server/api/types/settings"
+)
+
+func TestSchema_ApplySettings(t *testing.T) {
+	// setup types
+	cs := new(settings.Compiler)
+	cs.SetBlockedImages([]settings.ImageRestriction{
+		{Image: new("docker.io/blocked/*"), Reason: new("blocked org")},
+		{Image: new("alpine:3.10"), Reason: new("end of life")},
+	})
+	cs.SetWorkerFlavors([]string{"small", "large"})
+
+	s, err := NewPipelineSchema()
+	if err != nil {
+		t.Fatalf("NewPipelineSchema returned err: %v", err)
+	}
+
+	// run test
+	ApplySettings(s, cs)
+
+	for _, name := range imageDefinitions {
+		image, _ := s.Definitions[name].Properties.Get("image")
+
+		if image.Not == nil || len(image.Not.AnyOf) != 2 {
+			t.Fatalf("%s image is %v, want blocked images", name, image.Not)
+		}
+
+		if diff := cmp.Diff("^docker\\.io/blocked/[^/]*$", image.Not.AnyOf[0].Pattern); diff != "" {
+			t.Errorf("%s image pattern mismatch (-want +got):\n%s", name, diff)
+		}
+
+		if diff := cmp.Diff([]any{"alpine:3.10"}, image.Not.AnyOf[1].Enum); diff != "" {
+			t.Errorf("%s image enum mismatch (-want +got):\n%s", name, diff)
+		}
+
+		if !strings.Contains(image.Description, "alpine:3.10 (end of life)") {
+			t.Errorf("%s image description is %s, want blocked images", name, image.Description)
+		}
+	}
+
+	flavor, _ := s.Definitions["Worker"].Properties.Get("flavor")
+
+	if diff := cmp.Diff([]any{"small", "large"}, flavor.Enum); diff != "" {
+		t.Errorf("flavor enum mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSchema_globToRegexp(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		pattern string
+		image   string
+		want    bool
+	}{
+		{pattern: "docker.io/blocked/*", image: "docker.io/blocked/image:latest", want: true},
+		{pattern: "docker.io/blocked/*", image: "docker.io/blocked/nested/image", want: false},
+		{pattern: "alpine:3.1?", image: "alpine:3.10", want: true},
+		{pattern: "alpine:[0-2].*", image: "alpine:3.10", want: false},
+		{pattern: "alpine:[0-2].*", image: "alpine:2.7", want: true},
+		{pattern: "docker.io/library/alpine", image: "docker.ioXlibrary/alpine", want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := regexp.MustCompile(globToRegexp(test.pattern)).MatchString(test.image)
+
+		if got != test.want {
+			t.Errorf("globToRegexp(%s) match %s is %v, want %v", test.pattern, test.image, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/sirupsen/logrus"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+const (
+	// maxCheckRunAnnotations is the maximum number of
+	// annotations GitHub accepts in a single check run request.
+	maxCheckRunAnnotations = 50
+
+	// Below are github check run annotation level constants.
+	AnnotationLevelNotice  = "notice"
+	AnnotationLevelWarning = "warning"
+	AnnotationLevelFailure = "failure"
+)
+
+// Annotate sends the annotations detected for a step
+// as a check run for the given SHA from the GitHub repo.
+//
+// Check runs can only be created by a GitHub App, so the annotations
+// are only sent for repos with an installation. Annotations without
+// a file and line are not sent, since they can not be shown on a file.
+func (c *Client) Annotate(ctx context.Context, b *api.Build, s *api.Step, annotations []*api.Annotation) error {
+	c.Logger.WithFields(logrus.Fields{
+		"step":  s.GetName(),
+		"build": b.GetNumber(),
+		"org":   b.GetRepo().GetOrg(),
+		"repo":  b.GetRepo().GetName(),
+	}).Tracef("sending annotations for %s/%s/%d @ %s", b.GetRepo().GetOrg(), b.GetRepo().GetName(), b.GetNumber(), b.GetCommit())
+
+	// no check runs without an installation or on deployments
+	if c.AppClient == nil || b.GetRepo().GetInstallID() == 0 || strings.EqualFold(b.GetEvent(), constants.EventDeploy) {
+		return nil
+	}
+
+	checkAnnotations := []*github.CheckRunAnnotation{}
+
+	for _, a := range annotations {
+		if len(a.GetFile()) == 0 || a.GetLine() <= 0 {
+			continue
+		}
+
+		checkAnnotations = append(checkAnnotations, checkRunAnnotation(b.GetRepo(), a))
+	}
+
+	if len(checkAnnotations) == 0 {
+		return nil
+	}
+
+	tknPerms := map[string]string{AppInstallResourceChecks: constants.PermissionWrite}
+
+	installTkn, err := c.NewAppInstallationToken(ctx, b.GetRepo().GetInstallID(), []string{b.GetRepo().GetName()}, tknPerms)
+	if err != nil {
+		return fmt.Errorf("unable to generate installation token for check run: %w", err)
+	}
+
+	// create GitHub OAuth client with the installation token
+	client := c.newOAuthTokenClient(ctx, installTkn.Token)
+
+	_, _, url := parseCommitStatus(s.GetStatus(), c.config.WebUIAddress, b.GetRepo().GetFullName(), b.GetNumber(), s.GetNumber())
+
+	name := fmt.Sprintf("%s/%s/%s", c.config.StatusContext, b.GetEvent(), s.GetName())
+	title := fmt.Sprintf("%d annotations", len(checkAnnotations))
+	summary := fmt.Sprintf("annotations detected in the log for step %s", s.GetName())
+
+	// output is a helper function to create the output with a batch of annotations
+	output := func(batch []*github.CheckRunAnnotation) *github.CheckRunOutput {
+		return &github.CheckRunOutput{
+			Title:       new(title),
+			Summary:     new(summary),
+			Annotations: batch,
+		}
+	}
+
+	first := checkAnnotations[:min(len(checkAnnotations), maxCheckRunAnnotations)]
+
+	opts := github.CreateCheckRunOptions{
+		Name:       name,
+		HeadSHA:    b.GetCommit(),
+		Status:     new(StateCompleted),
+		Conclusion: new(checkRunConclusion(s.GetStatus())),
+		Output:     output(first),
+	}
+
+	// provide "Details" link in GitHub UI if server was configured with it
+	if len(c.config.WebUIAddress) > 0 {
+		opts.DetailsURL = new(url)
+	}
+
+	// send API call to create the check run for the commit
+	run, _, err := client.Checks.CreateCheckRun(ctx, b.GetRepo().GetOrg(), b.GetRepo().GetName(), opts)
+	if err != nil {
+		return err
+	}
+
+	// send the remaining annotations in batches, since
+	// GitHub appends the annotations of every update
+	for i := maxCheckRunAnnotations; i < len(checkAnnotations); i += maxCheckRunAnnotations {
+		batch := checkAnnotations[i:min(len(checkAnnotations), i+maxCheckRunAnnotations)]
+
+		_, _, err = client.Checks.UpdateCheckRun(ctx, b.GetRepo().GetOrg(), b.GetRepo().GetName(), run.GetID(), github.UpdateCheckRunOptions{
+			Name:   name,
+			Output: output(batch),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRunAnnotation is a helper function to convert
+// an annotation to a GitHub check run annotation.
+func checkRunAnnotation(r *api.Repo, a *api.Annotation) *github.CheckRunAnnotation {
+	level := AnnotationLevelFailure
+
+	switch a.GetSeverity() {
+	case constants.AnnotationWarning:
+		level = AnnotationLevelWarning
+	case constants.AnnotationNotice:
+		level = AnnotationLevelNotice
+	}
+
+	annotation := &github.CheckRunAnnotation{
+		Path:            new(repoPath(r, a.GetFile())),
+		StartLine:       new(int(a.GetLine())),
+		EndLine:         new(int(a.GetLine())),
+		AnnotationLevel: new(level),
+		Message:         new(a.GetMessage()),
+	}
+
+	// columns are only accepted for annotations on a single line
+	if a.GetColumn() > 0 {
+		annotation.StartColumn = new(int(a.GetColumn()))
+		annotation.EndColumn = new(int(a.GetColumn()))
+	}
+
+	return annotation
+}
+
+// repoPath is a helper function to make the path of a file
+// detected in a log relative to the root of the repo, removing
+// the workspace the repo was cloned to from absolute paths.
+//
+// pattern: /vela/src/<host>/<org>/<repo>/<path>
+func repoPath(r *api.Repo, file string) string {
+	workspace := fmt.Sprintf("/%s/%s/", r.GetOrg(), r.GetName())
+
+	if strings.HasPrefix(file, "/") {
+		if _, path, ok := strings.Cut(file, workspace); ok {
+			file = path
+		}
+	}
+
+	return strings.TrimPrefix(file, "./")
+}
+
+// checkRunConclusion is a helper function to determine
+// the conclusion of a check run for the status of a step.
+func checkRunConclusion(status string) string {
+	switch status {
+	case constants.StatusSuccess:
+		return StateSuccess
+	case constants.StatusCanceled:
+		return StateCancelled
+	case constants.StatusSkipped:
+		return StateSkipped
+	default:
+		return StateFailure
+	}
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-github/v84/github"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/constants"
+)
+
+func TestGithub_Annotate(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// capture the annotations sent to the mock server
+	var (
+		created []*github.CheckRunAnnotation
+		updated []*github.CheckRunAnnotation
+	)
+
+	// setup mock server
+	engine.POST("/api/v3/app/installations/:id/access_tokens", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/installations_access_tokens.json")
+	})
+	engine.POST("/api/v3/repos/:org/:repo/check-runs", func(c *gin.Context) {
+		opts := new(github.CreateCheckRunOptions)
+		_ = json.NewDecoder(c.Request.Body).Decode(opts)
+
+		created = append(created, opts.Output.Annotations...)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/check_run.json")
+	})
+	engine.PATCH("/api/v3/repos/:org/:repo/check-runs/:id", func(c *gin.Context) {
+		opts := new(github.UpdateCheckRunOptions)
+		_ = json.NewDecoder(c.Request.Body).Decode(opts)
+
+		updated = append(updated, opts.Output.Annotations...)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/check_run.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	r := new(api.Repo)
+	r.SetID(1)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetInstallID(1)
+
+	b := new(api.Build)
+	b.SetID(1)
+	b.SetRepo(r)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusFailure)
+	b.SetCommit("abcd1234")
+
+	step := new(api.Step)
+	step.SetID(1)
+	step.SetNumber(1)
+	step.SetName("test")
+	step.SetStatus(constants.StatusFailure)
+
+	annotations := []*api.Annotation{}
+
+	for i := range maxCheckRunAnnotations + 5 {
+		a := new(api.Annotation)
+		a.SetFile(fmt.Sprintf("/vela/src/github.com/foo/bar/pkg/file_%d.go", i))
+		a.SetLine(12)
+		a.SetColumn(3)
+		a.SetSeverity(constants.AnnotationError)
+		a.SetMessage("undefined: foo")
+
+		annotations = append(annotations, a)
+	}
+
+	// annotations without a file are not sent
+	annotation := new(api.Annotation)
+	annotation.SetSeverity(constants.AnnotationNotice)
+	annotation.SetMessage("deploy skipped")
+
+	annotations = append(annotations, annotation)
+
+	client, _ := NewTest(s.URL)
+	client.AppClient = NewTestAppClient(s.URL)
+
+	// run test
+	err := client.Annotate(t.Context(), b, step, annotations)
+	if err != nil {
+		t.Errorf("Annotate returned err: %v", err)
+	}
+
+	if len(created) != maxCheckRunAnnotations {
+		t.Errorf("Annotate created check run with %d annotations, want %d", len(created), maxCheckRunAnnotations)
+	}
+
+	if len(updated) != 5 {
+		t.Errorf("Annotate updated check run with %d annotations, want %d", len(updated), 5)
+	}
+
+	want := &github.CheckRunAnnotation{
+		Path:            new("pkg/file_0.go"),
+		StartLine:       new(12),
+		EndLine:         new(12),
+		StartColumn:     new(3),
+		EndColumn:       new(3),
+		AnnotationLevel: new(AnnotationLevelFailure),
+		Message:         new("undefined: foo"),
+	}
+
+	if len(created) > 0 && !reflect.DeepEqual(created[0], want) {
+		t.Errorf("Annotate sent %v, want %v", created[0], want)
+	}
+}
+
+func TestGithub_Annotate_NoInstallation(t *testing.T) {
+	// setup types
+	r := new(api.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	b := new(api.Build)
+	b.SetRepo(r)
+	b.SetEvent(constants.EventPush)
+
+	a := new(api.Annotation)
+	a.SetFile("main.go")
+	a.SetLine(12)
+	a.SetSeverity(constants.AnnotationError)
+	a.SetMessage("undefined: foo")
+
+	// the client would fail any request, since no server is running
+	client, _ := NewTest("http://127.0.0.1:0")
+
+	// run test
+	err := client.Annotate(t.Context(), b, new(api.Step), []*api.Annotation{a})
+	if err != nil {
+		t.Errorf("Annotate returned err: %v", err)
+	}
+}
+
+func TestGithub_repoPath(t *testing.T) {
+	// setup types
+	r := new(api.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	// setup tests
+	tests := []struct {
+		file string
+		want string
+	}{
+		{
+			file: "/vela/src/github.com/foo/bar/cmd/main.go",
+			want: "cmd/main.go",
+		},
+		{
+			file: "./cmd/main.go",
+			want: "cmd/main.go",
+		},
+		{
+			file: "cmd/main.go",
+			want: "cmd/main.go",
+		},
+		{
+			file: "/usr/local/go/src/fmt/print.go",
+			want: "/usr/local/go/src/fmt/print.go",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := repoPath(r, test.file)
+
+		if got != test.want {
+			t.Errorf("repoPath for %s is %v, want %v", test.file, got, test.want)
+		}
+	}
+}
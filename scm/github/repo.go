@@ -5,6 +5,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"net/http"
 	"slices"
 	"strings"
@@ -73,6 +74,11 @@ func (c *Client) Config(ctx context.Context, u *api.User, r *api.Repo, ref, toke
 		files = append([]string{".vela.star", ".vela.py"}, files...)
 	}
 
+	// jsonnet support - prefer .jsonnet, use default as fallback
+	if strings.EqualFold(r.GetPipelineType(), constants.PipelineTypeJsonnet) {
+		files = append([]string{".vela.jsonnet"}, files...)
+	}
+
 	// set the reference for the options to capture the pipeline configuration
 	opts := &github.RepositoryContentGetOptions{
 		Ref: ref,
@@ -101,6 +107,52 @@ func (c *Client) Config(ctx context.Context, u *api.User, r *api.Repo, ref, toke
 	return nil, fmt.Errorf("no valid pipeline configuration file (%s) found", strings.Join(files, ","))
 }
 
+// File captures the contents of a file from a repo at the reference.
+// The error wraps fs.ErrNotExist when the file does not exist.
+func (c *Client) File(ctx context.Context, u *api.User, r *api.Repo, path, ref, token string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing file %s for %s/commit/%s", path, r.GetFullName(), ref)
+
+	// create GitHub OAuth client
+	var client *github.Client
+
+	if token == "" {
+		client = c.newOAuthTokenClient(ctx, u.GetToken())
+	} else {
+		client = c.newOAuthTokenClient(ctx, token)
+	}
+
+	// set the reference for the options to capture the file
+	opts := &github.RepositoryContentGetOptions{
+		Ref: ref,
+	}
+
+	// send API call to capture the file
+	data, _, resp, err := client.Repositories.GetContents(ctx, r.GetOrg(), r.GetName(), path, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("file %s not found in %s: %w", path, r.GetFullName(), fs.ErrNotExist)
+		}
+
+		return nil, err
+	}
+
+	// data is nil when the path is a directory
+	if data == nil {
+		return nil, fmt.Errorf("path %s in %s is not a file: %w", path, r.GetFullName(), fs.ErrNotExist)
+	}
+
+	content, err := data.GetContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
+
 // Disable deactivates a repo by deleting the webhook.
 func (c *Client) Disable(ctx context.Context, u *api.User, org, name string) error {
 	return c.DestroyWebhook(ctx, u, org, name)
@@ -443,6 +495,41 @@ func (c *Client) GetPullRequest(ctx context.Context, r *api.Repo, number int, to
 	return commit, branch, baseref, headref, nil
 }
 
+// CommentPullRequest posts a comment on a pull request for a GitHub repo
+// using an installation token, or the repo owner's token for repos
+// without the GitHub App installed.
+func (c *Client) CommentPullRequest(ctx context.Context, r *api.Repo, number int, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": r.GetOwner().GetName(),
+	}).Tracef("commenting on pull request %d for repo %s", number, r.GetFullName())
+
+	token := r.GetOwner().GetToken()
+
+	// comment as the app for repos with an installation
+	if c.AppClient != nil && r.GetInstallID() != 0 {
+		tknPerms := map[string]string{AppInstallResourcePullRequests: constants.PermissionWrite}
+
+		installTkn, err := c.NewAppInstallationToken(ctx, r.GetInstallID(), []string{r.GetName()}, tknPerms)
+		if err != nil {
+			return fmt.Errorf("unable to generate installation token for pull request comment: %w", err)
+		}
+
+		token = installTkn.Token
+	}
+
+	// create GitHub OAuth client with the installation or repo owner's token
+	client := c.newOAuthTokenClient(ctx, token)
+
+	// pull requests are commented on through the issues API
+	_, _, err := client.Issues.CreateComment(ctx, r.GetOrg(), r.GetName(), number, &github.IssueComment{
+		Body: github.Ptr(body),
+	})
+
+	return err
+}
+
 // GetHTMLURL retrieves the html_url from repository contents from the GitHub repo.
 func (c *Client) GetHTMLURL(ctx context.Context, u *api.User, org, repo, name, ref string) (string, error) {
 	c.Logger.WithFields(logrus.Fields{
@@ -3,7 +3,9 @@
 package github
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -247,6 +249,62 @@ func TestGithub_Config_Star(t *testing.T) {
 	}
 }
 
+func TestGithub_Config_Jsonnet(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/contents/:path", func(c *gin.Context) {
+		if c.Param("path") == ".vela.jsonnet" {
+			c.Header("Content-Type", "application/json")
+			c.Status(http.StatusOK)
+			c.File("testdata/jsonnet.json")
+
+			return
+		}
+
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	want, err := os.ReadFile("testdata/pipeline.jsonnet")
+	if err != nil {
+		t.Errorf("Config reading file returned err: %v", err)
+	}
+
+	// setup types
+	u := new(api.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(api.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetPipelineType(constants.PipelineTypeJsonnet)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(t.Context(), u, r, "", "bar")
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("Config returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", got, want)
+	}
+}
+
 func TestGithub_Config_Star_Prefer(t *testing.T) {
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -586,6 +644,67 @@ func TestGithub_Config_BadEncoding(t *testing.T) {
 	}
 }
 
+func TestGithub_File(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/contents/:path", func(c *gin.Context) {
+		switch c.Param("path") {
+		case ".vela.yml":
+			c.Header("Content-Type", "application/json")
+			c.Status(http.StatusOK)
+			c.File("testdata/yml.json")
+		case ".vela.lock":
+			c.Status(http.StatusNotFound)
+		default:
+			c.Status(http.StatusUnauthorized)
+		}
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("File reading file returned err: %v", err)
+	}
+
+	// setup types
+	u := new(api.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(api.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.File(t.Context(), u, r, ".vela.yml", "", "")
+	if err != nil {
+		t.Errorf("File returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("File is %v, want %v", got, want)
+	}
+
+	_, err = client.File(t.Context(), u, r, ".vela.lock", "", "")
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("File for missing file returned err %v, want %v", err, fs.ErrNotExist)
+	}
+
+	_, err = client.File(t.Context(), u, r, "private.yml", "", "")
+	if err == nil || errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("File for unauthorized fetch returned err %v, want non-not-found err", err)
+	}
+}
+
 func TestGithub_Disable(t *testing.T) {
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -1232,6 +1351,103 @@ func TestGithub_GetPullRequest(t *testing.T) {
 	}
 }
 
+func TestGithub_CommentPullRequest(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	var gotBody string
+
+	// setup mock server
+	engine.POST("/api/v3/repos/:owner/:repo/issues/:issue_number/comments", func(c *gin.Context) {
+		comment := new(github.IssueComment)
+
+		_ = c.BindJSON(comment)
+
+		gotBody = comment.GetBody()
+
+		c.JSON(http.StatusCreated, comment)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(api.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(api.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetOwner(u)
+
+	want := "pipeline changes"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.CommentPullRequest(t.Context(), r, 1, want)
+	if err != nil {
+		t.Errorf("CommentPullRequest returned err: %v", err)
+	}
+
+	if gotBody != want {
+		t.Errorf("CommentPullRequest body is %v, want %v", gotBody, want)
+	}
+}
+
+func TestGithub_CommentPullRequest_Installation(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	var gotAuth string
+
+	// setup mock server
+	engine.POST("/api/v3/app/installations/:id/access_tokens", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/installations_access_tokens.json")
+	})
+	engine.POST("/api/v3/repos/:owner/:repo/issues/:issue_number/comments", func(c *gin.Context) {
+		gotAuth = c.GetHeader("Authorization")
+
+		c.JSON(http.StatusCreated, new(github.IssueComment))
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(api.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(api.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetOwner(u)
+	r.SetInstallID(1)
+
+	client, _ := NewTest(s.URL)
+	client.AppClient = NewTestAppClient(s.URL)
+
+	// run test
+	err := client.CommentPullRequest(t.Context(), r, 1, "pipeline changes")
+	if err != nil {
+		t.Errorf("CommentPullRequest returned err: %v", err)
+	}
+
+	if len(gotAuth) == 0 || strings.HasSuffix(gotAuth, u.GetToken()) {
+		t.Errorf("CommentPullRequest authorization is %v, want installation token", gotAuth)
+	}
+}
+
 func TestGithub_GetBranch(t *testing.T) {
 	// setup context
 	gin.SetMode(gin.TestMode)
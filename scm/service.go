@@ -112,6 +112,9 @@ type Service interface {
 	// Retry again in five seconds if Config fails to retrieve yaml/yml file.
 	// Will return an error after five failed attempts.
 	ConfigBackoff(context.Context, *api.User, *api.Repo, string, string) ([]byte, error)
+	// File defines a function that captures
+	// the contents of a file from a repo.
+	File(context.Context, *api.User, *api.Repo, string, string, string) ([]byte, error)
 	// Disable defines a function that deactivates
 	// a repo by destroying the webhook.
 	Disable(context.Context, *api.User, string, string) error
@@ -127,6 +130,9 @@ type Service interface {
 	// StepStatus defines a function that sends the
 	// commit status for the given SHA for a specified step context.
 	StepStatus(context.Context, *api.Build, *api.Step, string) error
+	// Annotate defines a function that sends the annotations
+	// detected for a step as a check run for the given SHA.
+	Annotate(context.Context, *api.Build, *api.Step, []*api.Annotation) error
 	// ListUserRepos defines a function that retrieves
 	// all repos with admin rights for the user.
 	ListUserRepos(context.Context, *api.User) ([]string, error)
@@ -136,6 +142,9 @@ type Service interface {
 	// GetPullRequest defines a function that retrieves
 	// a pull request for a repo.
 	GetPullRequest(context.Context, *api.Repo, int, string) (string, string, string, string, error)
+	// CommentPullRequest defines a function that
+	// posts a comment on a pull request for a repo.
+	CommentPullRequest(context.Context, *api.Repo, int, string) error
 	// GetRepo defines a function that retrieves
 	// details for a repo.
 	GetRepo(context.Context, *api.User, *api.Repo) (*api.Repo, int, error)
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// DeleteObject removes an object from the local filesystem.
+func (c *Client) DeleteObject(_ context.Context, object *api.Object) error {
+	c.Logger.Tracef("deleting object %s from bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	path, err := c.path(object.Bucket.BucketName, object.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	// removing an object that does not exist is not an error
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("unable to delete object %s from bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filesystem provides the ability for Vela to
+// store objects on the local filesystem of the server.
+//
+// Presigned URLs generated by this driver point back to
+// the Vela server, which verifies the signature and
+// serves the object itself.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/storage/filesystem"
+package filesystem
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// config holds the configuration for the filesystem client.
+type config struct {
+	// specifies the root directory objects are stored in
+	Root string
+	// specifies the bucket (subdirectory of the root) objects are stored in
+	Bucket string
+	// specifies the address of the Vela server used in signed URLs
+	Address string
+	// specifies the key used to sign URLs
+	SigningKey string
+}
+
+// Client implements the Storage interface using the local filesystem.
+type Client struct {
+	config *config
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New creates a new filesystem client rooted at the provided directory.
+func New(root string, opts ...ClientOpt) (*Client, error) {
+	// create new filesystem client
+	c := new(Client)
+
+	// create new fields
+	c.config = new(config)
+
+	// create new logger for the client
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger).WithField("storage", "filesystem")
+
+	// check if the root directory provided is empty
+	if len(root) == 0 {
+		return nil, fmt.Errorf("no filesystem root directory provided")
+	}
+
+	c.config.Root = root
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create the bucket directory if it does not exist
+	err := os.MkdirAll(filepath.Join(c.config.Root, c.config.Bucket), 0o750)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create bucket directory: %w", err)
+	}
+
+	return c, nil
+}
+
+// NewTest returns a Storage implementation that
+// integrates with a temporary local directory.
+//
+// This function is intended for running tests only.
+func NewTest(root, address, bucket string) (*Client, error) {
+	return New(root,
+		WithBucket(bucket),
+		WithAddress(address),
+		WithSigningKey("filesystem-signing-key"),
+	)
+}
+
+// path is a helper function to safely resolve the
+// location of an object on the local filesystem.
+func (c *Client) path(bucket, object string) (string, error) {
+	if len(bucket) == 0 {
+		bucket = c.config.Bucket
+	}
+
+	if bucket != c.config.Bucket {
+		return "", fmt.Errorf("bucket %s does not exist", bucket)
+	}
+
+	// reject object names that escape the bucket directory
+	if len(object) == 0 || strings.Contains(object, `\`) || slices.Contains(strings.Split(object, "/"), "..") {
+		return "", fmt.Errorf("invalid object name %q", object)
+	}
+
+	cleaned := filepath.Clean("/" + object)
+	if cleaned == "/" {
+		return "", fmt.Errorf("invalid object name %q", object)
+	}
+
+	return filepath.Join(c.config.Root, bucket, filepath.FromSlash(cleaned)), nil
+}
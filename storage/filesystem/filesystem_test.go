@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFilesystem_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		root    string
+		bucket  string
+	}{
+		{
+			failure: false,
+			root:    t.TempDir(),
+			bucket:  "vela",
+		},
+		{
+			failure: true,
+			root:    "",
+			bucket:  "vela",
+		},
+		{
+			failure: true,
+			root:    t.TempDir(),
+			bucket:  "",
+		},
+		{
+			failure: true,
+			root:    t.TempDir(),
+			bucket:  "../vela",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := NewTest(test.root, "http://localhost:8080", test.bucket)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+
+		_, err = os.Stat(filepath.Join(test.root, test.bucket))
+		if err != nil {
+			t.Errorf("New did not create bucket directory: %v", err)
+		}
+	}
+}
+
+func TestFilesystem_path(t *testing.T) {
+	client, err := NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create filesystem client: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		bucket  string
+		object  string
+	}{
+		{name: "valid", failure: false, bucket: "vela", object: "octocat/hello-world/1/coverage.out"},
+		{name: "default bucket", failure: false, bucket: "", object: "octocat/hello-world/1/coverage.out"},
+		{name: "dots in name", failure: false, bucket: "vela", object: "octocat/hello-world/1/app..tar"},
+		{name: "unknown bucket", failure: true, bucket: "other", object: "octocat/hello-world/1/coverage.out"},
+		{name: "empty object", failure: true, bucket: "vela", object: ""},
+		{name: "parent traversal", failure: true, bucket: "vela", object: "../../etc/passwd"},
+		{name: "backslash", failure: true, bucket: "vela", object: `..\\etc\\passwd`},
+		{name: "root", failure: true, bucket: "vela", object: "/"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := client.path(test.bucket, test.object)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("path should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("path returned err: %v", err)
+			}
+		})
+	}
+}
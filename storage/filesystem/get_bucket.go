@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+// GetBucket returns the name of the bucket objects are stored in.
+func (c *Client) GetBucket() string {
+	return c.config.Bucket
+}
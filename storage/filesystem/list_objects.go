@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ListBuildObjectNames lists the names of objects in a bucket for a specific build.
+func (c *Client) ListBuildObjectNames(ctx context.Context, org, repo, build string) (map[string]string, error) {
+	objectsWithURLs := make(map[string]string)
+	// Construct the prefix path for filtering
+	prefix := org + "/" + repo + "/" + build + "/"
+
+	c.Logger.Tracef("listing object names in bucket %s with prefix %s", c.config.Bucket, prefix)
+
+	dir, err := c.path(c.config.Bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketDir := filepath.Join(c.config.Root, c.config.Bucket)
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(bucketDir, path)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+
+		// Generate presigned URL for each object
+		url, err := c.PresignedGetObject(ctx, &api.Object{
+			ObjectName: key,
+			Bucket:     api.Bucket{BucketName: c.config.Bucket},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to generate presigned URL for object %s: %w", key, err)
+		}
+
+		objectsWithURLs[key] = url
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	return objectsWithURLs, nil
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// OpenObject opens an object on the local filesystem for reading.
+func (c *Client) OpenObject(_ context.Context, object *api.Object) (io.ReadSeekCloser, error) {
+	c.Logger.Tracef("opening object %s from bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	path, err := c.path(object.Bucket.BucketName, object.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(path)
+}
+
+// GetObject opens an object on the local filesystem for reading.
+func (c *Client) GetObject(ctx context.Context, object *api.Object) (io.ReadCloser, error) {
+	f, err := c.OpenObject(ctx, object)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get object %s from bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return f, nil
+}
+
+// PutObject writes an object to the local filesystem.
+func (c *Client) PutObject(ctx context.Context, object *api.Object, r io.Reader) error {
+	err := c.WriteObject(ctx, object, r)
+	if err != nil {
+		return fmt.Errorf("unable to put object %s in bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return nil
+}
+
+// WriteObject writes an object to the local filesystem,
+// replacing any object that already exists with the same name.
+func (c *Client) WriteObject(_ context.Context, object *api.Object, r io.Reader) error {
+	c.Logger.Tracef("writing object %s to bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	path, err := c.path(object.Bucket.BucketName, object.ObjectName)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return fmt.Errorf("unable to create directory for object %s: %w", object.ObjectName, err)
+	}
+
+	// write to a temporary file first so readers never observe partial objects
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("unable to create object %s: %w", object.ObjectName, err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("unable to write object %s: %w", object.ObjectName, err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("unable to write object %s: %w", object.ObjectName, err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestFilesystem_Object_Lifecycle(t *testing.T) {
+	client, err := NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create filesystem client: %v", err)
+	}
+
+	object := &api.Object{
+		ObjectName: "octocat/hello-world/1/results.json",
+		Bucket:     api.Bucket{BucketName: client.GetBucket()},
+	}
+
+	// stat before write should fail
+	_, err = client.StatObject(t.Context(), object)
+	if err == nil {
+		t.Errorf("StatObject should have returned err")
+	}
+
+	// presigning a download for a missing object should fail
+	_, err = client.PresignedGetObject(t.Context(), object)
+	if err == nil {
+		t.Errorf("PresignedGetObject should have returned err")
+	}
+
+	err = client.PutObject(t.Context(), object, strings.NewReader(`{"foo":"bar"}`))
+	if err != nil {
+		t.Errorf("PutObject returned err: %v", err)
+	}
+
+	got, err := client.StatObject(t.Context(), object)
+	if err != nil {
+		t.Errorf("StatObject returned err: %v", err)
+	}
+
+	if got.Size != 13 {
+		t.Errorf("StatObject size is %d, want %d", got.Size, 13)
+	}
+
+	if got.ContentType != "application/json" {
+		t.Errorf("StatObject content type is %s, want %s", got.ContentType, "application/json")
+	}
+
+	f, err := client.OpenObject(t.Context(), object)
+	if err != nil {
+		t.Fatalf("OpenObject returned err: %v", err)
+	}
+
+	data, _ := io.ReadAll(f)
+	f.Close()
+
+	if string(data) != `{"foo":"bar"}` {
+		t.Errorf("OpenObject data is %s, want %s", data, `{"foo":"bar"}`)
+	}
+
+	r, err := client.GetObject(t.Context(), object)
+	if err != nil {
+		t.Fatalf("GetObject returned err: %v", err)
+	}
+
+	data, _ = io.ReadAll(r)
+	r.Close()
+
+	if string(data) != `{"foo":"bar"}` {
+		t.Errorf("GetObject data is %s, want %s", data, `{"foo":"bar"}`)
+	}
+
+	getURL, err := client.PresignedGetObject(t.Context(), object)
+	if err != nil {
+		t.Errorf("PresignedGetObject returned err: %v", err)
+	}
+
+	if !strings.HasPrefix(getURL, "http://localhost:8080/_storage/vela/octocat/hello-world/1/results.json?") {
+		t.Errorf("PresignedGetObject returned unexpected URL %s", getURL)
+	}
+
+	objects, err := client.ListBuildObjectNames(t.Context(), "octocat", "hello-world", "1")
+	if err != nil {
+		t.Errorf("ListBuildObjectNames returned err: %v", err)
+	}
+
+	if _, ok := objects[object.ObjectName]; !ok || len(objects) != 1 {
+		t.Errorf("ListBuildObjectNames is %v, want only %s", objects, object.ObjectName)
+	}
+
+	err = client.DeleteObject(t.Context(), object)
+	if err != nil {
+		t.Errorf("DeleteObject returned err: %v", err)
+	}
+
+	// deleting a missing object is not an error
+	err = client.DeleteObject(t.Context(), object)
+	if err != nil {
+		t.Errorf("DeleteObject returned err: %v", err)
+	}
+
+	objects, err = client.ListBuildObjectNames(t.Context(), "octocat", "hello-world", "2")
+	if err != nil {
+		t.Errorf("ListBuildObjectNames returned err: %v", err)
+	}
+
+	if len(objects) != 0 {
+		t.Errorf("ListBuildObjectNames is %v, want empty", objects)
+	}
+}
+
+func TestFilesystem_PresignedPutObject(t *testing.T) {
+	client, err := NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create filesystem client: %v", err)
+	}
+
+	putURL, err := client.PresignedPutObject(t.Context(), "octocat/hello-world/1/test.xml", time.Minute)
+	if err != nil {
+		t.Errorf("PresignedPutObject returned err: %v", err)
+	}
+
+	u, err := url.Parse(putURL)
+	if err != nil {
+		t.Fatalf("unable to parse URL: %v", err)
+	}
+
+	err = client.VerifySignature("PUT", "vela", "octocat/hello-world/1/test.xml", u.Query().Get(ExpiresParam), u.Query().Get(SignatureParam))
+	if err != nil {
+		t.Errorf("VerifySignature returned err: %v", err)
+	}
+
+	_, err = client.PresignedPutObject(t.Context(), "octocat/hello-world/1/test.xml", -time.Second)
+	if err == nil {
+		t.Errorf("PresignedPutObject should have returned err")
+	}
+
+	_, err = client.PresignedPutObject(t.Context(), "../test.xml", time.Minute)
+	if err == nil {
+		t.Errorf("PresignedPutObject should have returned err")
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClientOpt represents a configuration option to initialize the filesystem client.
+type ClientOpt func(*Client) error
+
+// WithBucket sets the bucket in the filesystem client.
+func WithBucket(bucket string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring bucket in filesystem client")
+
+		// check if the bucket name provided is empty
+		if len(bucket) == 0 {
+			return fmt.Errorf("no filesystem bucket name provided")
+		}
+
+		// check if the bucket name provided is a single path segment
+		if strings.ContainsAny(bucket, `/\`) || bucket == "." || bucket == ".." {
+			return fmt.Errorf("invalid filesystem bucket name %q", bucket)
+		}
+
+		// set the bucket name in the filesystem client
+		c.config.Bucket = bucket
+
+		return nil
+	}
+}
+
+// WithAddress sets the Vela server address used for signed URLs in the filesystem client.
+func WithAddress(address string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring address in filesystem client")
+
+		// check if the address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no filesystem address provided")
+		}
+
+		// set the address in the filesystem client
+		c.config.Address = strings.TrimSuffix(address, "/")
+
+		return nil
+	}
+}
+
+// WithSigningKey sets the key used to sign URLs in the filesystem client.
+func WithSigningKey(key string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring signing key in filesystem client")
+
+		// check if the signing key provided is empty
+		if len(key) == 0 {
+			return fmt.Errorf("no filesystem signing key provided")
+		}
+
+		// set the signing key in the filesystem client
+		c.config.SigningKey = key
+
+		return nil
+	}
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"testing"
+)
+
+func TestFilesystem_ClientOpt_WithAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+		want    string
+	}{
+		{
+			failure: false,
+			address: "http://localhost:8080",
+			want:    "http://localhost:8080",
+		},
+		{
+			failure: false,
+			address: "http://localhost:8080/",
+			want:    "http://localhost:8080",
+		},
+		{
+			failure: true,
+			address: "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		client, err := New(t.TempDir(), WithAddress(test.address))
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithAddress should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithAddress returned err: %v", err)
+		}
+
+		if client.config.Address != test.want {
+			t.Errorf("WithAddress is %v, want %v", client.config.Address, test.want)
+		}
+	}
+}
+
+func TestFilesystem_ClientOpt_WithSigningKey(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		key     string
+		want    string
+	}{
+		{
+			failure: false,
+			key:     "superSecretKey",
+			want:    "superSecretKey",
+		},
+		{
+			failure: true,
+			key:     "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		client, err := New(t.TempDir(), WithSigningKey(test.key))
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithSigningKey should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithSigningKey returned err: %v", err)
+		}
+
+		if client.config.SigningKey != test.want {
+			t.Errorf("WithSigningKey is %v, want %v", client.config.SigningKey, test.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// PresignedGetObject generates a signed URL, served by the
+// Vela server, for downloading an object.
+func (c *Client) PresignedGetObject(ctx context.Context, object *api.Object) (string, error) {
+	c.Logger.Tracef("generating presigned URL for object %s in bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	// make sure the object exists before generating the presigned URL
+	_, err := c.StatObject(ctx, object)
+	if err != nil {
+		return "", err
+	}
+
+	// The URL is valid for 2 minutes.
+	return c.signedURL(http.MethodGet, c.config.Bucket, object.ObjectName, 2*time.Minute), nil
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PresignedPutObject generates a signed URL, served by the
+// Vela server, for uploading an object.
+func (c *Client) PresignedPutObject(_ context.Context, path string, duration time.Duration) (string, error) {
+	c.Logger.Tracef("generating presigned PUT URL for object %s in bucket %s", path, c.config.Bucket)
+
+	if duration <= 0 {
+		return fmt.Sprintf("Unable to generate presigned URL for object %s", path), fmt.Errorf("invalid expiration %s", duration)
+	}
+
+	// make sure the object name is valid before signing it
+	_, err := c.path(c.config.Bucket, path)
+	if err != nil {
+		return fmt.Sprintf("Unable to generate presigned URL for object %s", path), err
+	}
+
+	return c.signedURL(http.MethodPut, c.config.Bucket, path, duration), nil
+}
+
+// UploadHeaders returns the headers that must accompany requests
+// to presigned PUT URLs, which are none for the filesystem.
+func (c *Client) UploadHeaders() http.Header {
+	return http.Header{}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// PathPrefix defines the server path that signed filesystem URLs are served from.
+	PathPrefix = "/_storage"
+
+	// ExpiresParam defines the query parameter holding the expiration of a signed URL.
+	ExpiresParam = "X-Vela-Expires"
+
+	// SignatureParam defines the query parameter holding the signature of a signed URL.
+	SignatureParam = "X-Vela-Signature"
+)
+
+var (
+	// ErrExpiredSignature defines the error type when a signed URL has expired.
+	ErrExpiredSignature = errors.New("signed URL has expired")
+
+	// ErrInvalidSignature defines the error type when a signed URL has an invalid signature.
+	ErrInvalidSignature = errors.New("signed URL has an invalid signature")
+)
+
+// sign is a helper function to compute the signature for an object operation.
+func (c *Client) sign(method, bucket, object string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(c.config.SigningKey))
+
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%d", strings.ToUpper(method), bucket, object, expires)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signedURL is a helper function to build a URL served by
+// the Vela server that grants temporary access to an object.
+func (c *Client) signedURL(method, bucket, object string, duration time.Duration) string {
+	expires := time.Now().Add(duration).Unix()
+
+	query := url.Values{}
+	query.Set(ExpiresParam, strconv.FormatInt(expires, 10))
+	query.Set(SignatureParam, c.sign(method, bucket, object, expires))
+
+	u := url.URL{
+		Path:     fmt.Sprintf("%s/%s/%s", PathPrefix, bucket, object),
+		RawQuery: query.Encode(),
+	}
+
+	return c.config.Address + u.String()
+}
+
+// VerifySignature checks that a signed URL for the object
+// operation is authentic and has not yet expired.
+func (c *Client) VerifySignature(method, bucket, object, expires, signature string) error {
+	c.Logger.Tracef("verifying signature for object %s in bucket %s", object, bucket)
+
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	want := c.sign(method, bucket, object, exp)
+
+	if !hmac.Equal([]byte(want), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	if time.Now().Unix() > exp {
+		return ErrExpiredSignature
+	}
+
+	return nil
+}
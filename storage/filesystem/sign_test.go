@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilesystem_VerifySignature(t *testing.T) {
+	client, err := NewTest(t.TempDir(), "http://localhost:8080", "vela")
+	if err != nil {
+		t.Fatalf("unable to create filesystem client: %v", err)
+	}
+
+	object := "octocat/hello-world/1/coverage.out"
+
+	signed, err := url.Parse(client.signedURL(http.MethodGet, "vela", object, time.Minute))
+	if err != nil {
+		t.Fatalf("unable to parse signed URL: %v", err)
+	}
+
+	if !strings.HasPrefix(signed.Path, PathPrefix+"/vela/") {
+		t.Errorf("signed URL path is %s, want prefix %s/vela/", signed.Path, PathPrefix)
+	}
+
+	expires := signed.Query().Get(ExpiresParam)
+	signature := signed.Query().Get(SignatureParam)
+
+	expired := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+
+	// setup tests
+	tests := []struct {
+		name      string
+		method    string
+		object    string
+		expires   string
+		signature string
+		want      error
+	}{
+		{name: "valid", method: http.MethodGet, object: object, expires: expires, signature: signature, want: nil},
+		{name: "wrong method", method: http.MethodPut, object: object, expires: expires, signature: signature, want: ErrInvalidSignature},
+		{name: "wrong object", method: http.MethodGet, object: "octocat/hello-world/2/coverage.out", expires: expires, signature: signature, want: ErrInvalidSignature},
+		{name: "tampered expiry", method: http.MethodGet, object: object, expires: "9999999999", signature: signature, want: ErrInvalidSignature},
+		{name: "invalid expiry", method: http.MethodGet, object: object, expires: "foo", signature: signature, want: ErrInvalidSignature},
+		{name: "expired", method: http.MethodGet, object: object, expires: expired, signature: client.sign(http.MethodGet, "vela", object, time.Now().Add(-time.Minute).Unix()), want: ErrExpiredSignature},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := client.VerifySignature(test.method, "vela", test.object, test.expires, test.signature)
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("VerifySignature is %v, want %v", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// StatObject retrieves the metadata of an object from the local filesystem.
+func (c *Client) StatObject(_ context.Context, object *api.Object) (*api.Object, error) {
+	c.Logger.Tracef("retrieving metadata for object %s from bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	path, err := c.path(object.Bucket.BucketName, object.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get object info %s from bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	if info.IsDir() {
+		return nil, fmt.Errorf("unable to get object info %s from bucket %s: object is a directory", object.ObjectName, object.Bucket.BucketName)
+	}
+
+	return &api.Object{
+		ObjectName:  object.ObjectName,
+		Bucket:      object.Bucket,
+		Size:        info.Size(),
+		ContentType: contentType(object.ObjectName),
+	}, nil
+}
+
+// contentType is a helper function to determine the
+// content type of an object from its file extension.
+func contentType(name string) string {
+	t := mime.TypeByExtension(filepath.Ext(name))
+	if len(t) == 0 {
+		return "application/octet-stream"
+	}
+
+	return t
+}
@@ -85,4 +85,42 @@ var Flags = []cli.Flag{
 		Value:   false,
 		Sources: cli.EnvVars("VELA_STORAGE_USE_SSL"),
 	},
+	&cli.StringFlag{
+		Name:  "storage.region",
+		Usage: "set storage bucket region",
+		Sources: cli.NewValueSourceChain(
+			cli.EnvVar("VELA_STORAGE_REGION"),
+			cli.File("vela/storage/region"),
+		),
+	},
+	&cli.BoolFlag{
+		Name:    "storage.path.style",
+		Usage:   "enable path-style addressing instead of virtual-hosted-style for the s3 driver",
+		Value:   false,
+		Sources: cli.EnvVars("VELA_STORAGE_PATH_STYLE"),
+	},
+	&cli.StringFlag{
+		Name:  "storage.kms.key.id",
+		Usage: "set the KMS key used for server-side encryption of objects uploaded with the s3 driver",
+		Sources: cli.NewValueSourceChain(
+			cli.EnvVar("VELA_STORAGE_KMS_KEY_ID"),
+			cli.File("vela/storage/kms_key_id"),
+		),
+	},
+	&cli.StringFlag{
+		Name:  "storage.filesystem.root",
+		Usage: "set the directory objects are stored in for the filesystem driver",
+		Sources: cli.NewValueSourceChain(
+			cli.EnvVar("VELA_STORAGE_FILESYSTEM_ROOT"),
+			cli.File("vela/storage/filesystem_root"),
+		),
+	},
+	&cli.StringFlag{
+		Name:  "storage.filesystem.signing.key",
+		Usage: "set the key used to sign object URLs served by the server for the filesystem driver",
+		Sources: cli.NewValueSourceChain(
+			cli.EnvVar("VELA_STORAGE_FILESYSTEM_SIGNING_KEY"),
+			cli.File("vela/storage/filesystem_signing_key"),
+		),
+	},
 }
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package minio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// DeleteObject removes an object from the MinIO storage.
+func (c *Client) DeleteObject(ctx context.Context, object *api.Object) error {
+	c.Logger.Tracef("deleting object %s from bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	err := c.client.RemoveObject(ctx, object.Bucket.BucketName, object.ObjectName, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to delete object %s from bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package minio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func Test_DeleteObject_Success(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	ctx, engine := gin.CreateTestContext(resp)
+
+	// mock bucket location call
+	engine.GET("/foo/", func(c *gin.Context) {
+		c.Header("Content-Type", "application/xml")
+		c.XML(http.StatusOK, gin.H{
+			"bucketName": "foo",
+		})
+	})
+	// mock remove object call
+	engine.DELETE("/foo/test.xml", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	fake := httptest.NewServer(engine)
+	defer fake.Close()
+
+	client, _ := NewTest(fake.URL, "miniokey", "miniosecret", "foo", false)
+
+	object := &api.Object{
+		ObjectName: "test.xml",
+		Bucket: api.Bucket{
+			BucketName: "foo",
+		},
+	}
+
+	// run test
+	err := client.DeleteObject(ctx, object)
+	if err != nil {
+		t.Errorf("DeleteObject returned err: %v", err)
+	}
+}
+
+func Test_DeleteObject_Failure(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	ctx, engine := gin.CreateTestContext(resp)
+
+	// mock remove object call
+	engine.DELETE("/foo/test.xml", func(c *gin.Context) {
+		c.Header("Content-Type", "application/xml")
+		c.XML(http.StatusInternalServerError, gin.H{
+			"error": "Internal Server Error",
+		})
+	})
+
+	fake := httptest.NewServer(engine)
+	defer fake.Close()
+
+	client, _ := NewTest(fake.URL, "miniokey", "miniosecret", "foo", false)
+
+	object := &api.Object{
+		ObjectName: "test.xml",
+		Bucket: api.Bucket{
+			BucketName: "foo",
+		},
+	}
+
+	// run test
+	err := client.DeleteObject(ctx, object)
+	if err == nil {
+		t.Errorf("DeleteObject should have returned err")
+	}
+}
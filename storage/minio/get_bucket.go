@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package minio
+
+// GetBucket returns the name of the bucket configured for the client.
+func (c *Client) GetBucket() string {
+	return c.config.Bucket
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package minio
+
+import (
+	"testing"
+)
+
+func TestMinio_GetBucket(t *testing.T) {
+	// setup types
+	client, err := NewTest(endpoint, _accessKey, _secretKey, _bucket, _useSSL)
+	if err != nil {
+		t.Errorf("unable to create minio client: %v", err)
+	}
+
+	// run test
+	got := client.GetBucket()
+
+	if got != _bucket {
+		t.Errorf("GetBucket is %v, want %v", got, _bucket)
+	}
+}
@@ -5,6 +5,7 @@ package minio
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 )
 
@@ -19,3 +20,9 @@ func (c *Client) PresignedPutObject(ctx context.Context, path string, durationSe
 
 	return presignedURL.String(), nil
 }
+
+// UploadHeaders returns the headers that must accompany requests
+// to presigned PUT URLs, which are none for MinIO.
+func (c *Client) UploadHeaders() http.Header {
+	return http.Header{}
+}
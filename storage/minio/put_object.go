@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package minio
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+
+	"github.com/go-vela/server/api/types"
+)
+
+// PutObject uploads an object to the MinIO storage.
+//
+// The size of the object is used when provided,
+// otherwise the object is uploaded in parts.
+func (c *Client) PutObject(ctx context.Context, object *types.Object, r io.Reader) error {
+	c.Logger.Tracef("uploading object %s to bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	size := object.Size
+	if size <= 0 {
+		size = -1
+	}
+
+	_, err := c.client.PutObject(ctx, object.Bucket.BucketName, object.ObjectName, r, size, minio.PutObjectOptions{
+		ContentType: object.ContentType,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to put object %s in bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package minio
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func Test_PutObject_Success(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	ctx, engine := gin.CreateTestContext(resp)
+
+	// mock bucket location call
+	engine.GET("/foo/", func(c *gin.Context) {
+		c.Header("Content-Type", "application/xml")
+		c.XML(http.StatusOK, gin.H{
+			"bucketName": "foo",
+		})
+	})
+	// mock put object call
+	engine.PUT("/foo/test.log", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+
+		if !strings.Contains(string(body), "hello") {
+			c.Status(http.StatusBadRequest)
+
+			return
+		}
+
+		c.Header("ETag", `"abc"`)
+		c.Status(http.StatusOK)
+	})
+
+	fake := httptest.NewServer(engine)
+	defer fake.Close()
+
+	client, _ := NewTest(fake.URL, "miniokey", "miniosecret", "foo", false)
+
+	object := &api.Object{
+		ObjectName: "test.log",
+		Bucket: api.Bucket{
+			BucketName: "foo",
+		},
+		Size:        5,
+		ContentType: "text/plain",
+	}
+
+	// run test
+	err := client.PutObject(ctx, object, strings.NewReader("hello"))
+	if err != nil {
+		t.Errorf("PutObject returned err: %v", err)
+	}
+}
+
+func Test_PutObject_Failure(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	ctx, engine := gin.CreateTestContext(resp)
+
+	// mock put object call
+	engine.PUT("/foo/test.log", func(c *gin.Context) {
+		c.Header("Content-Type", "application/xml")
+		c.XML(http.StatusInternalServerError, gin.H{
+			"error": "Internal Server Error",
+		})
+	})
+
+	fake := httptest.NewServer(engine)
+	defer fake.Close()
+
+	client, _ := NewTest(fake.URL, "miniokey", "miniosecret", "foo", false)
+
+	object := &api.Object{
+		ObjectName: "test.log",
+		Bucket: api.Bucket{
+			BucketName: "foo",
+		},
+		Size: 5,
+	}
+
+	// run test
+	err := client.PutObject(ctx, object, strings.NewReader("hello"))
+	if err == nil {
+		t.Errorf("PutObject should have returned err")
+	}
+}
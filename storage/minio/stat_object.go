@@ -23,6 +23,9 @@ func (c *Client) StatObject(ctx context.Context, object *types.Object) (*types.O
 
 	// Map MinIO object info to API object
 	return &types.Object{
-		ObjectName: info.Key,
+		ObjectName:  info.Key,
+		Bucket:      object.Bucket,
+		Size:        info.Size,
+		ContentType: info.ContentType,
 	}, nil
 }
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package s3 provides the ability for Vela to integrate
+// with any S3-compatible object storage, supporting both
+// path-style and virtual-hosted-style addressing and
+// server-side encryption with KMS managed keys.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/storage/s3"
+package s3
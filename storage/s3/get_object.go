@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// GetObject opens an object from the S3 bucket for reading.
+func (c *Client) GetObject(ctx context.Context, object *api.Object) (io.ReadCloser, error) {
+	c.Logger.Tracef("retrieving object %s from bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	obj, err := c.client.GetObject(ctx, object.Bucket.BucketName, object.ObjectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get object %s from bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return obj, nil
+}
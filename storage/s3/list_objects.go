@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ListBuildObjectNames lists the names of objects in a bucket for a specific build.
+func (c *Client) ListBuildObjectNames(ctx context.Context, org, repo, build string) (map[string]string, error) {
+	objectsWithURLs := make(map[string]string)
+	// Construct the prefix path for filtering
+	prefix := org + "/" + repo + "/" + build + "/"
+
+	c.Logger.Tracef("listing object names in bucket %s with prefix %s", c.config.Bucket, prefix)
+
+	opts := minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}
+
+	for object := range c.client.ListObjects(ctx, c.config.Bucket, opts) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+
+		// Generate presigned URL for each object
+		url, err := c.PresignedGetObject(ctx, &api.Object{
+			ObjectName: object.Key,
+			Bucket:     api.Bucket{BucketName: c.config.Bucket},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate presigned URL for object %s: %w", object.Key, err)
+		}
+
+		objectsWithURLs[object.Key] = url
+	}
+
+	return objectsWithURLs, nil
+}
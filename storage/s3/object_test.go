@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestS3_Objects(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.HEAD("/vela/octocat/hello-world/1/test.xml", func(c *gin.Context) {
+		c.Header("Content-Type", "application/xml")
+		c.Header("Content-Length", "42")
+		c.Header("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		c.Header("ETag", `"abc"`)
+		c.Status(http.StatusOK)
+	})
+
+	engine.GET("/vela/octocat/hello-world/1/test.xml", func(c *gin.Context) {
+		c.Header("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		c.Header("ETag", `"abc"`)
+		c.Data(http.StatusOK, "application/xml", []byte("<testsuites/>"))
+	})
+
+	engine.PUT("/vela/octocat/hello-world/1/test.xml", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+
+		if !strings.Contains(string(body), "<testsuites/>") {
+			c.Status(http.StatusBadRequest)
+
+			return
+		}
+
+		c.Header("ETag", `"abc"`)
+		c.Status(http.StatusOK)
+	})
+
+	engine.GET("/vela/", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/xml", []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult><Name>vela</Name><Prefix>octocat/hello-world/1/</Prefix><IsTruncated>false</IsTruncated>
+<Contents><Key>octocat/hello-world/1/test.xml</Key><Size>42</Size></Contents></ListBucketResult>`))
+	})
+
+	engine.DELETE("/vela/octocat/hello-world/1/test.xml", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	fake := httptest.NewServer(engine)
+	defer fake.Close()
+
+	client, err := NewTest(fake.URL, "s3key", "s3secret", "vela", true, "")
+	if err != nil {
+		t.Fatalf("New returned err: %v", err)
+	}
+
+	object := &api.Object{
+		ObjectName: "octocat/hello-world/1/test.xml",
+		Bucket:     api.Bucket{BucketName: "vela"},
+	}
+
+	err = client.PutObject(t.Context(), &api.Object{
+		ObjectName:  object.ObjectName,
+		Bucket:      object.Bucket,
+		Size:        13,
+		ContentType: "application/xml",
+	}, strings.NewReader("<testsuites/>"))
+	if err != nil {
+		t.Errorf("PutObject returned err: %v", err)
+	}
+
+	got, err := client.StatObject(t.Context(), object)
+	if err != nil {
+		t.Fatalf("StatObject returned err: %v", err)
+	}
+
+	if got.Size != 42 || got.ContentType != "application/xml" {
+		t.Errorf("StatObject is %+v", got)
+	}
+
+	r, err := client.GetObject(t.Context(), object)
+	if err != nil {
+		t.Fatalf("GetObject returned err: %v", err)
+	}
+
+	data, _ := io.ReadAll(r)
+	r.Close()
+
+	if string(data) != "<testsuites/>" {
+		t.Errorf("GetObject data is %s, want %s", data, "<testsuites/>")
+	}
+
+	url, err := client.PresignedGetObject(t.Context(), object)
+	if err != nil || len(url) == 0 {
+		t.Errorf("PresignedGetObject returned %q, %v", url, err)
+	}
+
+	objects, err := client.ListBuildObjectNames(t.Context(), "octocat", "hello-world", "1")
+	if err != nil {
+		t.Errorf("ListBuildObjectNames returned err: %v", err)
+	}
+
+	if _, ok := objects[object.ObjectName]; !ok {
+		t.Errorf("ListBuildObjectNames is %v, want %s", objects, object.ObjectName)
+	}
+
+	err = client.DeleteObject(t.Context(), object)
+	if err != nil {
+		t.Errorf("DeleteObject returned err: %v", err)
+	}
+
+	_, err = client.StatObject(t.Context(), &api.Object{
+		ObjectName: "octocat/hello-world/1/missing.xml",
+		Bucket:     api.Bucket{BucketName: "vela"},
+	})
+	if err == nil {
+		t.Errorf("StatObject should have returned err")
+	}
+}
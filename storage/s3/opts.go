@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"fmt"
+)
+
+// ClientOpt represents a configuration option to initialize the S3 client.
+type ClientOpt func(*Client) error
+
+// WithAccessKey sets the access key in the S3 client.
+func WithAccessKey(accessKey string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring access key in s3 client")
+
+		// check if the access key provided is empty
+		if len(accessKey) == 0 {
+			return fmt.Errorf("no S3 access key provided")
+		}
+
+		// set the access key in the s3 client
+		c.config.AccessKey = accessKey
+
+		return nil
+	}
+}
+
+// WithSecretKey sets the secret key in the S3 client.
+func WithSecretKey(secretKey string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring secret key in s3 client")
+
+		// check if the secret key provided is empty
+		if len(secretKey) == 0 {
+			return fmt.Errorf("no S3 secret key provided")
+		}
+
+		// set the secret key in the s3 client
+		c.config.SecretKey = secretKey
+
+		return nil
+	}
+}
+
+// WithToken sets the session token in the S3 client.
+func WithToken(token string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring token in s3 client")
+
+		// set the token in the s3 client
+		c.config.Token = token
+
+		return nil
+	}
+}
+
+// WithBucket sets the bucket in the S3 client.
+func WithBucket(bucket string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring bucket in s3 client")
+
+		// check if the bucket name provided is empty
+		if len(bucket) == 0 {
+			return fmt.Errorf("no S3 bucket name provided")
+		}
+
+		// set the bucket name in the s3 client
+		c.config.Bucket = bucket
+
+		return nil
+	}
+}
+
+// WithRegion sets the region in the S3 client.
+func WithRegion(region string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring region in s3 client")
+
+		// set the region in the s3 client
+		c.config.Region = region
+
+		return nil
+	}
+}
+
+// WithSecure sets the secure connection mode in the S3 client.
+func WithSecure(secure bool) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring secure connection mode in s3 client")
+
+		// set the secure connection mode in the s3 client
+		c.config.Secure = secure
+
+		return nil
+	}
+}
+
+// WithPathStyle sets the addressing style in the S3 client.
+func WithPathStyle(pathStyle bool) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring addressing style in s3 client")
+
+		// set the addressing style in the s3 client
+		c.config.PathStyle = pathStyle
+
+		return nil
+	}
+}
+
+// WithKMSKeyID sets the KMS key used for server-side encryption in the S3 client.
+func WithKMSKeyID(keyID string) ClientOpt {
+	return func(c *Client) error {
+		c.Logger.Trace("configuring KMS key in s3 client")
+
+		// set the KMS key in the s3 client
+		c.config.KMSKeyID = keyID
+
+		return nil
+	}
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// PresignedGetObject generates a presigned URL for downloading an object.
+func (c *Client) PresignedGetObject(ctx context.Context, object *api.Object) (string, error) {
+	c.Logger.Tracef("generating presigned URL for object %s in bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	// make sure the object exists before generating the presigned URL
+	_, err := c.StatObject(ctx, object)
+	if err != nil {
+		return "", err
+	}
+
+	// The URL is valid for 2 minutes.
+	presignedURL, err := c.client.PresignedGetObject(ctx, object.Bucket.BucketName, object.ObjectName, 2*time.Minute, nil)
+	if err != nil {
+		return fmt.Sprintf("Unable to generate presigned URL for object %s", object.ObjectName), err
+	}
+
+	return presignedURL.String(), nil
+}
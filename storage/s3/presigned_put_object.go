@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PresignedPutObject generates a presigned URL for uploading an object.
+//
+// When server-side encryption is configured, the encryption headers
+// are included in the signature so the upload must send the headers
+// returned by UploadHeaders.
+func (c *Client) PresignedPutObject(ctx context.Context, path string, duration time.Duration) (string, error) {
+	c.Logger.Tracef("generating presigned PUT URL for object %s in bucket %s", path, c.config.Bucket)
+
+	presignedURL, err := c.client.PresignHeader(ctx, http.MethodPut, c.config.Bucket, path, duration, nil, c.UploadHeaders())
+	if err != nil {
+		return fmt.Sprintf("Unable to generate presigned URL for object %s", path), err
+	}
+
+	return presignedURL.String(), nil
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestS3_PresignedPutObject(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name      string
+		pathStyle bool
+		kmsKeyID  string
+		wantHost  string
+		wantPath  string
+	}{
+		{
+			name:      "virtual-hosted",
+			pathStyle: false,
+			wantHost:  "vela.s3.us-east-1.amazonaws.com",
+			wantPath:  "/octocat/hello-world/1/test.xml",
+		},
+		{
+			name:      "path-style",
+			pathStyle: true,
+			wantHost:  "s3.us-east-1.amazonaws.com",
+			wantPath:  "/vela/octocat/hello-world/1/test.xml",
+		},
+		{
+			name:      "sse-kms",
+			pathStyle: true,
+			kmsKeyID:  "vela-key",
+			wantHost:  "s3.us-east-1.amazonaws.com",
+			wantPath:  "/vela/octocat/hello-world/1/test.xml",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := NewTest("https://s3.us-east-1.amazonaws.com", "s3key", "s3secret", "vela", test.pathStyle, test.kmsKeyID)
+			if err != nil {
+				t.Fatalf("New returned err: %v", err)
+			}
+
+			got, err := client.PresignedPutObject(t.Context(), "octocat/hello-world/1/test.xml", time.Minute)
+			if err != nil {
+				t.Fatalf("PresignedPutObject returned err: %v", err)
+			}
+
+			u, err := url.Parse(got)
+			if err != nil {
+				t.Fatalf("unable to parse URL: %v", err)
+			}
+
+			if u.Host != test.wantHost {
+				t.Errorf("PresignedPutObject host is %s, want %s", u.Host, test.wantHost)
+			}
+
+			if u.Path != test.wantPath {
+				t.Errorf("PresignedPutObject path is %s, want %s", u.Path, test.wantPath)
+			}
+
+			signed := u.Query().Get("X-Amz-SignedHeaders")
+			if encrypted := strings.Contains(signed, "x-amz-server-side-encryption"); encrypted != (len(test.kmsKeyID) > 0) {
+				t.Errorf("PresignedPutObject signed headers are %s", signed)
+			}
+		})
+	}
+}
+
+func TestS3_PresignedPutObject_Failure(t *testing.T) {
+	client, err := NewTest("https://s3.us-east-1.amazonaws.com", "s3key", "s3secret", "vela", true, "")
+	if err != nil {
+		t.Fatalf("New returned err: %v", err)
+	}
+
+	// pass a negative duration to trigger a validation error
+	_, err = client.PresignedPutObject(t.Context(), "octocat/hello-world/1/test.xml", -1*time.Second)
+	if err == nil {
+		t.Error("PresignedPutObject should have returned error")
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// PutObject uploads an object to the S3 bucket, applying the
+// server-side encryption configured for the client.
+//
+// The size of the object is used when provided,
+// otherwise the object is uploaded in parts.
+func (c *Client) PutObject(ctx context.Context, object *api.Object, r io.Reader) error {
+	c.Logger.Tracef("uploading object %s to bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	size := object.Size
+	if size <= 0 {
+		size = -1
+	}
+
+	_, err := c.client.PutObject(ctx, object.Bucket.BucketName, object.ObjectName, r, size, minio.PutObjectOptions{
+		ContentType:          object.ContentType,
+		ServerSideEncryption: c.sse,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to put object %s in bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/sirupsen/logrus"
+)
+
+// config holds the configuration for the S3 client.
+type config struct {
+	// specifies the access key for the S3 client
+	AccessKey string
+	// specifies the secret key for the S3 client
+	SecretKey string
+	// specifies the session token for the S3 client
+	Token string
+	// specifies the bucket objects are stored in
+	Bucket string
+	// specifies the region of the bucket
+	Region string
+	// specifies whether to connect to the endpoint over TLS
+	Secure bool
+	// specifies whether to use path-style addressing instead of virtual-hosted-style
+	PathStyle bool
+	// specifies the KMS key used for server-side encryption of uploaded objects
+	KMSKeyID string
+}
+
+// Client implements the Storage interface using a generic S3-compatible API.
+type Client struct {
+	config *config
+	client *minio.Client
+	// server-side encryption applied to uploaded objects
+	sse encrypt.ServerSide
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New creates a new S3 client for the provided endpoint.
+func New(endpoint string, opts ...ClientOpt) (*Client, error) {
+	// create new S3 client
+	c := new(Client)
+
+	// create new fields
+	c.config = new(config)
+
+	// create new logger for the client
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger).WithField("storage", "s3")
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if the bucket name provided is empty
+	if len(c.config.Bucket) == 0 {
+		return nil, fmt.Errorf("no S3 bucket name provided")
+	}
+
+	urlEndpoint, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(urlEndpoint.Host) == 0 {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", endpoint)
+	}
+
+	options := &minio.Options{
+		Creds:        credentials.NewStaticV4(c.config.AccessKey, c.config.SecretKey, c.config.Token),
+		Secure:       c.config.Secure,
+		Region:       c.config.Region,
+		BucketLookup: minio.BucketLookupDNS,
+	}
+
+	if c.config.PathStyle {
+		options.BucketLookup = minio.BucketLookupPath
+	}
+
+	if len(c.config.KMSKeyID) > 0 {
+		c.sse, err = encrypt.NewSSEKMS(c.config.KMSKeyID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to configure SSE-KMS: %w", err)
+		}
+	}
+
+	// create the S3 client from the provided endpoint and options
+	s3Client, err := minio.New(urlEndpoint.Host, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// always address the configured endpoint rather than its dualstack variant
+	s3Client.SetS3EnableDualstack(false)
+
+	c.client = s3Client
+
+	return c, nil
+}
+
+// NewTest returns a Storage implementation that
+// integrates with a local S3-compatible instance.
+//
+// This function is intended for running tests only.
+func NewTest(endpoint, accessKey, secretKey, bucket string, pathStyle bool, kmsKeyID string) (*Client, error) {
+	return New(endpoint,
+		WithAccessKey(accessKey),
+		WithSecretKey(secretKey),
+		WithBucket(bucket),
+		WithRegion("us-east-1"),
+		WithPathStyle(pathStyle),
+		WithKMSKeyID(kmsKeyID),
+	)
+}
+
+// UploadHeaders returns the headers that must accompany requests
+// to presigned PUT URLs, such as server-side encryption settings.
+func (c *Client) UploadHeaders() http.Header {
+	h := http.Header{}
+
+	if c.sse != nil {
+		c.sse.Marshal(h)
+	}
+
+	return h
+}
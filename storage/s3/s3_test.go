@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"testing"
+)
+
+func TestS3_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name      string
+		failure   bool
+		endpoint  string
+		accessKey string
+		bucket    string
+	}{
+		{name: "valid", failure: false, endpoint: "https://s3.us-east-1.amazonaws.com", accessKey: "s3key", bucket: "vela"},
+		{name: "empty endpoint", failure: true, endpoint: "", accessKey: "s3key", bucket: "vela"},
+		{name: "empty access key", failure: true, endpoint: "https://s3.us-east-1.amazonaws.com", accessKey: "", bucket: "vela"},
+		{name: "empty bucket", failure: true, endpoint: "https://s3.us-east-1.amazonaws.com", accessKey: "s3key", bucket: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewTest(test.endpoint, test.accessKey, "s3secret", test.bucket, false, "")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestS3_UploadHeaders(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		kmsKeyID string
+		want     string
+	}{
+		{name: "no encryption", kmsKeyID: "", want: ""},
+		{name: "sse-kms", kmsKeyID: "arn:aws:kms:us-east-1:123456789012:key/vela", want: "aws:kms"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := NewTest("https://s3.us-east-1.amazonaws.com", "s3key", "s3secret", "vela", false, test.kmsKeyID)
+			if err != nil {
+				t.Fatalf("New returned err: %v", err)
+			}
+
+			h := client.UploadHeaders()
+
+			if got := h.Get("X-Amz-Server-Side-Encryption"); got != test.want {
+				t.Errorf("UploadHeaders encryption is %q, want %q", got, test.want)
+			}
+
+			if len(test.kmsKeyID) > 0 && h.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id") != test.kmsKeyID {
+				t.Errorf("UploadHeaders key id is %q, want %q", h.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"), test.kmsKeyID)
+			}
+		})
+	}
+}
+
+func TestS3_GetBucket(t *testing.T) {
+	client, err := NewTest("https://s3.us-east-1.amazonaws.com", "s3key", "s3secret", "vela", false, "")
+	if err != nil {
+		t.Fatalf("New returned err: %v", err)
+	}
+
+	if got := client.GetBucket(); got != "vela" {
+		t.Errorf("GetBucket is %s, want %s", got, "vela")
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// StatObject retrieves the metadata of an object from the S3 bucket.
+func (c *Client) StatObject(ctx context.Context, object *api.Object) (*api.Object, error) {
+	c.Logger.Tracef("retrieving metadata for object %s from bucket %s", object.ObjectName, object.Bucket.BucketName)
+
+	info, err := c.client.StatObject(ctx, object.Bucket.BucketName, object.ObjectName, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get object info %s from bucket %s: %w", object.ObjectName, object.Bucket.BucketName, err)
+	}
+
+	return &api.Object{
+		ObjectName:  info.Key,
+		Bucket:      object.Bucket,
+		Size:        info.Size,
+		ContentType: info.ContentType,
+	}, nil
+}
@@ -4,6 +4,8 @@ package storage
 
 import (
 	"context"
+	"io"
+	"net/http"
 	"time"
 
 	api "github.com/go-vela/server/api/types"
@@ -11,8 +13,13 @@ import (
 
 // Storage defines the service interface for object storage operations.
 type Storage interface {
+	GetBucket() string
+	DeleteObject(context.Context, *api.Object) error
+	GetObject(context.Context, *api.Object) (io.ReadCloser, error)
 	StatObject(context.Context, *api.Object) (*api.Object, error)
 	ListBuildObjectNames(context.Context, string, string, string) (map[string]string, error)
 	PresignedGetObject(context.Context, *api.Object) (string, error)
 	PresignedPutObject(context.Context, string, time.Duration) (string, error)
+	PutObject(context.Context, *api.Object, io.Reader) error
+	UploadHeaders() http.Header
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/go-vela/server/constants"
+	"github.com/go-vela/server/storage/filesystem"
 	"github.com/go-vela/server/storage/minio"
+	"github.com/go-vela/server/storage/s3"
 )
 
 // Setup represents the configuration necessary for
@@ -25,6 +27,15 @@ type Setup struct {
 	Region    string
 	Secure    bool
 	Token     string
+
+	// S3 specific configuration
+	PathStyle bool
+	KMSKeyID  string
+
+	// filesystem specific configuration
+	Root       string
+	Address    string
+	SigningKey string
 }
 
 // Minio creates and returns a Vela service capable
@@ -44,6 +55,33 @@ func (s *Setup) Minio() (Storage, error) {
 	)
 }
 
+// S3 creates and returns a Vela service capable
+// of integrating with a generic S3-compatible environment.
+func (s *Setup) S3() (Storage, error) {
+	return s3.New(
+		s.Endpoint,
+		s3.WithAccessKey(s.AccessKey),
+		s3.WithSecretKey(s.SecretKey),
+		s3.WithToken(s.Token),
+		s3.WithBucket(s.Bucket),
+		s3.WithRegion(s.Region),
+		s3.WithSecure(s.Secure),
+		s3.WithPathStyle(s.PathStyle),
+		s3.WithKMSKeyID(s.KMSKeyID),
+	)
+}
+
+// Filesystem creates and returns a Vela service capable
+// of integrating with the local filesystem of the server.
+func (s *Setup) Filesystem() (Storage, error) {
+	return filesystem.New(
+		s.Root,
+		filesystem.WithBucket(s.Bucket),
+		filesystem.WithAddress(s.Address),
+		filesystem.WithSigningKey(s.SigningKey),
+	)
+}
+
 // Validate verifies the necessary fields for the
 // provided configuration are populated correctly.
 func (s *Setup) Validate() error {
@@ -51,25 +89,38 @@ func (s *Setup) Validate() error {
 
 	// storage disabled: nothing to validate
 	if s.Enable {
-		if s.Driver != "" && s.Driver != constants.DriverMinio {
-			return fmt.Errorf("storage driver should be set to %s (got %q)",
-				constants.DriverMinio, s.Driver)
-		}
-
 		if s.Bucket == "" {
 			return fmt.Errorf("storage is enabled but no bucket provided")
 		}
 
-		if s.Endpoint == "" {
-			return fmt.Errorf("storage is enabled but no endpoint provided")
-		}
+		switch s.Driver {
+		case constants.DriverFilesystem:
+			if s.Root == "" {
+				return fmt.Errorf("storage is enabled but no filesystem root provided")
+			}
 
-		if s.AccessKey == "" || s.SecretKey == "" {
-			return fmt.Errorf("storage is enabled but no access key or secret key provided")
-		}
+			if s.SigningKey == "" {
+				return fmt.Errorf("storage is enabled but no filesystem signing key provided")
+			}
+
+			if _, err := url.ParseRequestURI(s.Address); err != nil {
+				return fmt.Errorf("storage is enabled but server address is invalid")
+			}
+		case "", constants.DriverMinio, constants.DriverS3:
+			if s.Endpoint == "" {
+				return fmt.Errorf("storage is enabled but no endpoint provided")
+			}
+
+			if s.AccessKey == "" || s.SecretKey == "" {
+				return fmt.Errorf("storage is enabled but no access key or secret key provided")
+			}
 
-		if _, err := url.ParseRequestURI(s.Endpoint); err != nil {
-			return fmt.Errorf("storage is enabled but endpoint is invalid")
+			if _, err := url.ParseRequestURI(s.Endpoint); err != nil {
+				return fmt.Errorf("storage is enabled but endpoint is invalid")
+			}
+		default:
+			return fmt.Errorf("storage driver should be one of %s, %s or %s (got %q)",
+				constants.DriverMinio, constants.DriverS3, constants.DriverFilesystem, s.Driver)
 		}
 	}
 
@@ -29,6 +29,50 @@ func TestSetup_Minio(t *testing.T) {
 	}
 }
 
+func TestSetup_S3(t *testing.T) {
+	setup := &Setup{
+		Enable:    true,
+		Driver:    constants.DriverS3,
+		Endpoint:  "https://s3.us-east-1.amazonaws.com",
+		AccessKey: "storage-access-key",
+		SecretKey: "storage-secret-key",
+		Bucket:    "bucket-name",
+		Region:    "us-east-1",
+		Secure:    true,
+		PathStyle: true,
+		KMSKeyID:  "storage-kms-key",
+	}
+
+	storageClient, err := setup.S3()
+	if err != nil {
+		t.Errorf("unable to create s3 client: %v", err)
+	}
+
+	if storageClient == nil {
+		t.Error("expected s3 client, got nil")
+	}
+}
+
+func TestSetup_Filesystem(t *testing.T) {
+	setup := &Setup{
+		Enable:     true,
+		Driver:     constants.DriverFilesystem,
+		Bucket:     "bucket-name",
+		Root:       t.TempDir(),
+		Address:    "http://vela.example.com",
+		SigningKey: "storage-signing-key",
+	}
+
+	storageClient, err := setup.Filesystem()
+	if err != nil {
+		t.Errorf("unable to create filesystem client: %v", err)
+	}
+
+	if storageClient == nil {
+		t.Error("expected filesystem client, got nil")
+	}
+}
+
 func TestSetup_Validate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -95,6 +139,75 @@ func TestSetup_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid s3 config",
+			setup: &Setup{
+				Enable:    true,
+				Driver:    constants.DriverS3,
+				Endpoint:  "https://s3.us-east-1.amazonaws.com",
+				AccessKey: "storage-access-key",
+				SecretKey: "storage-secret-key",
+				Bucket:    "bucket-name",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid filesystem config",
+			setup: &Setup{
+				Enable:     true,
+				Driver:     constants.DriverFilesystem,
+				Bucket:     "bucket-name",
+				Root:       "/var/lib/vela",
+				Address:    "http://vela.example.com",
+				SigningKey: "storage-signing-key",
+			},
+			wantErr: false,
+		},
+		{
+			name: "filesystem missing root",
+			setup: &Setup{
+				Enable:     true,
+				Driver:     constants.DriverFilesystem,
+				Bucket:     "bucket-name",
+				Address:    "http://vela.example.com",
+				SigningKey: "storage-signing-key",
+			},
+			wantErr: true,
+		},
+		{
+			name: "filesystem missing signing key",
+			setup: &Setup{
+				Enable:  true,
+				Driver:  constants.DriverFilesystem,
+				Bucket:  "bucket-name",
+				Root:    "/var/lib/vela",
+				Address: "http://vela.example.com",
+			},
+			wantErr: true,
+		},
+		{
+			name: "filesystem invalid address",
+			setup: &Setup{
+				Enable:     true,
+				Driver:     constants.DriverFilesystem,
+				Bucket:     "bucket-name",
+				Root:       "/var/lib/vela",
+				SigningKey: "storage-signing-key",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid driver",
+			setup: &Setup{
+				Enable:    true,
+				Driver:    "invalid-driver",
+				Endpoint:  "http://example.com",
+				AccessKey: "storage-access-key",
+				SecretKey: "storage-secret-key",
+				Bucket:    "bucket-name",
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid endpoint URL",
 			setup: &Setup{
@@ -15,6 +15,8 @@ import (
 // Currently, the following storages are supported:
 //
 // * minio
+// * s3
+// * filesystem
 // .
 func New(s *Setup) (Storage, error) {
 	// validate the setup being provided
@@ -34,6 +36,16 @@ func New(s *Setup) (Storage, error) {
 			//
 			// https://pkg.go.dev/github.com/go-vela/server/storage?tab=doc#Setup.Minio
 			return s.Minio()
+		case constants.DriverS3:
+			// handle the storage driver being provided
+			//
+			// https://pkg.go.dev/github.com/go-vela/server/storage?tab=doc#Setup.S3
+			return s.S3()
+		case constants.DriverFilesystem:
+			// handle the storage driver being provided
+			//
+			// https://pkg.go.dev/github.com/go-vela/server/storage?tab=doc#Setup.Filesystem
+			return s.Filesystem()
 		default:
 			// handle an invalid storage driver being provided
 			return nil, fmt.Errorf("invalid storage driver provided: %s", s.Driver)
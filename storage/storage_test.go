@@ -27,6 +27,31 @@ func TestStorage_New(t *testing.T) {
 				Secure:    true,
 			},
 		},
+		{
+			name:    "valid-s3-config",
+			failure: false,
+			setup: &Setup{
+				Driver:    constants.DriverS3,
+				Enable:    true,
+				Endpoint:  "https://s3.us-east-1.amazonaws.com",
+				AccessKey: "storage-access-key",
+				SecretKey: "storage-secret-key",
+				Bucket:    "bucket-name",
+				Secure:    true,
+			},
+		},
+		{
+			name:    "valid-filesystem-config",
+			failure: false,
+			setup: &Setup{
+				Driver:     constants.DriverFilesystem,
+				Enable:     true,
+				Bucket:     "bucket-name",
+				Root:       t.TempDir(),
+				Address:    "http://vela.example.com",
+				SigningKey: "storage-signing-key",
+			},
+		},
 		{
 			name:    "invalid-driver",
 			failure: true,